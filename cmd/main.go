@@ -1,11 +1,17 @@
 package main
 
 import (
+	"os"
+
 	"github.com.br/gibranct/simplified-wallet/internal/app/server"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "reconcile" {
+		server.Reconcile()
+		return
+	}
 	server.Run()
 }
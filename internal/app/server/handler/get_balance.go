@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type BalanceResponse struct {
+	UserID   string                    `json:"user_id"`
+	At       *time.Time                `json:"at,omitempty"`
+	Balances []CurrencyBalanceResponse `json:"balances"`
+}
+
+// CurrencyBalanceResponse leaves out the held and available amounts of past
+// balances, which are only derived from the ledger.
+type CurrencyBalanceResponse struct {
+	Currency         string `json:"currency"`
+	LedgerBalance    string `json:"ledger_balance"`
+	Held             string `json:"held,omitempty"`
+	AvailableBalance string `json:"available_balance,omitempty"`
+}
+
+// GetBalance returns the balances of a user in each currency, either the
+// current ones or, with at as an RFC 3339 date, the ones as of that moment.
+func (h handler) GetBalance(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetBalance")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	input := usecase.GetBalanceInput{UserID: userID}
+	if value := r.URL.Query().Get("at"); value != "" {
+		at, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid at"}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+		input.At = at.UTC()
+	}
+
+	balances, err := h.getBalance.Execute(ctx, input)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, errs.ErrUserNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, errs.ErrBalanceTimeInFuture):
+			status = http.StatusUnprocessableEntity
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	response := BalanceResponse{
+		UserID:   balances.UserID,
+		Balances: make([]CurrencyBalanceResponse, 0, len(balances.Balances)),
+	}
+	if !balances.At.IsZero() {
+		response.At = &balances.At
+	}
+	for _, balance := range balances.Balances {
+		item := CurrencyBalanceResponse{
+			Currency:      balance.Currency,
+			LedgerBalance: h.formatAmount(balance.Ledger),
+		}
+		if balances.At.IsZero() {
+			item.Held = h.formatAmount(balance.Held)
+			item.AvailableBalance = h.formatAmount(balance.Available)
+		}
+		response.Balances = append(response.Balances, item)
+	}
+
+	err = h.writeJson(w, http.StatusOK, response, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("user.id", userID.String()))
+}
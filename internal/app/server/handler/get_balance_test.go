@@ -0,0 +1,135 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type GetBalanceMock struct {
+	mock.Mock
+}
+
+func (m *GetBalanceMock) Execute(ctx context.Context, input usecase.GetBalanceInput) (*usecase.UserBalances, error) {
+	args := m.Called(ctx, input)
+	balances, _ := args.Get(0).(*usecase.UserBalances)
+	return balances, args.Error(1)
+}
+
+func TestGetBalance_CurrentBalance_ShouldReturn200WithHeldAndAvailable(t *testing.T) {
+	// Arrange
+	userID := uuid.New()
+	balanceMock := &GetBalanceMock{}
+	balanceMock.On("Execute", mock.Anything, usecase.GetBalanceInput{UserID: userID}).Return(&usecase.UserBalances{
+		UserID:   userID.String(),
+		Balances: []usecase.UserBalance{{Currency: "BRL", Ledger: 10000, Held: 2550, Available: 7450}},
+	}, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGetBalance(balanceMock))
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID.String()+"/balance", nil)
+	r = withURLParams(r, map[string]string{"id": userID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetBalance(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body handler.BalanceResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, userID.String(), body.UserID)
+	assert.Nil(t, body.At)
+	assert.Equal(t, []handler.CurrencyBalanceResponse{
+		{Currency: "BRL", LedgerBalance: "100.00", Held: "25.50", AvailableBalance: "74.50"},
+	}, body.Balances)
+	balanceMock.AssertExpectations(t)
+}
+
+func TestGetBalance_PastBalance_ShouldReturn200WithTheLedgerBalanceOnly(t *testing.T) {
+	// Arrange
+	userID := uuid.New()
+	at := time.Date(2026, 3, 10, 17, 30, 0, 0, time.UTC)
+	balanceMock := &GetBalanceMock{}
+	balanceMock.On("Execute", mock.Anything, usecase.GetBalanceInput{UserID: userID, At: at}).Return(&usecase.UserBalances{
+		UserID:   userID.String(),
+		At:       at,
+		Balances: []usecase.UserBalance{{Currency: "BRL", Ledger: 4200}},
+	}, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGetBalance(balanceMock))
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID.String()+"/balance?at=2026-03-10T14:30:00-03:00", nil)
+	r = withURLParams(r, map[string]string{"id": userID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetBalance(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "2026-03-10T17:30:00Z", body["at"])
+	assert.Equal(t, []any{map[string]any{"currency": "BRL", "ledger_balance": "42.00"}}, body["balances"])
+	balanceMock.AssertExpectations(t)
+}
+
+func TestGetBalance_InvalidAt_ShouldReturn400(t *testing.T) {
+	// Arrange
+	userID := uuid.NewString()
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGetBalance(&GetBalanceMock{}))
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID+"/balance?at=yesterday", nil)
+	r = withURLParams(r, map[string]string{"id": userID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetBalance(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func TestGetBalance_FutureAt_ShouldReturn422(t *testing.T) {
+	// Arrange
+	userID := uuid.NewString()
+	balanceMock := &GetBalanceMock{}
+	balanceMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrBalanceTimeInFuture)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGetBalance(balanceMock))
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID+"/balance?at=2999-01-01T00:00:00Z", nil)
+	r = withURLParams(r, map[string]string{"id": userID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetBalance(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+func TestGetBalance_UserNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	userID := uuid.NewString()
+	balanceMock := &GetBalanceMock{}
+	balanceMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrUserNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGetBalance(balanceMock))
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID+"/balance", nil)
+	r = withURLParams(r, map[string]string{"id": userID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetBalance(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// statementContentTypes maps the formats statements are exported in to
+// their media types.
+var statementContentTypes = map[string]string{
+	"csv": "text/csv; charset=utf-8",
+	"ofx": "application/x-ofx",
+	"pdf": "application/pdf",
+}
+
+// GetStatement exports the statement of a user for a period as a CSV, OFX or
+// PDF file, which is streamed as the transactions are read.
+func (h handler) GetStatement(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetStatement")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	input, err := h.readExportStatementQuery(r.URL.Query())
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+	input.UserID = userID
+
+	file := &statementResponseWriter{
+		w:           w,
+		contentType: statementContentTypes[input.Format],
+		filename:    fmt.Sprintf("statement-%s-%s.%s", input.From.Format("20060102"), input.To.Format("20060102"), input.Format),
+	}
+	err = h.exportStatement.Execute(ctx, input, file)
+	if err != nil && file.started {
+		// The status was already sent, the client gets a truncated file
+		h.logger.Println(err)
+		return
+	}
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, errs.ErrUserNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, errs.ErrUnsupportedStatementFormat):
+			status = http.StatusBadRequest
+		case errors.Is(err, errs.ErrStatementPeriodRequired),
+			errors.Is(err, errs.ErrInvalidStatementDateRange),
+			errors.Is(err, errs.ErrUnsupportedCurrency):
+			status = http.StatusUnprocessableEntity
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("user.id", userID.String()), attribute.String("statement.format", input.Format))
+}
+
+// readExportStatementQuery reads the format, the period as RFC 3339 dates and
+// the currency of an exported statement from the query string.
+func (h handler) readExportStatementQuery(query url.Values) (usecase.ExportStatementInput, error) {
+	input := usecase.ExportStatementInput{
+		Format:   query.Get("format"),
+		Currency: query.Get("currency"),
+	}
+	if _, ok := statementContentTypes[input.Format]; !ok {
+		return input, errs.ErrUnsupportedStatementFormat
+	}
+	for name, date := range map[string]*time.Time{"from": &input.From, "to": &input.To} {
+		if value := query.Get(name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return input, fmt.Errorf("invalid %s", name)
+			}
+			*date = parsed.UTC()
+		}
+	}
+	return input, nil
+}
+
+// statementResponseWriter sends the headers of the exported file along with
+// its first bytes, so errors found before anything is written can still be
+// answered with a JSON error.
+type statementResponseWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (sw *statementResponseWriter) Write(p []byte) (int, error) {
+	if !sw.started {
+		sw.started = true
+		sw.w.Header().Set("Content-Type", sw.contentType)
+		sw.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", sw.filename))
+		sw.w.WriteHeader(http.StatusOK)
+	}
+	return sw.w.Write(p)
+}
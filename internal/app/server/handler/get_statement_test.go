@@ -0,0 +1,115 @@
+package handler_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetStatement_ValidRequest_ShouldStreamTheFileAsAnAttachment(t *testing.T) {
+	// Arrange
+	userID := uuid.New()
+	exportMock := &ExportStatementMock{}
+	exportMock.On(
+		"Execute",
+		mock.Anything,
+		usecase.ExportStatementInput{
+			UserID: userID,
+			Format: "csv",
+			From:   time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC),
+			To:     time.Date(2026, 11, 1, 3, 0, 0, 0, time.UTC),
+		},
+		mock.Anything,
+	).Run(func(args mock.Arguments) {
+		_, _ = args.Get(2).(io.Writer).Write([]byte("date,transaction_id\n"))
+	}).Return(nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithExportStatement(exportMock))
+
+	query := "?format=csv&from=2026-10-01T00:00:00-03:00&to=2026-11-01T00:00:00-03:00"
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID.String()+"/statements"+query, nil)
+	r = withURLParams(r, map[string]string{"id": userID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetStatement(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="statement-20261001-20261101.csv"`, resp.Header.Get("Content-Disposition"))
+	assert.Equal(t, "date,transaction_id\n", w.Body.String())
+	exportMock.AssertExpectations(t)
+}
+
+func TestGetStatement_InvalidQuery_ShouldReturn400(t *testing.T) {
+	userID := uuid.NewString()
+	for _, query := range []string{"?from=2026-10-01T00:00:00Z&to=2026-11-01T00:00:00Z", "?format=xlsx", "?format=pdf&from=yesterday"} {
+		// Arrange
+		h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithExportStatement(&ExportStatementMock{}))
+		r, _ := http.NewRequest("GET", "/v1/users/"+userID+"/statements"+query, nil)
+		r = withURLParams(r, map[string]string{"id": userID})
+		w := httptest.NewRecorder()
+
+		// Act
+		h.GetStatement(w, r)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode, query)
+	}
+}
+
+func TestGetStatement_MissingPeriod_ShouldReturn422(t *testing.T) {
+	// Arrange
+	userID := uuid.NewString()
+	exportMock := &ExportStatementMock{}
+	exportMock.On("Execute", mock.Anything, mock.Anything, mock.Anything).Return(errs.ErrStatementPeriodRequired)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithExportStatement(exportMock))
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID+"/statements?format=ofx", nil)
+	r = withURLParams(r, map[string]string{"id": userID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetStatement(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+	assert.Equal(t, "application/json", w.Result().Header.Get("Content-Type"))
+}
+
+func TestGetStatement_UserNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	userID := uuid.NewString()
+	exportMock := &ExportStatementMock{}
+	exportMock.On("Execute", mock.Anything, mock.Anything, mock.Anything).Return(errs.ErrUserNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithExportStatement(exportMock))
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID+"/statements?format=pdf&from=2026-10-01T00:00:00Z&to=2026-11-01T00:00:00Z", nil)
+	r = withURLParams(r, map[string]string{"id": userID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetStatement(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+type ExportStatementMock struct {
+	mock.Mock
+}
+
+func (m *ExportStatementMock) Execute(ctx context.Context, input usecase.ExportStatementInput, w io.Writer) error {
+	args := m.Called(ctx, input, w)
+	return args.Error(0)
+}
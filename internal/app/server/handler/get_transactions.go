@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// StatementEntryResponse is a transaction in the statement of a user. Amount
+// is signed, negative for what the user sent, and Balance is the balance of
+// the user in Currency right after the transaction.
+type StatementEntryResponse struct {
+	TransactionID         string    `json:"transaction_id"`
+	Kind                  string    `json:"kind"`
+	Direction             string    `json:"direction"`
+	CounterpartyID        string    `json:"counterparty_id"`
+	Amount                string    `json:"amount"`
+	Currency              string    `json:"currency"`
+	Fee                   string    `json:"fee,omitempty"`
+	Balance               string    `json:"balance"`
+	OriginalTransactionID string    `json:"original_transaction_id,omitempty"`
+	CreatedAt             time.Time `json:"created_at"`
+}
+
+// GetTransactions lists the transactions sent and received by a user, from
+// the most recent, one page at a time.
+func (h handler) GetTransactions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetTransactions")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	input, err := h.readStatementQuery(r.URL.Query())
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+	input.UserID = userID
+
+	page, err := h.listTransactions.Execute(ctx, input)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, errs.ErrUserNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, errs.ErrInvalidStatementDirection),
+			errors.Is(err, errs.ErrInvalidStatementDateRange),
+			errors.Is(err, errs.ErrInvalidStatementAmountRange),
+			errors.Is(err, errs.ErrInvalidStatementCursor),
+			errors.Is(err, errs.ErrUnsupportedCurrency):
+			status = http.StatusUnprocessableEntity
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	response := make([]StatementEntryResponse, 0, len(page.Entries))
+	for _, entry := range page.Entries {
+		transaction := entry.Transaction()
+		item := StatementEntryResponse{
+			TransactionID:         transaction.ID(),
+			Kind:                  transaction.Kind(),
+			Direction:             entry.Direction(),
+			CounterpartyID:        entry.CounterpartyID(),
+			Amount:                h.formatAmount(entry.Amount()),
+			Currency:              entry.Currency(),
+			Balance:               h.formatAmount(entry.Balance()),
+			OriginalTransactionID: transaction.OriginalTransactionID(),
+			CreatedAt:             transaction.CreatedAt(),
+		}
+		if entry.Direction() == entity.ReceivedStatementDirection && transaction.Fee() > 0 {
+			item.Fee = h.formatAmount(transaction.Fee())
+		}
+		response = append(response, item)
+	}
+
+	body := envelope{"transactions": response}
+	if page.NextCursor != "" {
+		body["next_cursor"] = page.NextCursor
+	}
+	err = h.writeJson(w, http.StatusOK, body, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("user.id", userID.String()))
+}
+
+// readStatementQuery reads the filters and the page of a statement from the
+// query string: from and to as RFC 3339 dates, direction, counterparty_id,
+// currency, min_amount and max_amount as decimals, cursor and limit.
+func (h handler) readStatementQuery(query url.Values) (usecase.ListTransactionsInput, error) {
+	var input usecase.ListTransactionsInput
+	for name, date := range map[string]*time.Time{"from": &input.Filter.From, "to": &input.Filter.To} {
+		if value := query.Get(name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return input, fmt.Errorf("invalid %s", name)
+			}
+			*date = parsed.UTC()
+		}
+	}
+	for name, amount := range map[string]*int64{"min_amount": &input.Filter.MinAmount, "max_amount": &input.Filter.MaxAmount} {
+		if value := query.Get(name); value != "" {
+			cents, err := h.parseAmount(json.Number(value))
+			if err != nil {
+				return input, fmt.Errorf("invalid %s", name)
+			}
+			*amount = cents
+		}
+	}
+	if value := query.Get("counterparty_id"); value != "" {
+		counterpartyID, err := uuid.Parse(value)
+		if err != nil {
+			return input, errors.New("invalid counterparty_id")
+		}
+		input.Filter.CounterpartyID = counterpartyID.String()
+	}
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			return input, errors.New("invalid limit")
+		}
+		input.Limit = limit
+	}
+	input.Filter.Direction = query.Get("direction")
+	input.Filter.Currency = query.Get("currency")
+	input.Cursor = query.Get("cursor")
+	return input, nil
+}
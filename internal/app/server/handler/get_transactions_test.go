@@ -0,0 +1,134 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTransactions_ValidRequest_ShouldReturn200WithSignedAmountsAndCursor(t *testing.T) {
+	// Arrange
+	userID := uuid.New()
+	transaction, err := entity.NewTransaction(1050, userID.String(), aliasKeyReceiverID)
+	require.NoError(t, err)
+	listMock := &ListTransactionsMock{}
+	listMock.On(
+		"Execute",
+		mock.Anything,
+		usecase.ListTransactionsInput{
+			UserID: userID,
+			Filter: entity.StatementFilter{
+				From:           time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC),
+				Direction:      "sent",
+				CounterpartyID: aliasKeyReceiverID,
+				MinAmount:      1000,
+			},
+			Cursor: "abc",
+			Limit:  10,
+		},
+	).Return(&usecase.StatementPage{
+		Entries:    []*entity.StatementEntry{entity.NewStatementEntry(transaction, userID.String(), 8950)},
+		NextCursor: "next",
+	}, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListTransactions(listMock))
+
+	query := "?from=2025-03-01T00:00:00-03:00&direction=sent&counterparty_id=" + aliasKeyReceiverID + "&min_amount=10&cursor=abc&limit=10"
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID.String()+"/transactions"+query, nil)
+	r = withURLParams(r, map[string]string{"id": userID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetTransactions(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Transactions []handler.StatementEntryResponse `json:"transactions"`
+		NextCursor   string                           `json:"next_cursor"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	require.Len(t, body.Transactions, 1)
+	assert.Equal(t, transaction.ID(), body.Transactions[0].TransactionID)
+	assert.Equal(t, "sent", body.Transactions[0].Direction)
+	assert.Equal(t, "-10.50", body.Transactions[0].Amount)
+	assert.Equal(t, "89.50", body.Transactions[0].Balance)
+	assert.Equal(t, "next", body.NextCursor)
+	listMock.AssertExpectations(t)
+}
+
+func TestGetTransactions_InvalidQuery_ShouldReturn400(t *testing.T) {
+	userID := uuid.NewString()
+	for _, query := range []string{"?from=yesterday", "?min_amount=1.005", "?counterparty_id=someone", "?limit=0"} {
+		// Arrange
+		h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListTransactions(&ListTransactionsMock{}))
+		r, _ := http.NewRequest("GET", "/v1/users/"+userID+"/transactions"+query, nil)
+		r = withURLParams(r, map[string]string{"id": userID})
+		w := httptest.NewRecorder()
+
+		// Act
+		h.GetTransactions(w, r)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode, query)
+	}
+}
+
+func TestGetTransactions_InvalidCursor_ShouldReturn422(t *testing.T) {
+	// Arrange
+	userID := uuid.NewString()
+	listMock := &ListTransactionsMock{}
+	listMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrInvalidStatementCursor)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListTransactions(listMock))
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID+"/transactions?cursor=abc", nil)
+	r = withURLParams(r, map[string]string{"id": userID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetTransactions(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+func TestGetTransactions_UserNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	userID := uuid.NewString()
+	listMock := &ListTransactionsMock{}
+	listMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrUserNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListTransactions(listMock))
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID+"/transactions", nil)
+	r = withURLParams(r, map[string]string{"id": userID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetTransactions(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+type ListTransactionsMock struct {
+	mock.Mock
+}
+
+func (m *ListTransactionsMock) Execute(ctx context.Context, input usecase.ListTransactionsInput) (*usecase.StatementPage, error) {
+	args := m.Called(ctx, input)
+	page, _ := args.Get(0).(*usecase.StatementPage)
+	return page, args.Error(1)
+}
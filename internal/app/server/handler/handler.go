@@ -3,18 +3,73 @@ package handler
 import (
 	"context"
 	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"io"
 	"log"
 
 	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
 )
 
 type handler struct {
-	createTransaction ICreateTransaction
-	createUser        ICreateUser
-	otel              telemetry.Telemetry
-	logger            *log.Logger
+	createTransaction          ICreateTransaction
+	createUser                 ICreateUser
+	refundTransaction          IRefundTransaction
+	createDeposit              ICreateDeposit
+	registerPayoutDestination  IRegisterPayoutDestination
+	createWithdrawal           ICreateWithdrawal
+	settleWithdrawal           ISettleWithdrawal
+	payoutResultSecret         []byte
+	scheduleTransfer           IScheduleTransfer
+	listScheduledTransfers     IListScheduledTransfers
+	cancelScheduledTransfer    ICancelScheduledTransfer
+	createMandate              ICreateMandate
+	listMandates               IListMandates
+	listMandateExecutions      IListMandateExecutions
+	changeMandateStatus        IChangeMandateStatus
+	authorizeHold              IAuthorizeHold
+	getHold                    IGetHold
+	captureHold                ICaptureHold
+	voidHold                   IVoidHold
+	createTransactionBatch     ICreateTransactionBatch
+	getTransactionBatch        IGetTransactionBatch
+	createSplitPayment         ICreateSplitPayment
+	getSplitPayment            IGetSplitPayment
+	createCharge               ICreateCharge
+	getCharge                  IGetCharge
+	payCharge                  IPayCharge
+	cancelCharge               ICancelCharge
+	generateChargePixCode      IGenerateChargePixCode
+	generateStaticPixCode      IGenerateStaticPixCode
+	decodePixCode              IDecodePixCode
+	registerAliasKey           IRegisterAliasKey
+	listAliasKeys              IListAliasKeys
+	deleteAliasKey             IDeleteAliasKey
+	resolveAliasKey            IResolveAliasKey
+	listTransactions           IListTransactions
+	exportStatement            IExportStatement
+	getBalance                 IGetBalance
+	listSettlements            IListSettlements
+	listSettlementTransactions IListSettlementTransactions
+	paySettlement              IPaySettlement
+	openDispute                IOpenDispute
+	getDispute                 IGetDispute
+	listDisputes               IListDisputes
+	respondDispute             IRespondDispute
+	resolveDispute             IResolveDispute
+	createEscrow               ICreateEscrow
+	getEscrow                  IGetEscrow
+	shipEscrow                 IShipEscrow
+	confirmEscrow              IConfirmEscrow
+	resolveEscrow              IResolveEscrow
+	otel                       telemetry.Telemetry
+	logger                     *log.Logger
 }
 
+// Option wires an optional use case into the handler.
+type Option func(h *handler)
+
 type ICreateTransaction interface {
 	Execute(ctx context.Context, input usecase.CreateTransactionInput) (string, error)
 }
@@ -23,15 +78,493 @@ type ICreateUser interface {
 	Execute(ctx context.Context, input usecase.CreateUserInput) (string, error)
 }
 
+type IRefundTransaction interface {
+	Execute(ctx context.Context, input usecase.RefundTransactionInput) (string, error)
+}
+
+type ICreateDeposit interface {
+	Execute(ctx context.Context, input usecase.CreateDepositInput) (string, error)
+}
+
+type IRegisterPayoutDestination interface {
+	Execute(ctx context.Context, input usecase.RegisterPayoutDestinationInput) (string, error)
+}
+
+type ICreateWithdrawal interface {
+	Execute(ctx context.Context, input usecase.CreateWithdrawalInput) (string, error)
+}
+
+type ISettleWithdrawal interface {
+	Execute(ctx context.Context, input usecase.SettleWithdrawalInput) (string, error)
+}
+
+type IScheduleTransfer interface {
+	Execute(ctx context.Context, input usecase.ScheduleTransferInput) (string, error)
+}
+
+type IListScheduledTransfers interface {
+	Execute(ctx context.Context, senderID uuid.UUID) ([]*entity.ScheduledTransfer, error)
+}
+
+type ICancelScheduledTransfer interface {
+	Execute(ctx context.Context, id uuid.UUID) error
+}
+
+type ICreateMandate interface {
+	Execute(ctx context.Context, input usecase.CreateMandateInput) (string, error)
+}
+
+type IListMandates interface {
+	Execute(ctx context.Context, senderID uuid.UUID) ([]*entity.Mandate, error)
+}
+
+type IListMandateExecutions interface {
+	Execute(ctx context.Context, mandateID uuid.UUID) ([]*entity.MandateExecution, error)
+}
+
+type IChangeMandateStatus interface {
+	Execute(ctx context.Context, input usecase.ChangeMandateStatusInput) (string, error)
+}
+
+type IAuthorizeHold interface {
+	Execute(ctx context.Context, input usecase.AuthorizeHoldInput) (string, error)
+}
+
+type IGetHold interface {
+	Execute(ctx context.Context, id uuid.UUID) (*entity.BalanceHold, error)
+}
+
+type ICaptureHold interface {
+	Execute(ctx context.Context, input usecase.CaptureHoldInput) (string, error)
+}
+
+type IVoidHold interface {
+	Execute(ctx context.Context, id uuid.UUID) error
+}
+
+type ICreateTransactionBatch interface {
+	Execute(ctx context.Context, input usecase.CreateTransactionBatchInput) (*entity.TransactionBatch, error)
+}
+
+type IGetTransactionBatch interface {
+	Execute(ctx context.Context, id uuid.UUID) (*entity.TransactionBatch, error)
+}
+
+type ICreateSplitPayment interface {
+	Execute(ctx context.Context, input usecase.CreateSplitPaymentInput) (*entity.SplitPayment, error)
+}
+
+type IGetSplitPayment interface {
+	Execute(ctx context.Context, id uuid.UUID) (*entity.SplitPayment, error)
+}
+
+type ICreateCharge interface {
+	Execute(ctx context.Context, input usecase.CreateChargeInput) (*entity.Charge, error)
+}
+
+type IGetCharge interface {
+	Execute(ctx context.Context, id uuid.UUID) (*entity.Charge, error)
+}
+
+type IPayCharge interface {
+	Execute(ctx context.Context, input usecase.PayChargeInput) (*entity.Charge, error)
+}
+
+type ICancelCharge interface {
+	Execute(ctx context.Context, id uuid.UUID) error
+}
+
+type IGenerateChargePixCode interface {
+	Execute(ctx context.Context, chargeID uuid.UUID) (*vo.PixCode, error)
+}
+
+type IGenerateStaticPixCode interface {
+	Execute(ctx context.Context, input usecase.GenerateStaticPixCodeInput) (*vo.PixCode, error)
+}
+
+type IDecodePixCode interface {
+	Execute(ctx context.Context, payload string) (*usecase.PixPayment, error)
+}
+
+type IRegisterAliasKey interface {
+	Execute(ctx context.Context, input usecase.RegisterAliasKeyInput) (*entity.AliasKey, error)
+}
+
+type IListAliasKeys interface {
+	Execute(ctx context.Context, userID uuid.UUID) ([]*entity.AliasKey, error)
+}
+
+type IDeleteAliasKey interface {
+	Execute(ctx context.Context, userID, keyID uuid.UUID) error
+}
+
+type IResolveAliasKey interface {
+	Execute(ctx context.Context, key string) (uuid.UUID, error)
+}
+
+type IListTransactions interface {
+	Execute(ctx context.Context, input usecase.ListTransactionsInput) (*usecase.StatementPage, error)
+}
+
+type IExportStatement interface {
+	Execute(ctx context.Context, input usecase.ExportStatementInput, w io.Writer) error
+}
+
+type IGetBalance interface {
+	Execute(ctx context.Context, input usecase.GetBalanceInput) (*usecase.UserBalances, error)
+}
+
+type IListSettlements interface {
+	Execute(ctx context.Context, input usecase.ListSettlementsInput) ([]*entity.Settlement, error)
+}
+
+type IListSettlementTransactions interface {
+	Execute(ctx context.Context, settlementID uuid.UUID) ([]*entity.Transaction, error)
+}
+
+type IPaySettlement interface {
+	Execute(ctx context.Context, id uuid.UUID) error
+}
+
+type IOpenDispute interface {
+	Execute(ctx context.Context, input usecase.OpenDisputeInput) (*entity.Dispute, error)
+}
+
+type IGetDispute interface {
+	Execute(ctx context.Context, id uuid.UUID) (*entity.Dispute, error)
+}
+
+type IListDisputes interface {
+	Execute(ctx context.Context, userID uuid.UUID) ([]*entity.Dispute, error)
+}
+
+type IRespondDispute interface {
+	Execute(ctx context.Context, input usecase.RespondDisputeInput) (*entity.Dispute, error)
+}
+
+type IResolveDispute interface {
+	Execute(ctx context.Context, input usecase.ResolveDisputeInput) (*entity.Dispute, error)
+}
+
+type ICreateEscrow interface {
+	Execute(ctx context.Context, input usecase.CreateEscrowInput) (*entity.Escrow, error)
+}
+
+type IGetEscrow interface {
+	Execute(ctx context.Context, id uuid.UUID) (*entity.Escrow, error)
+}
+
+type IShipEscrow interface {
+	Execute(ctx context.Context, input usecase.ShipEscrowInput) (*entity.Escrow, error)
+}
+
+type IConfirmEscrow interface {
+	Execute(ctx context.Context, input usecase.ConfirmEscrowInput) (*entity.Escrow, error)
+}
+
+type IResolveEscrow interface {
+	Execute(ctx context.Context, input usecase.ResolveEscrowInput) (*entity.Escrow, error)
+}
+
+func WithRefundTransaction(refundTransaction IRefundTransaction) Option {
+	return func(h *handler) {
+		h.refundTransaction = refundTransaction
+	}
+}
+
+func WithCreateDeposit(createDeposit ICreateDeposit) Option {
+	return func(h *handler) {
+		h.createDeposit = createDeposit
+	}
+}
+
+func WithRegisterPayoutDestination(registerPayoutDestination IRegisterPayoutDestination) Option {
+	return func(h *handler) {
+		h.registerPayoutDestination = registerPayoutDestination
+	}
+}
+
+func WithCreateWithdrawal(createWithdrawal ICreateWithdrawal) Option {
+	return func(h *handler) {
+		h.createWithdrawal = createWithdrawal
+	}
+}
+
+// WithSettleWithdrawal wires the payout results, which are only taken when
+// signed with resultSecret.
+func WithSettleWithdrawal(settleWithdrawal ISettleWithdrawal, resultSecret string) Option {
+	return func(h *handler) {
+		h.settleWithdrawal = settleWithdrawal
+		h.payoutResultSecret = []byte(resultSecret)
+	}
+}
+
+func WithScheduleTransfer(scheduleTransfer IScheduleTransfer) Option {
+	return func(h *handler) {
+		h.scheduleTransfer = scheduleTransfer
+	}
+}
+
+func WithListScheduledTransfers(listScheduledTransfers IListScheduledTransfers) Option {
+	return func(h *handler) {
+		h.listScheduledTransfers = listScheduledTransfers
+	}
+}
+
+func WithCancelScheduledTransfer(cancelScheduledTransfer ICancelScheduledTransfer) Option {
+	return func(h *handler) {
+		h.cancelScheduledTransfer = cancelScheduledTransfer
+	}
+}
+
+func WithCreateMandate(createMandate ICreateMandate) Option {
+	return func(h *handler) {
+		h.createMandate = createMandate
+	}
+}
+
+func WithListMandates(listMandates IListMandates) Option {
+	return func(h *handler) {
+		h.listMandates = listMandates
+	}
+}
+
+func WithListMandateExecutions(listMandateExecutions IListMandateExecutions) Option {
+	return func(h *handler) {
+		h.listMandateExecutions = listMandateExecutions
+	}
+}
+
+func WithChangeMandateStatus(changeMandateStatus IChangeMandateStatus) Option {
+	return func(h *handler) {
+		h.changeMandateStatus = changeMandateStatus
+	}
+}
+
+func WithAuthorizeHold(authorizeHold IAuthorizeHold) Option {
+	return func(h *handler) {
+		h.authorizeHold = authorizeHold
+	}
+}
+
+func WithGetHold(getHold IGetHold) Option {
+	return func(h *handler) {
+		h.getHold = getHold
+	}
+}
+
+func WithCaptureHold(captureHold ICaptureHold) Option {
+	return func(h *handler) {
+		h.captureHold = captureHold
+	}
+}
+
+func WithVoidHold(voidHold IVoidHold) Option {
+	return func(h *handler) {
+		h.voidHold = voidHold
+	}
+}
+
+func WithCreateTransactionBatch(createTransactionBatch ICreateTransactionBatch) Option {
+	return func(h *handler) {
+		h.createTransactionBatch = createTransactionBatch
+	}
+}
+
+func WithGetTransactionBatch(getTransactionBatch IGetTransactionBatch) Option {
+	return func(h *handler) {
+		h.getTransactionBatch = getTransactionBatch
+	}
+}
+
+func WithCreateSplitPayment(createSplitPayment ICreateSplitPayment) Option {
+	return func(h *handler) {
+		h.createSplitPayment = createSplitPayment
+	}
+}
+
+func WithGetSplitPayment(getSplitPayment IGetSplitPayment) Option {
+	return func(h *handler) {
+		h.getSplitPayment = getSplitPayment
+	}
+}
+
+func WithCreateCharge(createCharge ICreateCharge) Option {
+	return func(h *handler) {
+		h.createCharge = createCharge
+	}
+}
+
+func WithGetCharge(getCharge IGetCharge) Option {
+	return func(h *handler) {
+		h.getCharge = getCharge
+	}
+}
+
+func WithPayCharge(payCharge IPayCharge) Option {
+	return func(h *handler) {
+		h.payCharge = payCharge
+	}
+}
+
+func WithCancelCharge(cancelCharge ICancelCharge) Option {
+	return func(h *handler) {
+		h.cancelCharge = cancelCharge
+	}
+}
+
+func WithGenerateChargePixCode(generateChargePixCode IGenerateChargePixCode) Option {
+	return func(h *handler) {
+		h.generateChargePixCode = generateChargePixCode
+	}
+}
+
+func WithGenerateStaticPixCode(generateStaticPixCode IGenerateStaticPixCode) Option {
+	return func(h *handler) {
+		h.generateStaticPixCode = generateStaticPixCode
+	}
+}
+
+func WithDecodePixCode(decodePixCode IDecodePixCode) Option {
+	return func(h *handler) {
+		h.decodePixCode = decodePixCode
+	}
+}
+
+func WithRegisterAliasKey(registerAliasKey IRegisterAliasKey) Option {
+	return func(h *handler) {
+		h.registerAliasKey = registerAliasKey
+	}
+}
+
+func WithListAliasKeys(listAliasKeys IListAliasKeys) Option {
+	return func(h *handler) {
+		h.listAliasKeys = listAliasKeys
+	}
+}
+
+func WithDeleteAliasKey(deleteAliasKey IDeleteAliasKey) Option {
+	return func(h *handler) {
+		h.deleteAliasKey = deleteAliasKey
+	}
+}
+
+func WithResolveAliasKey(resolveAliasKey IResolveAliasKey) Option {
+	return func(h *handler) {
+		h.resolveAliasKey = resolveAliasKey
+	}
+}
+
+func WithListTransactions(listTransactions IListTransactions) Option {
+	return func(h *handler) {
+		h.listTransactions = listTransactions
+	}
+}
+
+func WithExportStatement(exportStatement IExportStatement) Option {
+	return func(h *handler) {
+		h.exportStatement = exportStatement
+	}
+}
+
+func WithGetBalance(getBalance IGetBalance) Option {
+	return func(h *handler) {
+		h.getBalance = getBalance
+	}
+}
+
+func WithListSettlements(listSettlements IListSettlements) Option {
+	return func(h *handler) {
+		h.listSettlements = listSettlements
+	}
+}
+
+func WithListSettlementTransactions(listSettlementTransactions IListSettlementTransactions) Option {
+	return func(h *handler) {
+		h.listSettlementTransactions = listSettlementTransactions
+	}
+}
+
+func WithPaySettlement(paySettlement IPaySettlement) Option {
+	return func(h *handler) {
+		h.paySettlement = paySettlement
+	}
+}
+
+func WithOpenDispute(openDispute IOpenDispute) Option {
+	return func(h *handler) {
+		h.openDispute = openDispute
+	}
+}
+
+func WithGetDispute(getDispute IGetDispute) Option {
+	return func(h *handler) {
+		h.getDispute = getDispute
+	}
+}
+
+func WithListDisputes(listDisputes IListDisputes) Option {
+	return func(h *handler) {
+		h.listDisputes = listDisputes
+	}
+}
+
+func WithRespondDispute(respondDispute IRespondDispute) Option {
+	return func(h *handler) {
+		h.respondDispute = respondDispute
+	}
+}
+
+func WithResolveDispute(resolveDispute IResolveDispute) Option {
+	return func(h *handler) {
+		h.resolveDispute = resolveDispute
+	}
+}
+
+func WithCreateEscrow(createEscrow ICreateEscrow) Option {
+	return func(h *handler) {
+		h.createEscrow = createEscrow
+	}
+}
+
+func WithGetEscrow(getEscrow IGetEscrow) Option {
+	return func(h *handler) {
+		h.getEscrow = getEscrow
+	}
+}
+
+func WithShipEscrow(shipEscrow IShipEscrow) Option {
+	return func(h *handler) {
+		h.shipEscrow = shipEscrow
+	}
+}
+
+func WithConfirmEscrow(confirmEscrow IConfirmEscrow) Option {
+	return func(h *handler) {
+		h.confirmEscrow = confirmEscrow
+	}
+}
+
+func WithResolveEscrow(resolveEscrow IResolveEscrow) Option {
+	return func(h *handler) {
+		h.resolveEscrow = resolveEscrow
+	}
+}
+
 func New(
 	createTransaction ICreateTransaction,
 	createUser ICreateUser,
 	telemetry telemetry.Telemetry,
+	opts ...Option,
 ) *handler {
-	return &handler{
+	h := &handler{
 		createTransaction: createTransaction,
 		createUser:        createUser,
 		otel:              telemetry,
 		logger:            log.New(log.Writer(), "handler: ", log.LstdFlags),
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
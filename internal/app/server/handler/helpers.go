@@ -8,6 +8,10 @@ import (
 	"log"
 	"net/http"
 	"strings"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 )
 
 type envelope map[string]any
@@ -86,3 +90,44 @@ func (h *handler) readJSON(w http.ResponseWriter, r *http.Request, dst any) erro
 
 	return nil
 }
+
+// parseAmount converts a decimal amount from a request body into cents,
+// rejecting amounts with more than two decimal places.
+func (h *handler) parseAmount(amount json.Number) (int64, error) {
+	// Every supported currency has two decimal places.
+	money, err := vo.ParseMoney(amount.String(), vo.DefaultCurrency)
+	if err != nil {
+		return 0, err
+	}
+	return money.Value(), nil
+}
+
+// parsePercentage converts a percentage from a request body, e.g. 12.5 or
+// "12.50", into basis points, rejecting more than two decimal places.
+func (h *handler) parsePercentage(percentage json.Number) (int64, error) {
+	// Hundredths of a percent are parsed like cents
+	basisPoints, err := h.parseAmount(percentage)
+	if err != nil {
+		return 0, fmt.Errorf("invalid percentage %q", percentage.String())
+	}
+	return basisPoints, nil
+}
+
+// formatAmount converts cents into a decimal amount with two places, e.g.
+// "-10.50" for -1050.
+func (h *handler) formatAmount(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+func (h *handler) readUUIDParam(r *http.Request, name string) (uuid.UUID, error) {
+	id, err := uuid.Parse(chi.URLParam(r, name))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid %s", name)
+	}
+	return id, nil
+}
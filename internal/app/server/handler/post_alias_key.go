@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PostAliasKeyRequest struct {
+	// Type is email, cpf, cnpj, phone or random.
+	Type string `json:"type"`
+	// Value is the key itself, omitted for random keys as the wallet
+	// generates them.
+	Value string `json:"value"`
+}
+
+type AliasKeyResponse struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Type      string    `json:"type"`
+	Value     string    `json:"value"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PostAliasKey registers a key others can send money to the user by.
+func (h handler) PostAliasKey(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostAliasKey")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostAliasKeyRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	key, err := h.registerAliasKey.Execute(ctx, usecase.RegisterAliasKeyInput{
+		UserID: userID,
+		Type:   input.Type,
+		Value:  input.Value,
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrUserNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"key": newAliasKeyResponse(key)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("alias_key.id", key.ID()),
+		attribute.String("alias_key.user_id", userID.String()),
+		attribute.String("alias_key.type", key.Type()),
+	)
+}
+
+// GetAliasKeys lists the keys of the user.
+func (h handler) GetAliasKeys(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetAliasKeys")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	keys, err := h.listAliasKeys.Execute(ctx, userID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	response := make([]AliasKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		response = append(response, newAliasKeyResponse(key))
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"keys": response}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("alias_key.user_id", userID.String()),
+		attribute.Int("alias_key.count", len(response)),
+	)
+}
+
+// DeleteAliasKey removes a key of the user from the directory.
+func (h handler) DeleteAliasKey(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "DeleteAliasKey")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	keyID, err := h.readUUIDParam(r, "key_id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.deleteAliasKey.Execute(ctx, userID, keyID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errs.ErrAliasKeyNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	span.SetAttributes(
+		attribute.String("alias_key.id", keyID.String()),
+		attribute.String("alias_key.user_id", userID.String()),
+	)
+}
+
+func newAliasKeyResponse(key *entity.AliasKey) AliasKeyResponse {
+	return AliasKeyResponse{
+		ID:        key.ID(),
+		UserID:    key.UserID(),
+		Type:      key.Type(),
+		Value:     key.Value(),
+		CreatedAt: key.CreatedAt(),
+	}
+}
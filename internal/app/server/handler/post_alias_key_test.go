@@ -0,0 +1,248 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	aliasKeyOwnerID    = "7250961f-c104-46dd-9447-d57b4f5a2be4"
+	aliasKeySenderID   = "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	aliasKeyReceiverID = "b3ae1675-5978-49d3-a6e3-619955ec6b2f"
+)
+
+func newTestAliasKey(t *testing.T) *entity.AliasKey {
+	t.Helper()
+	owner, err := entity.NewUser("Fulano de Tal", "fulano@example.com", "password123", "52998224725", "", vo.CommonUserType)
+	require.NoError(t, err)
+	key, err := entity.NewAliasKey(owner, entity.EmailAliasKeyType, "fulano@example.com", time.Now())
+	require.NoError(t, err)
+	return key
+}
+
+func TestPostAliasKey_ValidRequest_ShouldReturn201WithKey(t *testing.T) {
+	// Arrange
+	key := newTestAliasKey(t)
+	registerMock := &RegisterAliasKeyMock{}
+	registerMock.On("Execute", mock.Anything, usecase.RegisterAliasKeyInput{
+		UserID: uuid.MustParse(aliasKeyOwnerID),
+		Type:   entity.EmailAliasKeyType,
+		Value:  "fulano@example.com",
+	}).Return(key, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithRegisterAliasKey(registerMock))
+
+	reqBody := `{"type": "email", "value": "fulano@example.com"}`
+	r, _ := http.NewRequest("POST", "/v1/users/"+aliasKeyOwnerID+"/keys", strings.NewReader(reqBody))
+	r = withURLParams(r, map[string]string{"id": aliasKeyOwnerID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostAliasKey(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body struct {
+		Key handler.AliasKeyResponse `json:"key"`
+	}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, key.ID(), body.Key.ID)
+	assert.Equal(t, "email", body.Key.Type)
+	assert.Equal(t, "fulano@example.com", body.Key.Value)
+	registerMock.AssertExpectations(t)
+}
+
+func TestPostAliasKey_KeyTaken_ShouldReturn422(t *testing.T) {
+	// Arrange
+	registerMock := &RegisterAliasKeyMock{}
+	registerMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrAliasKeyTaken)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithRegisterAliasKey(registerMock))
+
+	r, _ := http.NewRequest("POST", "/v1/users/"+aliasKeyOwnerID+"/keys", strings.NewReader(`{"type": "random"}`))
+	r = withURLParams(r, map[string]string{"id": aliasKeyOwnerID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostAliasKey(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+func TestGetAliasKeys_ShouldReturn200WithKeys(t *testing.T) {
+	// Arrange
+	key := newTestAliasKey(t)
+	listMock := &ListAliasKeysMock{}
+	listMock.On("Execute", mock.Anything, uuid.MustParse(aliasKeyOwnerID)).Return([]*entity.AliasKey{key}, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListAliasKeys(listMock))
+
+	r, _ := http.NewRequest("GET", "/v1/users/"+aliasKeyOwnerID+"/keys", nil)
+	r = withURLParams(r, map[string]string{"id": aliasKeyOwnerID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetAliasKeys(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Keys []handler.AliasKeyResponse `json:"keys"`
+	}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	require.Len(t, body.Keys, 1)
+	assert.Equal(t, key.ID(), body.Keys[0].ID)
+}
+
+func TestDeleteAliasKey_ShouldReturn204(t *testing.T) {
+	// Arrange
+	keyID := uuid.New()
+	deleteMock := &DeleteAliasKeyMock{}
+	deleteMock.On("Execute", mock.Anything, uuid.MustParse(aliasKeyOwnerID), keyID).Return(nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithDeleteAliasKey(deleteMock))
+
+	r, _ := http.NewRequest("DELETE", "/v1/users/"+aliasKeyOwnerID+"/keys/"+keyID.String(), nil)
+	r = withURLParams(r, map[string]string{"id": aliasKeyOwnerID, "key_id": keyID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.DeleteAliasKey(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+	deleteMock.AssertExpectations(t)
+}
+
+func TestDeleteAliasKey_KeyNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	keyID := uuid.New()
+	deleteMock := &DeleteAliasKeyMock{}
+	deleteMock.On("Execute", mock.Anything, mock.Anything, mock.Anything).Return(errs.ErrAliasKeyNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithDeleteAliasKey(deleteMock))
+
+	r, _ := http.NewRequest("DELETE", "/v1/users/"+aliasKeyOwnerID+"/keys/"+keyID.String(), nil)
+	r = withURLParams(r, map[string]string{"id": aliasKeyOwnerID, "key_id": keyID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.DeleteAliasKey(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestPostTransaction_WithReceiverKey_ShouldTransferToTheKeyOwner(t *testing.T) {
+	// Arrange
+	resolveMock := &ResolveAliasKeyMock{}
+	resolveMock.On("Execute", mock.Anything, "fulano@example.com").Return(uuid.MustParse(aliasKeyReceiverID), nil)
+	createTransactionMock := &CreateTransactionMock{}
+	createTransactionMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.CreateTransactionInput) bool {
+			return input.ReceiverID.String() == aliasKeyReceiverID
+		}),
+	).Return("transaction-id", nil)
+	h := handler.New(createTransactionMock, &CreateUserMock{}, telemetry.NewMockTelemetry(), handler.WithResolveAliasKey(resolveMock))
+
+	reqBody := `{"amount": 10, "sender_id": "` + aliasKeySenderID + `", "receiver_key": "fulano@example.com"}`
+	r, _ := http.NewRequest("POST", "/v1/transactions", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransaction(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Result().StatusCode)
+	createTransactionMock.AssertExpectations(t)
+}
+
+func TestPostTransaction_WithUnknownReceiverKey_ShouldReturn404(t *testing.T) {
+	// Arrange
+	resolveMock := &ResolveAliasKeyMock{}
+	resolveMock.On("Execute", mock.Anything, "+5511987654321").Return(uuid.Nil, errs.ErrAliasKeyNotFound)
+	h := handler.New(&CreateTransactionMock{}, &CreateUserMock{}, telemetry.NewMockTelemetry(), handler.WithResolveAliasKey(resolveMock))
+
+	reqBody := `{"amount": 10, "sender_id": "` + aliasKeySenderID + `", "receiver_key": "+5511987654321"}`
+	r, _ := http.NewRequest("POST", "/v1/transactions", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransaction(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestPostTransaction_WithReceiverIDAndKey_ShouldReturn400(t *testing.T) {
+	// Arrange
+	h := handler.New(&CreateTransactionMock{}, &CreateUserMock{}, telemetry.NewMockTelemetry(), handler.WithResolveAliasKey(&ResolveAliasKeyMock{}))
+
+	reqBody := `{"amount": 10, "sender_id": "` + aliasKeySenderID + `", "receiver_id": "` + aliasKeyReceiverID + `", "receiver_key": "fulano@example.com"}`
+	r, _ := http.NewRequest("POST", "/v1/transactions", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransaction(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+type RegisterAliasKeyMock struct {
+	mock.Mock
+}
+
+func (m *RegisterAliasKeyMock) Execute(ctx context.Context, input usecase.RegisterAliasKeyInput) (*entity.AliasKey, error) {
+	args := m.Called(ctx, input)
+	key, _ := args.Get(0).(*entity.AliasKey)
+	return key, args.Error(1)
+}
+
+type ListAliasKeysMock struct {
+	mock.Mock
+}
+
+func (m *ListAliasKeysMock) Execute(ctx context.Context, userID uuid.UUID) ([]*entity.AliasKey, error) {
+	args := m.Called(ctx, userID)
+	keys, _ := args.Get(0).([]*entity.AliasKey)
+	return keys, args.Error(1)
+}
+
+type DeleteAliasKeyMock struct {
+	mock.Mock
+}
+
+func (m *DeleteAliasKeyMock) Execute(ctx context.Context, userID, keyID uuid.UUID) error {
+	args := m.Called(ctx, userID, keyID)
+	return args.Error(0)
+}
+
+type ResolveAliasKeyMock struct {
+	mock.Mock
+}
+
+func (m *ResolveAliasKeyMock) Execute(ctx context.Context, key string) (uuid.UUID, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
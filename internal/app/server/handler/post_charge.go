@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/metrics"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PostChargeRequest struct {
+	MerchantID string `json:"merchant_id"`
+	// Amount is a decimal with at most two places, e.g. 10.50 or "10.50".
+	Amount json.Number `json:"amount"`
+	// Currency is the ISO-4217 code of the charge, BRL when omitted.
+	Currency string `json:"currency"`
+	// Reference is the merchant's own identifier of the charge, e.g. an
+	// order id, unique among its charges.
+	Reference   string `json:"reference"`
+	Description string `json:"description"`
+	// ExpiresAt is an RFC 3339 date after which the charge can no longer be
+	// paid, the default expiry when omitted.
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type PostPayChargeRequest struct {
+	PayerID string `json:"payer_id"`
+}
+
+type ChargeResponse struct {
+	ID            string    `json:"id"`
+	MerchantID    string    `json:"merchant_id"`
+	Reference     string    `json:"reference"`
+	Description   string    `json:"description,omitempty"`
+	Amount        string    `json:"amount"`
+	Currency      string    `json:"currency"`
+	Status        string    `json:"status"`
+	PayerID       string    `json:"payer_id,omitempty"`
+	TransactionID string    `json:"transaction_id,omitempty"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// PostCharge creates a payment request of a merchant that users can pay
+// until it expires.
+func (h handler) PostCharge(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostCharge")
+	defer span.End()
+
+	var input PostChargeRequest
+
+	err := h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	merchantID, err := uuid.Parse(input.MerchantID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid merchant_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	amount, err := h.parseAmount(input.Amount)
+	if err != nil {
+		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var expiresAt time.Time
+	if input.ExpiresAt != nil {
+		expiresAt = *input.ExpiresAt
+	}
+
+	charge, err := h.createCharge.Execute(ctx, usecase.CreateChargeInput{
+		MerchantID:  merchantID,
+		Amount:      amount,
+		Currency:    input.Currency,
+		Reference:   input.Reference,
+		Description: input.Description,
+		ExpiresAt:   expiresAt,
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrUserNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"charge": newChargeResponse(charge)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("charge.id", charge.ID()),
+		attribute.String("charge.merchant_id", input.MerchantID),
+		attribute.Int64("charge.amount_in_cents", amount),
+	)
+}
+
+// GetCharge returns a charge with its status and, once paid, the transfer
+// that paid it.
+func (h handler) GetCharge(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetCharge")
+	defer span.End()
+
+	chargeID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	charge, err := h.getCharge.Execute(ctx, chargeID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errs.ErrChargeNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"charge": newChargeResponse(charge)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("charge.id", chargeID.String()))
+}
+
+// PostPayCharge pays a charge with a transfer from the payer to the merchant.
+func (h handler) PostPayCharge(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostPayCharge")
+	defer span.End()
+
+	chargeID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostPayChargeRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	payerID, err := uuid.Parse(input.PayerID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid payer_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	charge, err := h.payCharge.Execute(ctx, usecase.PayChargeInput{
+		ChargeID: chargeID,
+		PayerID:  payerID,
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrChargeNotFound) {
+			status = http.StatusNotFound
+		}
+		body := envelope{"error": err.Error()}
+		var limitErr *errs.TransferLimitExceededError
+		if errors.As(err, &limitErr) {
+			body["limit_period"] = limitErr.Period
+			body["remaining_allowance"] = h.formatAmount(limitErr.Remaining)
+		}
+		err = h.writeJson(w, status, body, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"charge": newChargeResponse(charge)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+	}
+
+	metrics.TransactionCounter.Inc()
+	metrics.TransactionAmount.WithLabelValues(charge.Currency()).Add(float64(charge.Amount()) / 100)
+
+	span.SetAttributes(
+		attribute.String("charge.id", chargeID.String()),
+		attribute.String("charge.payer_id", input.PayerID),
+		attribute.String("charge.transaction_id", charge.TransactionID()),
+	)
+}
+
+// PostCancelCharge cancels a charge that was not paid yet.
+func (h handler) PostCancelCharge(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostCancelCharge")
+	defer span.End()
+
+	chargeID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.cancelCharge.Execute(ctx, chargeID)
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrChargeNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"charge_id": chargeID.String(), "status": entity.ChargeCancelledStatus}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("charge.id", chargeID.String()))
+}
+
+func newChargeResponse(charge *entity.Charge) ChargeResponse {
+	return ChargeResponse{
+		ID:            charge.ID(),
+		MerchantID:    charge.MerchantID(),
+		Reference:     charge.Reference(),
+		Description:   charge.Description(),
+		Amount:        charge.FormattedAmount(),
+		Currency:      charge.Currency(),
+		Status:        charge.Status(),
+		PayerID:       charge.PayerID(),
+		TransactionID: charge.TransactionID(),
+		ExpiresAt:     charge.ExpiresAt(),
+		CreatedAt:     charge.CreatedAt(),
+		UpdatedAt:     charge.UpdatedAt(),
+	}
+}
@@ -0,0 +1,224 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	chargeMerchantID = "b3ae1675-5978-49d3-a6e3-619955ec6b2f"
+	chargePayerID    = "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+)
+
+func newTestCharge(t *testing.T) *entity.Charge {
+	t.Helper()
+	amount, err := vo.NewMoney(4990, vo.BRL)
+	require.NoError(t, err)
+	charge, err := entity.NewCharge(chargeMerchantID, "order-42", "Order #42", amount, time.Now().Add(time.Hour), time.Now())
+	require.NoError(t, err)
+	return charge
+}
+
+func TestPostCharge_ValidRequest_ShouldReturn201WithCharge(t *testing.T) {
+	// Arrange
+	charge := newTestCharge(t)
+	createChargeMock := &CreateChargeMock{}
+	createChargeMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.CreateChargeInput) bool {
+			return input.MerchantID.String() == chargeMerchantID &&
+				input.Amount == 4990 &&
+				input.Reference == "order-42" &&
+				input.ExpiresAt.IsZero()
+		}),
+	).Return(charge, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateCharge(createChargeMock))
+
+	reqBody := `{"merchant_id": "` + chargeMerchantID + `", "amount": "49.90", "reference": "order-42", "description": "Order #42"}`
+	r, _ := http.NewRequest("POST", "/v1/charges", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostCharge(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body struct {
+		Charge handler.ChargeResponse `json:"charge"`
+	}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, charge.ID(), body.Charge.ID)
+	assert.Equal(t, "49.90", body.Charge.Amount)
+	assert.Equal(t, entity.ChargePendingStatus, body.Charge.Status)
+	assert.Empty(t, body.Charge.TransactionID)
+	createChargeMock.AssertExpectations(t)
+}
+
+func TestPostCharge_ReferenceTaken_ShouldReturn422(t *testing.T) {
+	// Arrange
+	createChargeMock := &CreateChargeMock{}
+	createChargeMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrChargeReferenceTaken)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateCharge(createChargeMock))
+
+	reqBody := `{"merchant_id": "` + chargeMerchantID + `", "amount": 49.90, "reference": "order-42"}`
+	r, _ := http.NewRequest("POST", "/v1/charges", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostCharge(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+func TestPostPayCharge_ValidRequest_ShouldReturn201WithTransaction(t *testing.T) {
+	// Arrange
+	charge := newTestCharge(t)
+	require.NoError(t, charge.Pay(chargePayerID, time.Now()))
+	charge.AttachTransaction("transaction-123")
+	payChargeMock := &PayChargeMock{}
+	payChargeMock.On("Execute", mock.Anything, usecase.PayChargeInput{
+		ChargeID: uuid.MustParse(charge.ID()),
+		PayerID:  uuid.MustParse(chargePayerID),
+	}).Return(charge, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithPayCharge(payChargeMock))
+
+	r, _ := http.NewRequest("POST", "/v1/charges/"+charge.ID()+"/pay", strings.NewReader(`{"payer_id": "`+chargePayerID+`"}`))
+	r = withURLParams(r, map[string]string{"id": charge.ID()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostPayCharge(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body struct {
+		Charge handler.ChargeResponse `json:"charge"`
+	}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.ChargePaidStatus, body.Charge.Status)
+	assert.Equal(t, chargePayerID, body.Charge.PayerID)
+	assert.Equal(t, "transaction-123", body.Charge.TransactionID)
+}
+
+func TestPostPayCharge_ChargeExpired_ShouldReturn422(t *testing.T) {
+	// Arrange
+	chargeID := "0f8e9b1c-3a5d-4b7e-9c2f-6d1a8e4b7c3f"
+	payChargeMock := &PayChargeMock{}
+	payChargeMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrChargeExpired)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithPayCharge(payChargeMock))
+
+	r, _ := http.NewRequest("POST", "/v1/charges/"+chargeID+"/pay", strings.NewReader(`{"payer_id": "`+chargePayerID+`"}`))
+	r = withURLParams(r, map[string]string{"id": chargeID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostPayCharge(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, errs.ErrChargeExpired.Error(), body["error"])
+}
+
+func TestGetCharge_ChargeNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	chargeID := "0f8e9b1c-3a5d-4b7e-9c2f-6d1a8e4b7c3f"
+	getChargeMock := &GetChargeMock{}
+	getChargeMock.On("Execute", mock.Anything, uuid.MustParse(chargeID)).Return(nil, errs.ErrChargeNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGetCharge(getChargeMock))
+
+	r, _ := http.NewRequest("GET", "/v1/charges/"+chargeID, nil)
+	r = withURLParams(r, map[string]string{"id": chargeID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetCharge(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestPostCancelCharge_ChargeAlreadyPaid_ShouldReturn422(t *testing.T) {
+	// Arrange
+	chargeID := "0f8e9b1c-3a5d-4b7e-9c2f-6d1a8e4b7c3f"
+	cancelChargeMock := &CancelChargeMock{}
+	cancelChargeMock.On("Execute", mock.Anything, uuid.MustParse(chargeID)).Return(errs.ErrChargeNotPending)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCancelCharge(cancelChargeMock))
+
+	r, _ := http.NewRequest("POST", "/v1/charges/"+chargeID+"/cancel", nil)
+	r = withURLParams(r, map[string]string{"id": chargeID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostCancelCharge(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+type CreateChargeMock struct {
+	mock.Mock
+}
+
+func (m *CreateChargeMock) Execute(ctx context.Context, input usecase.CreateChargeInput) (*entity.Charge, error) {
+	args := m.Called(ctx, input)
+	charge, _ := args.Get(0).(*entity.Charge)
+	return charge, args.Error(1)
+}
+
+type GetChargeMock struct {
+	mock.Mock
+}
+
+func (m *GetChargeMock) Execute(ctx context.Context, id uuid.UUID) (*entity.Charge, error) {
+	args := m.Called(ctx, id)
+	charge, _ := args.Get(0).(*entity.Charge)
+	return charge, args.Error(1)
+}
+
+type PayChargeMock struct {
+	mock.Mock
+}
+
+func (m *PayChargeMock) Execute(ctx context.Context, input usecase.PayChargeInput) (*entity.Charge, error) {
+	args := m.Called(ctx, input)
+	charge, _ := args.Get(0).(*entity.Charge)
+	return charge, args.Error(1)
+}
+
+type CancelChargeMock struct {
+	mock.Mock
+}
+
+func (m *CancelChargeMock) Execute(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
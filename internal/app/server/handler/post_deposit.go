@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PostDepositRequest struct {
+	// Amount is a decimal with at most two places, e.g. 10.50 or "10.50".
+	Amount json.Number `json:"amount"`
+	// Currency is the ISO-4217 code of the deposit, BRL when omitted.
+	Currency string `json:"currency"`
+}
+
+// PostDeposit credits the wallet of a user once the funding gateway confirms
+// the funds.
+func (h handler) PostDeposit(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostDeposit")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostDepositRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	amount, err := h.parseAmount(input.Amount)
+	if err != nil {
+		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	depositID, err := h.createDeposit.Execute(ctx, usecase.CreateDepositInput{
+		UserID:         userID,
+		Amount:         amount,
+		Currency:       input.Currency,
+		IdempotencyKey: r.Header.Get(IdempotencyKeyHeader),
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrUserNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"deposit_id": depositID}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("deposit.user_id", userID.String()),
+		attribute.Int64("deposit.amount_in_cents", amount),
+		attribute.String("deposit.currency", input.Currency),
+	)
+}
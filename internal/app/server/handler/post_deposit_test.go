@@ -0,0 +1,110 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPostDeposit_InvalidUserID_ShouldReturn400(t *testing.T) {
+	// Arrange
+	createDepositMock := &CreateDepositMock{}
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateDeposit(createDepositMock))
+
+	r, _ := http.NewRequest("POST", "/v1/users/invalid-uuid/deposits", strings.NewReader(`{"amount": 10}`))
+	r = withURLParams(r, map[string]string{"id": "invalid-uuid"})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostDeposit(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	createDepositMock.AssertNotCalled(t, "Execute")
+}
+
+func TestPostDeposit_ValidRequest_ShouldReturn201WithDepositID(t *testing.T) {
+	// Arrange
+	userID := "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	createDepositMock := &CreateDepositMock{}
+	createDepositMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.CreateDepositInput) bool {
+			return input.UserID.String() == userID && input.Amount == 15050 && input.Currency == "USD"
+		}),
+	).Return("deposit-123", nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateDeposit(createDepositMock))
+
+	r, _ := http.NewRequest("POST", "/v1/users/"+userID+"/deposits", strings.NewReader(`{"amount": "150.50", "currency": "USD"}`))
+	r = withURLParams(r, map[string]string{"id": userID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostDeposit(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "deposit-123", body["deposit_id"])
+	createDepositMock.AssertExpectations(t)
+}
+
+func TestPostDeposit_UserNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	userID := "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	createDepositMock := &CreateDepositMock{}
+	createDepositMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrUserNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateDeposit(createDepositMock))
+
+	r, _ := http.NewRequest("POST", "/v1/users/"+userID+"/deposits", strings.NewReader(`{"amount": 10}`))
+	r = withURLParams(r, map[string]string{"id": userID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostDeposit(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestPostDeposit_FundsNotConfirmed_ShouldReturn422(t *testing.T) {
+	// Arrange
+	userID := "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	createDepositMock := &CreateDepositMock{}
+	createDepositMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrFundsNotConfirmed)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateDeposit(createDepositMock))
+
+	r, _ := http.NewRequest("POST", "/v1/users/"+userID+"/deposits", strings.NewReader(`{"amount": 10}`))
+	r = withURLParams(r, map[string]string{"id": userID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostDeposit(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+type CreateDepositMock struct {
+	mock.Mock
+}
+
+func (m *CreateDepositMock) Execute(ctx context.Context, input usecase.CreateDepositInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
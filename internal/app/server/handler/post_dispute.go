@@ -0,0 +1,343 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PostDisputeRequest struct {
+	SenderID string `json:"sender_id"`
+	Reason   string `json:"reason"`
+	// Evidence is free text backing the claim, e.g. order and tracking
+	// numbers.
+	Evidence string `json:"evidence"`
+}
+
+type PostRespondDisputeRequest struct {
+	ReceiverID string `json:"receiver_id"`
+	Response   string `json:"response"`
+}
+
+type PostResolveDisputeRequest struct {
+	// Outcome is won when the sender gets the amount back, lost otherwise.
+	Outcome string `json:"outcome"`
+}
+
+type DisputeResponse struct {
+	ID            string     `json:"id"`
+	TransactionID string     `json:"transaction_id"`
+	SenderID      string     `json:"sender_id"`
+	ReceiverID    string     `json:"receiver_id"`
+	Amount        string     `json:"amount"`
+	FrozenAmount  string     `json:"frozen_amount"`
+	Currency      string     `json:"currency"`
+	Reason        string     `json:"reason"`
+	Evidence      string     `json:"evidence,omitempty"`
+	Response      string     `json:"response,omitempty"`
+	Status        string     `json:"status"`
+	RespondBy     time.Time  `json:"respond_by"`
+	ChargebackID  string     `json:"chargeback_id,omitempty"`
+	ResolvedAt    *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// PostDispute opens a dispute of the sender over a transaction, freezing the
+// disputed amount on the receiver.
+func (h handler) PostDispute(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostDispute")
+	defer span.End()
+
+	transactionID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostDisputeRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	senderID, err := uuid.Parse(input.SenderID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid sender_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	dispute, err := h.openDispute.Execute(ctx, usecase.OpenDisputeInput{
+		TransactionID: transactionID,
+		SenderID:      senderID,
+		Reason:        input.Reason,
+		Evidence:      input.Evidence,
+	})
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrTransactionNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"dispute": h.newDisputeResponse(dispute)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("dispute.id", dispute.ID()),
+		attribute.String("dispute.transaction_id", transactionID.String()),
+	)
+}
+
+// GetDispute returns a dispute with its status and, once won, the chargeback
+// that gave the amount back.
+func (h handler) GetDispute(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetDispute")
+	defer span.End()
+
+	disputeID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	dispute, err := h.getDispute.Execute(ctx, disputeID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errs.ErrDisputeNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"dispute": h.newDisputeResponse(dispute)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("dispute.id", disputeID.String()))
+}
+
+// GetDisputes lists the disputes a user opened or has to answer.
+func (h handler) GetDisputes(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetDisputes")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	disputes, err := h.listDisputes.Execute(ctx, userID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errs.ErrUserNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	response := make([]DisputeResponse, 0, len(disputes))
+	for _, dispute := range disputes {
+		response = append(response, h.newDisputeResponse(dispute))
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"disputes": response}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("dispute.user_id", userID.String()))
+}
+
+// PostRespondDispute records the side of the receiver of a disputed
+// transaction while the response window is open.
+func (h handler) PostRespondDispute(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostRespondDispute")
+	defer span.End()
+
+	disputeID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostRespondDisputeRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	receiverID, err := uuid.Parse(input.ReceiverID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid receiver_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	dispute, err := h.respondDispute.Execute(ctx, usecase.RespondDisputeInput{
+		DisputeID:  disputeID,
+		ReceiverID: receiverID,
+		Response:   input.Response,
+	})
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrDisputeNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"dispute": h.newDisputeResponse(dispute)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("dispute.id", disputeID.String()))
+}
+
+// PostResolveDispute resolves a pending dispute as won or lost by its sender.
+func (h handler) PostResolveDispute(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostResolveDispute")
+	defer span.End()
+
+	disputeID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostResolveDisputeRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	dispute, err := h.resolveDispute.Execute(ctx, usecase.ResolveDisputeInput{
+		DisputeID: disputeID,
+		Outcome:   input.Outcome,
+	})
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrDisputeNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"dispute": h.newDisputeResponse(dispute)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("dispute.id", disputeID.String()),
+		attribute.String("dispute.status", dispute.Status()),
+	)
+}
+
+func (h handler) newDisputeResponse(dispute *entity.Dispute) DisputeResponse {
+	return DisputeResponse{
+		ID:            dispute.ID(),
+		TransactionID: dispute.TransactionID(),
+		SenderID:      dispute.SenderID(),
+		ReceiverID:    dispute.ReceiverID(),
+		Amount:        h.formatAmount(dispute.Amount()),
+		FrozenAmount:  h.formatAmount(dispute.FrozenAmount()),
+		Currency:      dispute.Currency(),
+		Reason:        dispute.Reason(),
+		Evidence:      dispute.Evidence(),
+		Response:      dispute.Response(),
+		Status:        dispute.Status(),
+		RespondBy:     dispute.RespondBy(),
+		ChargebackID:  dispute.ChargebackID(),
+		ResolvedAt:    dispute.ResolvedAt(),
+		CreatedAt:     dispute.CreatedAt(),
+		UpdatedAt:     dispute.UpdatedAt(),
+	}
+}
@@ -0,0 +1,282 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type OpenDisputeMock struct {
+	mock.Mock
+}
+
+func (m *OpenDisputeMock) Execute(ctx context.Context, input usecase.OpenDisputeInput) (*entity.Dispute, error) {
+	args := m.Called(ctx, input)
+	dispute, _ := args.Get(0).(*entity.Dispute)
+	return dispute, args.Error(1)
+}
+
+type GetDisputeMock struct {
+	mock.Mock
+}
+
+func (m *GetDisputeMock) Execute(ctx context.Context, id uuid.UUID) (*entity.Dispute, error) {
+	args := m.Called(ctx, id)
+	dispute, _ := args.Get(0).(*entity.Dispute)
+	return dispute, args.Error(1)
+}
+
+type ListDisputesMock struct {
+	mock.Mock
+}
+
+func (m *ListDisputesMock) Execute(ctx context.Context, userID uuid.UUID) ([]*entity.Dispute, error) {
+	args := m.Called(ctx, userID)
+	disputes, _ := args.Get(0).([]*entity.Dispute)
+	return disputes, args.Error(1)
+}
+
+type RespondDisputeMock struct {
+	mock.Mock
+}
+
+func (m *RespondDisputeMock) Execute(ctx context.Context, input usecase.RespondDisputeInput) (*entity.Dispute, error) {
+	args := m.Called(ctx, input)
+	dispute, _ := args.Get(0).(*entity.Dispute)
+	return dispute, args.Error(1)
+}
+
+type ResolveDisputeMock struct {
+	mock.Mock
+}
+
+func (m *ResolveDisputeMock) Execute(ctx context.Context, input usecase.ResolveDisputeInput) (*entity.Dispute, error) {
+	args := m.Called(ctx, input)
+	dispute, _ := args.Get(0).(*entity.Dispute)
+	return dispute, args.Error(1)
+}
+
+func restoreDispute(t *testing.T, status, chargebackID string, resolvedAt *time.Time) *entity.Dispute {
+	createdAt := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	dispute, err := entity.RestoreDispute(uuid.New(), uuid.NewString(), uuid.NewString(), uuid.NewString(), 15000, 10000, vo.BRL, "not delivered", "order #42", "", status, createdAt.Add(168*time.Hour), chargebackID, resolvedAt, createdAt, createdAt)
+	require.NoError(t, err)
+	return dispute
+}
+
+func TestPostDispute_ShouldReturn201WithTheOpenDispute(t *testing.T) {
+	// Arrange
+	dispute := restoreDispute(t, entity.DisputeOpenStatus, "", nil)
+	transactionID := uuid.MustParse(dispute.TransactionID())
+	senderID := uuid.MustParse(dispute.SenderID())
+	openMock := &OpenDisputeMock{}
+	openMock.On("Execute", mock.Anything, usecase.OpenDisputeInput{
+		TransactionID: transactionID,
+		SenderID:      senderID,
+		Reason:        "not delivered",
+		Evidence:      "order #42",
+	}).Return(dispute, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithOpenDispute(openMock))
+	payload := `{"sender_id":"` + senderID.String() + `","reason":"not delivered","evidence":"order #42"}`
+	r, _ := http.NewRequest("POST", "/v1/transactions/"+transactionID.String()+"/disputes", strings.NewReader(payload))
+	r = withURLParams(r, map[string]string{"id": transactionID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostDispute(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	var body struct {
+		Dispute map[string]any `json:"dispute"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, dispute.ID(), body.Dispute["id"])
+	assert.Equal(t, "150.00", body.Dispute["amount"])
+	assert.Equal(t, "100.00", body.Dispute["frozen_amount"])
+	assert.Equal(t, "open", body.Dispute["status"])
+	assert.Equal(t, "2026-03-17T12:00:00Z", body.Dispute["respond_by"])
+	assert.NotContains(t, body.Dispute, "chargeback_id")
+	assert.NotContains(t, body.Dispute, "resolved_at")
+	openMock.AssertExpectations(t)
+}
+
+func TestPostDispute_InvalidSenderID_ShouldReturn400(t *testing.T) {
+	// Arrange
+	openMock := &OpenDisputeMock{}
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithOpenDispute(openMock))
+	transactionID := uuid.NewString()
+	r, _ := http.NewRequest("POST", "/v1/transactions/"+transactionID+"/disputes", strings.NewReader(`{"sender_id":"abc","reason":"x"}`))
+	r = withURLParams(r, map[string]string{"id": transactionID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostDispute(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	openMock.AssertNotCalled(t, "Execute")
+}
+
+func TestPostDispute_ShouldMapErrorsToStatusCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "transaction not found", err: errs.ErrTransactionNotFound, want: http.StatusNotFound},
+		{name: "already disputed", err: errs.ErrTransactionAlreadyDisputed, want: http.StatusUnprocessableEntity},
+		{name: "not the sender", err: errs.ErrOnlySenderCanDispute, want: http.StatusUnprocessableEntity},
+		{name: "insufficient balance", err: errs.ErrInsufficientBalance, want: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			openMock := &OpenDisputeMock{}
+			openMock.On("Execute", mock.Anything, mock.Anything).Return(nil, tt.err)
+			h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithOpenDispute(openMock))
+			transactionID := uuid.NewString()
+			payload := `{"sender_id":"` + uuid.NewString() + `","reason":"not delivered"}`
+			r, _ := http.NewRequest("POST", "/v1/transactions/"+transactionID+"/disputes", strings.NewReader(payload))
+			r = withURLParams(r, map[string]string{"id": transactionID})
+			w := httptest.NewRecorder()
+
+			// Act
+			h.PostDispute(w, r)
+
+			// Assert
+			assert.Equal(t, tt.want, w.Result().StatusCode)
+		})
+	}
+}
+
+func TestGetDispute_NotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	disputeID := uuid.New()
+	getMock := &GetDisputeMock{}
+	getMock.On("Execute", mock.Anything, disputeID).Return(nil, errs.ErrDisputeNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGetDispute(getMock))
+	r, _ := http.NewRequest("GET", "/v1/disputes/"+disputeID.String(), nil)
+	r = withURLParams(r, map[string]string{"id": disputeID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetDispute(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	getMock.AssertExpectations(t)
+}
+
+func TestGetDisputes_ShouldReturn200WithTheUserDisputes(t *testing.T) {
+	// Arrange
+	userID := uuid.New()
+	listMock := &ListDisputesMock{}
+	listMock.On("Execute", mock.Anything, userID).Return([]*entity.Dispute{restoreDispute(t, entity.DisputeOpenStatus, "", nil)}, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListDisputes(listMock))
+	r, _ := http.NewRequest("GET", "/v1/users/"+userID.String()+"/disputes", nil)
+	r = withURLParams(r, map[string]string{"id": userID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetDisputes(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body struct {
+		Disputes []map[string]any `json:"disputes"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Len(t, body.Disputes, 1)
+	listMock.AssertExpectations(t)
+}
+
+func TestPostRespondDispute_ResponseWindowClosed_ShouldReturn422(t *testing.T) {
+	// Arrange
+	disputeID := uuid.New()
+	receiverID := uuid.New()
+	respondMock := &RespondDisputeMock{}
+	respondMock.On("Execute", mock.Anything, usecase.RespondDisputeInput{
+		DisputeID:  disputeID,
+		ReceiverID: receiverID,
+		Response:   "delivered",
+	}).Return(nil, errs.ErrDisputeResponseWindowClosed)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithRespondDispute(respondMock))
+	payload := `{"receiver_id":"` + receiverID.String() + `","response":"delivered"}`
+	r, _ := http.NewRequest("POST", "/v1/disputes/"+disputeID.String()+"/respond", strings.NewReader(payload))
+	r = withURLParams(r, map[string]string{"id": disputeID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostRespondDispute(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, errs.ErrDisputeResponseWindowClosed.Error(), body["error"])
+	respondMock.AssertExpectations(t)
+}
+
+func TestPostResolveDispute_Won_ShouldReturn200WithTheChargeback(t *testing.T) {
+	// Arrange
+	resolvedAt := time.Date(2026, 3, 12, 9, 0, 0, 0, time.UTC)
+	dispute := restoreDispute(t, entity.DisputeWonStatus, "chargeback-123", &resolvedAt)
+	disputeID := uuid.MustParse(dispute.ID())
+	resolveMock := &ResolveDisputeMock{}
+	resolveMock.On("Execute", mock.Anything, usecase.ResolveDisputeInput{DisputeID: disputeID, Outcome: "won"}).Return(dispute, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithResolveDispute(resolveMock))
+	r, _ := http.NewRequest("POST", "/v1/disputes/"+disputeID.String()+"/resolve", strings.NewReader(`{"outcome":"won"}`))
+	r = withURLParams(r, map[string]string{"id": disputeID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostResolveDispute(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body struct {
+		Dispute map[string]any `json:"dispute"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "won", body.Dispute["status"])
+	assert.Equal(t, "chargeback-123", body.Dispute["chargeback_id"])
+	assert.Equal(t, "2026-03-12T09:00:00Z", body.Dispute["resolved_at"])
+	resolveMock.AssertExpectations(t)
+}
+
+func TestPostResolveDispute_NotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	disputeID := uuid.New()
+	resolveMock := &ResolveDisputeMock{}
+	resolveMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrDisputeNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithResolveDispute(resolveMock))
+	r, _ := http.NewRequest("POST", "/v1/disputes/"+disputeID.String()+"/resolve", strings.NewReader(`{"outcome":"lost"}`))
+	r = withURLParams(r, map[string]string{"id": disputeID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostResolveDispute(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
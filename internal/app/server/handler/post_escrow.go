@@ -0,0 +1,382 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PostEscrowRequest struct {
+	// Amount is a decimal with at most two places, e.g. 10.50 or "10.50".
+	Amount json.Number `json:"amount"`
+	// Currency is the ISO-4217 code of the escrow, BRL when omitted.
+	Currency   string `json:"currency"`
+	SenderID   string `json:"sender_id"`
+	ReceiverID string `json:"receiver_id"`
+	// ExpiresAt is an RFC 3339 date the money goes back to the sender at if
+	// the receiver did not ship, the default expiry when omitted.
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type PostShipEscrowRequest struct {
+	ReceiverID string `json:"receiver_id"`
+}
+
+type PostConfirmEscrowRequest struct {
+	SenderID string `json:"sender_id"`
+}
+
+type PostResolveEscrowRequest struct {
+	// Outcome is released when the receiver gets the money, returned
+	// otherwise.
+	Outcome string `json:"outcome"`
+}
+
+type EscrowResponse struct {
+	ID            string     `json:"id"`
+	SenderID      string     `json:"sender_id"`
+	ReceiverID    string     `json:"receiver_id"`
+	Amount        string     `json:"amount"`
+	Currency      string     `json:"currency"`
+	Status        string     `json:"status"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+	ShippedAt     *time.Time `json:"shipped_at,omitempty"`
+	ReleaseAt     *time.Time `json:"release_at,omitempty"`
+	TransactionID string     `json:"transaction_id,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// PostEscrow moves money of the sender into escrow for the receiver until it
+// is released or goes back to the sender.
+func (h handler) PostEscrow(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostEscrow")
+	defer span.End()
+
+	var input PostEscrowRequest
+
+	err := h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	amount, err := h.parseAmount(input.Amount)
+	if err != nil {
+		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	senderID, err := uuid.Parse(input.SenderID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid sender_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	receiverID, err := uuid.Parse(input.ReceiverID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid receiver_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var expiresAt time.Time
+	if input.ExpiresAt != nil {
+		expiresAt = *input.ExpiresAt
+	}
+
+	escrow, err := h.createEscrow.Execute(ctx, usecase.CreateEscrowInput{
+		Amount:     amount,
+		Currency:   input.Currency,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+		ExpiresAt:  expiresAt,
+	})
+	if err != nil {
+		body := envelope{"error": err.Error()}
+		var limitErr *errs.TransferLimitExceededError
+		if errors.As(err, &limitErr) {
+			body["limit_period"] = limitErr.Period
+			body["remaining_allowance"] = h.formatAmount(limitErr.Remaining)
+		}
+		err = h.writeJson(w, http.StatusUnprocessableEntity, body, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"escrow": h.newEscrowResponse(escrow)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("escrow.id", escrow.ID()),
+		attribute.String("escrow.sender_id", input.SenderID),
+		attribute.String("escrow.receiver_id", input.ReceiverID),
+		attribute.Int64("escrow.amount_in_cents", amount),
+	)
+}
+
+// GetEscrow returns an escrow with its deadlines and, once released, the
+// transfer that paid the receiver.
+func (h handler) GetEscrow(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetEscrow")
+	defer span.End()
+
+	escrowID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	escrow, err := h.getEscrow.Execute(ctx, escrowID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errs.ErrEscrowNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"escrow": h.newEscrowResponse(escrow)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("escrow.id", escrowID.String()))
+}
+
+// PostShipEscrow records that the receiver shipped the goods paid by the
+// escrow.
+func (h handler) PostShipEscrow(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostShipEscrow")
+	defer span.End()
+
+	escrowID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostShipEscrowRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	receiverID, err := uuid.Parse(input.ReceiverID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid receiver_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	escrow, err := h.shipEscrow.Execute(ctx, usecase.ShipEscrowInput{
+		EscrowID:   escrowID,
+		ReceiverID: receiverID,
+	})
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrEscrowNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"escrow": h.newEscrowResponse(escrow)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("escrow.id", escrowID.String()))
+}
+
+// PostConfirmEscrow releases the money to the receiver once the sender
+// confirms the delivery.
+func (h handler) PostConfirmEscrow(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostConfirmEscrow")
+	defer span.End()
+
+	escrowID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostConfirmEscrowRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	senderID, err := uuid.Parse(input.SenderID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid sender_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	escrow, err := h.confirmEscrow.Execute(ctx, usecase.ConfirmEscrowInput{
+		EscrowID: escrowID,
+		SenderID: senderID,
+	})
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrEscrowNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"escrow": h.newEscrowResponse(escrow)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("escrow.id", escrowID.String()))
+}
+
+// PostResolveEscrow releases a pending escrow to the receiver or returns it to
+// the sender, as decided by an operator.
+func (h handler) PostResolveEscrow(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostResolveEscrow")
+	defer span.End()
+
+	escrowID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostResolveEscrowRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	escrow, err := h.resolveEscrow.Execute(ctx, usecase.ResolveEscrowInput{
+		EscrowID: escrowID,
+		Outcome:  input.Outcome,
+	})
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrEscrowNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"escrow": h.newEscrowResponse(escrow)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("escrow.id", escrowID.String()),
+		attribute.String("escrow.outcome", input.Outcome),
+	)
+}
+
+func (h handler) newEscrowResponse(escrow *entity.Escrow) EscrowResponse {
+	return EscrowResponse{
+		ID:            escrow.ID(),
+		SenderID:      escrow.SenderID(),
+		ReceiverID:    escrow.ReceiverID(),
+		Amount:        h.formatAmount(escrow.Amount()),
+		Currency:      escrow.Currency(),
+		Status:        escrow.Status(),
+		ExpiresAt:     escrow.ExpiresAt(),
+		ShippedAt:     escrow.ShippedAt(),
+		ReleaseAt:     escrow.ReleaseAt(),
+		TransactionID: escrow.TransactionID(),
+		CreatedAt:     escrow.CreatedAt(),
+		UpdatedAt:     escrow.UpdatedAt(),
+	}
+}
@@ -0,0 +1,238 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type CreateEscrowMock struct {
+	mock.Mock
+}
+
+func (m *CreateEscrowMock) Execute(ctx context.Context, input usecase.CreateEscrowInput) (*entity.Escrow, error) {
+	args := m.Called(ctx, input)
+	escrow, _ := args.Get(0).(*entity.Escrow)
+	return escrow, args.Error(1)
+}
+
+type GetEscrowMock struct {
+	mock.Mock
+}
+
+func (m *GetEscrowMock) Execute(ctx context.Context, id uuid.UUID) (*entity.Escrow, error) {
+	args := m.Called(ctx, id)
+	escrow, _ := args.Get(0).(*entity.Escrow)
+	return escrow, args.Error(1)
+}
+
+type ShipEscrowMock struct {
+	mock.Mock
+}
+
+func (m *ShipEscrowMock) Execute(ctx context.Context, input usecase.ShipEscrowInput) (*entity.Escrow, error) {
+	args := m.Called(ctx, input)
+	escrow, _ := args.Get(0).(*entity.Escrow)
+	return escrow, args.Error(1)
+}
+
+type ConfirmEscrowMock struct {
+	mock.Mock
+}
+
+func (m *ConfirmEscrowMock) Execute(ctx context.Context, input usecase.ConfirmEscrowInput) (*entity.Escrow, error) {
+	args := m.Called(ctx, input)
+	escrow, _ := args.Get(0).(*entity.Escrow)
+	return escrow, args.Error(1)
+}
+
+type ResolveEscrowMock struct {
+	mock.Mock
+}
+
+func (m *ResolveEscrowMock) Execute(ctx context.Context, input usecase.ResolveEscrowInput) (*entity.Escrow, error) {
+	args := m.Called(ctx, input)
+	escrow, _ := args.Get(0).(*entity.Escrow)
+	return escrow, args.Error(1)
+}
+
+func restoreEscrow(t *testing.T, status string, releaseAt *time.Time, transactionID string) *entity.Escrow {
+	createdAt := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	escrow, err := entity.RestoreEscrow(uuid.New(), uuid.NewString(), uuid.NewString(), 15000, vo.BRL, status, createdAt.Add(168*time.Hour), releaseAt, releaseAt, transactionID, createdAt, createdAt)
+	require.NoError(t, err)
+	return escrow
+}
+
+func TestPostEscrow_ShouldReturn201WithTheHeldEscrow(t *testing.T) {
+	// Arrange
+	escrow := restoreEscrow(t, entity.EscrowHeldStatus, nil, "")
+	senderID := uuid.MustParse(escrow.SenderID())
+	receiverID := uuid.MustParse(escrow.ReceiverID())
+	createMock := &CreateEscrowMock{}
+	createMock.On("Execute", mock.Anything, usecase.CreateEscrowInput{
+		Amount:     15000,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+	}).Return(escrow, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateEscrow(createMock))
+	payload := `{"amount":"150.00","sender_id":"` + senderID.String() + `","receiver_id":"` + receiverID.String() + `"}`
+	r, _ := http.NewRequest("POST", "/v1/escrows", strings.NewReader(payload))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostEscrow(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	var body struct {
+		Escrow map[string]any `json:"escrow"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, escrow.ID(), body.Escrow["id"])
+	assert.Equal(t, "150.00", body.Escrow["amount"])
+	assert.Equal(t, "held", body.Escrow["status"])
+	assert.Equal(t, "2026-03-17T12:00:00Z", body.Escrow["expires_at"])
+	assert.NotContains(t, body.Escrow, "release_at")
+	assert.NotContains(t, body.Escrow, "transaction_id")
+	createMock.AssertExpectations(t)
+}
+
+func TestPostEscrow_InsufficientBalance_ShouldReturn422(t *testing.T) {
+	// Arrange
+	createMock := &CreateEscrowMock{}
+	createMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrInsufficientBalance)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateEscrow(createMock))
+	payload := `{"amount":"150.00","sender_id":"` + uuid.NewString() + `","receiver_id":"` + uuid.NewString() + `"}`
+	r, _ := http.NewRequest("POST", "/v1/escrows", strings.NewReader(payload))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostEscrow(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, errs.ErrInsufficientBalance.Error(), body["error"])
+}
+
+func TestGetEscrow_NotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	escrowID := uuid.New()
+	getMock := &GetEscrowMock{}
+	getMock.On("Execute", mock.Anything, escrowID).Return(nil, errs.ErrEscrowNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGetEscrow(getMock))
+	r, _ := http.NewRequest("GET", "/v1/escrows/"+escrowID.String(), nil)
+	r = withURLParams(r, map[string]string{"id": escrowID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetEscrow(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	getMock.AssertExpectations(t)
+}
+
+func TestPostShipEscrow_ShouldReturn200WithTheReleaseDeadline(t *testing.T) {
+	// Arrange
+	releaseAt := time.Date(2026, 3, 24, 12, 0, 0, 0, time.UTC)
+	escrow := restoreEscrow(t, entity.EscrowShippedStatus, &releaseAt, "")
+	escrowID := uuid.MustParse(escrow.ID())
+	receiverID := uuid.MustParse(escrow.ReceiverID())
+	shipMock := &ShipEscrowMock{}
+	shipMock.On("Execute", mock.Anything, usecase.ShipEscrowInput{EscrowID: escrowID, ReceiverID: receiverID}).Return(escrow, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithShipEscrow(shipMock))
+	r, _ := http.NewRequest("POST", "/v1/escrows/"+escrowID.String()+"/ship", strings.NewReader(`{"receiver_id":"`+receiverID.String()+`"}`))
+	r = withURLParams(r, map[string]string{"id": escrowID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostShipEscrow(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body struct {
+		Escrow map[string]any `json:"escrow"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "shipped", body.Escrow["status"])
+	assert.Equal(t, "2026-03-24T12:00:00Z", body.Escrow["release_at"])
+	shipMock.AssertExpectations(t)
+}
+
+func TestPostConfirmEscrow_ShouldMapErrorsToStatusCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "escrow not found", err: errs.ErrEscrowNotFound, want: http.StatusNotFound},
+		{name: "not the sender", err: errs.ErrOnlySenderCanConfirmEscrow, want: http.StatusUnprocessableEntity},
+		{name: "expired", err: errs.ErrEscrowExpired, want: http.StatusUnprocessableEntity},
+		{name: "already released", err: errs.ErrEscrowNotPending, want: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			confirmMock := &ConfirmEscrowMock{}
+			confirmMock.On("Execute", mock.Anything, mock.Anything).Return(nil, tt.err)
+			h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithConfirmEscrow(confirmMock))
+			escrowID := uuid.NewString()
+			r, _ := http.NewRequest("POST", "/v1/escrows/"+escrowID+"/confirm", strings.NewReader(`{"sender_id":"`+uuid.NewString()+`"}`))
+			r = withURLParams(r, map[string]string{"id": escrowID})
+			w := httptest.NewRecorder()
+
+			// Act
+			h.PostConfirmEscrow(w, r)
+
+			// Assert
+			assert.Equal(t, tt.want, w.Result().StatusCode)
+		})
+	}
+}
+
+func TestPostResolveEscrow_Released_ShouldReturn200WithTheTransfer(t *testing.T) {
+	// Arrange
+	escrow := restoreEscrow(t, entity.EscrowReleasedStatus, nil, "transaction-123")
+	escrowID := uuid.MustParse(escrow.ID())
+	resolveMock := &ResolveEscrowMock{}
+	resolveMock.On("Execute", mock.Anything, usecase.ResolveEscrowInput{EscrowID: escrowID, Outcome: "released"}).Return(escrow, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithResolveEscrow(resolveMock))
+	r, _ := http.NewRequest("POST", "/v1/escrows/"+escrowID.String()+"/resolve", strings.NewReader(`{"outcome":"released"}`))
+	r = withURLParams(r, map[string]string{"id": escrowID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostResolveEscrow(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body struct {
+		Escrow map[string]any `json:"escrow"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "released", body.Escrow["status"])
+	assert.Equal(t, "transaction-123", body.Escrow["transaction_id"])
+	resolveMock.AssertExpectations(t)
+}
@@ -0,0 +1,296 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PostHoldRequest struct {
+	// Amount is a decimal with at most two places, e.g. 10.50 or "10.50".
+	Amount json.Number `json:"amount"`
+	// Currency is the ISO-4217 code of the hold, BRL when omitted.
+	Currency   string `json:"currency"`
+	SenderID   string `json:"sender_id"`
+	ReceiverID string `json:"receiver_id"`
+	// ExpiresAt is an RFC 3339 date the hold is released at if not captured,
+	// the default expiry when omitted.
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type PostCaptureHoldRequest struct {
+	// Amount is a decimal with at most two places, e.g. 10.50 or "10.50".
+	Amount json.Number `json:"amount"`
+}
+
+type HoldResponse struct {
+	ID             string    `json:"id"`
+	SenderID       string    `json:"sender_id"`
+	ReceiverID     string    `json:"receiver_id"`
+	Amount         string    `json:"amount"`
+	CapturedAmount string    `json:"captured_amount"`
+	Currency       string    `json:"currency"`
+	Status         string    `json:"status"`
+	TransactionID  string    `json:"transaction_id,omitempty"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// PostHold reserves money of the sender for the receiver until it is
+// captured, voided or expires.
+func (h handler) PostHold(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostHold")
+	defer span.End()
+
+	var input PostHoldRequest
+
+	err := h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	amount, err := h.parseAmount(input.Amount)
+	if err != nil {
+		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	senderID, err := uuid.Parse(input.SenderID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid sender_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	receiverID, err := uuid.Parse(input.ReceiverID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid receiver_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var expiresAt time.Time
+	if input.ExpiresAt != nil {
+		expiresAt = *input.ExpiresAt
+	}
+
+	holdID, err := h.authorizeHold.Execute(ctx, usecase.AuthorizeHoldInput{
+		Amount:     amount,
+		Currency:   input.Currency,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+		ExpiresAt:  expiresAt,
+	})
+
+	if err != nil {
+		body := envelope{"error": err.Error()}
+		var limitErr *errs.TransferLimitExceededError
+		if errors.As(err, &limitErr) {
+			body["limit_period"] = limitErr.Period
+			body["remaining_allowance"] = h.formatAmount(limitErr.Remaining)
+		}
+		err = h.writeJson(w, http.StatusUnprocessableEntity, body, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"hold_id": holdID, "status": entity.BalanceHoldAuthorizedStatus}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("hold.id", holdID),
+		attribute.String("hold.sender_id", input.SenderID),
+		attribute.String("hold.receiver_id", input.ReceiverID),
+		attribute.Int64("hold.amount_in_cents", amount),
+	)
+}
+
+// GetHold returns a balance hold.
+func (h handler) GetHold(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetHold")
+	defer span.End()
+
+	holdID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	hold, err := h.getHold.Execute(ctx, holdID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errs.ErrBalanceHoldNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	response := HoldResponse{
+		ID:             hold.ID(),
+		SenderID:       hold.SenderID(),
+		ReceiverID:     hold.ReceiverID(),
+		Amount:         hold.FormattedAmount(),
+		CapturedAmount: h.formatAmount(hold.CapturedAmount()),
+		Currency:       hold.Currency(),
+		Status:         hold.Status(),
+		TransactionID:  hold.TransactionID(),
+		ExpiresAt:      hold.ExpiresAt(),
+		CreatedAt:      hold.CreatedAt(),
+		UpdatedAt:      hold.UpdatedAt(),
+	}
+	err = h.writeJson(w, http.StatusOK, envelope{"hold": response}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("hold.id", holdID.String()))
+}
+
+// PostCaptureHold moves the held money to the receiver. An empty body or an
+// omitted amount captures the whole hold.
+func (h handler) PostCaptureHold(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostCaptureHold")
+	defer span.End()
+
+	holdID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostCaptureHoldRequest
+
+	if r.ContentLength != 0 {
+		err = h.readJSON(w, r, &input)
+		if err != nil {
+			err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+	}
+
+	var amount int64
+	if input.Amount != "" {
+		amount, err = h.parseAmount(input.Amount)
+		if err != nil {
+			err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+	}
+
+	transactionID, err := h.captureHold.Execute(ctx, usecase.CaptureHoldInput{
+		HoldID: holdID,
+		Amount: amount,
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrBalanceHoldNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"hold_id": holdID.String(), "status": entity.BalanceHoldCapturedStatus, "transaction_id": transactionID}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("hold.id", holdID.String()),
+		attribute.String("hold.transaction_id", transactionID),
+	)
+}
+
+// PostVoidHold releases a hold without moving any money.
+func (h handler) PostVoidHold(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostVoidHold")
+	defer span.End()
+
+	holdID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.voidHold.Execute(ctx, holdID)
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrBalanceHoldNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"hold_id": holdID.String(), "status": entity.BalanceHoldVoidedStatus}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("hold.id", holdID.String()))
+}
@@ -0,0 +1,166 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	holdID         = "3c1e2a4b-8d7f-4e6a-9b5c-2f1d0e3a4b5c"
+	holdSenderID   = "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	holdReceiverID = "b3ae1675-5978-49d3-a6e3-619955ec6b2f"
+)
+
+func TestPostHold_ValidRequest_ShouldReturn201WithHoldID(t *testing.T) {
+	// Arrange
+	authorizeHoldMock := &AuthorizeHoldMock{}
+	authorizeHoldMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.AuthorizeHoldInput) bool {
+			return input.SenderID.String() == holdSenderID &&
+				input.ReceiverID.String() == holdReceiverID &&
+				input.Amount == 2590 &&
+				input.ExpiresAt.IsZero()
+		}),
+	).Return(holdID, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithAuthorizeHold(authorizeHoldMock))
+
+	reqBody := `{"amount": "25.90", "sender_id": "` + holdSenderID + `", "receiver_id": "` + holdReceiverID + `"}`
+	r, _ := http.NewRequest("POST", "/v1/holds", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostHold(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, holdID, body["hold_id"])
+	assert.Equal(t, entity.BalanceHoldAuthorizedStatus, body["status"])
+	authorizeHoldMock.AssertExpectations(t)
+}
+
+func TestPostHold_InsufficientAvailableBalance_ShouldReturn422(t *testing.T) {
+	// Arrange
+	authorizeHoldMock := &AuthorizeHoldMock{}
+	authorizeHoldMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrInsufficientBalance)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithAuthorizeHold(authorizeHoldMock))
+
+	reqBody := `{"amount": 100, "sender_id": "` + holdSenderID + `", "receiver_id": "` + holdReceiverID + `"}`
+	r, _ := http.NewRequest("POST", "/v1/holds", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostHold(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+func TestPostCaptureHold_EmptyBody_ShouldCaptureWholeHold(t *testing.T) {
+	// Arrange
+	captureHoldMock := &CaptureHoldMock{}
+	captureHoldMock.On(
+		"Execute",
+		mock.Anything,
+		usecase.CaptureHoldInput{HoldID: uuid.MustParse(holdID)},
+	).Return("transaction-123", nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCaptureHold(captureHoldMock))
+
+	r, _ := http.NewRequest("POST", "/v1/holds/"+holdID+"/capture", nil)
+	r = withURLParams(r, map[string]string{"id": holdID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostCaptureHold(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "transaction-123", body["transaction_id"])
+	assert.Equal(t, entity.BalanceHoldCapturedStatus, body["status"])
+	captureHoldMock.AssertExpectations(t)
+}
+
+func TestPostCaptureHold_HoldNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	captureHoldMock := &CaptureHoldMock{}
+	captureHoldMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrBalanceHoldNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCaptureHold(captureHoldMock))
+
+	r, _ := http.NewRequest("POST", "/v1/holds/"+holdID+"/capture", strings.NewReader(`{"amount": "10.00"}`))
+	r = withURLParams(r, map[string]string{"id": holdID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostCaptureHold(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestPostVoidHold_HoldAlreadyCaptured_ShouldReturn422(t *testing.T) {
+	// Arrange
+	voidHoldMock := &VoidHoldMock{}
+	voidHoldMock.On("Execute", mock.Anything, uuid.MustParse(holdID)).Return(errs.ErrBalanceHoldNotAuthorized)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithVoidHold(voidHoldMock))
+
+	r, _ := http.NewRequest("POST", "/v1/holds/"+holdID+"/void", nil)
+	r = withURLParams(r, map[string]string{"id": holdID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostVoidHold(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+type AuthorizeHoldMock struct {
+	mock.Mock
+}
+
+func (m *AuthorizeHoldMock) Execute(ctx context.Context, input usecase.AuthorizeHoldInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
+
+type CaptureHoldMock struct {
+	mock.Mock
+}
+
+func (m *CaptureHoldMock) Execute(ctx context.Context, input usecase.CaptureHoldInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
+
+type VoidHoldMock struct {
+	mock.Mock
+}
+
+func (m *VoidHoldMock) Execute(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
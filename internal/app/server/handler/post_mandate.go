@@ -0,0 +1,326 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PostMandateRequest struct {
+	// Amount is a decimal with at most two places transferred on every
+	// execution, e.g. 29.90 or "29.90".
+	Amount json.Number `json:"amount"`
+	// Currency is the ISO-4217 code the sender pays in, BRL when omitted.
+	Currency string `json:"currency"`
+	// ReceiverCurrency is the ISO-4217 code the receiver is credited in, the
+	// same as Currency when omitted.
+	ReceiverCurrency string `json:"receiver_currency"`
+	SenderID         string `json:"sender_id"`
+	ReceiverID       string `json:"receiver_id"`
+	// Frequency is weekly, monthly or cron.
+	Frequency string `json:"frequency"`
+	// Cron is the rule of the cron frequency, e.g. "0 9 * * 1-5".
+	Cron string `json:"cron"`
+	// StartAt is the RFC 3339 date of the first execution, now when omitted.
+	StartAt *time.Time `json:"start_at"`
+	// EndAt is the RFC 3339 date after which the mandate no longer runs.
+	EndAt *time.Time `json:"end_at"`
+	// MaxExecutions is how many times the mandate runs, unlimited when omitted.
+	MaxExecutions int `json:"max_executions"`
+}
+
+type MandateResponse struct {
+	ID               string     `json:"id"`
+	SenderID         string     `json:"sender_id"`
+	ReceiverID       string     `json:"receiver_id"`
+	Amount           string     `json:"amount"`
+	Currency         string     `json:"currency"`
+	ReceiverCurrency string     `json:"receiver_currency"`
+	Frequency        string     `json:"frequency"`
+	Cron             string     `json:"cron,omitempty"`
+	StartAt          time.Time  `json:"start_at"`
+	EndAt            *time.Time `json:"end_at,omitempty"`
+	MaxExecutions    int        `json:"max_executions,omitempty"`
+	ExecutionCount   int        `json:"execution_count"`
+	NextExecutionAt  time.Time  `json:"next_execution_at"`
+	Status           string     `json:"status"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+type MandateExecutionResponse struct {
+	ID            string    `json:"id"`
+	Sequence      int       `json:"sequence"`
+	ScheduledFor  time.Time `json:"scheduled_for"`
+	Status        string    `json:"status"`
+	TransactionID string    `json:"transaction_id,omitempty"`
+	FailureReason string    `json:"failure_reason,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// PostMandate creates a recurring transfer mandate.
+func (h handler) PostMandate(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostMandate")
+	defer span.End()
+
+	var input PostMandateRequest
+
+	err := h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	amount, err := h.parseAmount(input.Amount)
+	if err != nil {
+		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	senderID, err := uuid.Parse(input.SenderID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid sender_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	receiverID, err := uuid.Parse(input.ReceiverID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid receiver_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var startAt time.Time
+	if input.StartAt != nil {
+		startAt = *input.StartAt
+	}
+
+	mandateID, err := h.createMandate.Execute(ctx, usecase.CreateMandateInput{
+		Amount:           amount,
+		Currency:         input.Currency,
+		ReceiverCurrency: input.ReceiverCurrency,
+		SenderID:         senderID,
+		ReceiverID:       receiverID,
+		Frequency:        input.Frequency,
+		Cron:             input.Cron,
+		StartAt:          startAt,
+		EndAt:            input.EndAt,
+		MaxExecutions:    input.MaxExecutions,
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrSenderNotFound) || errors.Is(err, errs.ErrReceiverNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"mandate_id": mandateID}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("mandate.id", mandateID),
+		attribute.String("mandate.frequency", input.Frequency),
+		attribute.Int64("mandate.amount_in_cents", amount),
+	)
+}
+
+// GetMandates lists the mandates of a user.
+func (h handler) GetMandates(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetMandates")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	mandates, err := h.listMandates.Execute(ctx, userID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	response := make([]MandateResponse, 0, len(mandates))
+	for _, mandate := range mandates {
+		response = append(response, MandateResponse{
+			ID:               mandate.ID(),
+			SenderID:         mandate.SenderID(),
+			ReceiverID:       mandate.ReceiverID(),
+			Amount:           mandate.FormattedAmount(),
+			Currency:         mandate.Currency(),
+			ReceiverCurrency: mandate.ReceiverCurrency(),
+			Frequency:        mandate.Recurrence().Frequency(),
+			Cron:             mandate.Recurrence().Expression(),
+			StartAt:          mandate.StartAt(),
+			EndAt:            mandate.EndAt(),
+			MaxExecutions:    mandate.MaxExecutions(),
+			ExecutionCount:   mandate.ExecutionCount(),
+			NextExecutionAt:  mandate.NextExecutionAt(),
+			Status:           mandate.Status(),
+			CreatedAt:        mandate.CreatedAt(),
+		})
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"mandates": response}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("mandate.sender_id", userID.String()),
+		attribute.Int("mandate.count", len(response)),
+	)
+}
+
+// GetMandateExecutions lists every execution of a mandate.
+func (h handler) GetMandateExecutions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetMandateExecutions")
+	defer span.End()
+
+	mandateID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	executions, err := h.listMandateExecutions.Execute(ctx, mandateID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errs.ErrMandateNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	response := make([]MandateExecutionResponse, 0, len(executions))
+	for _, execution := range executions {
+		response = append(response, MandateExecutionResponse{
+			ID:            execution.ID(),
+			Sequence:      execution.Sequence(),
+			ScheduledFor:  execution.ScheduledFor(),
+			Status:        execution.Status(),
+			TransactionID: execution.TransactionID(),
+			FailureReason: execution.FailureReason(),
+			CreatedAt:     execution.CreatedAt(),
+		})
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"executions": response}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("mandate.id", mandateID.String()),
+		attribute.Int("mandate.execution_count", len(response)),
+	)
+}
+
+func (h handler) PostPauseMandate(w http.ResponseWriter, r *http.Request) {
+	h.changeMandate(w, r, usecase.PauseMandateAction)
+}
+
+func (h handler) PostResumeMandate(w http.ResponseWriter, r *http.Request) {
+	h.changeMandate(w, r, usecase.ResumeMandateAction)
+}
+
+func (h handler) PostCancelMandate(w http.ResponseWriter, r *http.Request) {
+	h.changeMandate(w, r, usecase.CancelMandateAction)
+}
+
+// changeMandate applies a pause, resume or cancel action to the mandate.
+func (h handler) changeMandate(w http.ResponseWriter, r *http.Request, action string) {
+	ctx, span := h.otel.Start(r.Context(), "PostChangeMandateStatus")
+	defer span.End()
+
+	mandateID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	status, err := h.changeMandateStatus.Execute(ctx, usecase.ChangeMandateStatusInput{
+		MandateID: mandateID,
+		Action:    action,
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrMandateNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"mandate_id": mandateID.String(), "status": status}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("mandate.id", mandateID.String()),
+		attribute.String("mandate.action", action),
+		attribute.String("mandate.status", status),
+	)
+}
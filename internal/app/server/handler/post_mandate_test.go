@@ -0,0 +1,248 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mandateSenderID   = "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	mandateReceiverID = "f6de1685-5978-49d3-a6e3-619955ec6b2f"
+)
+
+func TestPostMandate_ValidRequest_ShouldReturn201WithMandateID(t *testing.T) {
+	// Arrange
+	endAt := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+	createMandateMock := &CreateMandateMock{}
+	createMandateMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.CreateMandateInput) bool {
+			return input.Amount == 2990 &&
+				input.SenderID.String() == mandateSenderID &&
+				input.ReceiverID.String() == mandateReceiverID &&
+				input.Frequency == vo.CronFrequency &&
+				input.Cron == "0 9 1 * *" &&
+				input.StartAt.IsZero() &&
+				input.EndAt != nil && input.EndAt.Equal(endAt) &&
+				input.MaxExecutions == 12
+		}),
+	).Return("mandate-123", nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateMandate(createMandateMock))
+
+	reqBody := `{
+		"amount": "29.90",
+		"sender_id": "` + mandateSenderID + `",
+		"receiver_id": "` + mandateReceiverID + `",
+		"frequency": "cron",
+		"cron": "0 9 1 * *",
+		"end_at": "2031-01-01T00:00:00Z",
+		"max_executions": 12
+	}`
+	r, _ := http.NewRequest("POST", "/v1/mandates", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostMandate(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "mandate-123", body["mandate_id"])
+	createMandateMock.AssertExpectations(t)
+}
+
+func TestPostMandate_InvalidFrequency_ShouldReturn422(t *testing.T) {
+	// Arrange
+	createMandateMock := &CreateMandateMock{}
+	createMandateMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrInvalidRecurrenceFrequency)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateMandate(createMandateMock))
+
+	reqBody := `{"amount": 10, "sender_id": "` + mandateSenderID + `", "receiver_id": "` + mandateReceiverID + `", "frequency": "daily"}`
+	r, _ := http.NewRequest("POST", "/v1/mandates", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostMandate(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+func TestGetMandates_ValidRequest_ShouldReturn200WithMandates(t *testing.T) {
+	// Arrange
+	amount, err := vo.NewMoney(2990, vo.BRL)
+	require.NoError(t, err)
+	recurrence, err := vo.NewRecurrence(vo.WeeklyFrequency, "")
+	require.NoError(t, err)
+	now := time.Now()
+	mandate, err := entity.NewMandate(mandateSenderID, mandateReceiverID, amount, "", recurrence, now, nil, 4, now)
+	require.NoError(t, err)
+
+	listMandatesMock := &ListMandatesMock{}
+	listMandatesMock.On("Execute", mock.Anything, uuid.MustParse(mandateSenderID)).Return([]*entity.Mandate{mandate}, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListMandates(listMandatesMock))
+
+	r, _ := http.NewRequest("GET", "/v1/users/"+mandateSenderID+"/mandates", nil)
+	r = withURLParams(r, map[string]string{"id": mandateSenderID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetMandates(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Mandates []handler.MandateResponse `json:"mandates"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	require.Len(t, body.Mandates, 1)
+	assert.Equal(t, mandate.ID(), body.Mandates[0].ID)
+	assert.Equal(t, "29.90", body.Mandates[0].Amount)
+	assert.Equal(t, vo.WeeklyFrequency, body.Mandates[0].Frequency)
+	assert.Equal(t, 4, body.Mandates[0].MaxExecutions)
+	assert.Equal(t, entity.MandateActiveStatus, body.Mandates[0].Status)
+}
+
+func TestGetMandateExecutions_MandateNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	listMandateExecutionsMock := &ListMandateExecutionsMock{}
+	listMandateExecutionsMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrMandateNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListMandateExecutions(listMandateExecutionsMock))
+
+	id := uuid.NewString()
+	r, _ := http.NewRequest("GET", "/v1/mandates/"+id+"/executions", nil)
+	r = withURLParams(r, map[string]string{"id": id})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetMandateExecutions(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestPostPauseMandate_ValidRequest_ShouldReturn200WithStatus(t *testing.T) {
+	// Arrange
+	id := uuid.New()
+	changeMandateStatusMock := &ChangeMandateStatusMock{}
+	changeMandateStatusMock.On("Execute", mock.Anything, usecase.ChangeMandateStatusInput{
+		MandateID: id,
+		Action:    usecase.PauseMandateAction,
+	}).Return(entity.MandatePausedStatus, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithChangeMandateStatus(changeMandateStatusMock))
+
+	r, _ := http.NewRequest("POST", "/v1/mandates/"+id.String()+"/pause", nil)
+	r = withURLParams(r, map[string]string{"id": id.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostPauseMandate(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "paused", body["status"])
+	changeMandateStatusMock.AssertExpectations(t)
+}
+
+func TestPostResumeMandate_NotPaused_ShouldReturn422(t *testing.T) {
+	// Arrange
+	changeMandateStatusMock := &ChangeMandateStatusMock{}
+	changeMandateStatusMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrMandateNotPaused)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithChangeMandateStatus(changeMandateStatusMock))
+
+	id := uuid.NewString()
+	r, _ := http.NewRequest("POST", "/v1/mandates/"+id+"/resume", nil)
+	r = withURLParams(r, map[string]string{"id": id})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostResumeMandate(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+func TestPostCancelMandate_NotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	changeMandateStatusMock := &ChangeMandateStatusMock{}
+	changeMandateStatusMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrMandateNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithChangeMandateStatus(changeMandateStatusMock))
+
+	id := uuid.NewString()
+	r, _ := http.NewRequest("POST", "/v1/mandates/"+id+"/cancel", nil)
+	r = withURLParams(r, map[string]string{"id": id})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostCancelMandate(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+type CreateMandateMock struct {
+	mock.Mock
+}
+
+func (m *CreateMandateMock) Execute(ctx context.Context, input usecase.CreateMandateInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
+
+type ListMandatesMock struct {
+	mock.Mock
+}
+
+func (m *ListMandatesMock) Execute(ctx context.Context, senderID uuid.UUID) ([]*entity.Mandate, error) {
+	args := m.Called(ctx, senderID)
+	mandates, _ := args.Get(0).([]*entity.Mandate)
+	return mandates, args.Error(1)
+}
+
+type ListMandateExecutionsMock struct {
+	mock.Mock
+}
+
+func (m *ListMandateExecutionsMock) Execute(ctx context.Context, mandateID uuid.UUID) ([]*entity.MandateExecution, error) {
+	args := m.Called(ctx, mandateID)
+	executions, _ := args.Get(0).([]*entity.MandateExecution)
+	return executions, args.Error(1)
+}
+
+type ChangeMandateStatusMock struct {
+	mock.Mock
+}
+
+func (m *ChangeMandateStatusMock) Execute(ctx context.Context, input usecase.ChangeMandateStatusInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
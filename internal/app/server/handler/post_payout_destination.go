@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PostPayoutDestinationRequest struct {
+	// Kind is either bank_account or pix_key
+	Kind          string `json:"kind"`
+	BankCode      string `json:"bank_code"`
+	Branch        string `json:"branch"`
+	AccountNumber string `json:"account_number"`
+	PixKey        string `json:"pix_key"`
+}
+
+// PostPayoutDestination registers a bank account or a PIX key the user can
+// withdraw money to.
+func (h handler) PostPayoutDestination(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostPayoutDestination")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostPayoutDestinationRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	destinationID, err := h.registerPayoutDestination.Execute(ctx, usecase.RegisterPayoutDestinationInput{
+		UserID:        userID,
+		Kind:          input.Kind,
+		BankCode:      input.BankCode,
+		Branch:        input.Branch,
+		AccountNumber: input.AccountNumber,
+		PixKey:        input.PixKey,
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrUserNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"destination_id": destinationID}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("payout_destination.user_id", userID.String()),
+		attribute.String("payout_destination.kind", input.Kind),
+	)
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	pixChargeKind   = "charge"
+	pixTransferKind = "transfer"
+)
+
+type PostPixCodeRequest struct {
+	MerchantID string `json:"merchant_id"`
+	// Amount is a decimal with at most two places in BRL, e.g. 10.50 or
+	// "10.50". The payer chooses how much to pay when omitted.
+	Amount json.Number `json:"amount"`
+	// Reference identifies the payments made with the code, at most 25
+	// letters or digits.
+	Reference   string `json:"reference"`
+	Description string `json:"description"`
+}
+
+type PostDecodePixCodeRequest struct {
+	Payload string `json:"payload"`
+}
+
+type PixTransferResponse struct {
+	ReceiverID   string `json:"receiver_id"`
+	ReceiverName string `json:"receiver_name"`
+	Amount       string `json:"amount,omitempty"`
+	Currency     string `json:"currency"`
+	Reference    string `json:"reference,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// GetChargePixCode returns the dynamic PIX code paying a pending charge, to
+// be shown as a QR code.
+func (h handler) GetChargePixCode(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetChargePixCode")
+	defer span.End()
+
+	chargeID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	code, err := h.generateChargePixCode.Execute(ctx, chargeID)
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrChargeNotFound) || errors.Is(err, errs.ErrUserNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"payload": code.Payload()}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("charge.id", chargeID.String()))
+}
+
+// PostPixCode creates a static PIX code paying a merchant, which can be
+// printed and paid many times.
+func (h handler) PostPixCode(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostPixCode")
+	defer span.End()
+
+	var input PostPixCodeRequest
+
+	err := h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	merchantID, err := uuid.Parse(input.MerchantID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid merchant_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var amount int64
+	if input.Amount != "" {
+		amount, err = h.parseAmount(input.Amount)
+		if err != nil {
+			err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+	}
+
+	code, err := h.generateStaticPixCode.Execute(ctx, usecase.GenerateStaticPixCodeInput{
+		MerchantID:  merchantID,
+		Amount:      amount,
+		Reference:   input.Reference,
+		Description: input.Description,
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrUserNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"payload": code.Payload()}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("pix.merchant_id", input.MerchantID),
+		attribute.Int64("pix.amount_in_cents", amount),
+	)
+}
+
+// PostDecodePixCode reads a scanned PIX code and returns what paying it
+// means: a charge to pay or a transfer to make.
+func (h handler) PostDecodePixCode(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostDecodePixCode")
+	defer span.End()
+
+	var input PostDecodePixCodeRequest
+
+	err := h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	payment, err := h.decodePixCode.Execute(ctx, input.Payload)
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrChargeNotFound) || errors.Is(err, errs.ErrPixKeyNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	body := envelope{"kind": pixChargeKind}
+	if payment.Charge != nil {
+		body["charge"] = newChargeResponse(payment.Charge)
+	} else {
+		body["kind"] = pixTransferKind
+		transfer := PixTransferResponse{
+			ReceiverID:   payment.Receiver.ID(),
+			ReceiverName: payment.Receiver.Name(),
+			Currency:     vo.BRL,
+			Reference:    payment.Reference,
+			Description:  payment.Description,
+		}
+		if payment.Amount > 0 {
+			transfer.Amount = h.formatAmount(payment.Amount)
+		}
+		body["transfer"] = transfer
+	}
+
+	err = h.writeJson(w, http.StatusOK, body, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("pix.kind", body["kind"].(string)))
+}
@@ -0,0 +1,222 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetChargePixCode_ValidRequest_ShouldReturn200WithPayload(t *testing.T) {
+	// Arrange
+	charge := newTestCharge(t)
+	code, err := vo.NewDynamicPixCode("pix.example.com/v1/charges/"+charge.ID(), "Loja", "SAO PAULO", charge.Amount())
+	require.NoError(t, err)
+	generateMock := &GenerateChargePixCodeMock{}
+	generateMock.On("Execute", mock.Anything, uuid.MustParse(charge.ID())).Return(code, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGenerateChargePixCode(generateMock))
+
+	r, _ := http.NewRequest("GET", "/v1/charges/"+charge.ID()+"/pix", nil)
+	r = withURLParams(r, map[string]string{"id": charge.ID()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetChargePixCode(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Payload string `json:"payload"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, code.Payload(), body.Payload)
+}
+
+func TestGetChargePixCode_ChargeNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	chargeID := uuid.NewString()
+	generateMock := &GenerateChargePixCodeMock{}
+	generateMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrChargeNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGenerateChargePixCode(generateMock))
+
+	r, _ := http.NewRequest("GET", "/v1/charges/"+chargeID+"/pix", nil)
+	r = withURLParams(r, map[string]string{"id": chargeID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetChargePixCode(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestPostPixCode_ValidRequest_ShouldReturn201WithPayload(t *testing.T) {
+	// Arrange
+	code, err := vo.NewStaticPixCode(chargeMerchantID, "Loja", "SAO PAULO", 1050, "CAIXA1", "")
+	require.NoError(t, err)
+	generateMock := &GenerateStaticPixCodeMock{}
+	generateMock.On(
+		"Execute",
+		mock.Anything,
+		usecase.GenerateStaticPixCodeInput{MerchantID: uuid.MustParse(chargeMerchantID), Amount: 1050, Reference: "CAIXA1"},
+	).Return(code, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGenerateStaticPixCode(generateMock))
+
+	reqBody := `{"merchant_id": "` + chargeMerchantID + `", "amount": "10.50", "reference": "CAIXA1"}`
+	r, _ := http.NewRequest("POST", "/v1/pix/codes", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostPixCode(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body struct {
+		Payload string `json:"payload"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, code.Payload(), body.Payload)
+	generateMock.AssertExpectations(t)
+}
+
+func TestPostPixCode_InvalidReference_ShouldReturn422(t *testing.T) {
+	// Arrange
+	generateMock := &GenerateStaticPixCodeMock{}
+	generateMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrInvalidPixReference)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGenerateStaticPixCode(generateMock))
+
+	reqBody := `{"merchant_id": "` + chargeMerchantID + `", "reference": "order-42"}`
+	r, _ := http.NewRequest("POST", "/v1/pix/codes", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostPixCode(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+func TestPostDecodePixCode_DynamicCode_ShouldReturnTheCharge(t *testing.T) {
+	// Arrange
+	charge := newTestCharge(t)
+	decodeMock := &DecodePixCodeMock{}
+	decodeMock.On("Execute", mock.Anything, "payload").Return(&usecase.PixPayment{Charge: charge, Amount: charge.Amount()}, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithDecodePixCode(decodeMock))
+
+	r, _ := http.NewRequest("POST", "/v1/pix/decode", strings.NewReader(`{"payload": "payload"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostDecodePixCode(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Kind   string                  `json:"kind"`
+		Charge *handler.ChargeResponse `json:"charge"`
+	}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "charge", body.Kind)
+	require.NotNil(t, body.Charge)
+	assert.Equal(t, charge.ID(), body.Charge.ID)
+}
+
+func TestPostDecodePixCode_StaticCode_ShouldReturnTheTransfer(t *testing.T) {
+	// Arrange
+	receiver, err := entity.NewUser("Loja", "loja@example.com", "password123", "", "71627571000107", vo.MerchantUserType)
+	require.NoError(t, err)
+	decodeMock := &DecodePixCodeMock{}
+	decodeMock.On("Execute", mock.Anything, "payload").Return(&usecase.PixPayment{Receiver: receiver, Amount: 1050, Reference: "CAIXA1"}, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithDecodePixCode(decodeMock))
+
+	r, _ := http.NewRequest("POST", "/v1/pix/decode", strings.NewReader(`{"payload": "payload"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostDecodePixCode(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Kind     string                       `json:"kind"`
+		Transfer *handler.PixTransferResponse `json:"transfer"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "transfer", body.Kind)
+	require.NotNil(t, body.Transfer)
+	assert.Equal(t, receiver.ID(), body.Transfer.ReceiverID)
+	assert.Equal(t, "10.50", body.Transfer.Amount)
+	assert.Equal(t, vo.BRL, body.Transfer.Currency)
+	assert.Equal(t, "CAIXA1", body.Transfer.Reference)
+}
+
+func TestPostDecodePixCode_BadChecksum_ShouldReturn422(t *testing.T) {
+	// Arrange
+	decodeMock := &DecodePixCodeMock{}
+	decodeMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrPixCodeChecksumMismatch)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithDecodePixCode(decodeMock))
+
+	r, _ := http.NewRequest("POST", "/v1/pix/decode", strings.NewReader(`{"payload": "payload"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostDecodePixCode(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+type GenerateChargePixCodeMock struct {
+	mock.Mock
+}
+
+func (m *GenerateChargePixCodeMock) Execute(ctx context.Context, chargeID uuid.UUID) (*vo.PixCode, error) {
+	args := m.Called(ctx, chargeID)
+	code, _ := args.Get(0).(*vo.PixCode)
+	return code, args.Error(1)
+}
+
+type GenerateStaticPixCodeMock struct {
+	mock.Mock
+}
+
+func (m *GenerateStaticPixCodeMock) Execute(ctx context.Context, input usecase.GenerateStaticPixCodeInput) (*vo.PixCode, error) {
+	args := m.Called(ctx, input)
+	code, _ := args.Get(0).(*vo.PixCode)
+	return code, args.Error(1)
+}
+
+type DecodePixCodeMock struct {
+	mock.Mock
+}
+
+func (m *DecodePixCodeMock) Execute(ctx context.Context, payload string) (*usecase.PixPayment, error) {
+	args := m.Called(ctx, payload)
+	payment, _ := args.Get(0).(*usecase.PixPayment)
+	return payment, args.Error(1)
+}
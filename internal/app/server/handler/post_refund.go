@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PostRefundRequest struct {
+	// Amount is a decimal with at most two places, e.g. 10.50 or "10.50".
+	Amount json.Number `json:"amount"`
+}
+
+// PostRefund refunds a transaction. An empty body or an omitted amount
+// refunds everything that has not been refunded yet.
+func (h handler) PostRefund(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostRefund")
+	defer span.End()
+
+	transactionID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostRefundRequest
+
+	if r.ContentLength != 0 {
+		err = h.readJSON(w, r, &input)
+		if err != nil {
+			err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+	}
+
+	var amount int64
+	if input.Amount != "" {
+		amount, err = h.parseAmount(input.Amount)
+		if err != nil {
+			err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+	}
+
+	refundID, err := h.refundTransaction.Execute(ctx, usecase.RefundTransactionInput{
+		TransactionID: transactionID,
+		Amount:        amount,
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrTransactionNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"transaction_id": refundID}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("refund.original_transaction_id", transactionID.String()),
+		attribute.Int64("refund.amount_in_cents", amount),
+	)
+}
@@ -0,0 +1,159 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPostRefund_InvalidTransactionID_ShouldReturn400(t *testing.T) {
+	// Arrange
+	refundTransactionMock := &RefundTransactionMock{}
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithRefundTransaction(refundTransactionMock))
+
+	r, _ := http.NewRequest("POST", "/v1/transactions/invalid-uuid/refund", nil)
+	r = withURLParams(r, map[string]string{"id": "invalid-uuid"})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostRefund(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "invalid id", body["error"])
+	refundTransactionMock.AssertNotCalled(t, "Execute")
+}
+
+func TestPostRefund_EmptyBody_ShouldRefundTheWholeTransaction(t *testing.T) {
+	// Arrange
+	transactionID := "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	refundTransactionMock := &RefundTransactionMock{}
+	refundTransactionMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.RefundTransactionInput) bool {
+			return input.TransactionID.String() == transactionID && input.Amount == 0
+		}),
+	).Return("refund-123", nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithRefundTransaction(refundTransactionMock))
+
+	r, _ := http.NewRequest("POST", "/v1/transactions/"+transactionID+"/refund", nil)
+	r = withURLParams(r, map[string]string{"id": transactionID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostRefund(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "refund-123", body["transaction_id"])
+	refundTransactionMock.AssertExpectations(t)
+}
+
+func TestPostRefund_PartialAmount_ShouldRefundTheRequestedAmount(t *testing.T) {
+	// Arrange
+	transactionID := "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	refundTransactionMock := &RefundTransactionMock{}
+	refundTransactionMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.RefundTransactionInput) bool {
+			return input.TransactionID.String() == transactionID && input.Amount == 2550
+		}),
+	).Return("refund-123", nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithRefundTransaction(refundTransactionMock))
+
+	r, _ := http.NewRequest("POST", "/v1/transactions/"+transactionID+"/refund", strings.NewReader(`{"amount": 25.5}`))
+	r = withURLParams(r, map[string]string{"id": transactionID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostRefund(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	refundTransactionMock.AssertExpectations(t)
+}
+
+func TestPostRefund_TransactionNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	transactionID := "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	refundTransactionMock := &RefundTransactionMock{}
+	refundTransactionMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrTransactionNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithRefundTransaction(refundTransactionMock))
+
+	r, _ := http.NewRequest("POST", "/v1/transactions/"+transactionID+"/refund", nil)
+	r = withURLParams(r, map[string]string{"id": transactionID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostRefund(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, errs.ErrTransactionNotFound.Error(), body["error"])
+}
+
+func TestPostRefund_WhenUsecaseReturnsError_ShouldReturn422(t *testing.T) {
+	// Arrange
+	transactionID := "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	refundTransactionMock := &RefundTransactionMock{}
+	refundTransactionMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrRefundExceedsTransactionAmount)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithRefundTransaction(refundTransactionMock))
+
+	r, _ := http.NewRequest("POST", "/v1/transactions/"+transactionID+"/refund", strings.NewReader(`{"amount": 1000}`))
+	r = withURLParams(r, map[string]string{"id": transactionID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostRefund(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+type RefundTransactionMock struct {
+	mock.Mock
+}
+
+func (m *RefundTransactionMock) Execute(ctx context.Context, input usecase.RefundTransactionInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
+
+// withURLParams sets chi URL parameters on a request built outside the router.
+func withURLParams(r *http.Request, params map[string]string) *http.Request {
+	rctx := chi.NewRouteContext()
+	for key, value := range params {
+		rctx.URLParams.Add(key, value)
+	}
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+}
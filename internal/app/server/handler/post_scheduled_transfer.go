@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type ScheduledTransferResponse struct {
+	ID               string    `json:"id"`
+	SenderID         string    `json:"sender_id"`
+	ReceiverID       string    `json:"receiver_id"`
+	Amount           string    `json:"amount"`
+	Currency         string    `json:"currency"`
+	ReceiverCurrency string    `json:"receiver_currency"`
+	ExecuteAt        time.Time `json:"execute_at"`
+	Status           string    `json:"status"`
+	TransactionID    string    `json:"transaction_id,omitempty"`
+	FailureReason    string    `json:"failure_reason,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// postScheduledTransfer schedules the transfer of a POST /v1/transactions
+// request carrying an execute_at instead of executing it now.
+func (h handler) postScheduledTransfer(w http.ResponseWriter, r *http.Request, input PostTransactionRequest, amount int64, senderID, receiverID uuid.UUID) {
+	ctx, span := h.otel.Start(r.Context(), "PostScheduledTransfer")
+	defer span.End()
+
+	scheduledTransferID, err := h.scheduleTransfer.Execute(ctx, usecase.ScheduleTransferInput{
+		Amount:           amount,
+		Currency:         input.Currency,
+		ReceiverCurrency: input.ReceiverCurrency,
+		SenderID:         senderID,
+		ReceiverID:       receiverID,
+		ExecuteAt:        *input.ExecuteAt,
+		IdempotencyKey:   r.Header.Get(IdempotencyKeyHeader),
+	})
+
+	if err != nil {
+		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"scheduled_transfer_id": scheduledTransferID, "execute_at": input.ExecuteAt}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("scheduled_transfer.id", scheduledTransferID),
+		attribute.String("scheduled_transfer.execute_at", input.ExecuteAt.Format(time.RFC3339)),
+		attribute.Int64("scheduled_transfer.amount_in_cents", amount),
+	)
+}
+
+// GetScheduledTransfers lists the transfers scheduled by a user.
+func (h handler) GetScheduledTransfers(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetScheduledTransfers")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	scheduledTransfers, err := h.listScheduledTransfers.Execute(ctx, userID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	response := make([]ScheduledTransferResponse, 0, len(scheduledTransfers))
+	for _, scheduledTransfer := range scheduledTransfers {
+		response = append(response, ScheduledTransferResponse{
+			ID:               scheduledTransfer.ID(),
+			SenderID:         scheduledTransfer.SenderID(),
+			ReceiverID:       scheduledTransfer.ReceiverID(),
+			Amount:           scheduledTransfer.FormattedAmount(),
+			Currency:         scheduledTransfer.Currency(),
+			ReceiverCurrency: scheduledTransfer.ReceiverCurrency(),
+			ExecuteAt:        scheduledTransfer.ExecuteAt(),
+			Status:           scheduledTransfer.Status(),
+			TransactionID:    scheduledTransfer.TransactionID(),
+			FailureReason:    scheduledTransfer.FailureReason(),
+			CreatedAt:        scheduledTransfer.CreatedAt(),
+			UpdatedAt:        scheduledTransfer.UpdatedAt(),
+		})
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"scheduled_transfers": response}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("scheduled_transfer.sender_id", userID.String()),
+		attribute.Int("scheduled_transfer.count", len(response)),
+	)
+}
+
+// PostCancelScheduledTransfer cancels a transfer that has not run yet.
+func (h handler) PostCancelScheduledTransfer(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostCancelScheduledTransfer")
+	defer span.End()
+
+	scheduledTransferID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.cancelScheduledTransfer.Execute(ctx, scheduledTransferID)
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrScheduledTransferNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"scheduled_transfer_id": scheduledTransferID.String(), "status": entity.ScheduledTransferCancelledStatus}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("scheduled_transfer.id", scheduledTransferID.String()))
+}
@@ -0,0 +1,217 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	scheduledSenderID   = "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	scheduledReceiverID = "f6de1685-5978-49d3-a6e3-619955ec6b2f"
+)
+
+func TestPostTransaction_WithExecuteAt_ShouldScheduleTheTransfer(t *testing.T) {
+	// Arrange
+	executeAt := time.Date(2030, 1, 15, 9, 30, 0, 0, time.UTC)
+	createTransactionMock := &CreateTransactionMock{}
+	scheduleTransferMock := &ScheduleTransferMock{}
+	scheduleTransferMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.ScheduleTransferInput) bool {
+			return input.Amount == 2550 &&
+				input.SenderID.String() == scheduledSenderID &&
+				input.ReceiverID.String() == scheduledReceiverID &&
+				input.ExecuteAt.Equal(executeAt) &&
+				input.IdempotencyKey == "schedule-123"
+		}),
+	).Return("scheduled-123", nil)
+	h := handler.New(createTransactionMock, nil, telemetry.NewMockTelemetry(), handler.WithScheduleTransfer(scheduleTransferMock))
+
+	reqBody := `{
+		"amount": "25.50",
+		"sender_id": "` + scheduledSenderID + `",
+		"receiver_id": "` + scheduledReceiverID + `",
+		"execute_at": "2030-01-15T09:30:00Z"
+	}`
+	r, _ := http.NewRequest("POST", "/v1/transactions", strings.NewReader(reqBody))
+	r.Header.Set(handler.IdempotencyKeyHeader, "schedule-123")
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransaction(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "scheduled-123", body["scheduled_transfer_id"])
+	assert.Equal(t, "2030-01-15T09:30:00Z", body["execute_at"])
+	scheduleTransferMock.AssertExpectations(t)
+	createTransactionMock.AssertNotCalled(t, "Execute")
+}
+
+func TestPostTransaction_WithExecuteAtInThePast_ShouldReturn422(t *testing.T) {
+	// Arrange
+	scheduleTransferMock := &ScheduleTransferMock{}
+	scheduleTransferMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrExecuteAtNotInTheFuture)
+	h := handler.New(&CreateTransactionMock{}, nil, telemetry.NewMockTelemetry(), handler.WithScheduleTransfer(scheduleTransferMock))
+
+	reqBody := `{
+		"amount": 10,
+		"sender_id": "` + scheduledSenderID + `",
+		"receiver_id": "` + scheduledReceiverID + `",
+		"execute_at": "2020-01-15T09:30:00Z"
+	}`
+	r, _ := http.NewRequest("POST", "/v1/transactions", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransaction(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+func TestGetScheduledTransfers_ValidRequest_ShouldReturn200WithScheduledTransfers(t *testing.T) {
+	// Arrange
+	amount, err := vo.NewMoney(2550, vo.BRL)
+	require.NoError(t, err)
+	now := time.Now()
+	scheduledTransfer, err := entity.NewScheduledTransfer(scheduledSenderID, scheduledReceiverID, amount, "", now.Add(time.Hour), now)
+	require.NoError(t, err)
+
+	listScheduledTransfersMock := &ListScheduledTransfersMock{}
+	listScheduledTransfersMock.On("Execute", mock.Anything, uuid.MustParse(scheduledSenderID)).
+		Return([]*entity.ScheduledTransfer{scheduledTransfer}, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListScheduledTransfers(listScheduledTransfersMock))
+
+	r, _ := http.NewRequest("GET", "/v1/users/"+scheduledSenderID+"/scheduled-transfers", nil)
+	r = withURLParams(r, map[string]string{"id": scheduledSenderID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetScheduledTransfers(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		ScheduledTransfers []handler.ScheduledTransferResponse `json:"scheduled_transfers"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	require.Len(t, body.ScheduledTransfers, 1)
+	assert.Equal(t, scheduledTransfer.ID(), body.ScheduledTransfers[0].ID)
+	assert.Equal(t, "25.50", body.ScheduledTransfers[0].Amount)
+	assert.Equal(t, entity.ScheduledTransferScheduledStatus, body.ScheduledTransfers[0].Status)
+}
+
+func TestPostCancelScheduledTransfer_ValidRequest_ShouldReturn200(t *testing.T) {
+	// Arrange
+	id := uuid.New()
+	cancelScheduledTransferMock := &CancelScheduledTransferMock{}
+	cancelScheduledTransferMock.On("Execute", mock.Anything, id).Return(nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCancelScheduledTransfer(cancelScheduledTransferMock))
+
+	r, _ := http.NewRequest("POST", "/v1/scheduled-transfers/"+id.String()+"/cancel", nil)
+	r = withURLParams(r, map[string]string{"id": id.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostCancelScheduledTransfer(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "cancelled", body["status"])
+	cancelScheduledTransferMock.AssertExpectations(t)
+}
+
+func TestPostCancelScheduledTransfer_NotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	cancelScheduledTransferMock := &CancelScheduledTransferMock{}
+	cancelScheduledTransferMock.On("Execute", mock.Anything, mock.Anything).Return(errs.ErrScheduledTransferNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCancelScheduledTransfer(cancelScheduledTransferMock))
+
+	id := uuid.NewString()
+	r, _ := http.NewRequest("POST", "/v1/scheduled-transfers/"+id+"/cancel", nil)
+	r = withURLParams(r, map[string]string{"id": id})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostCancelScheduledTransfer(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestPostCancelScheduledTransfer_AlreadyExecuted_ShouldReturn422(t *testing.T) {
+	// Arrange
+	cancelScheduledTransferMock := &CancelScheduledTransferMock{}
+	cancelScheduledTransferMock.On("Execute", mock.Anything, mock.Anything).Return(errs.ErrScheduledTransferNotCancellable)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCancelScheduledTransfer(cancelScheduledTransferMock))
+
+	id := uuid.NewString()
+	r, _ := http.NewRequest("POST", "/v1/scheduled-transfers/"+id+"/cancel", nil)
+	r = withURLParams(r, map[string]string{"id": id})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostCancelScheduledTransfer(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+type ScheduleTransferMock struct {
+	mock.Mock
+}
+
+func (m *ScheduleTransferMock) Execute(ctx context.Context, input usecase.ScheduleTransferInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
+
+type ListScheduledTransfersMock struct {
+	mock.Mock
+}
+
+func (m *ListScheduledTransfersMock) Execute(ctx context.Context, senderID uuid.UUID) ([]*entity.ScheduledTransfer, error) {
+	args := m.Called(ctx, senderID)
+	scheduledTransfers, _ := args.Get(0).([]*entity.ScheduledTransfer)
+	return scheduledTransfers, args.Error(1)
+}
+
+type CancelScheduledTransferMock struct {
+	mock.Mock
+}
+
+func (m *CancelScheduledTransferMock) Execute(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
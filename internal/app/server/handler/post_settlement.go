@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type SettlementResponse struct {
+	ID               string     `json:"id"`
+	MerchantID       string     `json:"merchant_id"`
+	Date             string     `json:"date"`
+	Currency         string     `json:"currency"`
+	Gross            string     `json:"gross"`
+	Fee              string     `json:"fee"`
+	Net              string     `json:"net"`
+	TransactionCount int        `json:"transaction_count"`
+	Status           string     `json:"status"`
+	ClosedAt         *time.Time `json:"closed_at,omitempty"`
+	PaidAt           *time.Time `json:"paid_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+type SettlementTransactionResponse struct {
+	TransactionID string    `json:"transaction_id"`
+	SenderID      string    `json:"sender_id"`
+	Gross         string    `json:"gross"`
+	Fee           string    `json:"fee"`
+	Net           string    `json:"net"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// GetSettlements lists the daily settlements of a merchant, from the most
+// recent, optionally only the ones in a status.
+func (h handler) GetSettlements(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetSettlements")
+	defer span.End()
+
+	merchantID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	settlements, err := h.listSettlements.Execute(ctx, usecase.ListSettlementsInput{
+		MerchantID: merchantID,
+		Status:     r.URL.Query().Get("status"),
+	})
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, errs.ErrUserNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, errs.ErrInvalidSettlementStatus),
+			errors.Is(err, errs.ErrOnlyMerchantsHaveSettlements):
+			status = http.StatusUnprocessableEntity
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	response := make([]SettlementResponse, 0, len(settlements))
+	for _, settlement := range settlements {
+		response = append(response, h.newSettlementResponse(settlement))
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"settlements": response}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("settlement.merchant_id", merchantID.String()))
+}
+
+// GetSettlementTransactions lists the transfers grouped in a settlement, the
+// report merchants reconcile its totals with.
+func (h handler) GetSettlementTransactions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetSettlementTransactions")
+	defer span.End()
+
+	settlementID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	transactions, err := h.listSettlementTransactions.Execute(ctx, settlementID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errs.ErrSettlementNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	response := make([]SettlementTransactionResponse, 0, len(transactions))
+	for _, transaction := range transactions {
+		response = append(response, SettlementTransactionResponse{
+			TransactionID: transaction.ID(),
+			SenderID:      transaction.SenderID(),
+			Gross:         h.formatAmount(transaction.ReceivedAmount()),
+			Fee:           h.formatAmount(transaction.Fee()),
+			Net:           h.formatAmount(transaction.NetReceivedAmount()),
+			CreatedAt:     transaction.CreatedAt(),
+		})
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"transactions": response}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("settlement.id", settlementID.String()))
+}
+
+// PostPaySettlement records that a closed settlement was paid out to its
+// merchant.
+func (h handler) PostPaySettlement(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostPaySettlement")
+	defer span.End()
+
+	settlementID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.paySettlement.Execute(ctx, settlementID)
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrSettlementNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"settlement_id": settlementID.String(), "status": entity.SettlementPaidStatus}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("settlement.id", settlementID.String()))
+}
+
+func (h handler) newSettlementResponse(settlement *entity.Settlement) SettlementResponse {
+	return SettlementResponse{
+		ID:               settlement.ID(),
+		MerchantID:       settlement.MerchantID(),
+		Date:             settlement.Date().Format(time.DateOnly),
+		Currency:         settlement.Currency(),
+		Gross:            h.formatAmount(settlement.Gross()),
+		Fee:              h.formatAmount(settlement.Fee()),
+		Net:              h.formatAmount(settlement.Net()),
+		TransactionCount: settlement.TransactionCount(),
+		Status:           settlement.Status(),
+		ClosedAt:         settlement.ClosedAt(),
+		PaidAt:           settlement.PaidAt(),
+		CreatedAt:        settlement.CreatedAt(),
+	}
+}
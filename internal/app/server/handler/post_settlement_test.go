@@ -0,0 +1,194 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type ListSettlementsMock struct {
+	mock.Mock
+}
+
+func (m *ListSettlementsMock) Execute(ctx context.Context, input usecase.ListSettlementsInput) ([]*entity.Settlement, error) {
+	args := m.Called(ctx, input)
+	settlements, _ := args.Get(0).([]*entity.Settlement)
+	return settlements, args.Error(1)
+}
+
+type ListSettlementTransactionsMock struct {
+	mock.Mock
+}
+
+func (m *ListSettlementTransactionsMock) Execute(ctx context.Context, settlementID uuid.UUID) ([]*entity.Transaction, error) {
+	args := m.Called(ctx, settlementID)
+	transactions, _ := args.Get(0).([]*entity.Transaction)
+	return transactions, args.Error(1)
+}
+
+type PaySettlementMock struct {
+	mock.Mock
+}
+
+func (m *PaySettlementMock) Execute(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestGetSettlements_ShouldReturn200WithTheSettlementTotals(t *testing.T) {
+	// Arrange
+	merchantID := uuid.New()
+	closedAt := time.Date(2026, 3, 11, 1, 0, 0, 0, time.UTC)
+	settlement := entity.RestoreSettlement(uuid.New(), merchantID.String(), time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), vo.BRL, 15000, 150, 2, entity.SettlementClosedStatus, &closedAt, nil, closedAt, closedAt)
+	listMock := &ListSettlementsMock{}
+	listMock.On("Execute", mock.Anything, usecase.ListSettlementsInput{MerchantID: merchantID, Status: "closed"}).Return([]*entity.Settlement{settlement}, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListSettlements(listMock))
+	r, _ := http.NewRequest("GET", "/v1/merchants/"+merchantID.String()+"/settlements?status=closed", nil)
+	r = withURLParams(r, map[string]string{"id": merchantID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetSettlements(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body struct {
+		Settlements []map[string]any `json:"settlements"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Settlements, 1)
+	assert.Equal(t, settlement.ID(), body.Settlements[0]["id"])
+	assert.Equal(t, "2026-03-10", body.Settlements[0]["date"])
+	assert.Equal(t, "150.00", body.Settlements[0]["gross"])
+	assert.Equal(t, "1.50", body.Settlements[0]["fee"])
+	assert.Equal(t, "148.50", body.Settlements[0]["net"])
+	assert.Equal(t, float64(2), body.Settlements[0]["transaction_count"])
+	assert.Equal(t, "2026-03-11T01:00:00Z", body.Settlements[0]["closed_at"])
+	assert.NotContains(t, body.Settlements[0], "paid_at")
+	listMock.AssertExpectations(t)
+}
+
+func TestGetSettlements_ShouldMapErrorsToStatusCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "merchant not found", err: errs.ErrUserNotFound, want: http.StatusNotFound},
+		{name: "not a merchant", err: errs.ErrOnlyMerchantsHaveSettlements, want: http.StatusUnprocessableEntity},
+		{name: "invalid status", err: errs.ErrInvalidSettlementStatus, want: http.StatusUnprocessableEntity},
+		{name: "unexpected error", err: assert.AnError, want: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			merchantID := uuid.NewString()
+			listMock := &ListSettlementsMock{}
+			listMock.On("Execute", mock.Anything, mock.Anything).Return(nil, tt.err)
+			h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListSettlements(listMock))
+			r, _ := http.NewRequest("GET", "/v1/merchants/"+merchantID+"/settlements", nil)
+			r = withURLParams(r, map[string]string{"id": merchantID})
+			w := httptest.NewRecorder()
+
+			// Act
+			h.GetSettlements(w, r)
+
+			// Assert
+			assert.Equal(t, tt.want, w.Result().StatusCode)
+		})
+	}
+}
+
+func TestGetSettlementTransactions_ShouldReturn200WithTheTransfers(t *testing.T) {
+	// Arrange
+	settlementID := uuid.New()
+	transfer, err := entity.RestoreTransaction(uuid.New(), 10000, vo.BRL, 10000, vo.BRL, "1", 150, uuid.NewString(), uuid.NewString(), entity.TransferTransactionKind, "", time.Now())
+	require.NoError(t, err)
+	listMock := &ListSettlementTransactionsMock{}
+	listMock.On("Execute", mock.Anything, settlementID).Return([]*entity.Transaction{transfer}, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListSettlementTransactions(listMock))
+	r, _ := http.NewRequest("GET", "/v1/settlements/"+settlementID.String()+"/transactions", nil)
+	r = withURLParams(r, map[string]string{"id": settlementID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetSettlementTransactions(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body struct {
+		Transactions []handler.SettlementTransactionResponse `json:"transactions"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Transactions, 1)
+	assert.Equal(t, transfer.ID(), body.Transactions[0].TransactionID)
+	assert.Equal(t, "100.00", body.Transactions[0].Gross)
+	assert.Equal(t, "1.50", body.Transactions[0].Fee)
+	assert.Equal(t, "98.50", body.Transactions[0].Net)
+}
+
+func TestGetSettlementTransactions_SettlementNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	settlementID := uuid.New()
+	listMock := &ListSettlementTransactionsMock{}
+	listMock.On("Execute", mock.Anything, settlementID).Return(nil, errs.ErrSettlementNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithListSettlementTransactions(listMock))
+	r, _ := http.NewRequest("GET", "/v1/settlements/"+settlementID.String()+"/transactions", nil)
+	r = withURLParams(r, map[string]string{"id": settlementID.String()})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetSettlementTransactions(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestPostPaySettlement_ShouldMapOutcomesToStatusCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "paid", want: http.StatusOK},
+		{name: "settlement not found", err: errs.ErrSettlementNotFound, want: http.StatusNotFound},
+		{name: "settlement still open", err: errs.ErrSettlementNotClosed, want: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			settlementID := uuid.New()
+			payMock := &PaySettlementMock{}
+			payMock.On("Execute", mock.Anything, settlementID).Return(tt.err)
+			h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithPaySettlement(payMock))
+			r, _ := http.NewRequest("POST", "/v1/settlements/"+settlementID.String()+"/pay", nil)
+			r = withURLParams(r, map[string]string{"id": settlementID.String()})
+			w := httptest.NewRecorder()
+
+			// Act
+			h.PostPaySettlement(w, r)
+
+			// Assert
+			assert.Equal(t, tt.want, w.Result().StatusCode)
+			payMock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/metrics"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PostSplitPaymentRequest struct {
+	// Amount is a decimal with at most two places, e.g. 10.50 or "10.50".
+	Amount json.Number `json:"amount"`
+	// Currency is the ISO-4217 code of the payment, BRL when omitted.
+	Currency  string                         `json:"currency"`
+	SenderID  string                         `json:"sender_id"`
+	Receivers []PostSplitPaymentShareRequest `json:"receivers"`
+}
+
+// PostSplitPaymentShareRequest sets either the amount or the percentage of
+// the whole amount a receiver is credited.
+type PostSplitPaymentShareRequest struct {
+	ReceiverID string      `json:"receiver_id"`
+	Amount     json.Number `json:"amount"`
+	// Percentage is a decimal with at most two places, e.g. 12.5 or "12.50".
+	Percentage json.Number `json:"percentage"`
+}
+
+type SplitPaymentResponse struct {
+	ID        string               `json:"id"`
+	SenderID  string               `json:"sender_id"`
+	Amount    string               `json:"amount"`
+	Currency  string               `json:"currency"`
+	Receivers []SplitShareResponse `json:"receivers"`
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+type SplitShareResponse struct {
+	ReceiverID    string `json:"receiver_id"`
+	Amount        string `json:"amount"`
+	Percentage    string `json:"percentage,omitempty"`
+	TransactionID string `json:"transaction_id"`
+}
+
+// PostSplitPayment debits the sender once and credits several receivers
+// their shares of the amount, all or nothing.
+func (h handler) PostSplitPayment(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostSplitPayment")
+	defer span.End()
+
+	var input PostSplitPaymentRequest
+
+	err := h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	amount, err := h.parseAmount(input.Amount)
+	if err != nil {
+		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	senderID, err := uuid.Parse(input.SenderID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid sender_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	shares := make([]usecase.CreateSplitPaymentShareInput, 0, len(input.Receivers))
+	for i, receiver := range input.Receivers {
+		receiverID, err := uuid.Parse(receiver.ReceiverID)
+		if err != nil {
+			err = h.writeJson(w, http.StatusBadRequest, envelope{"error": fmt.Sprintf("receiver %d: invalid receiver_id", i+1)}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+
+		share := usecase.CreateSplitPaymentShareInput{ReceiverID: receiverID}
+		if receiver.Amount != "" {
+			share.Amount, err = h.parseAmount(receiver.Amount)
+		}
+		if err == nil && receiver.Percentage != "" {
+			share.Percentage, err = h.parsePercentage(receiver.Percentage)
+		}
+		if err != nil {
+			err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": fmt.Sprintf("receiver %d: %s", i+1, err.Error())}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+		shares = append(shares, share)
+	}
+
+	splitPayment, err := h.createSplitPayment.Execute(ctx, usecase.CreateSplitPaymentInput{
+		Amount:   amount,
+		Currency: input.Currency,
+		SenderID: senderID,
+		Shares:   shares,
+	})
+
+	if err != nil {
+		body := envelope{"error": err.Error()}
+		var limitErr *errs.TransferLimitExceededError
+		if errors.As(err, &limitErr) {
+			body["limit_period"] = limitErr.Period
+			body["remaining_allowance"] = h.formatAmount(limitErr.Remaining)
+		}
+		err = h.writeJson(w, http.StatusUnprocessableEntity, body, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"split_payment": h.newSplitPaymentResponse(splitPayment)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+	}
+
+	metrics.TransactionCounter.Add(float64(len(splitPayment.Shares())))
+	metrics.TransactionAmount.WithLabelValues(splitPayment.Currency()).Add(float64(amount) / 100)
+
+	span.SetAttributes(
+		attribute.String("split_payment.id", splitPayment.ID()),
+		attribute.String("split_payment.sender_id", input.SenderID),
+		attribute.Int64("split_payment.amount_in_cents", amount),
+		attribute.Int("split_payment.receivers", len(splitPayment.Shares())),
+	)
+}
+
+// GetSplitPayment returns a split payment with the transfer of each share.
+func (h handler) GetSplitPayment(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetSplitPayment")
+	defer span.End()
+
+	splitPaymentID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	splitPayment, err := h.getSplitPayment.Execute(ctx, splitPaymentID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errs.ErrSplitPaymentNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"split_payment": h.newSplitPaymentResponse(splitPayment)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("split_payment.id", splitPaymentID.String()))
+}
+
+func (h handler) newSplitPaymentResponse(splitPayment *entity.SplitPayment) SplitPaymentResponse {
+	receivers := make([]SplitShareResponse, 0, len(splitPayment.Shares()))
+	for _, share := range splitPayment.Shares() {
+		response := SplitShareResponse{
+			ReceiverID:    share.ReceiverID(),
+			Amount:        h.formatAmount(share.Amount()),
+			TransactionID: share.TransactionID(),
+		}
+		if share.Percentage() > 0 {
+			response.Percentage = h.formatAmount(share.Percentage())
+		}
+		receivers = append(receivers, response)
+	}
+	return SplitPaymentResponse{
+		ID:        splitPayment.ID(),
+		SenderID:  splitPayment.SenderID(),
+		Amount:    splitPayment.FormattedAmount(),
+		Currency:  splitPayment.Currency(),
+		Receivers: receivers,
+		CreatedAt: splitPayment.CreatedAt(),
+	}
+}
@@ -0,0 +1,163 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	splitSenderID   = "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	splitSellerID   = "b3ae1675-5978-49d3-a6e3-619955ec6b2f"
+	splitPlatformID = "c4ae1675-5978-49d3-a6e3-619955ec6b30"
+)
+
+func TestPostSplitPayment_ValidRequest_ShouldReturn201WithShares(t *testing.T) {
+	// Arrange
+	amount, err := vo.NewMoney(10000, vo.BRL)
+	require.NoError(t, err)
+	splitPayment, err := entity.NewSplitPayment(splitSenderID, amount, []entity.SplitShareSpec{
+		{ReceiverID: splitSellerID, Percentage: 8750},
+		{ReceiverID: splitPlatformID, Percentage: 1250},
+	}, time.Now())
+	require.NoError(t, err)
+	splitPayment.Shares()[0].AttachTransaction("transaction-1")
+	splitPayment.Shares()[1].AttachTransaction("transaction-2")
+
+	createSplitPaymentMock := &CreateSplitPaymentMock{}
+	createSplitPaymentMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.CreateSplitPaymentInput) bool {
+			return input.Amount == 10000 &&
+				input.SenderID.String() == splitSenderID &&
+				len(input.Shares) == 2 &&
+				input.Shares[0].Percentage == 8750 &&
+				input.Shares[1].ReceiverID.String() == splitPlatformID
+		}),
+	).Return(splitPayment, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateSplitPayment(createSplitPaymentMock))
+
+	reqBody := `{"amount": 100, "sender_id": "` + splitSenderID + `", "receivers": [` +
+		`{"receiver_id": "` + splitSellerID + `", "percentage": "87.5"}, ` +
+		`{"receiver_id": "` + splitPlatformID + `", "percentage": 12.5}]}`
+	r, _ := http.NewRequest("POST", "/v1/split-payments", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostSplitPayment(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body struct {
+		SplitPayment handler.SplitPaymentResponse `json:"split_payment"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, splitPayment.ID(), body.SplitPayment.ID)
+	assert.Equal(t, "100.00", body.SplitPayment.Amount)
+	require.Len(t, body.SplitPayment.Receivers, 2)
+	assert.Equal(t, "87.50", body.SplitPayment.Receivers[0].Amount)
+	assert.Equal(t, "87.50", body.SplitPayment.Receivers[0].Percentage)
+	assert.Equal(t, "transaction-2", body.SplitPayment.Receivers[1].TransactionID)
+	createSplitPaymentMock.AssertExpectations(t)
+}
+
+func TestPostSplitPayment_InvalidPercentage_ShouldReturn422(t *testing.T) {
+	// Arrange
+	createSplitPaymentMock := &CreateSplitPaymentMock{}
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateSplitPayment(createSplitPaymentMock))
+
+	reqBody := `{"amount": 100, "sender_id": "` + splitSenderID + `", "receivers": [` +
+		`{"receiver_id": "` + splitSellerID + `", "percentage": 50}, ` +
+		`{"receiver_id": "` + splitPlatformID + `", "percentage": 49.999}]}`
+	r, _ := http.NewRequest("POST", "/v1/split-payments", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostSplitPayment(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, `receiver 2: invalid percentage "49.999"`, body["error"])
+	createSplitPaymentMock.AssertNotCalled(t, "Execute")
+}
+
+func TestPostSplitPayment_SharesMismatch_ShouldReturn422(t *testing.T) {
+	// Arrange
+	createSplitPaymentMock := &CreateSplitPaymentMock{}
+	createSplitPaymentMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrSplitSharesMismatch)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateSplitPayment(createSplitPaymentMock))
+
+	reqBody := `{"amount": 100, "sender_id": "` + splitSenderID + `", "receivers": [` +
+		`{"receiver_id": "` + splitSellerID + `", "amount": 50}, ` +
+		`{"receiver_id": "` + splitPlatformID + `", "amount": 10}]}`
+	r, _ := http.NewRequest("POST", "/v1/split-payments", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostSplitPayment(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+func TestGetSplitPayment_SplitPaymentNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	splitPaymentID := "0f8e9b1c-3a5d-4b7e-9c2f-6d1a8e4b7c3f"
+	getSplitPaymentMock := &GetSplitPaymentMock{}
+	getSplitPaymentMock.On("Execute", mock.Anything, uuid.MustParse(splitPaymentID)).Return(nil, errs.ErrSplitPaymentNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGetSplitPayment(getSplitPaymentMock))
+
+	r, _ := http.NewRequest("GET", "/v1/split-payments/"+splitPaymentID, nil)
+	r = withURLParams(r, map[string]string{"id": splitPaymentID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetSplitPayment(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+type CreateSplitPaymentMock struct {
+	mock.Mock
+}
+
+func (m *CreateSplitPaymentMock) Execute(ctx context.Context, input usecase.CreateSplitPaymentInput) (*entity.SplitPayment, error) {
+	args := m.Called(ctx, input)
+	splitPayment, _ := args.Get(0).(*entity.SplitPayment)
+	return splitPayment, args.Error(1)
+}
+
+type GetSplitPaymentMock struct {
+	mock.Mock
+}
+
+func (m *GetSplitPaymentMock) Execute(ctx context.Context, id uuid.UUID) (*entity.SplitPayment, error) {
+	args := m.Called(ctx, id)
+	splitPayment, _ := args.Get(0).(*entity.SplitPayment)
+	return splitPayment, args.Error(1)
+}
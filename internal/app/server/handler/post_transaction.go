@@ -1,18 +1,40 @@
 package handler
 
 import (
+	"encoding/json"
+	"errors"
 	"github.com.br/gibranct/simplified-wallet/internal/provider/metrics"
 	"go.opentelemetry.io/otel/attribute"
 	"net/http"
+	"time"
 
 	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
 	"github.com/google/uuid"
 )
 
+// IdempotencyKeyHeader lets clients retry a transfer without sending money twice.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
 type PostTransactionRequest struct {
-	Amount     float64 `json:"amount"`
-	SenderID   string  `json:"sender_id"`
-	ReceiverID string  `json:"receiver_id"`
+	// Amount is a decimal with at most two places, sent either as a JSON
+	// number or a string, e.g. 10.50 or "10.50".
+	Amount json.Number `json:"amount"`
+	// Currency is the ISO-4217 code the sender pays in, BRL when omitted.
+	Currency string `json:"currency"`
+	// ReceiverCurrency is the ISO-4217 code the receiver is credited in, the
+	// same as Currency when omitted.
+	ReceiverCurrency string `json:"receiver_currency"`
+	SenderID         string `json:"sender_id"`
+	ReceiverID       string `json:"receiver_id"`
+	// ReceiverKey is a key of the receiver in the key directory, e.g. its
+	// e-mail or phone, sent instead of ReceiverID.
+	ReceiverKey string `json:"receiver_key"`
+	// ExecuteAt is an RFC 3339 date in the future to schedule the transfer
+	// at instead of executing it now.
+	ExecuteAt *time.Time `json:"execute_at"`
 }
 
 func (h handler) PostTransaction(w http.ResponseWriter, r *http.Request) {
@@ -30,39 +52,85 @@ func (h handler) PostTransaction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	senderID, err := uuid.Parse(input.SenderID)
+	amount, err := h.parseAmount(input.Amount)
 	if err != nil {
-		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid sender_id"}, nil)
+		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
 		if err != nil {
 			h.logger.Println(err)
 		}
 		return
 	}
 
-	receiverID, err := uuid.Parse(input.ReceiverID)
+	senderID, err := uuid.Parse(input.SenderID)
 	if err != nil {
-		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid receiver_id"}, nil)
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid sender_id"}, nil)
 		if err != nil {
 			h.logger.Println(err)
 		}
 		return
 	}
 
+	var receiverID uuid.UUID
+	if input.ReceiverKey != "" {
+		if input.ReceiverID != "" {
+			err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "send either receiver_id or receiver_key"}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+		receiverID, err = h.resolveAliasKey.Execute(ctx, input.ReceiverKey)
+		if err != nil {
+			status := http.StatusUnprocessableEntity
+			if errors.Is(err, errs.ErrAliasKeyNotFound) {
+				status = http.StatusNotFound
+			}
+			err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+	} else {
+		receiverID, err = uuid.Parse(input.ReceiverID)
+		if err != nil {
+			err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid receiver_id"}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+	}
+
+	if input.ExecuteAt != nil {
+		h.postScheduledTransfer(w, r, input, amount, senderID, receiverID)
+		return
+	}
+
 	transactionID, err := h.createTransaction.Execute(ctx, usecase.CreateTransactionInput{
-		Amount:     input.Amount,
-		SenderID:   senderID,
-		ReceiverID: receiverID,
+		Amount:           amount,
+		Currency:         input.Currency,
+		ReceiverCurrency: input.ReceiverCurrency,
+		SenderID:         senderID,
+		ReceiverID:       receiverID,
+		IdempotencyKey:   r.Header.Get(IdempotencyKeyHeader),
 	})
 
 	if err != nil {
-		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+		body := envelope{"error": err.Error()}
+		var limitErr *errs.TransferLimitExceededError
+		if errors.As(err, &limitErr) {
+			body["limit_period"] = limitErr.Period
+			body["remaining_allowance"] = h.formatAmount(limitErr.Remaining)
+		}
+		err = h.writeJson(w, http.StatusUnprocessableEntity, body, nil)
 		if err != nil {
 			h.logger.Println(err)
 		}
 		return
 	}
 
-	err = h.writeJson(w, http.StatusCreated, envelope{"transaction_id": transactionID}, nil)
+	err = h.writeJson(w, http.StatusCreated, envelope{"transaction_id": transactionID, "status": entity.TransactionCompletedStatus}, nil)
 	if err != nil {
 		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
 		if err != nil {
@@ -72,12 +140,18 @@ func (h handler) PostTransaction(w http.ResponseWriter, r *http.Request) {
 
 	// After successful transaction creation:
 	metrics.TransactionCounter.Inc()
-	metrics.TransactionAmount.Add(input.Amount)
+	currency := input.Currency
+	if currency == "" {
+		currency = vo.DefaultCurrency
+	}
+	metrics.TransactionAmount.WithLabelValues(currency).Add(float64(amount) / 100)
 
 	// Add transaction details to the span
 	span.SetAttributes(
-		attribute.Float64("transaction.amount", input.Amount),
+		attribute.Int64("transaction.amount_in_cents", amount),
+		attribute.String("transaction.currency", input.Currency),
+		attribute.String("transaction.receiver_currency", input.ReceiverCurrency),
 		attribute.String("transaction.sender_id", input.SenderID),
-		attribute.String("transaction.receiver_id", input.ReceiverID),
+		attribute.String("transaction.receiver_id", receiverID.String()),
 	)
 }
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/metrics"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PostTransactionBatchRequest struct {
+	// Atomic runs the batch all-or-nothing instead of item by item.
+	Atomic bool                              `json:"atomic"`
+	Items  []PostTransactionBatchItemRequest `json:"items"`
+}
+
+type PostTransactionBatchItemRequest struct {
+	// Amount is a decimal with at most two places, e.g. 10.50 or "10.50".
+	Amount json.Number `json:"amount"`
+	// Currency is the ISO-4217 code of the transfer, BRL when omitted.
+	Currency   string `json:"currency"`
+	SenderID   string `json:"sender_id"`
+	ReceiverID string `json:"receiver_id"`
+}
+
+type TransactionBatchResponse struct {
+	ID             string                         `json:"id"`
+	Atomic         bool                           `json:"atomic"`
+	Status         string                         `json:"status"`
+	SucceededItems int                            `json:"succeeded_items"`
+	FailedItems    int                            `json:"failed_items"`
+	Items          []TransactionBatchItemResponse `json:"items"`
+	CreatedAt      time.Time                      `json:"created_at"`
+	UpdatedAt      time.Time                      `json:"updated_at"`
+}
+
+type TransactionBatchItemResponse struct {
+	ID            string `json:"id"`
+	Sequence      int    `json:"sequence"`
+	SenderID      string `json:"sender_id"`
+	ReceiverID    string `json:"receiver_id"`
+	Amount        string `json:"amount"`
+	Currency      string `json:"currency"`
+	Status        string `json:"status"`
+	TransactionID string `json:"transaction_id,omitempty"`
+	FailureReason string `json:"failure_reason,omitempty"`
+}
+
+// PostTransactionBatch executes many transfers at once, e.g. a payroll. The
+// batch is created even when items fail, the outcome of each one being in the
+// response.
+func (h handler) PostTransactionBatch(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostTransactionBatch")
+	defer span.End()
+
+	var input PostTransactionBatchRequest
+
+	err := h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	items := make([]usecase.CreateTransactionBatchItemInput, 0, len(input.Items))
+	for i, item := range input.Items {
+		amount, err := h.parseAmount(item.Amount)
+		if err != nil {
+			err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": fmt.Sprintf("item %d: %s", i+1, err.Error())}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+
+		senderID, err := uuid.Parse(item.SenderID)
+		if err != nil {
+			err = h.writeJson(w, http.StatusBadRequest, envelope{"error": fmt.Sprintf("item %d: invalid sender_id", i+1)}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+
+		receiverID, err := uuid.Parse(item.ReceiverID)
+		if err != nil {
+			err = h.writeJson(w, http.StatusBadRequest, envelope{"error": fmt.Sprintf("item %d: invalid receiver_id", i+1)}, nil)
+			if err != nil {
+				h.logger.Println(err)
+			}
+			return
+		}
+
+		items = append(items, usecase.CreateTransactionBatchItemInput{
+			Amount:     amount,
+			Currency:   item.Currency,
+			SenderID:   senderID,
+			ReceiverID: receiverID,
+		})
+	}
+
+	batch, err := h.createTransactionBatch.Execute(ctx, usecase.CreateTransactionBatchInput{
+		Atomic: input.Atomic,
+		Items:  items,
+	})
+
+	if err != nil {
+		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusCreated, envelope{"batch": newTransactionBatchResponse(batch)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+	}
+
+	for _, item := range batch.Items() {
+		if item.Status() == entity.TransactionBatchItemSucceededStatus {
+			metrics.TransactionCounter.Inc()
+			metrics.TransactionAmount.WithLabelValues(item.Currency()).Add(float64(item.Amount()) / 100)
+		}
+	}
+
+	span.SetAttributes(
+		attribute.String("transaction_batch.id", batch.ID()),
+		attribute.Bool("transaction_batch.atomic", batch.Atomic()),
+		attribute.String("transaction_batch.status", batch.Status()),
+		attribute.Int("transaction_batch.items", len(batch.Items())),
+	)
+}
+
+// GetTransactionBatch returns a batch with the outcome of each of its items.
+func (h handler) GetTransactionBatch(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "GetTransactionBatch")
+	defer span.End()
+
+	batchID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	batch, err := h.getTransactionBatch.Execute(ctx, batchID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errs.ErrTransactionBatchNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"batch": newTransactionBatchResponse(batch)}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(attribute.String("transaction_batch.id", batchID.String()))
+}
+
+func newTransactionBatchResponse(batch *entity.TransactionBatch) TransactionBatchResponse {
+	items := make([]TransactionBatchItemResponse, 0, len(batch.Items()))
+	for _, item := range batch.Items() {
+		items = append(items, TransactionBatchItemResponse{
+			ID:            item.ID(),
+			Sequence:      item.Sequence(),
+			SenderID:      item.SenderID(),
+			ReceiverID:    item.ReceiverID(),
+			Amount:        item.FormattedAmount(),
+			Currency:      item.Currency(),
+			Status:        item.Status(),
+			TransactionID: item.TransactionID(),
+			FailureReason: item.FailureReason(),
+		})
+	}
+	return TransactionBatchResponse{
+		ID:             batch.ID(),
+		Atomic:         batch.Atomic(),
+		Status:         batch.Status(),
+		SucceededItems: batch.CountItems(entity.TransactionBatchItemSucceededStatus),
+		FailedItems:    batch.CountItems(entity.TransactionBatchItemFailedStatus),
+		Items:          items,
+		CreatedAt:      batch.CreatedAt(),
+		UpdatedAt:      batch.UpdatedAt(),
+	}
+}
@@ -0,0 +1,158 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	batchSenderID   = "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	batchReceiverID = "b3ae1675-5978-49d3-a6e3-619955ec6b2f"
+)
+
+func TestPostTransactionBatch_ValidRequest_ShouldReturn201WithItemStatuses(t *testing.T) {
+	// Arrange
+	batch := entity.NewTransactionBatch(false, time.Now())
+	amount, err := vo.NewMoney(1050, vo.BRL)
+	require.NoError(t, err)
+	first, err := batch.AddItem(batchSenderID, batchReceiverID, amount)
+	require.NoError(t, err)
+	second, err := batch.AddItem(batchSenderID, batchReceiverID, amount)
+	require.NoError(t, err)
+	first.Succeed("transaction-123")
+	second.Fail(errs.ErrInsufficientBalance.Error())
+	batch.Complete(time.Now())
+
+	createBatchMock := &CreateTransactionBatchMock{}
+	createBatchMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.CreateTransactionBatchInput) bool {
+			return !input.Atomic &&
+				len(input.Items) == 2 &&
+				input.Items[0].Amount == 1050 &&
+				input.Items[1].SenderID.String() == batchSenderID
+		}),
+	).Return(batch, nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateTransactionBatch(createBatchMock))
+
+	item := `{"amount": "10.50", "sender_id": "` + batchSenderID + `", "receiver_id": "` + batchReceiverID + `"}`
+	r, _ := http.NewRequest("POST", "/v1/transaction-batches", strings.NewReader(`{"items": [`+item+`, `+item+`]}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransactionBatch(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body struct {
+		Batch handler.TransactionBatchResponse `json:"batch"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, batch.ID(), body.Batch.ID)
+	assert.Equal(t, entity.TransactionBatchPartiallyCompletedStatus, body.Batch.Status)
+	assert.Equal(t, 1, body.Batch.SucceededItems)
+	assert.Equal(t, 1, body.Batch.FailedItems)
+	require.Len(t, body.Batch.Items, 2)
+	assert.Equal(t, "transaction-123", body.Batch.Items[0].TransactionID)
+	assert.Equal(t, "10.50", body.Batch.Items[0].Amount)
+	assert.Equal(t, errs.ErrInsufficientBalance.Error(), body.Batch.Items[1].FailureReason)
+	createBatchMock.AssertExpectations(t)
+}
+
+func TestPostTransactionBatch_InvalidItemReceiverID_ShouldReturn400(t *testing.T) {
+	// Arrange
+	createBatchMock := &CreateTransactionBatchMock{}
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateTransactionBatch(createBatchMock))
+
+	reqBody := `{"atomic": true, "items": [{"amount": 10, "sender_id": "` + batchSenderID + `", "receiver_id": "nope"}]}`
+	r, _ := http.NewRequest("POST", "/v1/transaction-batches", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransactionBatch(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "item 1: invalid receiver_id", body["error"])
+	createBatchMock.AssertNotCalled(t, "Execute")
+}
+
+func TestPostTransactionBatch_TooManyItems_ShouldReturn422(t *testing.T) {
+	// Arrange
+	createBatchMock := &CreateTransactionBatchMock{}
+	createBatchMock.On("Execute", mock.Anything, mock.Anything).Return(nil, errs.ErrTransactionBatchTooLarge)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateTransactionBatch(createBatchMock))
+
+	reqBody := `{"items": [{"amount": 10, "sender_id": "` + batchSenderID + `", "receiver_id": "` + batchReceiverID + `"}]}`
+	r, _ := http.NewRequest("POST", "/v1/transaction-batches", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransactionBatch(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+func TestGetTransactionBatch_BatchNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	batchID := "0f8e9b1c-3a5d-4b7e-9c2f-6d1a8e4b7c3f"
+	getBatchMock := &GetTransactionBatchMock{}
+	getBatchMock.On("Execute", mock.Anything, uuid.MustParse(batchID)).Return(nil, errs.ErrTransactionBatchNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithGetTransactionBatch(getBatchMock))
+
+	r, _ := http.NewRequest("GET", "/v1/transaction-batches/"+batchID, nil)
+	r = withURLParams(r, map[string]string{"id": batchID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.GetTransactionBatch(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+type CreateTransactionBatchMock struct {
+	mock.Mock
+}
+
+func (m *CreateTransactionBatchMock) Execute(ctx context.Context, input usecase.CreateTransactionBatchInput) (*entity.TransactionBatch, error) {
+	args := m.Called(ctx, input)
+	batch, _ := args.Get(0).(*entity.TransactionBatch)
+	return batch, args.Error(1)
+}
+
+type GetTransactionBatchMock struct {
+	mock.Mock
+}
+
+func (m *GetTransactionBatchMock) Execute(ctx context.Context, id uuid.UUID) (*entity.TransactionBatch, error) {
+	args := m.Called(ctx, id)
+	batch, _ := args.Get(0).(*entity.TransactionBatch)
+	return batch, args.Error(1)
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
 	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
 	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -112,7 +114,7 @@ func TestPostTransaction_WhenUsecaseReturnsError_ShouldReturn422(t *testing.T) {
 		"Execute",
 		mock.Anything,
 		mock.MatchedBy(func(input usecase.CreateTransactionInput) bool {
-			return input.Amount == 100 &&
+			return input.Amount == 10000 &&
 				input.SenderID.String() == "d6ae1675-5978-49d3-a6e3-619955ec6b2e" &&
 				input.ReceiverID.String() == "f6de1685-5978-49d3-a6e3-619955ec6b2f"
 		}),
@@ -146,6 +148,38 @@ func TestPostTransaction_WhenUsecaseReturnsError_ShouldReturn422(t *testing.T) {
 	createTransactionMock.AssertExpectations(t)
 }
 
+func TestPostTransaction_WhenTransferLimitIsExceeded_ShouldReturn422WithRemainingAllowance(t *testing.T) {
+	// Arrange
+	createTransactionMock := &CreateTransactionMock{}
+	createUserMock := &CreateUserMock{}
+	createTransactionMock.On("Execute", mock.Anything, mock.Anything).
+		Return("", &errs.TransferLimitExceededError{Period: "daily", Limit: 100000, Remaining: 2550})
+
+	h := handler.New(createTransactionMock, createUserMock, telemetry.NewMockTelemetry())
+
+	reqBody := `{
+		"amount": "50.00",
+		"sender_id": "d6ae1675-5978-49d3-a6e3-619955ec6b2e",
+		"receiver_id": "f6de1685-5978-49d3-a6e3-619955ec6b2f"
+	}`
+	r, _ := http.NewRequest("POST", "/transaction", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransaction(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "daily transfer limit of 1000.00 exceeded, 25.50 remaining", body["error"])
+	assert.Equal(t, "daily", body["limit_period"])
+	assert.Equal(t, "25.50", body["remaining_allowance"])
+}
+
 func TestPostTransaction_WhenUsecaseSucceeds_ShouldReturn201WithTransactionID(t *testing.T) {
 	// Arrange
 	expectedTransactionID := "transaction-123"
@@ -182,26 +216,15 @@ func TestPostTransaction_WhenUsecaseSucceeds_ShouldReturn201WithTransactionID(t
 	assert.NoError(t, err)
 	assert.Contains(t, body, "transaction_id")
 	assert.Equal(t, expectedTransactionID, body["transaction_id"])
+	assert.Equal(t, entity.TransactionCompletedStatus, body["status"])
 
 	createTransactionMock.AssertExpectations(t)
 }
 
 func TestPostTransaction_NegativeAmount_ShouldReturn422(t *testing.T) {
 	// Arrange
-	expectedError := errors.New("amount must be positive")
-
 	createTransactionMock := &CreateTransactionMock{}
 	createUserMock := &CreateUserMock{}
-	createTransactionMock.On(
-		"Execute",
-		mock.Anything,
-		mock.MatchedBy(func(input usecase.CreateTransactionInput) bool {
-			return input.Amount == -50 &&
-				input.SenderID.String() == "d6ae1675-5978-49d3-a6e3-619955ec6b2e" &&
-				input.ReceiverID.String() == "f6de1685-5978-49d3-a6e3-619955ec6b2f"
-		}),
-	).Return("", expectedError)
-
 	mockTelemetry := telemetry.NewMockTelemetry()
 	h := handler.New(createTransactionMock, createUserMock, mockTelemetry)
 
@@ -224,9 +247,65 @@ func TestPostTransaction_NegativeAmount_ShouldReturn422(t *testing.T) {
 	var body map[string]interface{}
 	err := json.NewDecoder(resp.Body).Decode(&body)
 	assert.NoError(t, err)
-	assert.Contains(t, body, "error")
-	assert.Equal(t, expectedError.Error(), body["error"])
+	assert.Equal(t, errs.ErrZeroOrNegativeAmount.Error(), body["error"])
+
+	createTransactionMock.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestPostTransaction_AmountWithMoreThanTwoDecimalPlaces_ShouldReturn422(t *testing.T) {
+	// Arrange
+	createTransactionMock := &CreateTransactionMock{}
+	h := handler.New(createTransactionMock, &CreateUserMock{}, telemetry.NewMockTelemetry())
+
+	reqBody := `{
+		"amount": 10.001,
+		"sender_id": "d6ae1675-5978-49d3-a6e3-619955ec6b2e",
+		"receiver_id": "f6de1685-5978-49d3-a6e3-619955ec6b2f"
+	}`
+	r, _ := http.NewRequest("POST", "/transaction", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransaction(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, errs.ErrAmountPrecision.Error(), body["error"])
+
+	createTransactionMock.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestPostTransaction_DecimalStringAmount_ShouldBeConvertedToExactCents(t *testing.T) {
+	// Arrange
+	createTransactionMock := &CreateTransactionMock{}
+	createTransactionMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.CreateTransactionInput) bool {
+			return input.Amount == 1029
+		}),
+	).Return("transaction-123", nil)
+
+	h := handler.New(createTransactionMock, &CreateUserMock{}, telemetry.NewMockTelemetry())
+
+	reqBody := `{
+		"amount": "10.29",
+		"sender_id": "d6ae1675-5978-49d3-a6e3-619955ec6b2e",
+		"receiver_id": "f6de1685-5978-49d3-a6e3-619955ec6b2f"
+	}`
+	r, _ := http.NewRequest("POST", "/transaction", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransaction(w, r)
 
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Result().StatusCode)
 	createTransactionMock.AssertExpectations(t)
 }
 
@@ -247,3 +326,71 @@ func (m *CreateUserMock) Execute(ctx context.Context, input usecase.CreateUserIn
 	args := m.Called(ctx, input)
 	return args.String(0), args.Error(1)
 }
+
+func TestPostTransaction_WithIdempotencyKeyHeader_ShouldForwardKeyToUsecase(t *testing.T) {
+	// Arrange
+	createTransactionMock := &CreateTransactionMock{}
+	createTransactionMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.CreateTransactionInput) bool {
+			return input.IdempotencyKey == "0b7c2f52-retry"
+		}),
+	).Return("transaction-123", nil)
+
+	h := handler.New(createTransactionMock, &CreateUserMock{}, telemetry.NewMockTelemetry())
+
+	reqBody := `{
+		"amount": 100,
+		"sender_id": "d6ae1675-5978-49d3-a6e3-619955ec6b2e",
+		"receiver_id": "f6de1685-5978-49d3-a6e3-619955ec6b2f"
+	}`
+	r, _ := http.NewRequest("POST", "/transaction", strings.NewReader(reqBody))
+	r.Header.Set(handler.IdempotencyKeyHeader, "0b7c2f52-retry")
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransaction(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "transaction-123", body["transaction_id"])
+	assert.Equal(t, entity.TransactionCompletedStatus, body["status"])
+	createTransactionMock.AssertExpectations(t)
+}
+
+func TestPostTransaction_WithCurrencies_ShouldForwardCurrenciesToUsecase(t *testing.T) {
+	// Arrange
+	createTransactionMock := &CreateTransactionMock{}
+	createTransactionMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.CreateTransactionInput) bool {
+			return input.Amount == 2000 && input.Currency == "USD" && input.ReceiverCurrency == "BRL"
+		}),
+	).Return("transaction-123", nil)
+
+	h := handler.New(createTransactionMock, &CreateUserMock{}, telemetry.NewMockTelemetry())
+
+	reqBody := `{
+		"amount": "20.00",
+		"currency": "USD",
+		"receiver_currency": "BRL",
+		"sender_id": "d6ae1675-5978-49d3-a6e3-619955ec6b2e",
+		"receiver_id": "f6de1685-5978-49d3-a6e3-619955ec6b2f"
+	}`
+	r, _ := http.NewRequest("POST", "/transaction", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostTransaction(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Result().StatusCode)
+	createTransactionMock.AssertExpectations(t)
+}
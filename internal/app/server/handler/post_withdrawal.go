@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// PayoutSignatureHeader carries the hex encoded HMAC-SHA256 of the body of a
+// payout result, keyed with the secret shared with the payout provider.
+const PayoutSignatureHeader = "X-Payout-Signature"
+
+type PostWithdrawalRequest struct {
+	// Amount is a decimal with at most two places, e.g. 10.50 or "10.50".
+	Amount json.Number `json:"amount"`
+	// Currency is the ISO-4217 code of the withdrawal, BRL when omitted.
+	Currency      string `json:"currency"`
+	DestinationID string `json:"destination_id"`
+}
+
+type PostWithdrawalResultRequest struct {
+	// Status is either completed or failed
+	Status        string `json:"status"`
+	FailureReason string `json:"failure_reason"`
+}
+
+// PostWithdrawal puts the amount on hold and submits the payout. The
+// withdrawal is accepted as pending, its result arrives asynchronously.
+func (h handler) PostWithdrawal(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostWithdrawal")
+	defer span.End()
+
+	userID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostWithdrawalRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	destinationID, err := uuid.Parse(input.DestinationID)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": "invalid destination_id"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	amount, err := h.parseAmount(input.Amount)
+	if err != nil {
+		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	withdrawalID, err := h.createWithdrawal.Execute(ctx, usecase.CreateWithdrawalInput{
+		UserID:        userID,
+		DestinationID: destinationID,
+		Amount:        amount,
+		Currency:      input.Currency,
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrUserNotFound) || errors.Is(err, errs.ErrPayoutDestinationNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusAccepted, envelope{"withdrawal_id": withdrawalID, "status": entity.WithdrawalPendingStatus}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("withdrawal.user_id", userID.String()),
+		attribute.String("withdrawal.destination_id", destinationID.String()),
+		attribute.Int64("withdrawal.amount_in_cents", amount),
+	)
+}
+
+// PostWithdrawalResult receives the result of a payout from the payout
+// provider, completing the withdrawal or releasing its hold. Results not
+// signed by the provider are refused.
+func (h handler) PostWithdrawalResult(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.otel.Start(r.Context(), "PostWithdrawalResult")
+	defer span.End()
+
+	withdrawalID, err := h.readUUIDParam(r, "id")
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.verifyPayoutSignature(w, r)
+	if err != nil {
+		err = h.writeJson(w, http.StatusUnauthorized, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	var input PostWithdrawalResultRequest
+
+	err = h.readJSON(w, r, &input)
+	if err != nil {
+		err = h.writeJson(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	if input.Status != entity.WithdrawalCompletedStatus && input.Status != entity.WithdrawalFailedStatus {
+		err = h.writeJson(w, http.StatusUnprocessableEntity, envelope{"error": "status must be completed or failed"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	status, err := h.settleWithdrawal.Execute(ctx, usecase.SettleWithdrawalInput{
+		WithdrawalID:  withdrawalID,
+		Succeeded:     input.Status == entity.WithdrawalCompletedStatus,
+		FailureReason: input.FailureReason,
+	})
+
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, errs.ErrWithdrawalNotFound) {
+			status = http.StatusNotFound
+		}
+		err = h.writeJson(w, status, envelope{"error": err.Error()}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	err = h.writeJson(w, http.StatusOK, envelope{"withdrawal_id": withdrawalID.String(), "status": status}, nil)
+	if err != nil {
+		err = h.writeJson(w, http.StatusInternalServerError, envelope{"error": "failed to write response"}, nil)
+		if err != nil {
+			h.logger.Println(err)
+		}
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("withdrawal.id", withdrawalID.String()),
+		attribute.String("withdrawal.status", status),
+	)
+}
+
+// verifyPayoutSignature checks the body of the request against its
+// PayoutSignatureHeader, leaving the body to be read again.
+func (h handler) verifyPayoutSignature(w http.ResponseWriter, r *http.Request) error {
+	errInvalidSignature := errors.New("invalid payout signature")
+	if len(h.payoutResultSecret) == 0 {
+		return errInvalidSignature
+	}
+	signature, err := hex.DecodeString(r.Header.Get(PayoutSignatureHeader))
+	if err != nil {
+		return errInvalidSignature
+	}
+
+	maxBytes := 1_048_576
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, int64(maxBytes)))
+	if err != nil {
+		return errInvalidSignature
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	mac := hmac.New(sha256.New, h.payoutResultSecret)
+	mac.Write(body)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return errInvalidSignature
+	}
+	return nil
+}
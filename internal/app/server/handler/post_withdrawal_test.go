@@ -0,0 +1,260 @@
+package handler_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/server/handler"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	withdrawalUserID        = "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	withdrawalDestinationID = "f6de1685-5978-49d3-a6e3-619955ec6b2f"
+	payoutResultSecret      = "payout-secret"
+)
+
+func signPayoutResult(body string) string {
+	mac := hmac.New(sha256.New, []byte(payoutResultSecret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestPostWithdrawal_ValidRequest_ShouldReturn202WithWithdrawalID(t *testing.T) {
+	// Arrange
+	createWithdrawalMock := &CreateWithdrawalMock{}
+	createWithdrawalMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.CreateWithdrawalInput) bool {
+			return input.UserID.String() == withdrawalUserID &&
+				input.DestinationID.String() == withdrawalDestinationID &&
+				input.Amount == 4050
+		}),
+	).Return("withdrawal-123", nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateWithdrawal(createWithdrawalMock))
+
+	reqBody := `{"amount": "40.50", "destination_id": "` + withdrawalDestinationID + `"}`
+	r, _ := http.NewRequest("POST", "/v1/users/"+withdrawalUserID+"/withdrawals", strings.NewReader(reqBody))
+	r = withURLParams(r, map[string]string{"id": withdrawalUserID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostWithdrawal(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "withdrawal-123", body["withdrawal_id"])
+	assert.Equal(t, "pending", body["status"])
+	createWithdrawalMock.AssertExpectations(t)
+}
+
+func TestPostWithdrawal_InvalidDestinationID_ShouldReturn400(t *testing.T) {
+	// Arrange
+	createWithdrawalMock := &CreateWithdrawalMock{}
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateWithdrawal(createWithdrawalMock))
+
+	r, _ := http.NewRequest("POST", "/v1/users/"+withdrawalUserID+"/withdrawals", strings.NewReader(`{"amount": 10, "destination_id": "nope"}`))
+	r = withURLParams(r, map[string]string{"id": withdrawalUserID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostWithdrawal(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	createWithdrawalMock.AssertNotCalled(t, "Execute")
+}
+
+func TestPostWithdrawal_DestinationNotFound_ShouldReturn404(t *testing.T) {
+	// Arrange
+	createWithdrawalMock := &CreateWithdrawalMock{}
+	createWithdrawalMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrPayoutDestinationNotFound)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithCreateWithdrawal(createWithdrawalMock))
+
+	reqBody := `{"amount": 10, "destination_id": "` + withdrawalDestinationID + `"}`
+	r, _ := http.NewRequest("POST", "/v1/users/"+withdrawalUserID+"/withdrawals", strings.NewReader(reqBody))
+	r = withURLParams(r, map[string]string{"id": withdrawalUserID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostWithdrawal(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestPostWithdrawalResult_FailedPayout_ShouldSettleAsFailed(t *testing.T) {
+	// Arrange
+	withdrawalID := "0f8e9b1c-3a5d-4b7e-9c2f-6d1a8e4b7c3f"
+	settleWithdrawalMock := &SettleWithdrawalMock{}
+	settleWithdrawalMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.SettleWithdrawalInput) bool {
+			return input.WithdrawalID.String() == withdrawalID && !input.Succeeded && input.FailureReason == "account closed"
+		}),
+	).Return("failed", nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithSettleWithdrawal(settleWithdrawalMock, payoutResultSecret))
+
+	body := `{"status": "failed", "failure_reason": "account closed"}`
+	r, _ := http.NewRequest("POST", "/v1/withdrawals/"+withdrawalID+"/result", strings.NewReader(body))
+	r.Header.Set(handler.PayoutSignatureHeader, signPayoutResult(body))
+	r = withURLParams(r, map[string]string{"id": withdrawalID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostWithdrawalResult(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var respBody map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&respBody)
+	assert.NoError(t, err)
+	assert.Equal(t, "failed", respBody["status"])
+	settleWithdrawalMock.AssertExpectations(t)
+}
+
+func TestPostWithdrawalResult_UnknownStatus_ShouldReturn422(t *testing.T) {
+	// Arrange
+	withdrawalID := "0f8e9b1c-3a5d-4b7e-9c2f-6d1a8e4b7c3f"
+	settleWithdrawalMock := &SettleWithdrawalMock{}
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithSettleWithdrawal(settleWithdrawalMock, payoutResultSecret))
+
+	body := `{"status": "pending"}`
+	r, _ := http.NewRequest("POST", "/v1/withdrawals/"+withdrawalID+"/result", strings.NewReader(body))
+	r.Header.Set(handler.PayoutSignatureHeader, signPayoutResult(body))
+	r = withURLParams(r, map[string]string{"id": withdrawalID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostWithdrawalResult(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+	settleWithdrawalMock.AssertNotCalled(t, "Execute")
+}
+
+func TestPostWithdrawalResult_InvalidSignature_ShouldReturn401(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		signature string
+	}{
+		{name: "missing signature", secret: payoutResultSecret, signature: ""},
+		{name: "signature of another body", secret: payoutResultSecret, signature: signPayoutResult(`{"status": "failed"}`)},
+		{name: "no secret configured", secret: "", signature: signPayoutResult(`{"status": "completed"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			withdrawalID := "0f8e9b1c-3a5d-4b7e-9c2f-6d1a8e4b7c3f"
+			settleWithdrawalMock := &SettleWithdrawalMock{}
+			h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithSettleWithdrawal(settleWithdrawalMock, tt.secret))
+
+			r, _ := http.NewRequest("POST", "/v1/withdrawals/"+withdrawalID+"/result", strings.NewReader(`{"status": "completed"}`))
+			r.Header.Set(handler.PayoutSignatureHeader, tt.signature)
+			r = withURLParams(r, map[string]string{"id": withdrawalID})
+			w := httptest.NewRecorder()
+
+			// Act
+			h.PostWithdrawalResult(w, r)
+
+			// Assert
+			assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			settleWithdrawalMock.AssertNotCalled(t, "Execute")
+		})
+	}
+}
+
+func TestPostPayoutDestination_ValidRequest_ShouldReturn201WithDestinationID(t *testing.T) {
+	// Arrange
+	registerMock := &RegisterPayoutDestinationMock{}
+	registerMock.On(
+		"Execute",
+		mock.Anything,
+		mock.MatchedBy(func(input usecase.RegisterPayoutDestinationInput) bool {
+			return input.UserID.String() == withdrawalUserID && input.Kind == "pix_key" && input.PixKey == "john@mail.com"
+		}),
+	).Return("destination-123", nil)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithRegisterPayoutDestination(registerMock))
+
+	r, _ := http.NewRequest("POST", "/v1/users/"+withdrawalUserID+"/payout-destinations", strings.NewReader(`{"kind": "pix_key", "pix_key": "john@mail.com"}`))
+	r = withURLParams(r, map[string]string{"id": withdrawalUserID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostPayoutDestination(w, r)
+
+	// Assert
+	resp := w.Result()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body map[string]interface{}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "destination-123", body["destination_id"])
+}
+
+func TestPostPayoutDestination_InvalidPixKey_ShouldReturn422(t *testing.T) {
+	// Arrange
+	registerMock := &RegisterPayoutDestinationMock{}
+	registerMock.On("Execute", mock.Anything, mock.Anything).Return("", errs.ErrInvalidPixKey)
+	h := handler.New(nil, nil, telemetry.NewMockTelemetry(), handler.WithRegisterPayoutDestination(registerMock))
+
+	r, _ := http.NewRequest("POST", "/v1/users/"+withdrawalUserID+"/payout-destinations", strings.NewReader(`{"kind": "pix_key"}`))
+	r = withURLParams(r, map[string]string{"id": withdrawalUserID})
+	w := httptest.NewRecorder()
+
+	// Act
+	h.PostPayoutDestination(w, r)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
+
+type CreateWithdrawalMock struct {
+	mock.Mock
+}
+
+func (m *CreateWithdrawalMock) Execute(ctx context.Context, input usecase.CreateWithdrawalInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
+
+type SettleWithdrawalMock struct {
+	mock.Mock
+}
+
+func (m *SettleWithdrawalMock) Execute(ctx context.Context, input usecase.SettleWithdrawalInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
+
+type RegisterPayoutDestinationMock struct {
+	mock.Mock
+}
+
+func (m *RegisterPayoutDestinationMock) Execute(ctx context.Context, input usecase.RegisterPayoutDestinationInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/config"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/report"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+)
+
+// Reconcile runs the balance reconciliation once, e.g. from a cron job, and
+// exits with a non-zero status when any balance drifted from its history.
+func Reconcile() {
+	otel, err := telemetry.NewJaeger(context.Background(), serviceName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	reconcileBalances := newReconcileBalances(otel)
+	driftReport, path, err := reconcileBalances.Execute(context.Background())
+	if shutdownErr := otel.Shutdown(context.Background()); shutdownErr != nil {
+		log.Printf("Error shutting down tracer provider: %v", shutdownErr)
+	}
+	if err != nil {
+		log.Fatal("Failed to reconcile balances, err: ", err)
+	}
+
+	logReconciliation(driftReport.AccountsChecked(), len(driftReport.Drifts()), path)
+	if driftReport.HasDrift() {
+		os.Exit(1)
+	}
+}
+
+func newReconcileBalances(otel telemetry.Telemetry) *usecase.ReconcileBalances {
+	reconciliationConfig := config.GetReconciliationConfig()
+	return usecase.NewReconcileBalances(
+		repository.NewReconciliationRepository(db.NewPostgresDB(), otel),
+		report.NewFileDriftReportWriter(reconciliationConfig.ReportDir, otel),
+		otel,
+	)
+}
+
+func logReconciliation(accountsChecked, driftedAccounts int, path string) {
+	log.Printf("Reconciled %d balances, %d drifted, report written to %s", accountsChecked, driftedAccounts, path)
+}
@@ -1,7 +1,14 @@
 package router
 
 import (
-	"github.com.br/gibranct/simplified-wallet/internal/provider/queue"
+	"context"
+	"errors"
+	"io"
+	"log"
+
+	"github.com.br/gibranct/simplified-wallet/internal/config"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fee"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
 	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"net/http"
@@ -11,11 +18,16 @@ import (
 	customMiddleware "github.com.br/gibranct/simplified-wallet/internal/app/server/middleware"
 	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
 	"github.com.br/gibranct/simplified-wallet/internal/app/usecase/strategy"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
 	"github.com.br/gibranct/simplified-wallet/internal/provider/db"
 	"github.com.br/gibranct/simplified-wallet/internal/provider/gateway"
 	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/report"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 )
 
 func InitRoutes(otel telemetry.Telemetry) *chi.Mux {
@@ -34,25 +46,323 @@ func InitRoutes(otel telemetry.Telemetry) *chi.Mux {
 	// Expose Prometheus metrics endpoint
 	r.Handle("/metrics", promhttp.Handler())
 
-	userRepo := repository.NewUserRepository(db.NewPostgresDB(), otel)
-	createTransaction := usecase.NewCreateTransaction(
+	postgres := db.NewPostgresDB()
+	userRepo := repository.NewUserRepository(postgres, otel)
+	transactionRepo := repository.NewTransactionRepository(postgres, otel)
+	createTransaction := NewCreateTransaction(postgres, otel)
+	refundTransaction := usecase.NewRefundTransaction(transactionRepo, otel)
+	createDeposit := usecase.NewCreateDeposit(
+		repository.NewDepositRepository(postgres, otel),
 		userRepo,
+		repository.NewIdempotencyKeyRepository(postgres, otel),
+		newFundingGateway(otel),
+		otel,
+	)
+	withdrawalRepo := repository.NewWithdrawalRepository(postgres, otel)
+	payoutDestinationRepo := repository.NewPayoutDestinationRepository(postgres, otel)
+	settleWithdrawal := usecase.NewSettleWithdrawal(withdrawalRepo, otel)
+	createWithdrawal := usecase.NewCreateWithdrawal(
+		withdrawalRepo,
+		payoutDestinationRepo,
+		newPayoutGateway(settleWithdrawal, otel),
+		otel,
+	)
+	registerPayoutDestination := usecase.NewRegisterPayoutDestination(payoutDestinationRepo, otel)
+	scheduledTransferRepo := repository.NewScheduledTransferRepository(postgres, otel)
+	mandateRepo := repository.NewMandateRepository(postgres, otel)
+	balanceHoldRepo := repository.NewBalanceHoldRepository(postgres, otel)
+	fxRateProvider, err := newFXRateProvider()
+	if err != nil {
+		log.Fatal("Failed to load exchange rates, err: ", err)
+	}
+	transferLimits, err := newTransferLimitPolicy(fxRateProvider)
+	if err != nil {
+		log.Fatal("Failed to load transfer limits, err: ", err)
+	}
+	fees, err := newFeePolicy()
+	if err != nil {
+		log.Fatal("Failed to load fee schedules, err: ", err)
+	}
+	authorizeHold := usecase.NewAuthorizeHold(
+		balanceHoldRepo,
+		gateway.NewTransactionAuthorizer(http.DefaultClient, otel),
+		*transferLimits,
+		config.GetHoldConfig().Expiry,
+		otel,
+	)
+	transactionBatchRepo := repository.NewTransactionBatchRepository(postgres, otel)
+	createTransactionBatch := usecase.NewCreateTransactionBatch(
+		transactionBatchRepo,
+		gateway.NewTransactionAuthorizer(http.DefaultClient, otel),
+		*transferLimits,
+		*fees,
+		config.GetTransactionBatchConfig().MaxItems,
+		otel,
+	)
+	splitPaymentRepo := repository.NewSplitPaymentRepository(postgres, otel)
+	createSplitPayment := usecase.NewCreateSplitPayment(
+		splitPaymentRepo,
 		gateway.NewTransactionAuthorizer(http.DefaultClient, otel),
-		queue.NewSNS(otel),
+		*transferLimits,
+		*fees,
 		otel,
 	)
+	chargeRepo := repository.NewChargeRepository(postgres, otel)
+	settlementRepo := repository.NewSettlementRepository(postgres, otel)
+	disputeRepo := repository.NewDisputeRepository(postgres, otel)
+	escrowRepo := repository.NewEscrowRepository(postgres, otel)
+	escrowConfig := config.GetEscrowConfig()
+	createEscrow := usecase.NewCreateEscrow(
+		escrowRepo,
+		gateway.NewTransactionAuthorizer(http.DefaultClient, otel),
+		*transferLimits,
+		escrowConfig.Expiry,
+		otel,
+	)
+	payCharge := usecase.NewPayCharge(
+		chargeRepo,
+		gateway.NewTransactionAuthorizer(http.DefaultClient, otel),
+		*transferLimits,
+		*fees,
+		otel,
+	)
+	aliasKeyRepo := repository.NewAliasKeyRepository(postgres, otel)
+	pixConfig := config.GetPixConfig()
+	statementConfig := config.GetStatementConfig()
 	strategies := []usecase.CreateUserStrategy{
 		strategy.NewCreateCommonUser(userRepo, otel),
 		strategy.NewCreateMerchantUser(userRepo, otel),
 	}
 	createUser := usecase.NewCreateUser(userRepo, strategies, otel)
 
-	h := handler.New(createTransaction, createUser, otel)
+	h := handler.New(
+		createTransaction,
+		createUser,
+		otel,
+		handler.WithRefundTransaction(refundTransaction),
+		handler.WithListTransactions(usecase.NewListTransactions(transactionRepo, statementConfig.PageSize, statementConfig.MaxPageSize, otel)),
+		handler.WithExportStatement(usecase.NewExportStatement(transactionRepo, userRepo, newStatementWriter, otel)),
+		handler.WithGetBalance(usecase.NewGetBalance(userRepo, otel)),
+		handler.WithListSettlements(usecase.NewListSettlements(userRepo, settlementRepo, otel)),
+		handler.WithListSettlementTransactions(usecase.NewListSettlementTransactions(settlementRepo, otel)),
+		handler.WithPaySettlement(usecase.NewPaySettlement(settlementRepo, otel)),
+		handler.WithOpenDispute(usecase.NewOpenDispute(disputeRepo, config.GetDisputeConfig().ResponseWindow, otel)),
+		handler.WithGetDispute(usecase.NewGetDispute(disputeRepo, otel)),
+		handler.WithListDisputes(usecase.NewListDisputes(disputeRepo, otel)),
+		handler.WithRespondDispute(usecase.NewRespondDispute(disputeRepo, otel)),
+		handler.WithResolveDispute(usecase.NewResolveDispute(disputeRepo, otel)),
+		handler.WithCreateEscrow(createEscrow),
+		handler.WithGetEscrow(usecase.NewGetEscrow(escrowRepo, otel)),
+		handler.WithShipEscrow(usecase.NewShipEscrow(escrowRepo, escrowConfig.ReleaseWindow, otel)),
+		handler.WithConfirmEscrow(usecase.NewConfirmEscrow(escrowRepo, *fees, otel)),
+		handler.WithResolveEscrow(usecase.NewResolveEscrow(escrowRepo, *fees, otel)),
+		handler.WithCreateDeposit(createDeposit),
+		handler.WithRegisterPayoutDestination(registerPayoutDestination),
+		handler.WithCreateWithdrawal(createWithdrawal),
+		handler.WithSettleWithdrawal(settleWithdrawal, config.GetPayoutConfig().ResultSecret),
+		handler.WithScheduleTransfer(usecase.NewScheduleTransfer(scheduledTransferRepo, otel)),
+		handler.WithListScheduledTransfers(usecase.NewListScheduledTransfers(scheduledTransferRepo, otel)),
+		handler.WithCancelScheduledTransfer(usecase.NewCancelScheduledTransfer(scheduledTransferRepo, otel)),
+		handler.WithCreateMandate(usecase.NewCreateMandate(mandateRepo, otel)),
+		handler.WithListMandates(usecase.NewListMandates(mandateRepo, otel)),
+		handler.WithListMandateExecutions(usecase.NewListMandateExecutions(mandateRepo, otel)),
+		handler.WithChangeMandateStatus(usecase.NewChangeMandateStatus(mandateRepo, otel)),
+		handler.WithAuthorizeHold(authorizeHold),
+		handler.WithGetHold(usecase.NewGetHold(balanceHoldRepo, otel)),
+		handler.WithCaptureHold(usecase.NewCaptureHold(balanceHoldRepo, *fees, otel)),
+		handler.WithVoidHold(usecase.NewVoidHold(balanceHoldRepo, otel)),
+		handler.WithCreateTransactionBatch(createTransactionBatch),
+		handler.WithGetTransactionBatch(usecase.NewGetTransactionBatch(transactionBatchRepo, otel)),
+		handler.WithCreateSplitPayment(createSplitPayment),
+		handler.WithGetSplitPayment(usecase.NewGetSplitPayment(splitPaymentRepo, otel)),
+		handler.WithCreateCharge(usecase.NewCreateCharge(chargeRepo, config.GetChargeConfig().Expiry, otel)),
+		handler.WithGetCharge(usecase.NewGetCharge(chargeRepo, otel)),
+		handler.WithPayCharge(payCharge),
+		handler.WithCancelCharge(usecase.NewCancelCharge(chargeRepo, otel)),
+		handler.WithGenerateChargePixCode(usecase.NewGenerateChargePixCode(chargeRepo, userRepo, pixConfig.LocationURL, pixConfig.MerchantCity, otel)),
+		handler.WithGenerateStaticPixCode(usecase.NewGenerateStaticPixCode(userRepo, pixConfig.MerchantCity, otel)),
+		handler.WithRegisterAliasKey(usecase.NewRegisterAliasKey(aliasKeyRepo, otel)),
+		handler.WithListAliasKeys(usecase.NewListAliasKeys(aliasKeyRepo, otel)),
+		handler.WithDeleteAliasKey(usecase.NewDeleteAliasKey(aliasKeyRepo, otel)),
+		handler.WithResolveAliasKey(usecase.NewResolveAliasKey(aliasKeyRepo, otel)),
+		handler.WithDecodePixCode(usecase.NewDecodePixCode(chargeRepo, userRepo, aliasKeyRepo, pixConfig.LocationURL, otel)),
+	)
 
 	r.Route("/v1", func(r chi.Router) {
 		r.Post("/transactions", h.PostTransaction)
+		r.Post("/transactions/{id}/refund", h.PostRefund)
+		r.Post("/transaction-batches", h.PostTransactionBatch)
+		r.Get("/transaction-batches/{id}", h.GetTransactionBatch)
+		r.Post("/split-payments", h.PostSplitPayment)
+		r.Get("/split-payments/{id}", h.GetSplitPayment)
 		r.Post("/users", h.PostUser)
+		r.Post("/users/{id}/deposits", h.PostDeposit)
+		r.Post("/users/{id}/payout-destinations", h.PostPayoutDestination)
+		r.Post("/users/{id}/withdrawals", h.PostWithdrawal)
+		r.Get("/users/{id}/transactions", h.GetTransactions)
+		r.Get("/users/{id}/statements", h.GetStatement)
+		r.Get("/users/{id}/balance", h.GetBalance)
+		r.Get("/users/{id}/scheduled-transfers", h.GetScheduledTransfers)
+		r.Post("/users/{id}/keys", h.PostAliasKey)
+		r.Get("/users/{id}/keys", h.GetAliasKeys)
+		r.Delete("/users/{id}/keys/{key_id}", h.DeleteAliasKey)
+		r.Post("/scheduled-transfers/{id}/cancel", h.PostCancelScheduledTransfer)
+		r.Post("/mandates", h.PostMandate)
+		r.Get("/users/{id}/mandates", h.GetMandates)
+		r.Get("/mandates/{id}/executions", h.GetMandateExecutions)
+		r.Post("/mandates/{id}/pause", h.PostPauseMandate)
+		r.Post("/mandates/{id}/resume", h.PostResumeMandate)
+		r.Post("/mandates/{id}/cancel", h.PostCancelMandate)
+		r.Post("/holds", h.PostHold)
+		r.Get("/holds/{id}", h.GetHold)
+		r.Post("/holds/{id}/capture", h.PostCaptureHold)
+		r.Post("/holds/{id}/void", h.PostVoidHold)
+		r.Post("/charges", h.PostCharge)
+		r.Get("/charges/{id}", h.GetCharge)
+		r.Post("/charges/{id}/pay", h.PostPayCharge)
+		r.Post("/charges/{id}/cancel", h.PostCancelCharge)
+		r.Get("/charges/{id}/pix", h.GetChargePixCode)
+		r.Get("/merchants/{id}/settlements", h.GetSettlements)
+		r.Get("/settlements/{id}/transactions", h.GetSettlementTransactions)
+		r.Post("/settlements/{id}/pay", h.PostPaySettlement)
+		r.Post("/transactions/{id}/disputes", h.PostDispute)
+		r.Get("/users/{id}/disputes", h.GetDisputes)
+		r.Get("/disputes/{id}", h.GetDispute)
+		r.Post("/disputes/{id}/respond", h.PostRespondDispute)
+		r.Post("/disputes/{id}/resolve", h.PostResolveDispute)
+		r.Post("/escrows", h.PostEscrow)
+		r.Get("/escrows/{id}", h.GetEscrow)
+		r.Post("/escrows/{id}/ship", h.PostShipEscrow)
+		r.Post("/escrows/{id}/confirm", h.PostConfirmEscrow)
+		r.Post("/escrows/{id}/resolve", h.PostResolveEscrow)
+		r.Post("/pix/codes", h.PostPixCode)
+		r.Post("/pix/decode", h.PostDecodePixCode)
+		r.Post("/withdrawals/{id}/result", h.PostWithdrawalResult)
 		r.Post("/merchants", h.PostMerchant)
 	})
 	return r
 }
+
+// NewCreateTransaction builds the transfer use case shared by the API and the
+// schedulers of scheduled transfers and mandates.
+func NewCreateTransaction(postgres *sqlx.DB, otel telemetry.Telemetry) *usecase.CreateTransaction {
+	fxRateProvider, err := newFXRateProvider()
+	if err != nil {
+		log.Fatal("Failed to load exchange rates, err: ", err)
+	}
+	transferLimits, err := newTransferLimitPolicy(fxRateProvider)
+	if err != nil {
+		log.Fatal("Failed to load transfer limits, err: ", err)
+	}
+	fees, err := newFeePolicy()
+	if err != nil {
+		log.Fatal("Failed to load fee schedules, err: ", err)
+	}
+	return usecase.NewCreateTransaction(
+		repository.NewUserRepository(postgres, otel),
+		repository.NewIdempotencyKeyRepository(postgres, otel),
+		gateway.NewTransactionAuthorizer(http.DefaultClient, otel),
+		fxRateProvider,
+		*transferLimits,
+		*fees,
+		otel,
+	)
+}
+
+// NewSettleDueEscrows builds the job settling escrows past their deadline,
+// charging released escrows the fees the API charges.
+func NewSettleDueEscrows(postgres *sqlx.DB, batchSize int, otel telemetry.Telemetry) *usecase.SettleDueEscrows {
+	fees, err := newFeePolicy()
+	if err != nil {
+		log.Fatal("Failed to load fee schedules, err: ", err)
+	}
+	return usecase.NewSettleDueEscrows(repository.NewEscrowRepository(postgres, otel), batchSize, *fees, otel)
+}
+
+// newTransferLimitPolicy loads the limits, valuing the other currencies in
+// the default one with the rates the provider quotes for them. Currencies
+// without a rate cannot be sent by users with limits.
+func newTransferLimitPolicy(fxRateProvider usecase.FXRateProvider) (*vo.TransferLimitPolicy, error) {
+	limits := map[string]vo.TransferLimits{}
+	for userType, limitsConfig := range config.GetTransferLimitsConfig() {
+		userLimits, err := vo.NewTransferLimits(limitsConfig.PerTransaction, limitsConfig.Daily, limitsConfig.Weekly, limitsConfig.Monthly)
+		if err != nil {
+			return nil, err
+		}
+		limits[userType] = *userLimits
+	}
+	var rates []*vo.ExchangeRate
+	for _, currency := range vo.SupportedCurrencies {
+		if currency == vo.DefaultCurrency {
+			continue
+		}
+		rate, err := fxRateProvider.GetRate(context.Background(), currency, vo.DefaultCurrency)
+		if errors.Is(err, errs.ErrExchangeRateNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return vo.NewTransferLimitPolicy(limits, rates...)
+}
+
+// newFeePolicy loads the fee schedules, charging no fees when no schedules
+// file is configured.
+func newFeePolicy() (*vo.FeePolicy, error) {
+	feeConfig := config.GetFeeConfig()
+	if feeConfig.SchedulesFile != "" {
+		return fee.NewFilePolicy(feeConfig.SchedulesFile)
+	}
+	return &vo.FeePolicy{}, nil
+}
+
+func newFXRateProvider() (*fx.InMemoryRateProvider, error) {
+	fxConfig := config.GetFXConfig()
+	if fxConfig.RatesFile != "" {
+		return fx.NewFileRateProvider(fxConfig.RatesFile)
+	}
+	return fx.NewInMemoryRateProvider(fx.DefaultRates)
+}
+
+// newFundingGateway returns the funding gateway. The fake one confirms every
+// deposit, so it is only used when explicitly asked for.
+func newFundingGateway(otel telemetry.Telemetry) usecase.FundingGateway {
+	fundingConfig := config.GetFundingConfig()
+	if fundingConfig.GatewayURL != "" {
+		return gateway.NewFundingGateway(http.DefaultClient, fundingConfig.GatewayURL, otel)
+	}
+	if !fundingConfig.UseFakeGateway {
+		log.Fatal("FUNDING_GATEWAY_URL must be set to confirm deposits, or FUNDING_USE_FAKE_GATEWAY for local development")
+	}
+	log.Println("Using the fake funding gateway, every deposit is confirmed")
+	return gateway.NewFakeFundingGateway()
+}
+
+// newPayoutGateway returns the payout gateway, falling back to a stub that
+// settles withdrawals in-process when no payout provider is configured.
+func newPayoutGateway(settleWithdrawal *usecase.SettleWithdrawal, otel telemetry.Telemetry) usecase.PayoutGateway {
+	payoutConfig := config.GetPayoutConfig()
+	if payoutConfig.GatewayURL != "" {
+		if payoutConfig.ResultSecret == "" {
+			log.Fatal("PAYOUT_RESULT_SECRET must be set to take the results of the payout gateway")
+		}
+		return gateway.NewPayoutGateway(http.DefaultClient, payoutConfig.GatewayURL, otel)
+	}
+	return gateway.NewStubPayoutGateway(payoutConfig.StubDelay, func(ctx context.Context, withdrawalID string, succeeded bool, failureReason string) {
+		_, err := settleWithdrawal.Execute(ctx, usecase.SettleWithdrawalInput{
+			WithdrawalID:  uuid.MustParse(withdrawalID),
+			Succeeded:     succeeded,
+			FailureReason: failureReason,
+		})
+		if err != nil {
+			log.Printf("Failed to settle withdrawal %s, err: %v", withdrawalID, err)
+		}
+	})
+}
+
+// newStatementWriter creates the writers statements are exported with.
+func newStatementWriter(format string, w io.Writer) (usecase.StatementWriter, error) {
+	return report.NewStatementWriter(format, w)
+}
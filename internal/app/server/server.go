@@ -6,14 +6,28 @@ import (
 	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com.br/gibranct/simplified-wallet/internal/app/server/router"
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/app/worker"
 	"github.com.br/gibranct/simplified-wallet/internal/config"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/queue"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
 	"github.com/golang-migrate/migrate/v4"
 )
 
 const serviceName = "simplified-wallet"
 
+// shutdownTimeout is how long the requests in flight have to finish once the
+// server is asked to stop.
+const shutdownTimeout = 30 * time.Second
+
 func Run() {
 	// Initialize tracing
 	otel, err := telemetry.NewJaeger(context.Background(), serviceName)
@@ -38,10 +52,175 @@ func Run() {
 	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
 		log.Fatal("Failed to apply migrations, err: ", err)
 	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// workers keeps track of the background workers, so the server only
+	// returns once they stopped
+	var workers sync.WaitGroup
+	runWorker := func(ctx context.Context, interval time.Duration, name string, fn func(ctx context.Context) error) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			worker.Every(ctx, interval, name, fn)
+		}()
+	}
+
+	outboxConfig := config.GetOutboxConfig()
+	relayOutbox := usecase.NewRelayOutbox(
+		repository.NewOutboxRepository(db.NewPostgresDB(), otel),
+		queue.NewSNS(otel),
+		outboxConfig.BatchSize,
+		otel,
+	)
+	runWorker(ctx, outboxConfig.RelayInterval, "outbox-relay", func(ctx context.Context) error {
+		// Keep publishing while full batches come back so a backlog drains
+		// without waiting for the next tick
+		for {
+			processed, err := relayOutbox.Execute(ctx)
+			if err != nil || processed < outboxConfig.BatchSize {
+				return err
+			}
+		}
+	})
+
+	schedulerConfig := config.GetSchedulerConfig()
+	createTransaction := router.NewCreateTransaction(db.NewPostgresDB(), otel)
+	runScheduledTransfers := usecase.NewRunScheduledTransfers(
+		repository.NewScheduledTransferRepository(db.NewPostgresDB(), otel),
+		createTransaction,
+		schedulerConfig.BatchSize,
+		otel,
+	)
+	runWorker(ctx, schedulerConfig.Interval, "transfer-scheduler", func(ctx context.Context) error {
+		for {
+			processed, err := runScheduledTransfers.Execute(ctx)
+			if err != nil || processed < schedulerConfig.BatchSize {
+				return err
+			}
+		}
+	})
+
+	runMandates := usecase.NewRunMandates(
+		repository.NewMandateRepository(db.NewPostgresDB(), otel),
+		createTransaction,
+		schedulerConfig.BatchSize,
+		otel,
+	)
+	runWorker(ctx, schedulerConfig.Interval, "mandate-scheduler", func(ctx context.Context) error {
+		for {
+			processed, err := runMandates.Execute(ctx)
+			if err != nil || processed < schedulerConfig.BatchSize {
+				return err
+			}
+		}
+	})
+
+	holdConfig := config.GetHoldConfig()
+	expireHolds := usecase.NewExpireHolds(
+		repository.NewBalanceHoldRepository(db.NewPostgresDB(), otel),
+		holdConfig.BatchSize,
+		otel,
+	)
+	runWorker(ctx, holdConfig.ExpiryInterval, "hold-expiry", func(ctx context.Context) error {
+		for {
+			processed, err := expireHolds.Execute(ctx)
+			if err != nil || processed < holdConfig.BatchSize {
+				return err
+			}
+		}
+	})
+
+	chargeConfig := config.GetChargeConfig()
+	expireCharges := usecase.NewExpireCharges(
+		repository.NewChargeRepository(db.NewPostgresDB(), otel),
+		chargeConfig.BatchSize,
+		otel,
+	)
+	runWorker(ctx, chargeConfig.ExpiryInterval, "charge-expiry", func(ctx context.Context) error {
+		for {
+			processed, err := expireCharges.Execute(ctx)
+			if err != nil || processed < chargeConfig.BatchSize {
+				return err
+			}
+		}
+	})
+
+	settlementConfig := config.GetSettlementConfig()
+	runSettlements := usecase.NewRunSettlements(
+		repository.NewSettlementRepository(db.NewPostgresDB(), otel),
+		settlementConfig.CloseDelay,
+		settlementConfig.BatchSize,
+		otel,
+	)
+	runWorker(ctx, settlementConfig.Interval, "merchant-settlement", func(ctx context.Context) error {
+		for {
+			processed, err := runSettlements.Execute(ctx)
+			if err != nil || processed < settlementConfig.BatchSize {
+				return err
+			}
+		}
+	})
+
+	disputeConfig := config.GetDisputeConfig()
+	resolveOverdueDisputes := usecase.NewResolveOverdueDisputes(
+		repository.NewDisputeRepository(db.NewPostgresDB(), otel),
+		disputeConfig.BatchSize,
+		otel,
+	)
+	runWorker(ctx, disputeConfig.Interval, "dispute-resolution", func(ctx context.Context) error {
+		for {
+			processed, err := resolveOverdueDisputes.Execute(ctx)
+			if err != nil || processed < disputeConfig.BatchSize {
+				return err
+			}
+		}
+	})
+
+	escrowConfig := config.GetEscrowConfig()
+	settleDueEscrows := router.NewSettleDueEscrows(db.NewPostgresDB(), escrowConfig.BatchSize, otel)
+	runWorker(ctx, escrowConfig.Interval, "escrow-settlement", func(ctx context.Context) error {
+		for {
+			processed, err := settleDueEscrows.Execute(ctx)
+			if err != nil || processed < escrowConfig.BatchSize {
+				return err
+			}
+		}
+	})
+
+	reconciliationConfig := config.GetReconciliationConfig()
+	reconcileBalances := newReconcileBalances(otel)
+	runWorker(ctx, reconciliationConfig.Interval, "balance-reconciliation", func(ctx context.Context) error {
+		driftReport, path, err := reconcileBalances.Execute(ctx)
+		if err != nil {
+			return err
+		}
+		logReconciliation(driftReport.AccountsChecked(), len(driftReport.Drifts()), path)
+		return nil
+	})
+
 	r := router.InitRoutes(otel)
+	srv := &http.Server{Addr: ":3000", Handler: r}
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+		log.Println("Shutting down the server...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Failed to shut down the server, err: %v", err)
+		}
+	}()
+
 	log.Println("Server running on port 3000...")
-	err = http.ListenAndServe(":3000", r)
-	if err != nil {
+	err = srv.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Fatal("Failed to start server, err: ", err)
 	}
+	// ListenAndServe returns as soon as the shutdown starts, wait for the
+	// requests in flight and the workers to finish
+	<-shutdownDone
+	workers.Wait()
+	log.Println("Server stopped")
 }
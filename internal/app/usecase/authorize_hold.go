@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type BalanceHoldRepository interface {
+	Authorize(ctx context.Context, senderID, receiverID string, authorizeFn func(sender, receiver *entity.User) (*entity.BalanceHold, error)) error
+	GetBalanceHold(ctx context.Context, id string) (*entity.BalanceHold, error)
+	Settle(ctx context.Context, id string, settleFn func(hold *entity.BalanceHold, sender, receiver *entity.User) (*entity.Transaction, error)) error
+	ExpireDue(ctx context.Context, now time.Time, limit int, expireFn func(hold *entity.BalanceHold) error) (int, error)
+}
+
+type AuthorizeHold struct {
+	balanceHoldRepository BalanceHoldRepository
+	transactionAuthorizer TransactionAuthorizerGateway
+	transferLimits        vo.TransferLimitPolicy
+	defaultExpiry         time.Duration
+	otel                  telemetry.Telemetry
+}
+
+type AuthorizeHoldInput struct {
+	// Amount in cents of Currency
+	Amount int64
+	// Currency of the hold, the default currency when empty
+	Currency   string
+	SenderID   uuid.UUID
+	ReceiverID uuid.UUID
+	// ExpiresAt is when the hold is released if not captured, after the
+	// default expiry when zero.
+	ExpiresAt time.Time
+}
+
+// Execute reserves the amount out of the sender's available balance for the
+// receiver. The hold is authorized and limited like a transfer, as capturing
+// it moves the money without asking again.
+func (ah *AuthorizeHold) Execute(ctx context.Context, input AuthorizeHoldInput) (string, error) {
+	ctx, span := ah.otel.Start(ctx, "AuthorizeHold")
+	defer span.End()
+
+	currency := input.Currency
+	if currency == "" {
+		currency = vo.DefaultCurrency
+	}
+	amount, err := vo.NewMoney(input.Amount, currency)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	expiresAt := input.ExpiresAt
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(ah.defaultExpiry)
+	}
+
+	if !ah.transactionAuthorizer.IsTransactionAllowed(ctx) {
+		return "", errs.ErrTransactionNotAllowed
+	}
+
+	var hold *entity.BalanceHold
+	err = ah.balanceHoldRepository.Authorize(ctx, input.SenderID.String(), input.ReceiverID.String(), func(sender, receiver *entity.User) (*entity.BalanceHold, error) {
+		if sender.IsMerchant() {
+			return nil, errs.ErrMerchantCannotSendMoney
+		}
+
+		var err error
+		hold, err = entity.NewBalanceHold(sender.ID(), receiver.ID(), amount, expiresAt, now)
+		if err != nil {
+			return nil, err
+		}
+
+		err = sender.CheckTransferLimits(ah.transferLimits, hold.Currency(), hold.Amount())
+		if err != nil {
+			return nil, err
+		}
+
+		err = sender.Hold(hold.Currency(), hold.Amount())
+		if err != nil {
+			return nil, err
+		}
+
+		hold.RecordEvent(event.NewBalanceHoldAuthorizedEventV1(
+			hold.ID(),
+			input.SenderID,
+			input.ReceiverID,
+			event.Amount{InCents: hold.Amount(), Currency: hold.Currency()},
+			hold.Status(),
+		))
+		return hold, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return hold.ID(), nil
+}
+
+func NewAuthorizeHold(
+	balanceHoldRepository BalanceHoldRepository,
+	transactionAuthorizer TransactionAuthorizerGateway,
+	transferLimits vo.TransferLimitPolicy,
+	defaultExpiry time.Duration,
+	otel telemetry.Telemetry,
+) *AuthorizeHold {
+	return &AuthorizeHold{
+		balanceHoldRepository: balanceHoldRepository,
+		transactionAuthorizer: transactionAuthorizer,
+		transferLimits:        transferLimits,
+		defaultExpiry:         defaultExpiry,
+		otel:                  otel,
+	}
+}
@@ -0,0 +1,154 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	authorizeHoldFnType = "func(*entity.User, *entity.User) (*entity.BalanceHold, error)"
+	settleHoldFnType    = "func(*entity.BalanceHold, *entity.User, *entity.User) (*entity.Transaction, error)"
+	expireHoldFnType    = "func(*entity.BalanceHold) error"
+)
+
+func TestAuthorizeHold_Execute_ShouldReserveAmountOutOfAvailableBalance(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+
+	var hold *entity.BalanceHold
+	mockRepo := &mockBalanceHoldRepository{}
+	mockRepo.On("Authorize", ctx, sender.ID(), receiver.ID(), mock.AnythingOfType(authorizeHoldFnType)).
+		Run(func(args mock.Arguments) {
+			authorizeFn := args.Get(3).(func(*entity.User, *entity.User) (*entity.BalanceHold, error))
+			var err error
+			hold, err = authorizeFn(sender, receiver)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+
+	useCase := usecase.NewAuthorizeHold(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, 24*time.Hour, telemetry.NewMockTelemetry())
+
+	// Act
+	holdID, err := useCase.Execute(ctx, usecase.AuthorizeHoldInput{
+		Amount:     4000,
+		SenderID:   uuid.MustParse(sender.ID()),
+		ReceiverID: uuid.MustParse(receiver.ID()),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, hold.ID(), holdID)
+	assert.Equal(t, entity.BalanceHoldAuthorizedStatus, hold.Status())
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), hold.ExpiresAt(), time.Minute)
+	assert.Equal(t, int64(10000), sender.Balance())
+	assert.Equal(t, int64(6000), sender.AvailableBalance())
+	require.Len(t, hold.Events(), 1)
+	assert.Equal(t, "BalanceHoldAuthorizedEventV1", hold.Events()[0].Name())
+}
+
+func TestAuthorizeHold_Execute_ShouldReturnErrorWhenAvailableBalanceIsShort(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	require.NoError(t, sender.Hold(vo.BRL, 8000))
+	receiver := NewUser(vo.MerchantUserType)
+
+	mockRepo := &mockBalanceHoldRepository{}
+	mockRepo.On("Authorize", ctx, sender.ID(), receiver.ID(), mock.AnythingOfType(authorizeHoldFnType)).
+		Run(func(args mock.Arguments) {
+			authorizeFn := args.Get(3).(func(*entity.User, *entity.User) (*entity.BalanceHold, error))
+			_, err := authorizeFn(sender, receiver)
+			assert.ErrorIs(t, err, errs.ErrInsufficientBalance)
+		}).
+		Return(errs.ErrInsufficientBalance)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+
+	useCase := usecase.NewAuthorizeHold(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, time.Hour, telemetry.NewMockTelemetry())
+
+	// Act
+	holdID, err := useCase.Execute(ctx, usecase.AuthorizeHoldInput{
+		Amount:     4000,
+		SenderID:   uuid.MustParse(sender.ID()),
+		ReceiverID: uuid.MustParse(receiver.ID()),
+	})
+
+	// Assert
+	assert.Empty(t, holdID)
+	assert.ErrorIs(t, err, errs.ErrInsufficientBalance)
+	assert.Equal(t, int64(2000), sender.AvailableBalance())
+}
+
+func TestAuthorizeHold_Execute_ShouldReturnErrorWhenNotAllowedByAuthorizer(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := &mockBalanceHoldRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(false)
+
+	useCase := usecase.NewAuthorizeHold(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, time.Hour, telemetry.NewMockTelemetry())
+
+	// Act
+	holdID, err := useCase.Execute(ctx, usecase.AuthorizeHoldInput{
+		Amount:     4000,
+		SenderID:   uuid.New(),
+		ReceiverID: uuid.New(),
+	})
+
+	// Assert
+	assert.Empty(t, holdID)
+	assert.ErrorIs(t, err, errs.ErrTransactionNotAllowed)
+	mockRepo.AssertNotCalled(t, "Authorize", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+type mockBalanceHoldRepository struct {
+	mock.Mock
+}
+
+func (m *mockBalanceHoldRepository) Authorize(ctx context.Context, senderID, receiverID string, authorizeFn func(sender, receiver *entity.User) (*entity.BalanceHold, error)) error {
+	args := m.Called(ctx, senderID, receiverID, authorizeFn)
+	return args.Error(0)
+}
+
+func (m *mockBalanceHoldRepository) GetBalanceHold(ctx context.Context, id string) (*entity.BalanceHold, error) {
+	args := m.Called(ctx, id)
+	hold, _ := args.Get(0).(*entity.BalanceHold)
+	return hold, args.Error(1)
+}
+
+func (m *mockBalanceHoldRepository) Settle(ctx context.Context, id string, settleFn func(hold *entity.BalanceHold, sender, receiver *entity.User) (*entity.Transaction, error)) error {
+	args := m.Called(ctx, id, settleFn)
+	return args.Error(0)
+}
+
+func (m *mockBalanceHoldRepository) ExpireDue(ctx context.Context, now time.Time, limit int, expireFn func(hold *entity.BalanceHold) error) (int, error) {
+	args := m.Called(ctx, now, limit, expireFn)
+	return args.Int(0), args.Error(1)
+}
+
+// newAuthorizedHold holds amount of the sender's balance for the receiver.
+func newAuthorizedHold(t *testing.T, sender, receiver *entity.User, amount int64) *entity.BalanceHold {
+	money, err := vo.NewMoney(amount, vo.BRL)
+	require.NoError(t, err)
+	hold, err := entity.NewBalanceHold(sender.ID(), receiver.ID(), money, time.Now().Add(time.Hour), time.Now())
+	require.NoError(t, err)
+	require.NoError(t, sender.Hold(hold.Currency(), hold.Amount()))
+	return hold
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type CancelCharge struct {
+	chargeRepository ChargeRepository
+	otel             telemetry.Telemetry
+}
+
+// Execute cancels a charge that was not paid yet, so it can no longer be paid.
+func (cc *CancelCharge) Execute(ctx context.Context, id uuid.UUID) error {
+	ctx, span := cc.otel.Start(ctx, "CancelCharge")
+	defer span.End()
+
+	return cc.chargeRepository.Cancel(ctx, id.String(), func(charge *entity.Charge) error {
+		err := charge.Cancel(time.Now())
+		if err != nil {
+			return err
+		}
+
+		charge.RecordEvent(event.NewChargeCancelledEventV1(
+			charge.ID(),
+			uuid.MustParse(charge.MerchantID()),
+			charge.Reference(),
+			event.Amount{InCents: charge.Amount(), Currency: charge.Currency()},
+			charge.Status(),
+		))
+		return nil
+	})
+}
+
+func NewCancelCharge(
+	chargeRepository ChargeRepository,
+	otel telemetry.Telemetry,
+) *CancelCharge {
+	return &CancelCharge{
+		chargeRepository: chargeRepository,
+		otel:             otel,
+	}
+}
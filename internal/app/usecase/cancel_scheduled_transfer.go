@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type CancelScheduledTransfer struct {
+	scheduledTransferRepository ScheduledTransferRepository
+	otel                        telemetry.Telemetry
+}
+
+func (cs *CancelScheduledTransfer) Execute(ctx context.Context, id uuid.UUID) error {
+	ctx, span := cs.otel.Start(ctx, "CancelScheduledTransfer")
+	defer span.End()
+
+	return cs.scheduledTransferRepository.Update(ctx, id.String(), func(scheduledTransfer *entity.ScheduledTransfer) error {
+		return scheduledTransfer.Cancel(time.Now())
+	})
+}
+
+func NewCancelScheduledTransfer(
+	scheduledTransferRepository ScheduledTransferRepository,
+	otel telemetry.Telemetry,
+) *CancelScheduledTransfer {
+	return &CancelScheduledTransfer{
+		scheduledTransferRepository: scheduledTransferRepository,
+		otel:                        otel,
+	}
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type CaptureHold struct {
+	balanceHoldRepository BalanceHoldRepository
+	fees                  vo.FeePolicy
+	otel                  telemetry.Telemetry
+}
+
+type CaptureHoldInput struct {
+	HoldID uuid.UUID
+	// Amount in cents to capture, at most the held amount. Zero captures the
+	// whole hold.
+	Amount int64
+}
+
+// Execute moves the captured amount from the sender to the receiver as a
+// transfer, charged the fee of the receiver, and releases the rest of the
+// hold. It returns the transfer.
+func (ch *CaptureHold) Execute(ctx context.Context, input CaptureHoldInput) (string, error) {
+	ctx, span := ch.otel.Start(ctx, "CaptureHold")
+	defer span.End()
+
+	var transactionID string
+	err := ch.balanceHoldRepository.Settle(ctx, input.HoldID.String(), func(hold *entity.BalanceHold, sender, receiver *entity.User) (*entity.Transaction, error) {
+		err := hold.Capture(input.Amount, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		sender.ReleaseHold(hold.Currency(), hold.Amount())
+
+		captured, err := vo.NewMoney(hold.CapturedAmount(), hold.Currency())
+		if err != nil {
+			return nil, err
+		}
+		exchangeRate, err := vo.NewIdentityExchangeRate(hold.Currency())
+		if err != nil {
+			return nil, err
+		}
+		// The transfer was checked against the limits when authorized
+		transaction, err := postTransfer(sender, receiver, captured, exchangeRate, ch.fees)
+		if err != nil {
+			return nil, err
+		}
+
+		transactionID = transaction.ID()
+		hold.AttachTransaction(transactionID)
+
+		hold.RecordEvent(event.NewBalanceHoldCapturedEventV1(
+			hold.ID(),
+			uuid.MustParse(sender.ID()),
+			uuid.MustParse(receiver.ID()),
+			event.Amount{InCents: hold.Amount(), Currency: hold.Currency()},
+			hold.CapturedAmount(),
+			hold.Status(),
+			transactionID,
+		))
+		return transaction, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return transactionID, nil
+}
+
+func NewCaptureHold(
+	balanceHoldRepository BalanceHoldRepository,
+	fees vo.FeePolicy,
+	otel telemetry.Telemetry,
+) *CaptureHold {
+	return &CaptureHold{
+		balanceHoldRepository: balanceHoldRepository,
+		fees:                  fees,
+		otel:                  otel,
+	}
+}
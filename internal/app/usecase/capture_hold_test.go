@@ -0,0 +1,154 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptureHold_Execute_ShouldMoveCapturedAmountAndReleaseTheRest(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+	hold := newAuthorizedHold(t, sender, receiver, 6000)
+
+	var transaction *entity.Transaction
+	mockRepo := &mockBalanceHoldRepository{}
+	mockRepo.On("Settle", ctx, hold.ID(), mock.AnythingOfType(settleHoldFnType)).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(2).(func(*entity.BalanceHold, *entity.User, *entity.User) (*entity.Transaction, error))
+			var err error
+			transaction, err = settleFn(hold, sender, receiver)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewCaptureHold(mockRepo, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	transactionID, err := useCase.Execute(ctx, usecase.CaptureHoldInput{
+		HoldID: uuid.MustParse(hold.ID()),
+		Amount: 4500,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, transaction.ID(), transactionID)
+	assert.Equal(t, int64(4500), transaction.Amount())
+	assert.Equal(t, entity.BalanceHoldCapturedStatus, hold.Status())
+	assert.Equal(t, int64(4500), hold.CapturedAmount())
+	assert.Equal(t, transactionID, hold.TransactionID())
+	assert.Equal(t, int64(5500), sender.Balance())
+	assert.Equal(t, int64(5500), sender.AvailableBalance())
+	assert.Equal(t, int64(4500), receiver.Balance())
+	require.Len(t, hold.Events(), 1)
+	assert.Equal(t, "BalanceHoldCapturedEventV1", hold.Events()[0].Name())
+	require.Len(t, transaction.Events(), 1)
+}
+
+func TestCaptureHold_Execute_ShouldReturnErrorWhenCaptureExceedsHold(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+	hold := newAuthorizedHold(t, sender, receiver, 6000)
+
+	mockRepo := &mockBalanceHoldRepository{}
+	mockRepo.On("Settle", ctx, hold.ID(), mock.AnythingOfType(settleHoldFnType)).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(2).(func(*entity.BalanceHold, *entity.User, *entity.User) (*entity.Transaction, error))
+			_, err := settleFn(hold, sender, receiver)
+			assert.ErrorIs(t, err, errs.ErrCaptureExceedsHold)
+		}).
+		Return(errs.ErrCaptureExceedsHold)
+
+	useCase := usecase.NewCaptureHold(mockRepo, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	transactionID, err := useCase.Execute(ctx, usecase.CaptureHoldInput{
+		HoldID: uuid.MustParse(hold.ID()),
+		Amount: 6001,
+	})
+
+	// Assert
+	assert.Empty(t, transactionID)
+	assert.ErrorIs(t, err, errs.ErrCaptureExceedsHold)
+	assert.Equal(t, entity.BalanceHoldAuthorizedStatus, hold.Status())
+	assert.Equal(t, int64(4000), sender.AvailableBalance())
+}
+
+func TestVoidHold_Execute_ShouldReleaseHoldWithoutMovingMoney(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+	hold := newAuthorizedHold(t, sender, receiver, 6000)
+
+	mockRepo := &mockBalanceHoldRepository{}
+	mockRepo.On("Settle", ctx, hold.ID(), mock.AnythingOfType(settleHoldFnType)).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(2).(func(*entity.BalanceHold, *entity.User, *entity.User) (*entity.Transaction, error))
+			transaction, err := settleFn(hold, sender, receiver)
+			require.NoError(t, err)
+			assert.Nil(t, transaction)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewVoidHold(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	err := useCase.Execute(ctx, uuid.MustParse(hold.ID()))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entity.BalanceHoldVoidedStatus, hold.Status())
+	assert.Equal(t, int64(10000), sender.AvailableBalance())
+	assert.Equal(t, int64(0), receiver.Balance())
+	require.Len(t, hold.Events(), 1)
+	assert.Equal(t, "BalanceHoldVoidedEventV1", hold.Events()[0].Name())
+}
+
+func TestCaptureHold_Execute_ShouldChargeTheFeeOfTheReceiver(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+	hold := newAuthorizedHold(t, sender, receiver, 10000)
+
+	var transaction *entity.Transaction
+	mockRepo := &mockBalanceHoldRepository{}
+	mockRepo.On("Settle", ctx, hold.ID(), mock.AnythingOfType(settleHoldFnType)).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(2).(func(*entity.BalanceHold, *entity.User, *entity.User) (*entity.Transaction, error))
+			var err error
+			transaction, err = settleFn(hold, sender, receiver)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewCaptureHold(mockRepo, merchantFees(t), telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx, usecase.CaptureHoldInput{HoldID: uuid.MustParse(hold.ID())})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(199), transaction.Fee())
+	assert.Equal(t, int64(199), platformCredit(t, transaction))
+	assert.Equal(t, int64(0), sender.Balance())
+	assert.Equal(t, int64(9801), receiver.Balance())
+}
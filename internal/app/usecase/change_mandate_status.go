@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+// Actions changing the status of a mandate.
+const (
+	PauseMandateAction  = "pause"
+	ResumeMandateAction = "resume"
+	CancelMandateAction = "cancel"
+)
+
+type ChangeMandateStatus struct {
+	mandateRepository MandateRepository
+	otel              telemetry.Telemetry
+}
+
+type ChangeMandateStatusInput struct {
+	MandateID uuid.UUID
+	// Action is pause, resume or cancel
+	Action string
+}
+
+// Execute pauses, resumes or cancels a mandate and returns its new status.
+func (cs *ChangeMandateStatus) Execute(ctx context.Context, input ChangeMandateStatusInput) (string, error) {
+	ctx, span := cs.otel.Start(ctx, "ChangeMandateStatus")
+	defer span.End()
+
+	var status string
+	err := cs.mandateRepository.Update(ctx, input.MandateID.String(), func(mandate *entity.Mandate) error {
+		now := time.Now()
+		var err error
+		switch input.Action {
+		case PauseMandateAction:
+			err = mandate.Pause(now)
+		case ResumeMandateAction:
+			err = mandate.Resume(now)
+		case CancelMandateAction:
+			err = mandate.Cancel(now)
+		default:
+			err = errs.ErrInvalidMandateAction
+		}
+		status = mandate.Status()
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return status, nil
+}
+
+func NewChangeMandateStatus(
+	mandateRepository MandateRepository,
+	otel telemetry.Telemetry,
+) *ChangeMandateStatus {
+	return &ChangeMandateStatus{
+		mandateRepository: mandateRepository,
+		otel:              otel,
+	}
+}
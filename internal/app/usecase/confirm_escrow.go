@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ConfirmEscrow struct {
+	escrowRepository EscrowRepository
+	fees             vo.FeePolicy
+	otel             telemetry.Telemetry
+}
+
+type ConfirmEscrowInput struct {
+	EscrowID uuid.UUID
+	SenderID uuid.UUID
+}
+
+// Execute releases the money to the receiver once the sender confirms the
+// delivery.
+func (ce *ConfirmEscrow) Execute(ctx context.Context, input ConfirmEscrowInput) (*entity.Escrow, error) {
+	ctx, span := ce.otel.Start(ctx, "ConfirmEscrow")
+	defer span.End()
+
+	var confirmed *entity.Escrow
+	err := ce.escrowRepository.Settle(ctx, input.EscrowID.String(), func(escrow *entity.Escrow, sender, receiver *entity.User) (*entity.Transaction, error) {
+		err := escrow.Confirm(input.SenderID.String(), time.Now())
+		if err != nil {
+			return nil, err
+		}
+		confirmed = escrow
+		return releaseEscrow(escrow, sender, receiver, ce.fees)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return confirmed, nil
+}
+
+// releaseEscrow moves the money of a released escrow from the sender to the
+// receiver as a transfer, charged the fee of the receiver. The transfer was
+// checked against the limits when the escrow was created.
+func releaseEscrow(escrow *entity.Escrow, sender, receiver *entity.User, fees vo.FeePolicy) (*entity.Transaction, error) {
+	sender.ReleaseHold(escrow.Currency(), escrow.Amount())
+
+	amount, err := vo.NewMoney(escrow.Amount(), escrow.Currency())
+	if err != nil {
+		return nil, err
+	}
+	exchangeRate, err := vo.NewIdentityExchangeRate(escrow.Currency())
+	if err != nil {
+		return nil, err
+	}
+	transaction, err := postTransfer(sender, receiver, amount, exchangeRate, fees)
+	if err != nil {
+		return nil, err
+	}
+
+	escrow.AttachTransaction(transaction.ID())
+	escrow.RecordEvent(event.NewEscrowReleasedEventV1(
+		escrow.ID(),
+		uuid.MustParse(sender.ID()),
+		uuid.MustParse(receiver.ID()),
+		event.Amount{InCents: escrow.Amount(), Currency: escrow.Currency()},
+		escrow.Status(),
+		transaction.ID(),
+	))
+	return transaction, nil
+}
+
+func NewConfirmEscrow(
+	escrowRepository EscrowRepository,
+	fees vo.FeePolicy,
+	otel telemetry.Telemetry,
+) *ConfirmEscrow {
+	return &ConfirmEscrow{
+		escrowRepository: escrowRepository,
+		fees:             fees,
+		otel:             otel,
+	}
+}
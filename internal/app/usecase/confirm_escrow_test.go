@@ -0,0 +1,181 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmEscrow_Execute_ShouldReleaseTheMoneyToTheReceiver(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+	escrow := newHeldEscrow(t, sender, receiver, 7000)
+
+	var transaction *entity.Transaction
+	mockRepo := &mockEscrowRepository{}
+	mockRepo.On("Settle", ctx, escrow.ID(), mock.AnythingOfType(settleEscrowFnType)).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(2).(func(*entity.Escrow, *entity.User, *entity.User) (*entity.Transaction, error))
+			var err error
+			transaction, err = settleFn(escrow, sender, receiver)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewConfirmEscrow(mockRepo, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	confirmed, err := useCase.Execute(ctx, usecase.ConfirmEscrowInput{
+		EscrowID: uuid.MustParse(escrow.ID()),
+		SenderID: uuid.MustParse(sender.ID()),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.EscrowReleasedStatus, confirmed.Status())
+	require.NotNil(t, transaction)
+	assert.Equal(t, transaction.ID(), confirmed.TransactionID())
+	assert.Equal(t, int64(3000), sender.Balance())
+	assert.Equal(t, int64(3000), sender.AvailableBalance())
+	assert.Equal(t, int64(7000), receiver.Balance())
+	require.Len(t, confirmed.Events(), 1)
+	assert.Equal(t, "EscrowReleasedEventV1", confirmed.Events()[0].Name())
+	require.Len(t, transaction.Events(), 1)
+}
+
+func TestResolveEscrow_Execute_ReturnedShouldGiveTheMoneyBackToTheSender(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+	escrow := newHeldEscrow(t, sender, receiver, 7000)
+
+	mockRepo := &mockEscrowRepository{}
+	mockRepo.On("Settle", ctx, escrow.ID(), mock.AnythingOfType(settleEscrowFnType)).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(2).(func(*entity.Escrow, *entity.User, *entity.User) (*entity.Transaction, error))
+			transaction, err := settleFn(escrow, sender, receiver)
+			require.NoError(t, err)
+			assert.Nil(t, transaction)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewResolveEscrow(mockRepo, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	resolved, err := useCase.Execute(ctx, usecase.ResolveEscrowInput{
+		EscrowID: uuid.MustParse(escrow.ID()),
+		Outcome:  entity.EscrowReturnedStatus,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.EscrowReturnedStatus, resolved.Status())
+	assert.Equal(t, int64(10000), sender.AvailableBalance())
+	assert.Equal(t, int64(0), receiver.Balance())
+	require.Len(t, resolved.Events(), 1)
+	assert.Equal(t, "EscrowReturnedEventV1", resolved.Events()[0].Name())
+}
+
+func TestResolveEscrow_Execute_ShouldRejectUnknownOutcomes(t *testing.T) {
+	// Arrange
+	mockRepo := &mockEscrowRepository{}
+	useCase := usecase.NewResolveEscrow(mockRepo, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(context.Background(), usecase.ResolveEscrowInput{EscrowID: uuid.New(), Outcome: "shipped"})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrInvalidEscrowOutcome)
+	mockRepo.AssertNotCalled(t, "Settle", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSettleDueEscrows_Execute_ShouldReleaseShippedAndExpireUnshippedEscrows(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+	shipped := newHeldEscrow(t, sender, receiver, 4000)
+	require.NoError(t, shipped.Ship(receiver.ID(), time.Hour, time.Now()))
+	unshipped := newHeldEscrow(t, sender, receiver, 3000)
+
+	var transactions []*entity.Transaction
+	mockRepo := &mockEscrowRepository{}
+	mockRepo.On("SettleDue", ctx, mock.AnythingOfType("time.Time"), 100, mock.AnythingOfType(settleEscrowFnType)).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(3).(func(*entity.Escrow, *entity.User, *entity.User) (*entity.Transaction, error))
+			for _, escrow := range []*entity.Escrow{shipped, unshipped} {
+				transaction, err := settleFn(escrow, sender, receiver)
+				require.NoError(t, err)
+				if transaction != nil {
+					transactions = append(transactions, transaction)
+				}
+			}
+		}).
+		Return(2, nil)
+
+	useCase := usecase.NewSettleDueEscrows(mockRepo, 100, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, processed)
+	assert.Equal(t, entity.EscrowReleasedStatus, shipped.Status())
+	assert.Equal(t, entity.EscrowExpiredStatus, unshipped.Status())
+	require.Len(t, transactions, 1)
+	assert.Equal(t, int64(4000), receiver.Balance())
+	assert.Equal(t, int64(6000), sender.Balance())
+	require.Len(t, unshipped.Events(), 1)
+	assert.Equal(t, "EscrowExpiredEventV1", unshipped.Events()[0].Name())
+}
+
+func TestConfirmEscrow_Execute_ShouldChargeTheFeeOfTheReceiver(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+	escrow := newHeldEscrow(t, sender, receiver, 10000)
+
+	var transaction *entity.Transaction
+	mockRepo := &mockEscrowRepository{}
+	mockRepo.On("Settle", ctx, escrow.ID(), mock.AnythingOfType(settleEscrowFnType)).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(2).(func(*entity.Escrow, *entity.User, *entity.User) (*entity.Transaction, error))
+			var err error
+			transaction, err = settleFn(escrow, sender, receiver)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewConfirmEscrow(mockRepo, merchantFees(t), telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx, usecase.ConfirmEscrowInput{
+		EscrowID: uuid.MustParse(escrow.ID()),
+		SenderID: uuid.MustParse(sender.ID()),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(199), transaction.Fee())
+	assert.Equal(t, int64(199), platformCredit(t, transaction))
+	assert.Equal(t, int64(9801), receiver.Balance())
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ChargeRepository interface {
+	Create(ctx context.Context, merchantID string, createFn func(merchant *entity.User) (*entity.Charge, error)) error
+	GetCharge(ctx context.Context, id string) (*entity.Charge, error)
+	Pay(ctx context.Context, id, payerID string, payFn func(charge *entity.Charge, payer, merchant *entity.User) (*entity.Transaction, error)) error
+	Cancel(ctx context.Context, id string, cancelFn func(charge *entity.Charge) error) error
+	ExpireDue(ctx context.Context, now time.Time, limit int, expireFn func(charge *entity.Charge) error) (int, error)
+}
+
+type CreateCharge struct {
+	chargeRepository ChargeRepository
+	defaultExpiry    time.Duration
+	otel             telemetry.Telemetry
+}
+
+type CreateChargeInput struct {
+	MerchantID uuid.UUID
+	// Amount in cents of Currency
+	Amount int64
+	// Currency of the charge, the default currency when empty
+	Currency string
+	// Reference identifies the charge among the merchant's own, e.g. the id
+	// of the order it is for.
+	Reference   string
+	Description string
+	// ExpiresAt is when the charge can no longer be paid, after the default
+	// expiry when zero.
+	ExpiresAt time.Time
+}
+
+// Execute creates a pending charge that users can pay until it expires.
+func (cc *CreateCharge) Execute(ctx context.Context, input CreateChargeInput) (*entity.Charge, error) {
+	ctx, span := cc.otel.Start(ctx, "CreateCharge")
+	defer span.End()
+
+	currency := input.Currency
+	if currency == "" {
+		currency = vo.DefaultCurrency
+	}
+	amount, err := vo.NewMoney(input.Amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	expiresAt := input.ExpiresAt
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(cc.defaultExpiry)
+	}
+
+	var charge *entity.Charge
+	err = cc.chargeRepository.Create(ctx, input.MerchantID.String(), func(merchant *entity.User) (*entity.Charge, error) {
+		if !merchant.IsMerchant() {
+			return nil, errs.ErrOnlyMerchantsCanCharge
+		}
+
+		var err error
+		charge, err = entity.NewCharge(merchant.ID(), input.Reference, input.Description, amount, expiresAt, now)
+		if err != nil {
+			return nil, err
+		}
+
+		charge.RecordEvent(event.NewChargeCreatedEventV1(
+			charge.ID(),
+			input.MerchantID,
+			charge.Reference(),
+			event.Amount{InCents: charge.Amount(), Currency: charge.Currency()},
+			charge.Status(),
+		))
+		return charge, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return charge, nil
+}
+
+func NewCreateCharge(
+	chargeRepository ChargeRepository,
+	defaultExpiry time.Duration,
+	otel telemetry.Telemetry,
+) *CreateCharge {
+	return &CreateCharge{
+		chargeRepository: chargeRepository,
+		defaultExpiry:    defaultExpiry,
+		otel:             otel,
+	}
+}
@@ -0,0 +1,123 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	createChargeFnType = "func(*entity.User) (*entity.Charge, error)"
+	payChargeFnType    = "func(*entity.Charge, *entity.User, *entity.User) (*entity.Transaction, error)"
+	expireChargeFnType = "func(*entity.Charge) error"
+)
+
+func TestCreateCharge_Execute_ShouldCreatePendingChargeOfMerchant(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	merchant := NewUser(vo.MerchantUserType)
+
+	mockRepo := &mockChargeRepository{}
+	mockRepo.On("Create", ctx, merchant.ID(), mock.AnythingOfType(createChargeFnType)).
+		Run(func(args mock.Arguments) {
+			createFn := args.Get(2).(func(*entity.User) (*entity.Charge, error))
+			_, err := createFn(merchant)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewCreateCharge(mockRepo, 24*time.Hour, telemetry.NewMockTelemetry())
+
+	// Act
+	charge, err := useCase.Execute(ctx, usecase.CreateChargeInput{
+		MerchantID: uuid.MustParse(merchant.ID()),
+		Amount:     4990,
+		Reference:  "order-42",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.ChargePendingStatus, charge.Status())
+	assert.Equal(t, vo.DefaultCurrency, charge.Currency())
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), charge.ExpiresAt(), time.Minute)
+	require.Len(t, charge.Events(), 1)
+	assert.Equal(t, "ChargeCreatedEventV1", charge.Events()[0].Name())
+}
+
+func TestCreateCharge_Execute_ShouldReturnErrorWhenUserIsNotMerchant(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	user := NewUser(vo.CommonUserType)
+
+	mockRepo := &mockChargeRepository{}
+	mockRepo.On("Create", ctx, user.ID(), mock.AnythingOfType(createChargeFnType)).
+		Run(func(args mock.Arguments) {
+			createFn := args.Get(2).(func(*entity.User) (*entity.Charge, error))
+			_, err := createFn(user)
+			assert.ErrorIs(t, err, errs.ErrOnlyMerchantsCanCharge)
+		}).
+		Return(errs.ErrOnlyMerchantsCanCharge)
+
+	useCase := usecase.NewCreateCharge(mockRepo, time.Hour, telemetry.NewMockTelemetry())
+
+	// Act
+	charge, err := useCase.Execute(ctx, usecase.CreateChargeInput{
+		MerchantID: uuid.MustParse(user.ID()),
+		Amount:     4990,
+		Reference:  "order-42",
+	})
+
+	// Assert
+	assert.Nil(t, charge)
+	assert.ErrorIs(t, err, errs.ErrOnlyMerchantsCanCharge)
+}
+
+// newPendingCharge creates a charge of the merchant that expires in an hour.
+func newPendingCharge(t *testing.T, merchant *entity.User, amount int64) *entity.Charge {
+	t.Helper()
+	money, err := vo.NewMoney(amount, vo.BRL)
+	require.NoError(t, err)
+	charge, err := entity.NewCharge(merchant.ID(), "order-42", "", money, time.Now().Add(time.Hour), time.Now())
+	require.NoError(t, err)
+	return charge
+}
+
+type mockChargeRepository struct {
+	mock.Mock
+}
+
+func (m *mockChargeRepository) Create(ctx context.Context, merchantID string, createFn func(merchant *entity.User) (*entity.Charge, error)) error {
+	args := m.Called(ctx, merchantID, createFn)
+	return args.Error(0)
+}
+
+func (m *mockChargeRepository) GetCharge(ctx context.Context, id string) (*entity.Charge, error) {
+	args := m.Called(ctx, id)
+	charge, _ := args.Get(0).(*entity.Charge)
+	return charge, args.Error(1)
+}
+
+func (m *mockChargeRepository) Pay(ctx context.Context, id, payerID string, payFn func(charge *entity.Charge, payer, merchant *entity.User) (*entity.Transaction, error)) error {
+	args := m.Called(ctx, id, payerID, payFn)
+	return args.Error(0)
+}
+
+func (m *mockChargeRepository) Cancel(ctx context.Context, id string, cancelFn func(charge *entity.Charge) error) error {
+	args := m.Called(ctx, id, cancelFn)
+	return args.Error(0)
+}
+
+func (m *mockChargeRepository) ExpireDue(ctx context.Context, now time.Time, limit int, expireFn func(charge *entity.Charge) error) (int, error) {
+	args := m.Called(ctx, now, limit, expireFn)
+	return args.Int(0), args.Error(1)
+}
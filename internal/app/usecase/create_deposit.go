@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type DepositRepository interface {
+	Deposit(ctx context.Context, userID string, depositFn func(user *entity.User) (*entity.Deposit, error)) error
+}
+
+// FundingGateway confirms that the external funds of a deposit, e.g. a card
+// charge or a bank transfer, were received before the wallet is credited.
+type FundingGateway interface {
+	ConfirmFunds(ctx context.Context, deposit *entity.Deposit) bool
+}
+
+type CreateDeposit struct {
+	depositRepository        DepositRepository
+	userRepository           UserRepository
+	idempotencyKeyRepository IdempotencyKeyRepository
+	fundingGateway           FundingGateway
+	otel                     telemetry.Telemetry
+}
+
+type CreateDepositInput struct {
+	UserID uuid.UUID
+	// Amount in cents of Currency
+	Amount int64
+	// Currency of the deposit, the default currency when empty
+	Currency string
+	// IdempotencyKey is optional. Retries carrying the same key and the same
+	// request return the deposit created by the first attempt.
+	IdempotencyKey string
+}
+
+// requestHash fingerprints the request so a reused idempotency key can be
+// told apart from a retry.
+func (i CreateDepositInput) requestHash() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("deposit|%d|%s|%s", i.Amount, i.currency(), i.UserID)))
+	return hex.EncodeToString(sum[:])
+}
+
+func (i CreateDepositInput) currency() string {
+	if i.Currency == "" {
+		return vo.DefaultCurrency
+	}
+	return i.Currency
+}
+
+func (cd *CreateDeposit) Execute(ctx context.Context, input CreateDepositInput) (string, error) {
+	ctx, span := cd.otel.Start(ctx, "CreateDeposit")
+	defer span.End()
+
+	var idempotencyKey *entity.IdempotencyKey
+	if input.IdempotencyKey != "" {
+		var err error
+		idempotencyKey, err = entity.NewIdempotencyKey(input.IdempotencyKey, input.requestHash())
+		if err != nil {
+			return "", err
+		}
+		depositID, replayed, err := replay(ctx, cd.idempotencyKeyRepository, idempotencyKey)
+		if err != nil || replayed {
+			return depositID, err
+		}
+	}
+
+	amount, err := vo.NewMoney(input.Amount, input.currency())
+	if err != nil {
+		return "", err
+	}
+	deposit, err := entity.NewDeposit(input.UserID.String(), amount)
+	if err != nil {
+		return "", err
+	}
+
+	// The funds are only confirmed for users that exist, as confirmed funds
+	// cannot be credited otherwise
+	_, err = cd.userRepository.GetUserByID(ctx, input.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	if !cd.fundingGateway.ConfirmFunds(ctx, deposit) {
+		return "", errs.ErrFundsNotConfirmed
+	}
+
+	err = cd.depositRepository.Deposit(ctx, deposit.UserID(), func(user *entity.User) (*entity.Deposit, error) {
+		err := user.DepositIn(deposit.Currency(), deposit.Amount())
+		if err != nil {
+			return nil, err
+		}
+
+		if idempotencyKey != nil {
+			deposit.AttachIdempotencyKey(idempotencyKey)
+		}
+
+		deposit.RecordEvent(event.NewDepositEventV1(
+			deposit.ID(),
+			input.UserID,
+			event.Amount{InCents: deposit.Amount(), Currency: deposit.Currency()},
+		))
+
+		return deposit, nil
+	})
+	if errors.Is(err, errs.ErrIdempotencyKeyConflict) {
+		// A concurrent request with the same key won the race
+		depositID, replayed, replayErr := replay(ctx, cd.idempotencyKeyRepository, idempotencyKey)
+		if replayErr != nil || replayed {
+			return depositID, replayErr
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return deposit.ID(), nil
+}
+
+func NewCreateDeposit(
+	depositRepository DepositRepository,
+	userRepository UserRepository,
+	idempotencyKeyRepository IdempotencyKeyRepository,
+	fundingGateway FundingGateway,
+	otel telemetry.Telemetry,
+) *CreateDeposit {
+	return &CreateDeposit{
+		depositRepository:        depositRepository,
+		userRepository:           userRepository,
+		idempotencyKeyRepository: idempotencyKeyRepository,
+		fundingGateway:           fundingGateway,
+		otel:                     otel,
+	}
+}
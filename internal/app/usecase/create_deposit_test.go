@@ -0,0 +1,249 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const depositFnType = "func(*entity.User) (*entity.Deposit, error)"
+
+func TestCreateDeposit_Execute_ShouldCreditUserWhenFundsAreConfirmed(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	user := NewUser(vo.CommonUserType)
+	userID := uuid.MustParse(user.ID())
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, userID).Return(user, nil)
+
+	mockFundingGateway := &mockFundingGateway{}
+	mockFundingGateway.On("ConfirmFunds", ctx, mock.AnythingOfType("*entity.Deposit")).Return(true)
+
+	var capturedDeposit *entity.Deposit
+	mockDepositRepo := &mockDepositRepository{}
+	mockDepositRepo.On("Deposit", ctx, user.ID(), mock.AnythingOfType(depositFnType)).
+		Run(func(args mock.Arguments) {
+			depositFn := args.Get(2).(func(*entity.User) (*entity.Deposit, error))
+			var err error
+			capturedDeposit, err = depositFn(user)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewCreateDeposit(mockDepositRepo, mockUserRepo, &mockIdempotencyKeyRepository{}, mockFundingGateway, telemetry.NewMockTelemetry())
+
+	// Act
+	depositID, err := useCase.Execute(ctx, usecase.CreateDepositInput{
+		UserID: userID,
+		Amount: 25000,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, capturedDeposit.ID(), depositID)
+	assert.Equal(t, int64(25000), user.Balance())
+	require.Len(t, capturedDeposit.Events(), 1)
+	assert.Equal(t, "DepositEventV1", capturedDeposit.Events()[0].Name())
+}
+
+func TestCreateDeposit_Execute_ShouldCreditTheRequestedCurrency(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	user := NewUser(vo.CommonUserType)
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, uuid.MustParse(user.ID())).Return(user, nil)
+
+	mockFundingGateway := &mockFundingGateway{}
+	mockFundingGateway.On("ConfirmFunds", ctx, mock.AnythingOfType("*entity.Deposit")).Return(true)
+
+	mockDepositRepo := &mockDepositRepository{}
+	mockDepositRepo.On("Deposit", ctx, user.ID(), mock.AnythingOfType(depositFnType)).
+		Run(func(args mock.Arguments) {
+			depositFn := args.Get(2).(func(*entity.User) (*entity.Deposit, error))
+			_, err := depositFn(user)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewCreateDeposit(mockDepositRepo, mockUserRepo, &mockIdempotencyKeyRepository{}, mockFundingGateway, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx, usecase.CreateDepositInput{
+		UserID:   uuid.MustParse(user.ID()),
+		Amount:   1000,
+		Currency: vo.USD,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), user.BalanceIn(vo.USD))
+	assert.Equal(t, int64(0), user.Balance())
+}
+
+func TestCreateDeposit_Execute_ShouldReturnErrorWhenFundsAreNotConfirmed(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, mock.Anything).Return(NewUser(vo.CommonUserType), nil)
+	mockFundingGateway := &mockFundingGateway{}
+	mockFundingGateway.On("ConfirmFunds", ctx, mock.AnythingOfType("*entity.Deposit")).Return(false)
+	mockDepositRepo := &mockDepositRepository{}
+
+	useCase := usecase.NewCreateDeposit(mockDepositRepo, mockUserRepo, &mockIdempotencyKeyRepository{}, mockFundingGateway, telemetry.NewMockTelemetry())
+
+	// Act
+	depositID, err := useCase.Execute(ctx, usecase.CreateDepositInput{
+		UserID: uuid.New(),
+		Amount: 1000,
+	})
+
+	// Assert
+	assert.Empty(t, depositID)
+	assert.ErrorIs(t, err, errs.ErrFundsNotConfirmed)
+	mockDepositRepo.AssertNotCalled(t, "Deposit", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateDeposit_Execute_ShouldNotCallGatewayWhenAmountIsInvalid(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockUserRepo := &mockUserRepository{}
+	mockFundingGateway := &mockFundingGateway{}
+	mockDepositRepo := &mockDepositRepository{}
+
+	useCase := usecase.NewCreateDeposit(mockDepositRepo, mockUserRepo, &mockIdempotencyKeyRepository{}, mockFundingGateway, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx, usecase.CreateDepositInput{
+		UserID: uuid.New(),
+		Amount: 0,
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrZeroOrNegativeAmount)
+	mockFundingGateway.AssertNotCalled(t, "ConfirmFunds", mock.Anything, mock.Anything)
+}
+
+func TestCreateDeposit_Execute_ShouldReturnErrorWhenRepositoryFails(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expectedError := errors.New("database error")
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, mock.Anything).Return(NewUser(vo.CommonUserType), nil)
+	mockFundingGateway := &mockFundingGateway{}
+	mockFundingGateway.On("ConfirmFunds", ctx, mock.AnythingOfType("*entity.Deposit")).Return(true)
+	mockDepositRepo := &mockDepositRepository{}
+	mockDepositRepo.On("Deposit", ctx, mock.Anything, mock.AnythingOfType(depositFnType)).Return(expectedError)
+
+	useCase := usecase.NewCreateDeposit(mockDepositRepo, mockUserRepo, &mockIdempotencyKeyRepository{}, mockFundingGateway, telemetry.NewMockTelemetry())
+
+	// Act
+	depositID, err := useCase.Execute(ctx, usecase.CreateDepositInput{
+		UserID: uuid.New(),
+		Amount: 1000,
+	})
+
+	// Assert
+	assert.Empty(t, depositID)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestCreateDeposit_Execute_ShouldNotConfirmFundsWhenUserDoesNotExist(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, userID).Return(nil, errs.ErrUserNotFound)
+	mockFundingGateway := &mockFundingGateway{}
+	mockDepositRepo := &mockDepositRepository{}
+
+	useCase := usecase.NewCreateDeposit(mockDepositRepo, mockUserRepo, &mockIdempotencyKeyRepository{}, mockFundingGateway, telemetry.NewMockTelemetry())
+
+	// Act
+	depositID, err := useCase.Execute(ctx, usecase.CreateDepositInput{
+		UserID: userID,
+		Amount: 1000,
+	})
+
+	// Assert
+	assert.Empty(t, depositID)
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+	mockFundingGateway.AssertNotCalled(t, "ConfirmFunds", mock.Anything, mock.Anything)
+	mockDepositRepo.AssertNotCalled(t, "Deposit", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateDeposit_Execute_ShouldReplayOriginalDepositWhenIdempotencyKeyIsReused(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	user := NewUser(vo.CommonUserType)
+	userID := uuid.MustParse(user.ID())
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, userID).Return(user, nil)
+	mockFundingGateway := &mockFundingGateway{}
+	mockFundingGateway.On("ConfirmFunds", ctx, mock.AnythingOfType("*entity.Deposit")).Return(true)
+	mockIdempotencyKeyRepo := &mockIdempotencyKeyRepository{}
+
+	// Capture the key stored by the first attempt
+	var storedKey *entity.IdempotencyKey
+	mockIdempotencyKeyRepo.On("GetIdempotencyKey", ctx, "retry-key").Return(nil, nil).Once()
+	mockDepositRepo := &mockDepositRepository{}
+	mockDepositRepo.On("Deposit", ctx, user.ID(), mock.AnythingOfType(depositFnType)).
+		Run(func(args mock.Arguments) {
+			depositFn := args.Get(2).(func(*entity.User) (*entity.Deposit, error))
+			deposit, err := depositFn(user)
+			require.NoError(t, err)
+			storedKey = deposit.IdempotencyKey()
+		}).
+		Return(nil).Once()
+
+	useCase := usecase.NewCreateDeposit(mockDepositRepo, mockUserRepo, mockIdempotencyKeyRepo, mockFundingGateway, telemetry.NewMockTelemetry())
+	input := usecase.CreateDepositInput{
+		UserID:         userID,
+		Amount:         1000,
+		IdempotencyKey: "retry-key",
+	}
+
+	firstID, err := useCase.Execute(ctx, input)
+	require.NoError(t, err)
+	require.NotNil(t, storedKey)
+	assert.Equal(t, firstID, storedKey.TransactionID())
+
+	mockIdempotencyKeyRepo.On("GetIdempotencyKey", ctx, "retry-key").Return(storedKey, nil).Once()
+
+	// Act
+	secondID, err := useCase.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, firstID, secondID)
+	assert.Equal(t, int64(1000), user.Balance())
+	mockFundingGateway.AssertNumberOfCalls(t, "ConfirmFunds", 1)
+	mockDepositRepo.AssertNumberOfCalls(t, "Deposit", 1)
+}
+
+type mockDepositRepository struct {
+	mock.Mock
+}
+
+func (m *mockDepositRepository) Deposit(ctx context.Context, userID string, depositFn func(user *entity.User) (*entity.Deposit, error)) error {
+	args := m.Called(ctx, userID, depositFn)
+	return args.Error(0)
+}
+
+type mockFundingGateway struct {
+	mock.Mock
+}
+
+func (m *mockFundingGateway) ConfirmFunds(ctx context.Context, deposit *entity.Deposit) bool {
+	args := m.Called(ctx, deposit)
+	return args.Bool(0)
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type EscrowRepository interface {
+	Create(ctx context.Context, senderID, receiverID string, createFn func(sender, receiver *entity.User) (*entity.Escrow, error)) error
+	GetEscrow(ctx context.Context, id string) (*entity.Escrow, error)
+	Ship(ctx context.Context, id string, shipFn func(escrow *entity.Escrow) error) error
+	Settle(ctx context.Context, id string, settleFn func(escrow *entity.Escrow, sender, receiver *entity.User) (*entity.Transaction, error)) error
+	SettleDue(ctx context.Context, now time.Time, limit int, settleFn func(escrow *entity.Escrow, sender, receiver *entity.User) (*entity.Transaction, error)) (int, error)
+}
+
+type CreateEscrow struct {
+	escrowRepository      EscrowRepository
+	transactionAuthorizer TransactionAuthorizerGateway
+	transferLimits        vo.TransferLimitPolicy
+	defaultExpiry         time.Duration
+	otel                  telemetry.Telemetry
+}
+
+type CreateEscrowInput struct {
+	// Amount in cents of Currency
+	Amount int64
+	// Currency of the escrow, the default currency when empty
+	Currency   string
+	SenderID   uuid.UUID
+	ReceiverID uuid.UUID
+	// ExpiresAt is when the money goes back to the sender if the receiver did
+	// not ship, after the default expiry when zero.
+	ExpiresAt time.Time
+}
+
+// Execute moves the amount out of the sender's available balance into escrow
+// for the receiver. The escrow is authorized and limited like a transfer, as
+// releasing it moves the money without asking again.
+func (ce *CreateEscrow) Execute(ctx context.Context, input CreateEscrowInput) (*entity.Escrow, error) {
+	ctx, span := ce.otel.Start(ctx, "CreateEscrow")
+	defer span.End()
+
+	currency := input.Currency
+	if currency == "" {
+		currency = vo.DefaultCurrency
+	}
+	amount, err := vo.NewMoney(input.Amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	expiresAt := input.ExpiresAt
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(ce.defaultExpiry)
+	}
+
+	if !ce.transactionAuthorizer.IsTransactionAllowed(ctx) {
+		return nil, errs.ErrTransactionNotAllowed
+	}
+
+	var escrow *entity.Escrow
+	err = ce.escrowRepository.Create(ctx, input.SenderID.String(), input.ReceiverID.String(), func(sender, receiver *entity.User) (*entity.Escrow, error) {
+		if sender.IsMerchant() {
+			return nil, errs.ErrMerchantCannotSendMoney
+		}
+
+		var err error
+		escrow, err = entity.NewEscrow(sender.ID(), receiver.ID(), amount, expiresAt, now)
+		if err != nil {
+			return nil, err
+		}
+
+		err = sender.CheckTransferLimits(ce.transferLimits, escrow.Currency(), escrow.Amount())
+		if err != nil {
+			return nil, err
+		}
+
+		err = sender.Hold(escrow.Currency(), escrow.Amount())
+		if err != nil {
+			return nil, err
+		}
+
+		escrow.RecordEvent(event.NewEscrowCreatedEventV1(
+			escrow.ID(),
+			input.SenderID,
+			input.ReceiverID,
+			event.Amount{InCents: escrow.Amount(), Currency: escrow.Currency()},
+			escrow.Status(),
+		))
+		return escrow, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return escrow, nil
+}
+
+func NewCreateEscrow(
+	escrowRepository EscrowRepository,
+	transactionAuthorizer TransactionAuthorizerGateway,
+	transferLimits vo.TransferLimitPolicy,
+	defaultExpiry time.Duration,
+	otel telemetry.Telemetry,
+) *CreateEscrow {
+	return &CreateEscrow{
+		escrowRepository:      escrowRepository,
+		transactionAuthorizer: transactionAuthorizer,
+		transferLimits:        transferLimits,
+		defaultExpiry:         defaultExpiry,
+		otel:                  otel,
+	}
+}
@@ -0,0 +1,165 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	createEscrowFnType = "func(*entity.User, *entity.User) (*entity.Escrow, error)"
+	shipEscrowFnType   = "func(*entity.Escrow) error"
+	settleEscrowFnType = "func(*entity.Escrow, *entity.User, *entity.User) (*entity.Transaction, error)"
+)
+
+type mockEscrowRepository struct {
+	mock.Mock
+}
+
+func (m *mockEscrowRepository) Create(ctx context.Context, senderID, receiverID string, createFn func(sender, receiver *entity.User) (*entity.Escrow, error)) error {
+	args := m.Called(ctx, senderID, receiverID, createFn)
+	return args.Error(0)
+}
+
+func (m *mockEscrowRepository) GetEscrow(ctx context.Context, id string) (*entity.Escrow, error) {
+	args := m.Called(ctx, id)
+	escrow, _ := args.Get(0).(*entity.Escrow)
+	return escrow, args.Error(1)
+}
+
+func (m *mockEscrowRepository) Ship(ctx context.Context, id string, shipFn func(escrow *entity.Escrow) error) error {
+	args := m.Called(ctx, id, shipFn)
+	return args.Error(0)
+}
+
+func (m *mockEscrowRepository) Settle(ctx context.Context, id string, settleFn func(escrow *entity.Escrow, sender, receiver *entity.User) (*entity.Transaction, error)) error {
+	args := m.Called(ctx, id, settleFn)
+	return args.Error(0)
+}
+
+func (m *mockEscrowRepository) SettleDue(ctx context.Context, now time.Time, limit int, settleFn func(escrow *entity.Escrow, sender, receiver *entity.User) (*entity.Transaction, error)) (int, error) {
+	args := m.Called(ctx, now, limit, settleFn)
+	return args.Int(0), args.Error(1)
+}
+
+// newHeldEscrow puts amount of the sender in escrow for the receiver, as
+// CreateEscrow does.
+func newHeldEscrow(t *testing.T, sender, receiver *entity.User, amount int64) *entity.Escrow {
+	money, err := vo.NewMoney(amount, vo.BRL)
+	require.NoError(t, err)
+	escrow, err := entity.NewEscrow(sender.ID(), receiver.ID(), money, time.Now().Add(time.Hour), time.Now())
+	require.NoError(t, err)
+	require.NoError(t, sender.Hold(escrow.Currency(), escrow.Amount()))
+	return escrow
+}
+
+func TestCreateEscrow_Execute_ShouldMoveTheAmountOutOfAvailableBalance(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+
+	mockRepo := &mockEscrowRepository{}
+	mockRepo.On("Create", ctx, sender.ID(), receiver.ID(), mock.AnythingOfType(createEscrowFnType)).
+		Run(func(args mock.Arguments) {
+			createFn := args.Get(3).(func(*entity.User, *entity.User) (*entity.Escrow, error))
+			_, err := createFn(sender, receiver)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+
+	useCase := usecase.NewCreateEscrow(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, 72*time.Hour, telemetry.NewMockTelemetry())
+
+	// Act
+	escrow, err := useCase.Execute(ctx, usecase.CreateEscrowInput{
+		Amount:     7000,
+		SenderID:   uuid.MustParse(sender.ID()),
+		ReceiverID: uuid.MustParse(receiver.ID()),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.EscrowHeldStatus, escrow.Status())
+	assert.WithinDuration(t, time.Now().Add(72*time.Hour), escrow.ExpiresAt(), time.Minute)
+	assert.Equal(t, int64(10000), sender.Balance())
+	assert.Equal(t, int64(3000), sender.AvailableBalance())
+	require.Len(t, escrow.Events(), 1)
+	assert.Equal(t, "EscrowCreatedEventV1", escrow.Events()[0].Name())
+}
+
+func TestCreateEscrow_Execute_ShouldReturnErrorWhenAvailableBalanceIsShort(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(5000))
+	receiver := NewUser(vo.MerchantUserType)
+
+	mockRepo := &mockEscrowRepository{}
+	mockRepo.On("Create", ctx, sender.ID(), receiver.ID(), mock.AnythingOfType(createEscrowFnType)).
+		Run(func(args mock.Arguments) {
+			createFn := args.Get(3).(func(*entity.User, *entity.User) (*entity.Escrow, error))
+			_, err := createFn(sender, receiver)
+			assert.ErrorIs(t, err, errs.ErrInsufficientBalance)
+		}).
+		Return(errs.ErrInsufficientBalance)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+
+	useCase := usecase.NewCreateEscrow(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, time.Hour, telemetry.NewMockTelemetry())
+
+	// Act
+	escrow, err := useCase.Execute(ctx, usecase.CreateEscrowInput{
+		Amount:     7000,
+		SenderID:   uuid.MustParse(sender.ID()),
+		ReceiverID: uuid.MustParse(receiver.ID()),
+	})
+
+	// Assert
+	assert.Nil(t, escrow)
+	assert.ErrorIs(t, err, errs.ErrInsufficientBalance)
+}
+
+func TestShipEscrow_Execute_ShouldSetTheReleaseDeadline(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+	escrow := newHeldEscrow(t, sender, receiver, 7000)
+
+	mockRepo := &mockEscrowRepository{}
+	mockRepo.On("Ship", ctx, escrow.ID(), mock.AnythingOfType(shipEscrowFnType)).
+		Run(func(args mock.Arguments) {
+			shipFn := args.Get(2).(func(*entity.Escrow) error)
+			require.NoError(t, shipFn(escrow))
+		}).
+		Return(nil)
+
+	useCase := usecase.NewShipEscrow(mockRepo, 48*time.Hour, telemetry.NewMockTelemetry())
+
+	// Act
+	shipped, err := useCase.Execute(ctx, usecase.ShipEscrowInput{
+		EscrowID:   uuid.MustParse(escrow.ID()),
+		ReceiverID: uuid.MustParse(receiver.ID()),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.EscrowShippedStatus, shipped.Status())
+	assert.WithinDuration(t, time.Now().Add(48*time.Hour), *shipped.ReleaseAt(), time.Minute)
+	require.Len(t, shipped.Events(), 1)
+	assert.Equal(t, "EscrowShippedEventV1", shipped.Events()[0].Name())
+}
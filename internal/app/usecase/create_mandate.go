@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type MandateRepository interface {
+	Save(ctx context.Context, mandate *entity.Mandate) error
+	ListBySender(ctx context.Context, senderID string) ([]*entity.Mandate, error)
+	ListExecutions(ctx context.Context, mandateID string) ([]*entity.MandateExecution, error)
+	Update(ctx context.Context, id string, updateFn func(mandate *entity.Mandate) error) error
+	ProcessDue(ctx context.Context, now time.Time, limit int, processFn func(mandate *entity.Mandate) *entity.MandateExecution) (int, error)
+}
+
+type CreateMandate struct {
+	mandateRepository MandateRepository
+	otel              telemetry.Telemetry
+}
+
+type CreateMandateInput struct {
+	// Amount in cents of Currency transferred on every execution
+	Amount int64
+	// Currency the sender pays in, the default currency when empty
+	Currency string
+	// ReceiverCurrency the receiver is credited in, Currency when empty
+	ReceiverCurrency string
+	SenderID         uuid.UUID
+	ReceiverID       uuid.UUID
+	// Frequency is weekly, monthly or cron
+	Frequency string
+	// Cron is the rule of the cron frequency, e.g. "0 9 * * 1-5"
+	Cron string
+	// StartAt is the first execution date, now when zero
+	StartAt time.Time
+	// EndAt is the date after which the mandate no longer runs, optional
+	EndAt *time.Time
+	// MaxExecutions is how many times the mandate runs, zero being unlimited
+	MaxExecutions int
+}
+
+// Execute creates a recurring transfer mandate. Like scheduled transfers,
+// balances, limits and authorization are checked on every execution.
+func (cm *CreateMandate) Execute(ctx context.Context, input CreateMandateInput) (string, error) {
+	ctx, span := cm.otel.Start(ctx, "CreateMandate")
+	defer span.End()
+
+	currency := input.Currency
+	if currency == "" {
+		currency = vo.DefaultCurrency
+	}
+	amount, err := vo.NewMoney(input.Amount, currency)
+	if err != nil {
+		return "", err
+	}
+	recurrence, err := vo.NewRecurrence(input.Frequency, input.Cron)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	startAt := input.StartAt
+	if startAt.IsZero() {
+		startAt = now
+	}
+
+	mandate, err := entity.NewMandate(
+		input.SenderID.String(),
+		input.ReceiverID.String(),
+		amount,
+		input.ReceiverCurrency,
+		recurrence,
+		startAt,
+		input.EndAt,
+		input.MaxExecutions,
+		now,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	err = cm.mandateRepository.Save(ctx, mandate)
+	if err != nil {
+		return "", err
+	}
+
+	return mandate.ID(), nil
+}
+
+func NewCreateMandate(
+	mandateRepository MandateRepository,
+	otel telemetry.Telemetry,
+) *CreateMandate {
+	return &CreateMandate{
+		mandateRepository: mandateRepository,
+		otel:              otel,
+	}
+}
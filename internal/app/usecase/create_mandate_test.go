@@ -0,0 +1,177 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	updateMandateFnType  = "func(*entity.Mandate) error"
+	processMandateFnType = "func(*entity.Mandate) *entity.MandateExecution"
+)
+
+func newWeeklyMandate(t *testing.T, amount int64) *entity.Mandate {
+	t.Helper()
+	money, err := vo.NewMoney(amount, vo.BRL)
+	require.NoError(t, err)
+	recurrence, err := vo.NewRecurrence(vo.WeeklyFrequency, "")
+	require.NoError(t, err)
+	now := time.Now()
+	mandate, err := entity.NewMandate(uuid.NewString(), uuid.NewString(), money, "", recurrence, now, nil, 0, now)
+	require.NoError(t, err)
+	return mandate
+}
+
+func TestCreateMandate_Execute_ShouldSaveTheMandateStartingNow(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	senderID, receiverID := uuid.New(), uuid.New()
+
+	var captured *entity.Mandate
+	mockRepo := &mockMandateRepository{}
+	mockRepo.On("Save", ctx, mock.AnythingOfType("*entity.Mandate")).
+		Run(func(args mock.Arguments) {
+			captured = args.Get(1).(*entity.Mandate)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewCreateMandate(mockRepo, telemetry.NewMockTelemetry())
+	before := time.Now()
+
+	// Act
+	id, err := useCase.Execute(ctx, usecase.CreateMandateInput{
+		Amount:        2990,
+		SenderID:      senderID,
+		ReceiverID:    receiverID,
+		Frequency:     vo.MonthlyFrequency,
+		MaxExecutions: 12,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, captured.ID(), id)
+	assert.Equal(t, senderID.String(), captured.SenderID())
+	assert.Equal(t, vo.DefaultCurrency, captured.Currency())
+	assert.Equal(t, vo.MonthlyFrequency, captured.Recurrence().Frequency())
+	assert.Equal(t, 12, captured.MaxExecutions())
+	assert.False(t, captured.NextExecutionAt().Before(before))
+	assert.Equal(t, entity.MandateActiveStatus, captured.Status())
+}
+
+func TestCreateMandate_Execute_ShouldReturnErrorWhenCronIsInvalid(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := &mockMandateRepository{}
+
+	useCase := usecase.NewCreateMandate(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	id, err := useCase.Execute(ctx, usecase.CreateMandateInput{
+		Amount:     2990,
+		SenderID:   uuid.New(),
+		ReceiverID: uuid.New(),
+		Frequency:  vo.CronFrequency,
+		Cron:       "every monday",
+	})
+
+	// Assert
+	assert.Empty(t, id)
+	assert.ErrorIs(t, err, errs.ErrInvalidCronExpression)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestChangeMandateStatus_Execute_ShouldPauseTheMandate(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mandate := newWeeklyMandate(t, 2990)
+
+	mockRepo := &mockMandateRepository{}
+	mockRepo.On("Update", ctx, mandate.ID(), mock.AnythingOfType(updateMandateFnType)).
+		Run(func(args mock.Arguments) {
+			updateFn := args.Get(2).(func(*entity.Mandate) error)
+			require.NoError(t, updateFn(mandate))
+		}).
+		Return(nil)
+
+	useCase := usecase.NewChangeMandateStatus(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	status, err := useCase.Execute(ctx, usecase.ChangeMandateStatusInput{
+		MandateID: uuid.MustParse(mandate.ID()),
+		Action:    usecase.PauseMandateAction,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entity.MandatePausedStatus, status)
+}
+
+func TestChangeMandateStatus_Execute_ShouldReturnErrorWhenResumingAnActiveMandate(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mandate := newWeeklyMandate(t, 2990)
+
+	mockRepo := &mockMandateRepository{}
+	mockRepo.On("Update", ctx, mandate.ID(), mock.AnythingOfType(updateMandateFnType)).
+		Return(errs.ErrMandateNotPaused).
+		Run(func(args mock.Arguments) {
+			updateFn := args.Get(2).(func(*entity.Mandate) error)
+			assert.ErrorIs(t, updateFn(mandate), errs.ErrMandateNotPaused)
+		})
+
+	useCase := usecase.NewChangeMandateStatus(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	status, err := useCase.Execute(ctx, usecase.ChangeMandateStatusInput{
+		MandateID: uuid.MustParse(mandate.ID()),
+		Action:    usecase.ResumeMandateAction,
+	})
+
+	// Assert
+	assert.Empty(t, status)
+	assert.ErrorIs(t, err, errs.ErrMandateNotPaused)
+	assert.Equal(t, entity.MandateActiveStatus, mandate.Status())
+}
+
+type mockMandateRepository struct {
+	mock.Mock
+}
+
+func (m *mockMandateRepository) Save(ctx context.Context, mandate *entity.Mandate) error {
+	args := m.Called(ctx, mandate)
+	return args.Error(0)
+}
+
+func (m *mockMandateRepository) ListBySender(ctx context.Context, senderID string) ([]*entity.Mandate, error) {
+	args := m.Called(ctx, senderID)
+	mandates, _ := args.Get(0).([]*entity.Mandate)
+	return mandates, args.Error(1)
+}
+
+func (m *mockMandateRepository) ListExecutions(ctx context.Context, mandateID string) ([]*entity.MandateExecution, error) {
+	args := m.Called(ctx, mandateID)
+	executions, _ := args.Get(0).([]*entity.MandateExecution)
+	return executions, args.Error(1)
+}
+
+func (m *mockMandateRepository) Update(ctx context.Context, id string, updateFn func(mandate *entity.Mandate) error) error {
+	args := m.Called(ctx, id, updateFn)
+	return args.Error(0)
+}
+
+func (m *mockMandateRepository) ProcessDue(ctx context.Context, now time.Time, limit int, processFn func(mandate *entity.Mandate) *entity.MandateExecution) (int, error) {
+	args := m.Called(ctx, now, limit, processFn)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type SplitPaymentRepository interface {
+	Pay(ctx context.Context, splitPayment *entity.SplitPayment, payFn func(sender *entity.User, receivers []*entity.User) ([]*entity.Transaction, error)) error
+	GetSplitPayment(ctx context.Context, id string) (*entity.SplitPayment, error)
+}
+
+type CreateSplitPayment struct {
+	splitPaymentRepository SplitPaymentRepository
+	transactionAuthorizer  TransactionAuthorizerGateway
+	transferLimits         vo.TransferLimitPolicy
+	fees                   vo.FeePolicy
+	otel                   telemetry.Telemetry
+}
+
+type CreateSplitPaymentInput struct {
+	// Amount in cents of Currency the sender pays in total
+	Amount int64
+	// Currency of the payment, the default currency when empty
+	Currency string
+	SenderID uuid.UUID
+	Shares   []CreateSplitPaymentShareInput
+}
+
+// CreateSplitPaymentShareInput is the share of one receiver, either an Amount
+// in cents or a Percentage in basis points of the whole amount.
+type CreateSplitPaymentShareInput struct {
+	ReceiverID uuid.UUID
+	Amount     int64
+	Percentage int64
+}
+
+// Execute transfers every receiver its share, all or nothing, each share
+// charged the fee of its receiver. The payment is authorized and checked
+// against the sender's limits as a single transfer of the whole amount.
+func (cs *CreateSplitPayment) Execute(ctx context.Context, input CreateSplitPaymentInput) (*entity.SplitPayment, error) {
+	ctx, span := cs.otel.Start(ctx, "CreateSplitPayment")
+	defer span.End()
+
+	currency := input.Currency
+	if currency == "" {
+		currency = vo.DefaultCurrency
+	}
+	amount, err := vo.NewMoney(input.Amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	specs := make([]entity.SplitShareSpec, 0, len(input.Shares))
+	for _, share := range input.Shares {
+		specs = append(specs, entity.SplitShareSpec{
+			ReceiverID: share.ReceiverID.String(),
+			Amount:     share.Amount,
+			Percentage: share.Percentage,
+		})
+	}
+	splitPayment, err := entity.NewSplitPayment(input.SenderID.String(), amount, specs, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if !cs.transactionAuthorizer.IsTransactionAllowed(ctx) {
+		return nil, errs.ErrTransactionNotAllowed
+	}
+
+	err = cs.splitPaymentRepository.Pay(ctx, splitPayment, func(sender *entity.User, receivers []*entity.User) ([]*entity.Transaction, error) {
+		// The payment fits the limits as a whole or not at all, so a payment
+		// over them is rejected before any share moves.
+		err := sender.CheckTransferLimits(cs.transferLimits, splitPayment.Currency(), splitPayment.Amount())
+		if err != nil {
+			return nil, err
+		}
+
+		exchangeRate, err := vo.NewIdentityExchangeRate(splitPayment.Currency())
+		if err != nil {
+			return nil, err
+		}
+		transactions := make([]*entity.Transaction, 0, len(receivers))
+		for i, share := range splitPayment.Shares() {
+			shareAmount, err := vo.NewMoney(share.Amount(), splitPayment.Currency())
+			if err != nil {
+				return nil, err
+			}
+			transaction, err := transfer(sender, receivers[i], shareAmount, exchangeRate, cs.transferLimits, cs.fees)
+			if err != nil {
+				return nil, err
+			}
+			share.AttachTransaction(transaction.ID())
+			transactions = append(transactions, transaction)
+		}
+		return transactions, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return splitPayment, nil
+}
+
+func NewCreateSplitPayment(
+	splitPaymentRepository SplitPaymentRepository,
+	transactionAuthorizer TransactionAuthorizerGateway,
+	transferLimits vo.TransferLimitPolicy,
+	fees vo.FeePolicy,
+	otel telemetry.Telemetry,
+) *CreateSplitPayment {
+	return &CreateSplitPayment{
+		splitPaymentRepository: splitPaymentRepository,
+		transactionAuthorizer:  transactionAuthorizer,
+		transferLimits:         transferLimits,
+		fees:                   fees,
+		otel:                   otel,
+	}
+}
@@ -0,0 +1,220 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const splitPayFnType = "func(*entity.User, []*entity.User) ([]*entity.Transaction, error)"
+
+func TestCreateSplitPayment_Execute_ShouldCreditEachReceiverItsShare(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(20000))
+	seller := NewUser(vo.MerchantUserType)
+	platform := NewUser(vo.MerchantUserType)
+
+	var transactions []*entity.Transaction
+	mockRepo := &mockSplitPaymentRepository{}
+	mockRepo.On("Pay", ctx, mock.AnythingOfType("*entity.SplitPayment"), mock.AnythingOfType(splitPayFnType)).
+		Run(func(args mock.Arguments) {
+			payFn := args.Get(2).(func(*entity.User, []*entity.User) ([]*entity.Transaction, error))
+			var err error
+			transactions, err = payFn(sender, []*entity.User{seller, platform})
+			require.NoError(t, err)
+		}).
+		Return(nil)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true).Once()
+
+	useCase := usecase.NewCreateSplitPayment(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	splitPayment, err := useCase.Execute(ctx, usecase.CreateSplitPaymentInput{
+		Amount:   10000,
+		SenderID: uuid.MustParse(sender.ID()),
+		Shares: []usecase.CreateSplitPaymentShareInput{
+			{ReceiverID: uuid.MustParse(seller.ID()), Percentage: 9000},
+			{ReceiverID: uuid.MustParse(platform.ID()), Percentage: 1000},
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(10000), sender.Balance())
+	assert.Equal(t, int64(9000), seller.Balance())
+	assert.Equal(t, int64(1000), platform.Balance())
+	require.Len(t, transactions, 2)
+	assert.Equal(t, transactions[0].ID(), splitPayment.Shares()[0].TransactionID())
+	assert.Equal(t, platform.ID(), transactions[1].ReceiverID())
+	assert.Len(t, transactions[1].Events(), 1)
+}
+
+func TestCreateSplitPayment_Execute_ShouldCheckTransferLimitsAgainstWholeAmount(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(100000))
+	seller := NewUser(vo.MerchantUserType)
+	platform := NewUser(vo.MerchantUserType)
+	commonLimits, err := vo.NewTransferLimits(0, 10000, 0, 0)
+	require.NoError(t, err)
+	policy, err := vo.NewTransferLimitPolicy(map[string]vo.TransferLimits{vo.CommonUserType: *commonLimits})
+	require.NoError(t, err)
+
+	mockRepo := &mockSplitPaymentRepository{}
+	mockRepo.On("Pay", ctx, mock.AnythingOfType("*entity.SplitPayment"), mock.AnythingOfType(splitPayFnType)).
+		Run(func(args mock.Arguments) {
+			payFn := args.Get(2).(func(*entity.User, []*entity.User) ([]*entity.Transaction, error))
+			_, err := payFn(sender, []*entity.User{seller, platform})
+			assert.ErrorIs(t, err, errs.ErrTransferLimitExceeded)
+		}).
+		Return(errs.ErrTransferLimitExceeded)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+
+	useCase := usecase.NewCreateSplitPayment(mockRepo, mockAuthorizer, *policy, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	splitPayment, err := useCase.Execute(ctx, usecase.CreateSplitPaymentInput{
+		Amount:   12000,
+		SenderID: uuid.MustParse(sender.ID()),
+		Shares: []usecase.CreateSplitPaymentShareInput{
+			{ReceiverID: uuid.MustParse(seller.ID()), Amount: 6000},
+			{ReceiverID: uuid.MustParse(platform.ID()), Amount: 6000},
+		},
+	})
+
+	// Assert
+	assert.Nil(t, splitPayment)
+	assert.ErrorIs(t, err, errs.ErrTransferLimitExceeded)
+	assert.Equal(t, int64(100000), sender.Balance())
+}
+
+func TestCreateSplitPayment_Execute_ShouldRejectSharesNotAddingUp(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := &mockSplitPaymentRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	useCase := usecase.NewCreateSplitPayment(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	splitPayment, err := useCase.Execute(ctx, usecase.CreateSplitPaymentInput{
+		Amount:   10000,
+		SenderID: uuid.New(),
+		Shares: []usecase.CreateSplitPaymentShareInput{
+			{ReceiverID: uuid.New(), Amount: 6000},
+			{ReceiverID: uuid.New(), Amount: 3000},
+		},
+	})
+
+	// Assert
+	assert.Nil(t, splitPayment)
+	assert.ErrorIs(t, err, errs.ErrSplitSharesMismatch)
+	mockAuthorizer.AssertNotCalled(t, "IsTransactionAllowed", mock.Anything)
+	mockRepo.AssertNotCalled(t, "Pay", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateSplitPayment_Execute_ShouldRejectMerchantSender(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.MerchantUserType)
+	require.NoError(t, sender.Deposit(20000))
+	firstReceiver := NewUser(vo.CommonUserType)
+	secondReceiver := NewUser(vo.CommonUserType)
+
+	mockRepo := &mockSplitPaymentRepository{}
+	mockRepo.On("Pay", ctx, mock.AnythingOfType("*entity.SplitPayment"), mock.AnythingOfType(splitPayFnType)).
+		Run(func(args mock.Arguments) {
+			payFn := args.Get(2).(func(*entity.User, []*entity.User) ([]*entity.Transaction, error))
+			_, err := payFn(sender, []*entity.User{firstReceiver, secondReceiver})
+			assert.ErrorIs(t, err, errs.ErrMerchantCannotSendMoney)
+		}).
+		Return(errs.ErrMerchantCannotSendMoney)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+	useCase := usecase.NewCreateSplitPayment(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx, usecase.CreateSplitPaymentInput{
+		Amount:   10000,
+		SenderID: uuid.MustParse(sender.ID()),
+		Shares: []usecase.CreateSplitPaymentShareInput{
+			{ReceiverID: uuid.MustParse(firstReceiver.ID()), Percentage: 5000},
+			{ReceiverID: uuid.MustParse(secondReceiver.ID()), Percentage: 5000},
+		},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrMerchantCannotSendMoney)
+	assert.Equal(t, int64(20000), sender.Balance())
+}
+
+type mockSplitPaymentRepository struct {
+	mock.Mock
+}
+
+func (m *mockSplitPaymentRepository) Pay(ctx context.Context, splitPayment *entity.SplitPayment, payFn func(sender *entity.User, receivers []*entity.User) ([]*entity.Transaction, error)) error {
+	args := m.Called(ctx, splitPayment, payFn)
+	return args.Error(0)
+}
+
+func (m *mockSplitPaymentRepository) GetSplitPayment(ctx context.Context, id string) (*entity.SplitPayment, error) {
+	args := m.Called(ctx, id)
+	splitPayment, _ := args.Get(0).(*entity.SplitPayment)
+	return splitPayment, args.Error(1)
+}
+
+func TestCreateSplitPayment_Execute_ShouldChargeTheFeeOfEachReceiver(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(20000))
+	seller := NewUser(vo.MerchantUserType)
+	friend := NewUser(vo.CommonUserType)
+
+	var transactions []*entity.Transaction
+	mockRepo := &mockSplitPaymentRepository{}
+	mockRepo.On("Pay", ctx, mock.AnythingOfType("*entity.SplitPayment"), mock.AnythingOfType(splitPayFnType)).
+		Run(func(args mock.Arguments) {
+			payFn := args.Get(2).(func(*entity.User, []*entity.User) ([]*entity.Transaction, error))
+			var err error
+			transactions, err = payFn(sender, []*entity.User{seller, friend})
+			require.NoError(t, err)
+		}).
+		Return(nil)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+
+	useCase := usecase.NewCreateSplitPayment(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, merchantFees(t), telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx, usecase.CreateSplitPaymentInput{
+		Amount:   12000,
+		SenderID: uuid.MustParse(sender.ID()),
+		Shares: []usecase.CreateSplitPaymentShareInput{
+			{ReceiverID: uuid.MustParse(seller.ID()), Amount: 10000},
+			{ReceiverID: uuid.MustParse(friend.ID()), Amount: 2000},
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	assert.Equal(t, int64(199), platformCredit(t, transactions[0]))
+	assert.Equal(t, int64(0), platformCredit(t, transactions[1]))
+	assert.Equal(t, int64(8000), sender.Balance())
+	assert.Equal(t, int64(9801), seller.Balance())
+	assert.Equal(t, int64(2000), friend.Balance())
+}
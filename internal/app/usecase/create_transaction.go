@@ -2,9 +2,15 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
 	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
 	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
 	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
 	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
 	"github.com/google/uuid"
 )
@@ -18,61 +24,118 @@ type TransactionAuthorizerGateway interface {
 	IsTransactionAllowed(ctx context.Context) bool
 }
 
-type Queue interface {
-	Send(ctx context.Context, message []byte) error
+// FXRateProvider quotes the rate converting one currency into another.
+type FXRateProvider interface {
+	GetRate(ctx context.Context, from, to string) (*vo.ExchangeRate, error)
+}
+
+type IdempotencyKeyRepository interface {
+	GetIdempotencyKey(ctx context.Context, key string) (*entity.IdempotencyKey, error)
 }
 
 type CreateTransaction struct {
-	userRepository        UserRepository
-	transactionAuthorizer TransactionAuthorizerGateway
-	queue                 Queue
-	otel                  telemetry.Telemetry
+	userRepository           UserRepository
+	idempotencyKeyRepository IdempotencyKeyRepository
+	transactionAuthorizer    TransactionAuthorizerGateway
+	fxRateProvider           FXRateProvider
+	transferLimits           vo.TransferLimitPolicy
+	fees                     vo.FeePolicy
+	otel                     telemetry.Telemetry
 }
 type CreateTransactionInput struct {
-	Amount     float64
-	SenderID   uuid.UUID
-	ReceiverID uuid.UUID
+	// Amount in cents of Currency
+	Amount int64
+	// Currency the sender pays in, the default currency when empty
+	Currency string
+	// ReceiverCurrency the receiver is credited in, Currency when empty. The
+	// amount is converted when both differ.
+	ReceiverCurrency string
+	SenderID         uuid.UUID
+	ReceiverID       uuid.UUID
+	// IdempotencyKey is optional. Retries carrying the same key and the same
+	// request return the transaction created by the first attempt.
+	IdempotencyKey string
+}
+
+// requestHash fingerprints the request so a reused idempotency key can be
+// told apart from a retry.
+func (i CreateTransactionInput) requestHash() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d|%s|%s|%s|%s", i.Amount, i.currency(), i.receiverCurrency(), i.SenderID, i.ReceiverID)))
+	return hex.EncodeToString(sum[:])
+}
+
+func (i CreateTransactionInput) currency() string {
+	if i.Currency == "" {
+		return vo.DefaultCurrency
+	}
+	return i.Currency
+}
+
+func (i CreateTransactionInput) receiverCurrency() string {
+	if i.ReceiverCurrency == "" {
+		return i.currency()
+	}
+	return i.ReceiverCurrency
 }
 
 func (c *CreateTransaction) Execute(ctx context.Context, input CreateTransactionInput) (string, error) {
 	ctx, span := c.otel.Start(ctx, "CreateTransaction")
 	defer span.End()
-	if !c.transactionAuthorizer.IsTransactionAllowed(ctx) {
-		return "", errs.ErrTransactionNotAllowed
-	}
 
-	var transactionID string
-
-	err := c.userRepository.UpdateBalance(ctx, input.SenderID.String(), input.ReceiverID.String(), func(sender, receiver *entity.User) (*entity.Transaction, error) {
-		if sender.IsMerchant() {
-			return nil, errs.ErrMerchantCannotSendMoney
-		}
+	if input.SenderID == input.ReceiverID {
+		return "", errs.ErrTransactionInvalidSender
+	}
 
-		err := sender.Withdraw(input.Amount)
+	var idempotencyKey *entity.IdempotencyKey
+	if input.IdempotencyKey != "" {
+		var err error
+		idempotencyKey, err = entity.NewIdempotencyKey(input.IdempotencyKey, input.requestHash())
 		if err != nil {
-			return nil, err
+			return "", err
 		}
-
-		err = receiver.Deposit(input.Amount)
-		if err != nil {
-			return nil, err
+		transactionID, replayed, err := replay(ctx, c.idempotencyKeyRepository, idempotencyKey)
+		if err != nil || replayed {
+			return transactionID, err
 		}
+	}
 
-		transaction, err := entity.NewTransaction(input.Amount, sender.ID(), receiver.ID())
-		if err != nil {
-			return nil, err
-		}
+	amount, err := vo.NewMoney(input.Amount, input.currency())
+	if err != nil {
+		return "", err
+	}
+	if amount.Value() <= 0 {
+		return "", errs.ErrZeroOrNegativeAmount
+	}
+	exchangeRate, err := c.exchangeRate(ctx, amount.Currency(), input.receiverCurrency())
+	if err != nil {
+		return "", err
+	}
 
-		transactionID = transaction.ID()
+	if !c.transactionAuthorizer.IsTransactionAllowed(ctx) {
+		return "", errs.ErrTransactionNotAllowed
+	}
+
+	var transactionID string
 
-		eventTransaction := event.NewCreateTransactionEventV1(transactionID, input.Amount, input.SenderID, input.ReceiverID)
-		err = c.queue.Send(ctx, eventTransaction.ToJSON())
+	err = c.userRepository.UpdateBalance(ctx, input.SenderID.String(), input.ReceiverID.String(), func(sender, receiver *entity.User) (*entity.Transaction, error) {
+		transaction, err := transfer(sender, receiver, amount, exchangeRate, c.transferLimits, c.fees)
 		if err != nil {
 			return nil, err
 		}
 
+		transactionID = transaction.ID()
+		if idempotencyKey != nil {
+			transaction.AttachIdempotencyKey(idempotencyKey)
+		}
 		return transaction, nil
 	})
+	if errors.Is(err, errs.ErrIdempotencyKeyConflict) {
+		// A concurrent request with the same key won the race
+		transactionID, replayed, replayErr := replay(ctx, c.idempotencyKeyRepository, idempotencyKey)
+		if replayErr != nil || replayed {
+			return transactionID, replayErr
+		}
+	}
 	if err != nil {
 		return "", err
 	}
@@ -80,16 +143,114 @@ func (c *CreateTransaction) Execute(ctx context.Context, input CreateTransaction
 	return transactionID, err
 }
 
+// transfer moves amount from the sender to the receiver under the rules of a
+// transfer: users cannot send money to themselves, merchants cannot send
+// money and the sender must fit its limits and balance. The repository loads
+// what the sender already sent while holding the sender's lock, so concurrent
+// transfers cannot both fit the limits, and the transfer is added to it so the
+// following transfers of the same unit of work are checked against it too.
+// Every flow sending money between users goes through transfer, so they all
+// apply the same rules.
+func transfer(sender, receiver *entity.User, amount *vo.Money, exchangeRate *vo.ExchangeRate, transferLimits vo.TransferLimitPolicy, fees vo.FeePolicy) (*entity.Transaction, error) {
+	if sender.ID() == receiver.ID() {
+		return nil, errs.ErrTransactionInvalidSender
+	}
+	if sender.IsMerchant() {
+		return nil, errs.ErrMerchantCannotSendMoney
+	}
+
+	err := sender.CheckTransferLimits(transferLimits, amount.Currency(), amount.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := postTransfer(sender, receiver, amount, exchangeRate, fees)
+	if err != nil {
+		return nil, err
+	}
+	sender.RecordTransferUsage(transaction.Currency(), transaction.Amount())
+	return transaction, nil
+}
+
+// postTransfer moves amount from the sender to the receiver, with no other
+// check than the sender's balance. The fee of the receiver is taken from what
+// it gets and goes to the platform account. Flows releasing money reserved
+// under the rules of a transfer, like captured holds and released escrows,
+// post it directly.
+func postTransfer(sender, receiver *entity.User, amount *vo.Money, exchangeRate *vo.ExchangeRate, fees vo.FeePolicy) (*entity.Transaction, error) {
+	transaction, err := entity.NewConvertedTransaction(amount, exchangeRate, sender.ID(), receiver.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	err = transaction.ChargeFee(receiver.FeeSchedule(fees))
+	if err != nil {
+		return nil, err
+	}
+
+	err = sender.WithdrawIn(transaction.Currency(), transaction.Amount())
+	if err != nil {
+		return nil, err
+	}
+
+	err = receiver.DepositIn(transaction.ReceivedCurrency(), transaction.NetReceivedAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	transaction.RecordEvent(event.NewCreateTransactionEventV1(
+		transaction.ID(),
+		event.Amount{InCents: transaction.Amount(), Currency: transaction.Currency()},
+		event.Amount{InCents: transaction.ReceivedAmount(), Currency: transaction.ReceivedCurrency()},
+		event.Amount{InCents: transaction.Fee(), Currency: transaction.ReceivedCurrency()},
+		transaction.ExchangeRate().Rate(),
+		uuid.MustParse(sender.ID()),
+		uuid.MustParse(receiver.ID()),
+	))
+	return transaction, nil
+}
+
+// exchangeRate quotes the rate of the transfer, skipping the provider when no
+// conversion is needed.
+func (c *CreateTransaction) exchangeRate(ctx context.Context, from, to string) (*vo.ExchangeRate, error) {
+	if from == to {
+		return vo.NewIdentityExchangeRate(from)
+	}
+	return c.fxRateProvider.GetRate(ctx, from, to)
+}
+
+// replay looks up a previous use of the idempotency key, returning the id of
+// what it created, a transaction or a deposit, when the request matches.
+func replay(ctx context.Context, idempotencyKeyRepository IdempotencyKeyRepository, idempotencyKey *entity.IdempotencyKey) (string, bool, error) {
+	existing, err := idempotencyKeyRepository.GetIdempotencyKey(ctx, idempotencyKey.Key())
+	if err != nil {
+		return "", false, err
+	}
+	if existing == nil {
+		return "", false, nil
+	}
+	if !existing.Matches(idempotencyKey.RequestHash()) {
+		return "", false, errs.ErrIdempotencyKeyReused
+	}
+	return existing.TransactionID(), true, nil
+}
+
 func NewCreateTransaction(
 	userRepository UserRepository,
+	idempotencyKeyRepository IdempotencyKeyRepository,
 	transactionAuthorizer TransactionAuthorizerGateway,
-	queue Queue,
+	fxRateProvider FXRateProvider,
+	transferLimits vo.TransferLimitPolicy,
+	fees vo.FeePolicy,
 	otel telemetry.Telemetry,
 ) *CreateTransaction {
 	return &CreateTransaction{
-		userRepository:        userRepository,
-		transactionAuthorizer: transactionAuthorizer,
-		queue:                 queue,
-		otel:                  otel,
+		userRepository:           userRepository,
+		idempotencyKeyRepository: idempotencyKeyRepository,
+		transactionAuthorizer:    transactionAuthorizer,
+		fxRateProvider:           fxRateProvider,
+		transferLimits:           transferLimits,
+		fees:                     fees,
+		otel:                     otel,
 	}
 }
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type TransactionBatchRepository interface {
+	Execute(ctx context.Context, batch *entity.TransactionBatch, transferFn func(item *entity.TransactionBatchItem, sender, receiver *entity.User) (*entity.Transaction, error)) error
+	GetTransactionBatch(ctx context.Context, id string) (*entity.TransactionBatch, error)
+}
+
+type CreateTransactionBatch struct {
+	transactionBatchRepository TransactionBatchRepository
+	transactionAuthorizer      TransactionAuthorizerGateway
+	transferLimits             vo.TransferLimitPolicy
+	fees                       vo.FeePolicy
+	maxItems                   int
+	otel                       telemetry.Telemetry
+}
+
+type CreateTransactionBatchInput struct {
+	// Atomic runs the batch all-or-nothing: when any item fails, none is
+	// executed.
+	Atomic bool
+	Items  []CreateTransactionBatchItemInput
+}
+
+type CreateTransactionBatchItemInput struct {
+	// Amount in cents of Currency
+	Amount int64
+	// Currency of the transfer, the default currency when empty
+	Currency   string
+	SenderID   uuid.UUID
+	ReceiverID uuid.UUID
+}
+
+// Execute runs every transfer of the batch in a single database transaction
+// and returns the batch with the outcome of each item. The authorizer is asked
+// once for the whole batch, while each item is a single transfer, checked
+// against the limits of its sender and charged the fee of its receiver.
+func (cb *CreateTransactionBatch) Execute(ctx context.Context, input CreateTransactionBatchInput) (*entity.TransactionBatch, error) {
+	ctx, span := cb.otel.Start(ctx, "CreateTransactionBatch")
+	defer span.End()
+
+	if len(input.Items) == 0 {
+		return nil, errs.ErrTransactionBatchEmpty
+	}
+	if len(input.Items) > cb.maxItems {
+		return nil, fmt.Errorf("%w, the maximum is %d", errs.ErrTransactionBatchTooLarge, cb.maxItems)
+	}
+
+	batch := entity.NewTransactionBatch(input.Atomic, time.Now())
+	for i, itemInput := range input.Items {
+		currency := itemInput.Currency
+		if currency == "" {
+			currency = vo.DefaultCurrency
+		}
+		amount, err := vo.NewMoney(itemInput.Amount, currency)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i+1, err)
+		}
+		_, err = batch.AddItem(itemInput.SenderID.String(), itemInput.ReceiverID.String(), amount)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i+1, err)
+		}
+	}
+
+	if !cb.transactionAuthorizer.IsTransactionAllowed(ctx) {
+		return nil, errs.ErrTransactionNotAllowed
+	}
+
+	err := cb.transactionBatchRepository.Execute(ctx, batch, func(item *entity.TransactionBatchItem, sender, receiver *entity.User) (*entity.Transaction, error) {
+		exchangeRate, err := vo.NewIdentityExchangeRate(item.Currency())
+		if err != nil {
+			return nil, err
+		}
+		return transfer(sender, receiver, item.Money(), exchangeRate, cb.transferLimits, cb.fees)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+func NewCreateTransactionBatch(
+	transactionBatchRepository TransactionBatchRepository,
+	transactionAuthorizer TransactionAuthorizerGateway,
+	transferLimits vo.TransferLimitPolicy,
+	fees vo.FeePolicy,
+	maxItems int,
+	otel telemetry.Telemetry,
+) *CreateTransactionBatch {
+	return &CreateTransactionBatch{
+		transactionBatchRepository: transactionBatchRepository,
+		transactionAuthorizer:      transactionAuthorizer,
+		transferLimits:             transferLimits,
+		fees:                       fees,
+		maxItems:                   maxItems,
+		otel:                       otel,
+	}
+}
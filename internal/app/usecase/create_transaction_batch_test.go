@@ -0,0 +1,199 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const batchTransferFnType = "func(*entity.TransactionBatchItem, *entity.User, *entity.User) (*entity.Transaction, error)"
+
+func TestCreateTransactionBatch_Execute_ShouldRunEachItemOnItsOwn(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	firstReceiver := NewUser(vo.CommonUserType)
+	secondReceiver := NewUser(vo.CommonUserType)
+	users := map[string]*entity.User{sender.ID(): sender, firstReceiver.ID(): firstReceiver, secondReceiver.ID(): secondReceiver}
+
+	mockRepo := &mockTransactionBatchRepository{}
+	mockRepo.On("Execute", ctx, mock.AnythingOfType("*entity.TransactionBatch"), mock.AnythingOfType(batchTransferFnType)).
+		Run(func(args mock.Arguments) {
+			batch := args.Get(1).(*entity.TransactionBatch)
+			transferFn := args.Get(2).(func(*entity.TransactionBatchItem, *entity.User, *entity.User) (*entity.Transaction, error))
+			for _, item := range batch.Items() {
+				transaction, err := transferFn(item, users[item.SenderID()], users[item.ReceiverID()])
+				if err != nil {
+					item.Fail(err.Error())
+					continue
+				}
+				item.Succeed(transaction.ID())
+			}
+		}).
+		Return(nil)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true).Once()
+
+	useCase := usecase.NewCreateTransactionBatch(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, vo.FeePolicy{}, 10, telemetry.NewMockTelemetry())
+
+	// Act
+	batch, err := useCase.Execute(ctx, usecase.CreateTransactionBatchInput{
+		Items: []usecase.CreateTransactionBatchItemInput{
+			{Amount: 6000, SenderID: uuid.MustParse(sender.ID()), ReceiverID: uuid.MustParse(firstReceiver.ID())},
+			{Amount: 6000, SenderID: uuid.MustParse(sender.ID()), ReceiverID: uuid.MustParse(secondReceiver.ID())},
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	items := batch.Items()
+	require.Len(t, items, 2)
+	assert.Equal(t, entity.TransactionBatchItemSucceededStatus, items[0].Status())
+	assert.NotEmpty(t, items[0].TransactionID())
+	assert.Equal(t, entity.TransactionBatchItemFailedStatus, items[1].Status())
+	assert.Equal(t, errs.ErrInsufficientBalance.Error(), items[1].FailureReason())
+	assert.Equal(t, int64(4000), sender.Balance())
+	assert.Equal(t, int64(6000), firstReceiver.Balance())
+	mockAuthorizer.AssertNumberOfCalls(t, "IsTransactionAllowed", 1)
+}
+
+func TestCreateTransactionBatch_Execute_ShouldCountEarlierItemsTowardsTransferLimits(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(100000))
+	receiver := NewUser(vo.CommonUserType)
+	commonLimits, err := vo.NewTransferLimits(0, 10000, 0, 0)
+	require.NoError(t, err)
+	policy, err := vo.NewTransferLimitPolicy(map[string]vo.TransferLimits{vo.CommonUserType: *commonLimits})
+	require.NoError(t, err)
+
+	var secondErr error
+	mockRepo := &mockTransactionBatchRepository{}
+	mockRepo.On("Execute", ctx, mock.AnythingOfType("*entity.TransactionBatch"), mock.AnythingOfType(batchTransferFnType)).
+		Run(func(args mock.Arguments) {
+			batch := args.Get(1).(*entity.TransactionBatch)
+			transferFn := args.Get(2).(func(*entity.TransactionBatchItem, *entity.User, *entity.User) (*entity.Transaction, error))
+			_, err := transferFn(batch.Items()[0], sender, receiver)
+			require.NoError(t, err)
+			_, secondErr = transferFn(batch.Items()[1], sender, receiver)
+		}).
+		Return(nil)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+
+	useCase := usecase.NewCreateTransactionBatch(mockRepo, mockAuthorizer, *policy, vo.FeePolicy{}, 10, telemetry.NewMockTelemetry())
+	item := usecase.CreateTransactionBatchItemInput{Amount: 6000, SenderID: uuid.MustParse(sender.ID()), ReceiverID: uuid.MustParse(receiver.ID())}
+
+	// Act
+	_, err = useCase.Execute(ctx, usecase.CreateTransactionBatchInput{
+		Items: []usecase.CreateTransactionBatchItemInput{item, item},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.ErrorIs(t, secondErr, errs.ErrTransferLimitExceeded)
+	assert.Equal(t, int64(94000), sender.Balance())
+}
+
+func TestCreateTransactionBatch_Execute_ShouldRejectBatchesOverTheMaximumSize(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := &mockTransactionBatchRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	useCase := usecase.NewCreateTransactionBatch(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, vo.FeePolicy{}, 1, telemetry.NewMockTelemetry())
+	item := usecase.CreateTransactionBatchItemInput{Amount: 100, SenderID: uuid.New(), ReceiverID: uuid.New()}
+
+	// Act
+	batch, err := useCase.Execute(ctx, usecase.CreateTransactionBatchInput{
+		Items: []usecase.CreateTransactionBatchItemInput{item, item},
+	})
+
+	// Assert
+	assert.Nil(t, batch)
+	assert.ErrorIs(t, err, errs.ErrTransactionBatchTooLarge)
+	mockAuthorizer.AssertNotCalled(t, "IsTransactionAllowed", mock.Anything)
+	mockRepo.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateTransactionBatch_Execute_ShouldReturnErrorWhenNotAllowedByAuthorizer(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := &mockTransactionBatchRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(false)
+	useCase := usecase.NewCreateTransactionBatch(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, vo.FeePolicy{}, 10, telemetry.NewMockTelemetry())
+
+	// Act
+	batch, err := useCase.Execute(ctx, usecase.CreateTransactionBatchInput{
+		Items: []usecase.CreateTransactionBatchItemInput{{Amount: 100, SenderID: uuid.New(), ReceiverID: uuid.New()}},
+	})
+
+	// Assert
+	assert.Nil(t, batch)
+	assert.ErrorIs(t, err, errs.ErrTransactionNotAllowed)
+	mockRepo.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+}
+
+type mockTransactionBatchRepository struct {
+	mock.Mock
+}
+
+func (m *mockTransactionBatchRepository) Execute(ctx context.Context, batch *entity.TransactionBatch, transferFn func(item *entity.TransactionBatchItem, sender, receiver *entity.User) (*entity.Transaction, error)) error {
+	args := m.Called(ctx, batch, transferFn)
+	return args.Error(0)
+}
+
+func (m *mockTransactionBatchRepository) GetTransactionBatch(ctx context.Context, id string) (*entity.TransactionBatch, error) {
+	args := m.Called(ctx, id)
+	batch, _ := args.Get(0).(*entity.TransactionBatch)
+	return batch, args.Error(1)
+}
+
+func TestCreateTransactionBatch_Execute_ShouldChargeTheFeeOfEachReceiver(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(20000))
+	merchant := NewUser(vo.MerchantUserType)
+
+	var transaction *entity.Transaction
+	mockRepo := &mockTransactionBatchRepository{}
+	mockRepo.On("Execute", ctx, mock.AnythingOfType("*entity.TransactionBatch"), mock.AnythingOfType(batchTransferFnType)).
+		Run(func(args mock.Arguments) {
+			batch := args.Get(1).(*entity.TransactionBatch)
+			transferFn := args.Get(2).(func(*entity.TransactionBatchItem, *entity.User, *entity.User) (*entity.Transaction, error))
+			var err error
+			transaction, err = transferFn(batch.Items()[0], sender, merchant)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+
+	useCase := usecase.NewCreateTransactionBatch(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, merchantFees(t), 10, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx, usecase.CreateTransactionBatchInput{
+		Items: []usecase.CreateTransactionBatchItemInput{
+			{Amount: 10000, SenderID: uuid.MustParse(sender.ID()), ReceiverID: uuid.MustParse(merchant.ID())},
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(199), transaction.Fee())
+	assert.Equal(t, int64(199), platformCredit(t, transaction))
+	assert.Equal(t, int64(10000), sender.Balance())
+	assert.Equal(t, int64(9801), merchant.Balance())
+}
@@ -4,16 +4,19 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
 	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
 	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
 	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
 	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
 	"github.com/go-faker/faker/v4"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateTransaction_Execute_ShouldReturnErrorWhenTransactionIsNotAllowedByAuthorizer(t *testing.T) {
@@ -21,17 +24,16 @@ func TestCreateTransaction_Execute_ShouldReturnErrorWhenTransactionIsNotAllowedB
 	ctx := context.Background()
 	mockUserRepo := &mockUserRepository{}
 	mockAuthorizer := &mockTransactionAuthorizerGateway{}
-	mockQueue := &mockQueue{}
 	mockTelemetry := telemetry.NewMockTelemetry()
 
 	senderID := uuid.New()
 	receiverID := uuid.New()
-	amount := 100.0
+	amount := int64(10000)
 
 	// Setup mock to deny transaction authorization
 	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(false)
 
-	useCase := usecase.NewCreateTransaction(mockUserRepo, mockAuthorizer, mockQueue, mockTelemetry)
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
 
 	input := usecase.CreateTransactionInput{
 		Amount:     amount,
@@ -54,13 +56,13 @@ func TestCreateTransaction_Execute_ShouldSuccessfullyUpdateBalanceAndCreateTrans
 	ctx := context.Background()
 	mockUserRepo := &mockUserRepository{}
 	mockAuthorizer := &mockTransactionAuthorizerGateway{}
-	mockQueue := &mockQueue{}
 	mockTelemetry := telemetry.NewMockTelemetry()
 
 	senderID := uuid.New()
 	receiverID := uuid.New()
-	amount := 100.0
+	amount := int64(10000)
 	expectedTransactionID := "transaction-id"
+	var recordedEvents []event.Event
 
 	// Mock users
 	sender := NewUser(vo.CommonUserType)
@@ -78,12 +80,12 @@ func TestCreateTransaction_Execute_ShouldSuccessfullyUpdateBalanceAndCreateTrans
 			// Set the transaction ID for verification
 			if transaction != nil {
 				expectedTransactionID = transaction.ID()
+				recordedEvents = transaction.Events()
 			}
 		}).
 		Return(nil)
-	mockQueue.On("Send", ctx, mock.AnythingOfType("[]uint8")).Return(nil)
 
-	useCase := usecase.NewCreateTransaction(mockUserRepo, mockAuthorizer, mockQueue, mockTelemetry)
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
 
 	input := usecase.CreateTransactionInput{
 		Amount:     amount,
@@ -99,7 +101,9 @@ func TestCreateTransaction_Execute_ShouldSuccessfullyUpdateBalanceAndCreateTrans
 	assert.NoError(t, err)
 	mockAuthorizer.AssertCalled(t, "IsTransactionAllowed", ctx)
 	mockUserRepo.AssertCalled(t, "UpdateBalance", ctx, senderID.String(), receiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)"))
-	mockQueue.AssertCalled(t, "Send", ctx, mock.AnythingOfType("[]uint8"))
+	if assert.Len(t, recordedEvents, 1) {
+		assert.Equal(t, "CreateTransactionEventV1", recordedEvents[0].Name())
+	}
 }
 
 func TestCreateTransaction_Execute_ShouldReturnErrorWhenSenderIsAMerchant(t *testing.T) {
@@ -107,17 +111,16 @@ func TestCreateTransaction_Execute_ShouldReturnErrorWhenSenderIsAMerchant(t *tes
 	ctx := context.Background()
 	mockUserRepo := &mockUserRepository{}
 	mockAuthorizer := &mockTransactionAuthorizerGateway{}
-	mockQueue := &mockQueue{}
 	mockTelemetry := telemetry.NewMockTelemetry()
 
 	senderID := uuid.New()
 	receiverID := uuid.New()
-	amount := 100.0
+	amount := int64(10000)
 
 	// Mock users with insufficient balance
 	sender := NewUser(vo.MerchantUserType)
 	// Note: Not depositing enough money (only 50)
-	err := sender.Deposit(50.0)
+	err := sender.Deposit(5000)
 	assert.NoError(t, err)
 	receiver := NewUser(vo.CommonUserType)
 
@@ -133,7 +136,7 @@ func TestCreateTransaction_Execute_ShouldReturnErrorWhenSenderIsAMerchant(t *tes
 		}).
 		Return(errs.ErrMerchantCannotSendMoney)
 
-	useCase := usecase.NewCreateTransaction(mockUserRepo, mockAuthorizer, mockQueue, mockTelemetry)
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
 
 	input := usecase.CreateTransactionInput{
 		Amount:     amount,
@@ -151,22 +154,94 @@ func TestCreateTransaction_Execute_ShouldReturnErrorWhenSenderIsAMerchant(t *tes
 	mockUserRepo.AssertCalled(t, "UpdateBalance", ctx, senderID.String(), receiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)"))
 }
 
+func TestCreateTransaction_Execute_ShouldReturnErrorWhenSenderIsTheReceiver(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockUserRepo := &mockUserRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockFXRateProvider := &mockFXRateProvider{}
+	userID := uuid.New()
+
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, mockFXRateProvider, vo.TransferLimitPolicy{}, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	result, err := useCase.Execute(ctx, usecase.CreateTransactionInput{
+		Amount:     5000,
+		SenderID:   userID,
+		ReceiverID: userID,
+	})
+
+	// Assert
+	assert.Equal(t, "", result)
+	assert.ErrorIs(t, err, errs.ErrTransactionInvalidSender)
+	mockFXRateProvider.AssertNotCalled(t, "GetRate")
+	mockAuthorizer.AssertNotCalled(t, "IsTransactionAllowed")
+	mockUserRepo.AssertNotCalled(t, "UpdateBalance")
+}
+
+func TestCreateTransaction_Execute_ShouldReturnRemainingAllowanceWhenTransferLimitIsExceeded(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockUserRepo := &mockUserRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+
+	senderID := uuid.New()
+	receiverID := uuid.New()
+
+	sender := NewUser(vo.CommonUserType)
+	err := sender.Deposit(100000)
+	assert.NoError(t, err)
+	// Already sent 800.00 today
+	sender.RestoreTransferUsage(vo.BRL, vo.TransferUsage{Daily: 80000, Weekly: 80000, Monthly: 80000})
+	receiver := NewUser(vo.CommonUserType)
+
+	commonLimits, err := vo.NewTransferLimits(0, 100000, 0, 0)
+	assert.NoError(t, err)
+	transferLimits, err := vo.NewTransferLimitPolicy(map[string]vo.TransferLimits{vo.CommonUserType: *commonLimits})
+	assert.NoError(t, err)
+
+	expectedErr := &errs.TransferLimitExceededError{Period: vo.DailyLimitPeriod, Limit: 100000, Remaining: 20000}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+	mockUserRepo.On("UpdateBalance", ctx, senderID.String(), receiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)")).
+		Run(func(args mock.Arguments) {
+			updateFn := args.Get(3).(func(*entity.User, *entity.User) (*entity.Transaction, error))
+			// 300.00 is above the 200.00 left for today
+			_, err := updateFn(sender, receiver)
+			assert.Equal(t, expectedErr, err)
+		}).
+		Return(expectedErr)
+
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, &mockFXRateProvider{}, *transferLimits, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	result, err := useCase.Execute(ctx, usecase.CreateTransactionInput{
+		Amount:     30000,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+	})
+
+	// Assert
+	assert.Equal(t, "", result)
+	assert.ErrorIs(t, err, errs.ErrTransferLimitExceeded)
+	assert.Equal(t, int64(100000), sender.Balance())
+	assert.Equal(t, int64(0), receiver.Balance())
+}
+
 func TestCreateTransaction_Execute_ShouldReturnErrorWhenSenderHasInsufficientFunds(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockUserRepo := &mockUserRepository{}
 	mockAuthorizer := &mockTransactionAuthorizerGateway{}
-	mockQueue := &mockQueue{}
 	mockTelemetry := telemetry.NewMockTelemetry()
 
 	senderID := uuid.New()
 	receiverID := uuid.New()
-	amount := 100.0
+	amount := int64(10000)
 
 	// Mock users with insufficient balance
 	sender := NewUser(vo.CommonUserType)
 	// Note: Not depositing enough money (only 50)
-	err := sender.Deposit(50.0)
+	err := sender.Deposit(5000)
 	assert.NoError(t, err)
 	receiver := NewUser(vo.CommonUserType)
 
@@ -181,7 +256,7 @@ func TestCreateTransaction_Execute_ShouldReturnErrorWhenSenderHasInsufficientFun
 		}).
 		Return(errs.ErrNotEnoughMoney)
 
-	useCase := usecase.NewCreateTransaction(mockUserRepo, mockAuthorizer, mockQueue, mockTelemetry)
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
 
 	input := usecase.CreateTransactionInput{
 		Amount:     amount,
@@ -204,10 +279,9 @@ func TestCreateTransaction_Execute_ShouldCreateTransactionWithCorrectAmountAndID
 	ctx := context.Background()
 	mockUserRepo := &mockUserRepository{}
 	mockAuthorizer := &mockTransactionAuthorizerGateway{}
-	mockQueue := &mockQueue{}
 	mockTelemetry := telemetry.NewMockTelemetry()
 
-	amount := 150.0
+	amount := int64(15000)
 
 	var capturedTransaction *entity.Transaction
 
@@ -230,9 +304,8 @@ func TestCreateTransaction_Execute_ShouldCreateTransactionWithCorrectAmountAndID
 			capturedTransaction = transaction
 		}).
 		Return(nil)
-	mockQueue.On("Send", ctx, mock.AnythingOfType("[]uint8")).Return(nil)
 
-	useCase := usecase.NewCreateTransaction(mockUserRepo, mockAuthorizer, mockQueue, mockTelemetry)
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
 
 	input := usecase.CreateTransactionInput{
 		Amount:     amount,
@@ -247,7 +320,7 @@ func TestCreateTransaction_Execute_ShouldCreateTransactionWithCorrectAmountAndID
 	assert.NoError(t, err)
 	assert.NotEmpty(t, result)
 	assert.NotNil(t, capturedTransaction)
-	assert.Equal(t, int64(amount*100), capturedTransaction.Amount())
+	assert.Equal(t, amount, capturedTransaction.Amount())
 	assert.Equal(t, senderID.String(), capturedTransaction.SenderID())
 	assert.Equal(t, receiverID.String(), capturedTransaction.ReceiverID())
 	mockAuthorizer.AssertCalled(t, "IsTransactionAllowed", ctx)
@@ -259,14 +332,13 @@ func TestCreateTransaction_Execute_ShouldIncreaseReceiverBalanceByCorrectAmount(
 	ctx := context.Background()
 	mockUserRepo := &mockUserRepository{}
 	mockAuthorizer := &mockTransactionAuthorizerGateway{}
-	mockQueue := &mockQueue{}
 	mockTelemetry := telemetry.NewMockTelemetry()
 
 	senderID := uuid.New()
 	receiverID := uuid.New()
-	initialSenderBalance := 200.0
-	initialReceiverBalance := 50.0
-	amount := 100.0
+	initialSenderBalance := int64(20000)
+	initialReceiverBalance := int64(5000)
+	amount := int64(10000)
 	expectedTransactionID := "transaction-id"
 
 	// Create users with initial balances
@@ -296,9 +368,8 @@ func TestCreateTransaction_Execute_ShouldIncreaseReceiverBalanceByCorrectAmount(
 			}
 		}).
 		Return(nil)
-	mockQueue.On("Send", ctx, mock.AnythingOfType("[]uint8")).Return(nil)
 
-	useCase := usecase.NewCreateTransaction(mockUserRepo, mockAuthorizer, mockQueue, mockTelemetry)
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
 
 	input := usecase.CreateTransactionInput{
 		Amount:     amount,
@@ -312,8 +383,8 @@ func TestCreateTransaction_Execute_ShouldIncreaseReceiverBalanceByCorrectAmount(
 	// Assert
 	assert.Equal(t, expectedTransactionID, result)
 	assert.NoError(t, err)
-	assert.Equal(t, int64((initialSenderBalance-amount)*100), actualSenderBalance, "Sender balance should be decreased by transfer amount")
-	assert.Equal(t, int64((initialReceiverBalance+amount)*100), actualReceiverBalance, "Receiver balance should be increased by exact transfer amount")
+	assert.Equal(t, initialSenderBalance-amount, actualSenderBalance, "Sender balance should be decreased by transfer amount")
+	assert.Equal(t, initialReceiverBalance+amount, actualReceiverBalance, "Receiver balance should be increased by exact transfer amount")
 	mockAuthorizer.AssertCalled(t, "IsTransactionAllowed", ctx)
 	mockUserRepo.AssertCalled(t, "UpdateBalance", ctx, senderID.String(), receiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)"))
 }
@@ -323,14 +394,13 @@ func TestCreateTransaction_Execute_ShouldVerifySenderBalanceIsDecreasedByCorrect
 	ctx := context.Background()
 	mockUserRepo := &mockUserRepository{}
 	mockAuthorizer := &mockTransactionAuthorizerGateway{}
-	mockQueue := &mockQueue{}
 	mockTelemetry := telemetry.NewMockTelemetry()
 
 	senderID := uuid.New()
 	receiverID := uuid.New()
-	initialBalance := 200.0
-	amount := 75.0
-	expectedRemainingBalance := int64((initialBalance - amount) * 100)
+	initialBalance := int64(20000)
+	amount := int64(7500)
+	expectedRemainingBalance := initialBalance - amount
 
 	// Create mock sender with initial balance
 	sender := NewUser(vo.CommonUserType)
@@ -347,9 +417,8 @@ func TestCreateTransaction_Execute_ShouldVerifySenderBalanceIsDecreasedByCorrect
 			_, _ = updateFn(sender, receiver)
 		}).
 		Return(nil)
-	mockQueue.On("Send", ctx, mock.AnythingOfType("[]uint8")).Return(nil)
 
-	useCase := usecase.NewCreateTransaction(mockUserRepo, mockAuthorizer, mockQueue, mockTelemetry)
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
 
 	input := usecase.CreateTransactionInput{
 		Amount:     amount,
@@ -367,57 +436,31 @@ func TestCreateTransaction_Execute_ShouldVerifySenderBalanceIsDecreasedByCorrect
 	mockUserRepo.AssertCalled(t, "UpdateBalance", ctx, senderID.String(), receiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)"))
 }
 
-func TestCreateTransaction_Execute_ShouldHandleTransactionWithZeroAmount(t *testing.T) {
+func TestCreateTransaction_Execute_ShouldRejectTransactionWithZeroAmount(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	mockUserRepo := &mockUserRepository{}
 	mockAuthorizer := &mockTransactionAuthorizerGateway{}
-	mockQueue := &mockQueue{}
+	mockFXRateProvider := &mockFXRateProvider{}
 	mockTelemetry := telemetry.NewMockTelemetry()
 
-	senderID := uuid.New()
-	receiverID := uuid.New()
-	amount := 0.0
-
-	// Create mock users
-	sender := NewUser(vo.CommonUserType)
-	err := sender.Deposit(100.0) // Add some initial balance
-	assert.NoError(t, err)
-	receiver := NewUser(vo.CommonUserType)
-
-	initialSenderBalance := sender.Balance()
-	initialReceiverBalance := receiver.Balance()
-
-	// Configure mocks
-	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
-	mockUserRepo.On("UpdateBalance", ctx, senderID.String(), receiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)")).
-		Run(func(args mock.Arguments) {
-			// Execute the callback function to simulate the balance update
-			updateFn := args.Get(3).(func(*entity.User, *entity.User) (*entity.Transaction, error))
-			_, _ = updateFn(sender, receiver)
-		}).
-		Return(nil)
-	mockQueue.On("Send", ctx, mock.AnythingOfType("[]uint8")).Return(nil)
-
-	useCase := usecase.NewCreateTransaction(mockUserRepo, mockAuthorizer, mockQueue, mockTelemetry)
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, mockFXRateProvider, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
 
 	input := usecase.CreateTransactionInput{
-		Amount:     amount,
-		SenderID:   senderID,
-		ReceiverID: receiverID,
+		Amount:     0,
+		SenderID:   uuid.New(),
+		ReceiverID: uuid.New(),
 	}
 
 	// Act
 	result, err := useCase.Execute(ctx, input)
 
 	// Assert
-	assert.NotEmpty(t, result)
-	assert.NoError(t, err)
-	assert.Equal(t, initialSenderBalance, sender.Balance(), "Sender balance should not change")
-	assert.Equal(t, initialReceiverBalance, receiver.Balance(), "Receiver balance should not change")
-	mockAuthorizer.AssertCalled(t, "IsTransactionAllowed", ctx)
-	mockUserRepo.AssertCalled(t, "UpdateBalance", ctx, senderID.String(), receiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)"))
-	mockQueue.AssertCalled(t, "Send", ctx, mock.AnythingOfType("[]uint8"))
+	assert.Empty(t, result)
+	assert.ErrorIs(t, err, errs.ErrZeroOrNegativeAmount)
+	mockFXRateProvider.AssertNotCalled(t, "GetRate")
+	mockAuthorizer.AssertNotCalled(t, "IsTransactionAllowed")
+	mockUserRepo.AssertNotCalled(t, "UpdateBalance")
 }
 
 func TestCreateTransaction_Execute_ShouldPropagateErrorsFromRepositoryLayer(t *testing.T) {
@@ -425,12 +468,11 @@ func TestCreateTransaction_Execute_ShouldPropagateErrorsFromRepositoryLayer(t *t
 	ctx := context.Background()
 	mockUserRepo := &mockUserRepository{}
 	mockAuthorizer := &mockTransactionAuthorizerGateway{}
-	mockQueue := &mockQueue{}
 	mockTelemetry := telemetry.NewMockTelemetry()
 
 	senderID := uuid.New()
 	receiverID := uuid.New()
-	amount := 100.0
+	amount := int64(10000)
 	expectedError := errors.New("database connection error")
 
 	// Configure mocks
@@ -438,7 +480,7 @@ func TestCreateTransaction_Execute_ShouldPropagateErrorsFromRepositoryLayer(t *t
 	mockUserRepo.On("UpdateBalance", ctx, senderID.String(), receiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)")).
 		Return(expectedError)
 
-	useCase := usecase.NewCreateTransaction(mockUserRepo, mockAuthorizer, mockQueue, mockTelemetry)
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
 
 	input := usecase.CreateTransactionInput{
 		Amount:     amount,
@@ -462,7 +504,8 @@ type mockUserRepository struct {
 
 func (m *mockUserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	args := m.Called(ctx, id)
-	return args.Get(0).(*entity.User), args.Error(1)
+	user, _ := args.Get(0).(*entity.User)
+	return user, args.Error(1)
 }
 
 func (m *mockUserRepository) UpdateBalance(ctx context.Context, senderID, receiverID string, updateFn func(sender, receiver *entity.User) (*entity.Transaction, error)) error {
@@ -479,6 +522,16 @@ func (m *mockTransactionAuthorizerGateway) IsTransactionAllowed(ctx context.Cont
 	return args.Bool(0)
 }
 
+type mockFXRateProvider struct {
+	mock.Mock
+}
+
+func (m *mockFXRateProvider) GetRate(ctx context.Context, from, to string) (*vo.ExchangeRate, error) {
+	args := m.Called(ctx, from, to)
+	rate, _ := args.Get(0).(*vo.ExchangeRate)
+	return rate, args.Error(1)
+}
+
 func NewUser(userType string) *entity.User {
 	var cpf, cnpj string
 
@@ -503,6 +556,29 @@ func NewUser(userType string) *entity.User {
 	return user
 }
 
+// platformCredit returns the cents the transaction credits to the platform
+// account.
+func platformCredit(t *testing.T, transaction *entity.Transaction) int64 {
+	entries, err := transaction.LedgerEntries()
+	require.NoError(t, err)
+	var credited int64
+	for _, entry := range entries {
+		if entry.AccountID() == entity.PlatformAccountID {
+			credited += entry.SignedAmount()
+		}
+	}
+	return credited
+}
+
+// merchantFees charges merchants 1.99% of what they receive.
+func merchantFees(t *testing.T) vo.FeePolicy {
+	schedule, err := vo.NewPercentageFee(199)
+	require.NoError(t, err)
+	fees, err := vo.NewFeePolicy(map[string]vo.FeeSchedule{vo.MerchantUserType: *schedule}, nil)
+	require.NoError(t, err)
+	return *fees
+}
+
 type mockQueue struct {
 	mock.Mock
 }
@@ -511,3 +587,277 @@ func (m *mockQueue) Send(ctx context.Context, message []byte) error {
 	args := m.Called(ctx, message)
 	return args.Error(0)
 }
+
+func TestCreateTransaction_Execute_ShouldReplayOriginalTransactionWhenIdempotencyKeyIsReused(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockUserRepo := &mockUserRepository{}
+	mockIdempotencyKeyRepo := &mockIdempotencyKeyRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockTelemetry := telemetry.NewMockTelemetry()
+
+	input := usecase.CreateTransactionInput{
+		Amount:         10000,
+		SenderID:       uuid.New(),
+		ReceiverID:     uuid.New(),
+		IdempotencyKey: "retry-key",
+	}
+
+	useCase := usecase.NewCreateTransaction(mockUserRepo, mockIdempotencyKeyRepo, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
+
+	// Capture the request hash stored by the first attempt
+	var storedKey *entity.IdempotencyKey
+	mockIdempotencyKeyRepo.On("GetIdempotencyKey", ctx, "retry-key").Return(nil, nil).Once()
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+	mockUserRepo.On("UpdateBalance", ctx, input.SenderID.String(), input.ReceiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)")).
+		Run(func(args mock.Arguments) {
+			sender := NewUser(vo.CommonUserType)
+			_ = sender.Deposit(20000)
+			updateFn := args.Get(3).(func(*entity.User, *entity.User) (*entity.Transaction, error))
+			transaction, _ := updateFn(sender, NewUser(vo.CommonUserType))
+			storedKey = transaction.IdempotencyKey()
+		}).
+		Return(nil).Once()
+
+	firstID, err := useCase.Execute(ctx, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, storedKey)
+	assert.Equal(t, firstID, storedKey.TransactionID())
+
+	mockIdempotencyKeyRepo.On("GetIdempotencyKey", ctx, "retry-key").Return(storedKey, nil).Once()
+
+	// Act
+	secondID, err := useCase.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, firstID, secondID)
+	mockUserRepo.AssertNumberOfCalls(t, "UpdateBalance", 1)
+	mockAuthorizer.AssertNumberOfCalls(t, "IsTransactionAllowed", 1)
+}
+
+func TestCreateTransaction_Execute_ShouldRejectIdempotencyKeyReusedWithDifferentRequest(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockUserRepo := &mockUserRepository{}
+	mockIdempotencyKeyRepo := &mockIdempotencyKeyRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockTelemetry := telemetry.NewMockTelemetry()
+
+	existingKey := entity.RestoreIdempotencyKey("retry-key", "another-request-hash", uuid.NewString(), time.Now(), time.Now().Add(time.Hour))
+	mockIdempotencyKeyRepo.On("GetIdempotencyKey", ctx, "retry-key").Return(existingKey, nil)
+
+	useCase := usecase.NewCreateTransaction(mockUserRepo, mockIdempotencyKeyRepo, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
+
+	input := usecase.CreateTransactionInput{
+		Amount:         10000,
+		SenderID:       uuid.New(),
+		ReceiverID:     uuid.New(),
+		IdempotencyKey: "retry-key",
+	}
+
+	// Act
+	result, err := useCase.Execute(ctx, input)
+
+	// Assert
+	assert.Equal(t, "", result)
+	assert.ErrorIs(t, err, errs.ErrIdempotencyKeyReused)
+	mockAuthorizer.AssertNotCalled(t, "IsTransactionAllowed")
+	mockUserRepo.AssertNotCalled(t, "UpdateBalance")
+}
+
+func TestCreateTransaction_Execute_ShouldReplayTransactionOfConcurrentRequestWithSameIdempotencyKey(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockUserRepo := &mockUserRepository{}
+	mockIdempotencyKeyRepo := &mockIdempotencyKeyRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockTelemetry := telemetry.NewMockTelemetry()
+
+	input := usecase.CreateTransactionInput{
+		Amount:         10000,
+		SenderID:       uuid.New(),
+		ReceiverID:     uuid.New(),
+		IdempotencyKey: "retry-key",
+	}
+
+	useCase := usecase.NewCreateTransaction(mockUserRepo, mockIdempotencyKeyRepo, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, mockTelemetry)
+
+	var attemptedKey *entity.IdempotencyKey
+	mockIdempotencyKeyRepo.On("GetIdempotencyKey", ctx, "retry-key").Return(nil, nil).Once()
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+	mockUserRepo.On("UpdateBalance", ctx, input.SenderID.String(), input.ReceiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)")).
+		Run(func(args mock.Arguments) {
+			sender := NewUser(vo.CommonUserType)
+			_ = sender.Deposit(20000)
+			updateFn := args.Get(3).(func(*entity.User, *entity.User) (*entity.Transaction, error))
+			transaction, _ := updateFn(sender, NewUser(vo.CommonUserType))
+			attemptedKey = transaction.IdempotencyKey()
+		}).
+		Return(errs.ErrIdempotencyKeyConflict)
+
+	winnerID := uuid.NewString()
+	mockIdempotencyKeyRepo.On("GetIdempotencyKey", ctx, "retry-key").
+		Return(func(ctx context.Context, key string) *entity.IdempotencyKey {
+			return entity.RestoreIdempotencyKey(key, attemptedKey.RequestHash(), winnerID, time.Now(), time.Now().Add(time.Hour))
+		}, nil).Once()
+
+	// Act
+	result, err := useCase.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, winnerID, result)
+}
+
+type mockIdempotencyKeyRepository struct {
+	mock.Mock
+}
+
+func (m *mockIdempotencyKeyRepository) GetIdempotencyKey(ctx context.Context, key string) (*entity.IdempotencyKey, error) {
+	args := m.Called(ctx, key)
+	if fn, ok := args.Get(0).(func(context.Context, string) *entity.IdempotencyKey); ok {
+		return fn(ctx, key), args.Error(1)
+	}
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.IdempotencyKey), args.Error(1)
+}
+
+func TestCreateTransaction_Execute_ShouldConvertAmountWhenReceiverCurrencyDiffers(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockUserRepo := &mockUserRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockFXProvider := &mockFXRateProvider{}
+
+	sender := NewUser(vo.CommonUserType)
+	assert.NoError(t, sender.DepositIn(vo.USD, 5000))
+	receiver := NewUser(vo.MerchantUserType)
+	rate, err := vo.NewExchangeRate(vo.USD, vo.BRL, "5.25")
+	assert.NoError(t, err)
+
+	input := usecase.CreateTransactionInput{
+		Amount:           1000,
+		Currency:         vo.USD,
+		ReceiverCurrency: vo.BRL,
+		SenderID:         uuid.New(),
+		ReceiverID:       uuid.New(),
+	}
+
+	var capturedTransaction *entity.Transaction
+	mockFXProvider.On("GetRate", ctx, vo.USD, vo.BRL).Return(rate, nil)
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+	mockUserRepo.On("UpdateBalance", ctx, input.SenderID.String(), input.ReceiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)")).
+		Run(func(args mock.Arguments) {
+			updateFn := args.Get(3).(func(*entity.User, *entity.User) (*entity.Transaction, error))
+			capturedTransaction, err = updateFn(sender, receiver)
+			assert.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, mockFXProvider, vo.TransferLimitPolicy{}, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	transactionID, err := useCase.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, capturedTransaction.ID(), transactionID)
+	assert.Equal(t, int64(4000), sender.BalanceIn(vo.USD))
+	assert.Equal(t, int64(5250), receiver.Balance())
+	assert.Equal(t, "5.25", capturedTransaction.ExchangeRate().Rate())
+	assert.Equal(t, int64(5250), capturedTransaction.ReceivedAmount())
+}
+
+func TestCreateTransaction_Execute_ShouldChargeTheFeeOfTheReceiver(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockUserRepo := &mockUserRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+
+	sender := NewUser(vo.CommonUserType)
+	assert.NoError(t, sender.Deposit(10000))
+	receiver := NewUser(vo.MerchantUserType)
+	schedule, err := vo.NewPercentageFee(199)
+	assert.NoError(t, err)
+	fees, err := vo.NewFeePolicy(nil, map[string]vo.FeeSchedule{receiver.ID(): *schedule})
+	assert.NoError(t, err)
+
+	input := usecase.CreateTransactionInput{
+		Amount:     10000,
+		SenderID:   uuid.New(),
+		ReceiverID: uuid.New(),
+	}
+
+	var capturedTransaction *entity.Transaction
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+	mockUserRepo.On("UpdateBalance", ctx, input.SenderID.String(), input.ReceiverID.String(), mock.AnythingOfType("func(*entity.User, *entity.User) (*entity.Transaction, error)")).
+		Run(func(args mock.Arguments) {
+			updateFn := args.Get(3).(func(*entity.User, *entity.User) (*entity.Transaction, error))
+			capturedTransaction, err = updateFn(sender, receiver)
+			assert.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, *fees, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err = useCase.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), sender.Balance())
+	assert.Equal(t, int64(9801), receiver.Balance())
+	assert.Equal(t, int64(199), capturedTransaction.Fee())
+	createdEvent := capturedTransaction.Events()[0].(*event.CreateTransactionEventV1)
+	assert.Equal(t, int64(10000), createdEvent.ReceivedAmountInCents)
+	assert.Equal(t, int64(199), createdEvent.FeeInCents)
+	assert.Equal(t, vo.BRL, createdEvent.FeeCurrency)
+}
+
+func TestCreateTransaction_Execute_ShouldReturnErrorWhenRateIsNotAvailable(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockUserRepo := &mockUserRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockFXProvider := &mockFXRateProvider{}
+	mockFXProvider.On("GetRate", ctx, vo.EUR, vo.USD).Return(nil, errs.ErrExchangeRateNotFound)
+
+	useCase := usecase.NewCreateTransaction(mockUserRepo, &mockIdempotencyKeyRepository{}, mockAuthorizer, mockFXProvider, vo.TransferLimitPolicy{}, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	transactionID, err := useCase.Execute(ctx, usecase.CreateTransactionInput{
+		Amount:           1000,
+		Currency:         vo.EUR,
+		ReceiverCurrency: vo.USD,
+		SenderID:         uuid.New(),
+		ReceiverID:       uuid.New(),
+	})
+
+	// Assert
+	assert.Empty(t, transactionID)
+	assert.ErrorIs(t, err, errs.ErrExchangeRateNotFound)
+	mockAuthorizer.AssertNotCalled(t, "IsTransactionAllowed", ctx)
+	mockUserRepo.AssertNotCalled(t, "UpdateBalance")
+}
+
+func TestCreateTransaction_Execute_ShouldReturnErrorWhenCurrencyIsNotSupported(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	useCase := usecase.NewCreateTransaction(&mockUserRepository{}, &mockIdempotencyKeyRepository{}, mockAuthorizer, &mockFXRateProvider{}, vo.TransferLimitPolicy{}, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx, usecase.CreateTransactionInput{
+		Amount:     1000,
+		Currency:   "XYZ",
+		SenderID:   uuid.New(),
+		ReceiverID: uuid.New(),
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrUnsupportedCurrency)
+	mockAuthorizer.AssertNotCalled(t, "IsTransactionAllowed", ctx)
+}
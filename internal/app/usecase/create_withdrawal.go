@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type WithdrawalRepository interface {
+	Hold(ctx context.Context, userID string, holdFn func(user *entity.User) (*entity.Withdrawal, error)) error
+	Settle(ctx context.Context, withdrawalID string, settleFn func(withdrawal *entity.Withdrawal, user *entity.User) error) error
+}
+
+// PayoutGateway sends the money of a withdrawal to its destination. Accepting
+// a payout does not mean it succeeded, the result is reported asynchronously
+// through SettleWithdrawal. A payout the gateway definitely turned down is
+// reported with an error matching errs.ErrPayoutNotAccepted, any other error
+// leaves it unknown whether the payout is going ahead.
+type PayoutGateway interface {
+	RequestPayout(ctx context.Context, withdrawal *entity.Withdrawal, destination *entity.PayoutDestination) error
+}
+
+type CreateWithdrawal struct {
+	withdrawalRepository        WithdrawalRepository
+	payoutDestinationRepository PayoutDestinationRepository
+	payoutGateway               PayoutGateway
+	otel                        telemetry.Telemetry
+}
+
+type CreateWithdrawalInput struct {
+	UserID        uuid.UUID
+	DestinationID uuid.UUID
+	// Amount in cents of Currency
+	Amount int64
+	// Currency of the withdrawal, the default currency when empty
+	Currency string
+}
+
+// Execute puts the amount on hold and asks the payout gateway to pay it out.
+// Unlike transfers, withdrawals are allowed for merchants, as it is how they
+// get their money out of the wallet.
+func (cw *CreateWithdrawal) Execute(ctx context.Context, input CreateWithdrawalInput) (string, error) {
+	ctx, span := cw.otel.Start(ctx, "CreateWithdrawal")
+	defer span.End()
+
+	currency := input.Currency
+	if currency == "" {
+		currency = vo.DefaultCurrency
+	}
+	amount, err := vo.NewMoney(input.Amount, currency)
+	if err != nil {
+		return "", err
+	}
+
+	destination, err := cw.payoutDestinationRepository.GetPayoutDestination(ctx, input.DestinationID.String())
+	if err != nil {
+		return "", err
+	}
+	if !destination.BelongsTo(input.UserID.String()) {
+		return "", errs.ErrPayoutDestinationNotFound
+	}
+
+	var withdrawal *entity.Withdrawal
+	err = cw.withdrawalRepository.Hold(ctx, input.UserID.String(), func(user *entity.User) (*entity.Withdrawal, error) {
+		var err error
+		withdrawal, err = entity.NewWithdrawal(user.ID(), destination.ID(), amount)
+		if err != nil {
+			return nil, err
+		}
+
+		err = user.WithdrawIn(withdrawal.Currency(), withdrawal.Amount())
+		if err != nil {
+			return nil, err
+		}
+
+		withdrawal.RecordEvent(event.NewWithdrawalRequestedEventV1(
+			withdrawal.ID(),
+			input.UserID,
+			withdrawal.DestinationID(),
+			event.Amount{InCents: withdrawal.Amount(), Currency: withdrawal.Currency()},
+			withdrawal.Status(),
+		))
+
+		return withdrawal, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	err = cw.payoutGateway.RequestPayout(ctx, withdrawal, destination)
+	if errors.Is(err, errs.ErrPayoutNotAccepted) {
+		releaseErr := cw.withdrawalRepository.Settle(ctx, withdrawal.ID(), settleWithdrawalFn(false, err.Error()))
+		if releaseErr != nil {
+			// The withdrawal stays pending and can still be failed through
+			// SettleWithdrawal.
+			log.Printf("Error releasing withdrawal %s: %v", withdrawal.ID(), releaseErr)
+		}
+		return "", err
+	}
+	if err != nil {
+		// The payout may still go ahead, e.g. after a timeout, so the money
+		// stays on hold until its result is reported.
+		log.Printf("Error requesting payout of withdrawal %s, leaving it pending: %v", withdrawal.ID(), err)
+	}
+
+	return withdrawal.ID(), nil
+}
+
+func NewCreateWithdrawal(
+	withdrawalRepository WithdrawalRepository,
+	payoutDestinationRepository PayoutDestinationRepository,
+	payoutGateway PayoutGateway,
+	otel telemetry.Telemetry,
+) *CreateWithdrawal {
+	return &CreateWithdrawal{
+		withdrawalRepository:        withdrawalRepository,
+		payoutDestinationRepository: payoutDestinationRepository,
+		payoutGateway:               payoutGateway,
+		otel:                        otel,
+	}
+}
@@ -0,0 +1,253 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	holdFnType   = "func(*entity.User) (*entity.Withdrawal, error)"
+	settleFnType = "func(*entity.Withdrawal, *entity.User) error"
+)
+
+func TestCreateWithdrawal_Execute_ShouldHoldTheAmountAndRequestThePayout(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	merchant := NewUser(vo.MerchantUserType)
+	require.NoError(t, merchant.Deposit(10000))
+	destination, err := entity.NewPixKeyDestination(merchant.ID(), "merchant@mail.com")
+	require.NoError(t, err)
+
+	mockDestinationRepo := &mockPayoutDestinationRepository{}
+	mockDestinationRepo.On("GetPayoutDestination", ctx, destination.ID()).Return(destination, nil)
+
+	var capturedWithdrawal *entity.Withdrawal
+	mockWithdrawalRepo := &mockWithdrawalRepository{}
+	mockWithdrawalRepo.On("Hold", ctx, merchant.ID(), mock.AnythingOfType(holdFnType)).
+		Run(func(args mock.Arguments) {
+			holdFn := args.Get(2).(func(*entity.User) (*entity.Withdrawal, error))
+			capturedWithdrawal, err = holdFn(merchant)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	mockGateway := &mockPayoutGateway{}
+	mockGateway.On("RequestPayout", ctx, mock.AnythingOfType("*entity.Withdrawal"), destination).Return(nil)
+
+	useCase := usecase.NewCreateWithdrawal(mockWithdrawalRepo, mockDestinationRepo, mockGateway, telemetry.NewMockTelemetry())
+
+	// Act
+	withdrawalID, err := useCase.Execute(ctx, usecase.CreateWithdrawalInput{
+		UserID:        uuid.MustParse(merchant.ID()),
+		DestinationID: uuid.MustParse(destination.ID()),
+		Amount:        4000,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, capturedWithdrawal.ID(), withdrawalID)
+	assert.Equal(t, entity.WithdrawalPendingStatus, capturedWithdrawal.Status())
+	assert.Equal(t, int64(6000), merchant.Balance())
+	require.Len(t, capturedWithdrawal.Events(), 1)
+	assert.Equal(t, "WithdrawalRequestedEventV1", capturedWithdrawal.Events()[0].Name())
+	mockGateway.AssertExpectations(t)
+	mockWithdrawalRepo.AssertNotCalled(t, "Settle", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateWithdrawal_Execute_ShouldReturnErrorWhenDestinationBelongsToAnotherUser(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	destination, err := entity.NewPixKeyDestination(uuid.NewString(), "someone@mail.com")
+	require.NoError(t, err)
+
+	mockDestinationRepo := &mockPayoutDestinationRepository{}
+	mockDestinationRepo.On("GetPayoutDestination", ctx, destination.ID()).Return(destination, nil)
+	mockWithdrawalRepo := &mockWithdrawalRepository{}
+
+	useCase := usecase.NewCreateWithdrawal(mockWithdrawalRepo, mockDestinationRepo, &mockPayoutGateway{}, telemetry.NewMockTelemetry())
+
+	// Act
+	withdrawalID, err := useCase.Execute(ctx, usecase.CreateWithdrawalInput{
+		UserID:        uuid.New(),
+		DestinationID: uuid.MustParse(destination.ID()),
+		Amount:        4000,
+	})
+
+	// Assert
+	assert.Empty(t, withdrawalID)
+	assert.ErrorIs(t, err, errs.ErrPayoutDestinationNotFound)
+	mockWithdrawalRepo.AssertNotCalled(t, "Hold", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateWithdrawal_Execute_ShouldReturnErrorWhenBalanceIsNotEnough(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	user := NewUser(vo.CommonUserType)
+	destination, err := entity.NewBankAccountDestination(user.ID(), "341", "0001", "12345-6")
+	require.NoError(t, err)
+
+	mockDestinationRepo := &mockPayoutDestinationRepository{}
+	mockDestinationRepo.On("GetPayoutDestination", ctx, destination.ID()).Return(destination, nil)
+
+	mockWithdrawalRepo := &mockWithdrawalRepository{}
+	mockWithdrawalRepo.On("Hold", ctx, user.ID(), mock.AnythingOfType(holdFnType)).
+		Return(errs.ErrInsufficientBalance).
+		Run(func(args mock.Arguments) {
+			holdFn := args.Get(2).(func(*entity.User) (*entity.Withdrawal, error))
+			_, err = holdFn(user)
+			assert.ErrorIs(t, err, errs.ErrInsufficientBalance)
+		})
+	mockGateway := &mockPayoutGateway{}
+
+	useCase := usecase.NewCreateWithdrawal(mockWithdrawalRepo, mockDestinationRepo, mockGateway, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err = useCase.Execute(ctx, usecase.CreateWithdrawalInput{
+		UserID:        uuid.MustParse(user.ID()),
+		DestinationID: uuid.MustParse(destination.ID()),
+		Amount:        4000,
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrInsufficientBalance)
+	mockGateway.AssertNotCalled(t, "RequestPayout", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateWithdrawal_Execute_ShouldReleaseTheHoldWhenPayoutIsNotAccepted(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	user := NewUser(vo.CommonUserType)
+	require.NoError(t, user.Deposit(10000))
+	destination, err := entity.NewPixKeyDestination(user.ID(), "john@mail.com")
+	require.NoError(t, err)
+
+	mockDestinationRepo := &mockPayoutDestinationRepository{}
+	mockDestinationRepo.On("GetPayoutDestination", ctx, destination.ID()).Return(destination, nil)
+
+	var capturedWithdrawal *entity.Withdrawal
+	mockWithdrawalRepo := &mockWithdrawalRepository{}
+	mockWithdrawalRepo.On("Hold", ctx, user.ID(), mock.AnythingOfType(holdFnType)).
+		Run(func(args mock.Arguments) {
+			holdFn := args.Get(2).(func(*entity.User) (*entity.Withdrawal, error))
+			capturedWithdrawal, err = holdFn(user)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+	mockWithdrawalRepo.On("Settle", ctx, mock.Anything, mock.AnythingOfType(settleFnType)).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(2).(func(*entity.Withdrawal, *entity.User) error)
+			require.NoError(t, settleFn(capturedWithdrawal, user))
+		}).
+		Return(nil)
+
+	mockGateway := &mockPayoutGateway{}
+	rejection := fmt.Errorf("%w: invalid pix key", errs.ErrPayoutNotAccepted)
+	mockGateway.On("RequestPayout", ctx, mock.Anything, destination).Return(rejection)
+
+	useCase := usecase.NewCreateWithdrawal(mockWithdrawalRepo, mockDestinationRepo, mockGateway, telemetry.NewMockTelemetry())
+
+	// Act
+	withdrawalID, err := useCase.Execute(ctx, usecase.CreateWithdrawalInput{
+		UserID:        uuid.MustParse(user.ID()),
+		DestinationID: uuid.MustParse(destination.ID()),
+		Amount:        4000,
+	})
+
+	// Assert
+	assert.Empty(t, withdrawalID)
+	assert.ErrorIs(t, err, errs.ErrPayoutNotAccepted)
+	assert.Equal(t, entity.WithdrawalFailedStatus, capturedWithdrawal.Status())
+	assert.Equal(t, rejection.Error(), capturedWithdrawal.FailureReason())
+	assert.Equal(t, int64(10000), user.Balance())
+}
+
+func TestCreateWithdrawal_Execute_ShouldKeepTheHoldWhenThePayoutResultIsUnknown(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	user := NewUser(vo.CommonUserType)
+	require.NoError(t, user.Deposit(10000))
+	destination, err := entity.NewPixKeyDestination(user.ID(), "john@mail.com")
+	require.NoError(t, err)
+
+	mockDestinationRepo := &mockPayoutDestinationRepository{}
+	mockDestinationRepo.On("GetPayoutDestination", ctx, destination.ID()).Return(destination, nil)
+
+	var capturedWithdrawal *entity.Withdrawal
+	mockWithdrawalRepo := &mockWithdrawalRepository{}
+	mockWithdrawalRepo.On("Hold", ctx, user.ID(), mock.AnythingOfType(holdFnType)).
+		Run(func(args mock.Arguments) {
+			holdFn := args.Get(2).(func(*entity.User) (*entity.Withdrawal, error))
+			capturedWithdrawal, err = holdFn(user)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	mockGateway := &mockPayoutGateway{}
+	mockGateway.On("RequestPayout", ctx, mock.Anything, destination).Return(errors.New("context deadline exceeded"))
+
+	useCase := usecase.NewCreateWithdrawal(mockWithdrawalRepo, mockDestinationRepo, mockGateway, telemetry.NewMockTelemetry())
+
+	// Act
+	withdrawalID, err := useCase.Execute(ctx, usecase.CreateWithdrawalInput{
+		UserID:        uuid.MustParse(user.ID()),
+		DestinationID: uuid.MustParse(destination.ID()),
+		Amount:        4000,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, capturedWithdrawal.ID(), withdrawalID)
+	assert.Equal(t, entity.WithdrawalPendingStatus, capturedWithdrawal.Status())
+	assert.Equal(t, int64(6000), user.Balance())
+	mockWithdrawalRepo.AssertNotCalled(t, "Settle", mock.Anything, mock.Anything, mock.Anything)
+}
+
+type mockWithdrawalRepository struct {
+	mock.Mock
+}
+
+func (m *mockWithdrawalRepository) Hold(ctx context.Context, userID string, holdFn func(user *entity.User) (*entity.Withdrawal, error)) error {
+	args := m.Called(ctx, userID, holdFn)
+	return args.Error(0)
+}
+
+func (m *mockWithdrawalRepository) Settle(ctx context.Context, withdrawalID string, settleFn func(withdrawal *entity.Withdrawal, user *entity.User) error) error {
+	args := m.Called(ctx, withdrawalID, settleFn)
+	return args.Error(0)
+}
+
+type mockPayoutDestinationRepository struct {
+	mock.Mock
+}
+
+func (m *mockPayoutDestinationRepository) Save(ctx context.Context, destination *entity.PayoutDestination) error {
+	args := m.Called(ctx, destination)
+	return args.Error(0)
+}
+
+func (m *mockPayoutDestinationRepository) GetPayoutDestination(ctx context.Context, id string) (*entity.PayoutDestination, error) {
+	args := m.Called(ctx, id)
+	destination, _ := args.Get(0).(*entity.PayoutDestination)
+	return destination, args.Error(1)
+}
+
+type mockPayoutGateway struct {
+	mock.Mock
+}
+
+func (m *mockPayoutGateway) RequestPayout(ctx context.Context, withdrawal *entity.Withdrawal, destination *entity.PayoutDestination) error {
+	args := m.Called(ctx, withdrawal, destination)
+	return args.Error(0)
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type DecodePixCode struct {
+	chargeRepository   ChargeRepository
+	userRepository     UserRepository
+	aliasKeyRepository AliasKeyRepository
+	locationURL        string
+	otel               telemetry.Telemetry
+}
+
+// PixPayment is what a scanned PIX code asks the payer to do: pay a Charge
+// for a dynamic code, or transfer to a Receiver for a static one.
+type PixPayment struct {
+	Charge   *entity.Charge
+	Receiver *entity.User
+	// Amount in cents of BRL to transfer, zero when the payer chooses it
+	Amount      int64
+	Reference   string
+	Description string
+}
+
+// Execute reads a scanned PIX code and resolves the charge or the user it
+// pays. Dynamic codes must point at a charge of this wallet, and the key of
+// static codes is either a user id or a key of the directory.
+func (dc *DecodePixCode) Execute(ctx context.Context, payload string) (*PixPayment, error) {
+	ctx, span := dc.otel.Start(ctx, "DecodePixCode")
+	defer span.End()
+
+	code, err := vo.ParsePixCode(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if code.IsDynamic() {
+		chargeID, ok := strings.CutPrefix(code.URL(), chargePixURL(dc.locationURL, ""))
+		if !ok {
+			return nil, errs.ErrForeignPixCode
+		}
+		id, err := uuid.Parse(chargeID)
+		if err != nil {
+			return nil, errs.ErrForeignPixCode
+		}
+		charge, err := dc.chargeRepository.GetCharge(ctx, id.String())
+		if err != nil {
+			return nil, err
+		}
+		return &PixPayment{
+			Charge:      charge,
+			Amount:      charge.Amount(),
+			Reference:   charge.Reference(),
+			Description: charge.Description(),
+		}, nil
+	}
+
+	receiver, err := dc.findReceiver(ctx, code.Key())
+	if err != nil {
+		return nil, err
+	}
+	return &PixPayment{
+		Receiver:    receiver,
+		Amount:      code.Amount(),
+		Reference:   code.Reference(),
+		Description: code.Description(),
+	}, nil
+}
+
+func (dc *DecodePixCode) findReceiver(ctx context.Context, key string) (*entity.User, error) {
+	if userID, err := uuid.Parse(key); err == nil {
+		receiver, err := dc.userRepository.GetUserByID(ctx, userID)
+		if !errors.Is(err, errs.ErrUserNotFound) {
+			return receiver, err
+		}
+	}
+
+	userID, err := resolveAliasKey(ctx, dc.aliasKeyRepository, key)
+	if errors.Is(err, errs.ErrAliasKeyNotFound) || errors.Is(err, errs.ErrInvalidAliasKey) {
+		return nil, errs.ErrPixKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return dc.userRepository.GetUserByID(ctx, userID)
+}
+
+func NewDecodePixCode(
+	chargeRepository ChargeRepository,
+	userRepository UserRepository,
+	aliasKeyRepository AliasKeyRepository,
+	locationURL string,
+	otel telemetry.Telemetry,
+) *DecodePixCode {
+	return &DecodePixCode{
+		chargeRepository:   chargeRepository,
+		userRepository:     userRepository,
+		aliasKeyRepository: aliasKeyRepository,
+		locationURL:        locationURL,
+		otel:               otel,
+	}
+}
@@ -0,0 +1,117 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodePixCode_Execute_ShouldResolveTheChargeOfDynamicCodes(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	merchant := NewUser(vo.MerchantUserType)
+	charge := newPendingCharge(t, merchant, 4990)
+	code, err := vo.NewDynamicPixCode(testPixLocationURL+"/"+charge.ID(), merchant.Name(), "SAO PAULO", charge.Amount())
+	require.NoError(t, err)
+
+	mockChargeRepo := &mockChargeRepository{}
+	mockChargeRepo.On("GetCharge", ctx, charge.ID()).Return(charge, nil)
+
+	useCase := usecase.NewDecodePixCode(mockChargeRepo, &mockUserRepository{}, &mockAliasKeyRepository{}, testPixLocationURL, telemetry.NewMockTelemetry())
+
+	// Act
+	payment, err := useCase.Execute(ctx, code.Payload())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, charge, payment.Charge)
+	assert.Nil(t, payment.Receiver)
+	assert.Equal(t, int64(4990), payment.Amount)
+	assert.Equal(t, charge.Reference(), payment.Reference)
+}
+
+func TestDecodePixCode_Execute_ShouldResolveTheReceiverOfStaticCodes(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	merchant := NewUser(vo.MerchantUserType)
+	code, err := vo.NewStaticPixCode(merchant.ID(), merchant.Name(), "SAO PAULO", 1000, "CAIXA1", "Cafe")
+	require.NoError(t, err)
+
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, uuid.MustParse(merchant.ID())).Return(merchant, nil)
+
+	useCase := usecase.NewDecodePixCode(&mockChargeRepository{}, mockUserRepo, &mockAliasKeyRepository{}, testPixLocationURL, telemetry.NewMockTelemetry())
+
+	// Act
+	payment, err := useCase.Execute(ctx, code.Payload())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, payment.Charge)
+	assert.Equal(t, merchant, payment.Receiver)
+	assert.Equal(t, int64(1000), payment.Amount)
+	assert.Equal(t, "CAIXA1", payment.Reference)
+	assert.Equal(t, "Cafe", payment.Description)
+}
+
+func TestDecodePixCode_Execute_ShouldResolveAliasKeysOfStaticCodes(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	receiver := NewUser(vo.CommonUserType)
+	key, err := entity.NewAliasKey(receiver, entity.EmailAliasKeyType, "fulano@example.com", receiver.CreatedAt())
+	require.NoError(t, err)
+	code, err := vo.NewStaticPixCode("fulano@example.com", "Fulano de Tal", "BRASILIA", 0, "", "")
+	require.NoError(t, err)
+
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, uuid.MustParse(receiver.ID())).Return(receiver, nil)
+	mockAliasKeyRepo := &mockAliasKeyRepository{}
+	mockAliasKeyRepo.On("GetByValue", ctx, entity.EmailAliasKeyType, "fulano@example.com").Return(key, nil)
+
+	useCase := usecase.NewDecodePixCode(&mockChargeRepository{}, mockUserRepo, mockAliasKeyRepo, testPixLocationURL, telemetry.NewMockTelemetry())
+
+	// Act
+	payment, err := useCase.Execute(ctx, code.Payload())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, receiver, payment.Receiver)
+}
+
+func TestDecodePixCode_Execute_ShouldRejectUnknownKeysAndForeignCodes(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	unknownID := uuid.New()
+	unknownKey, err := vo.NewStaticPixCode(unknownID.String(), "Fulano de Tal", "BRASILIA", 0, "", "")
+	require.NoError(t, err)
+	emailKey, err := vo.NewStaticPixCode("fulano@example.com", "Fulano de Tal", "BRASILIA", 0, "", "")
+	require.NoError(t, err)
+	foreign, err := vo.NewDynamicPixCode("pix.other-bank.com/qr/"+uuid.NewString(), "Loja", "SAO PAULO", 100)
+	require.NoError(t, err)
+
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, unknownID).Return(nil, errs.ErrUserNotFound)
+	mockAliasKeyRepo := &mockAliasKeyRepository{}
+	mockAliasKeyRepo.On("GetByValue", ctx, mock.Anything, mock.Anything).Return(nil, errs.ErrAliasKeyNotFound)
+
+	useCase := usecase.NewDecodePixCode(&mockChargeRepository{}, mockUserRepo, mockAliasKeyRepo, testPixLocationURL, telemetry.NewMockTelemetry())
+
+	// Act
+	_, unknownErr := useCase.Execute(ctx, unknownKey.Payload())
+	_, emailErr := useCase.Execute(ctx, emailKey.Payload())
+	_, foreignErr := useCase.Execute(ctx, foreign.Payload())
+
+	// Assert
+	assert.ErrorIs(t, unknownErr, errs.ErrPixKeyNotFound)
+	assert.ErrorIs(t, emailErr, errs.ErrPixKeyNotFound)
+	assert.ErrorIs(t, foreignErr, errs.ErrForeignPixCode)
+}
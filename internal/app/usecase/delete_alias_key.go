@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type DeleteAliasKey struct {
+	aliasKeyRepository AliasKeyRepository
+	otel               telemetry.Telemetry
+}
+
+// Execute removes a key of the user from the directory, freeing it to be
+// registered again.
+func (dk *DeleteAliasKey) Execute(ctx context.Context, userID, keyID uuid.UUID) error {
+	ctx, span := dk.otel.Start(ctx, "DeleteAliasKey")
+	defer span.End()
+
+	return dk.aliasKeyRepository.Delete(ctx, userID.String(), keyID.String())
+}
+
+func NewDeleteAliasKey(
+	aliasKeyRepository AliasKeyRepository,
+	otel telemetry.Telemetry,
+) *DeleteAliasKey {
+	return &DeleteAliasKey{
+		aliasKeyRepository: aliasKeyRepository,
+		otel:               otel,
+	}
+}
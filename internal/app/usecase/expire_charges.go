@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ExpireCharges struct {
+	chargeRepository ChargeRepository
+	batchSize        int
+	otel             telemetry.Telemetry
+}
+
+// Execute expires a batch of charges that were not paid in time and publishes
+// an event for each of them. It returns how many charges were processed so
+// callers can drain the backlog.
+func (ec *ExpireCharges) Execute(ctx context.Context) (int, error) {
+	ctx, span := ec.otel.Start(ctx, "ExpireCharges")
+	defer span.End()
+
+	now := time.Now()
+	return ec.chargeRepository.ExpireDue(ctx, now, ec.batchSize, func(charge *entity.Charge) error {
+		err := charge.Expire(now)
+		if err != nil {
+			return err
+		}
+		charge.RecordEvent(event.NewChargeExpiredEventV1(
+			charge.ID(),
+			uuid.MustParse(charge.MerchantID()),
+			charge.Reference(),
+			event.Amount{InCents: charge.Amount(), Currency: charge.Currency()},
+			charge.Status(),
+		))
+		return nil
+	})
+}
+
+func NewExpireCharges(
+	chargeRepository ChargeRepository,
+	batchSize int,
+	otel telemetry.Telemetry,
+) *ExpireCharges {
+	return &ExpireCharges{
+		chargeRepository: chargeRepository,
+		batchSize:        batchSize,
+		otel:             otel,
+	}
+}
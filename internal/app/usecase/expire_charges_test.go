@@ -0,0 +1,40 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpireCharges_Execute_ShouldExpireDueChargesAndPublishEvents(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	charge := newPendingCharge(t, NewUser(vo.MerchantUserType), 4990)
+
+	mockRepo := &mockChargeRepository{}
+	mockRepo.On("ExpireDue", ctx, mock.AnythingOfType("time.Time"), 100, mock.AnythingOfType(expireChargeFnType)).
+		Run(func(args mock.Arguments) {
+			expireFn := args.Get(3).(func(*entity.Charge) error)
+			require.NoError(t, expireFn(charge))
+		}).
+		Return(1, nil)
+
+	useCase := usecase.NewExpireCharges(mockRepo, 100, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, entity.ChargeExpiredStatus, charge.Status())
+	require.Len(t, charge.Events(), 1)
+	assert.Equal(t, "ChargeExpiredEventV1", charge.Events()[0].Name())
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ExpireHolds struct {
+	balanceHoldRepository BalanceHoldRepository
+	batchSize             int
+	otel                  telemetry.Telemetry
+}
+
+// Execute expires a batch of holds that were not captured in time and
+// publishes an event for each of them. Expired holds already stopped counting
+// against the available balance, so no balance changes. It returns how many
+// holds were processed so callers can drain the backlog.
+func (eh *ExpireHolds) Execute(ctx context.Context) (int, error) {
+	ctx, span := eh.otel.Start(ctx, "ExpireHolds")
+	defer span.End()
+
+	now := time.Now()
+	return eh.balanceHoldRepository.ExpireDue(ctx, now, eh.batchSize, func(hold *entity.BalanceHold) error {
+		err := hold.Expire(now)
+		if err != nil {
+			return err
+		}
+		hold.RecordEvent(event.NewBalanceHoldExpiredEventV1(
+			hold.ID(),
+			uuid.MustParse(hold.SenderID()),
+			uuid.MustParse(hold.ReceiverID()),
+			event.Amount{InCents: hold.Amount(), Currency: hold.Currency()},
+			hold.Status(),
+		))
+		return nil
+	})
+}
+
+func NewExpireHolds(
+	balanceHoldRepository BalanceHoldRepository,
+	batchSize int,
+	otel telemetry.Telemetry,
+) *ExpireHolds {
+	return &ExpireHolds{
+		balanceHoldRepository: balanceHoldRepository,
+		batchSize:             batchSize,
+		otel:                  otel,
+	}
+}
@@ -0,0 +1,42 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpireHolds_Execute_ShouldExpireDueHoldsAndPublishEvents(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	sender := NewUser(vo.CommonUserType)
+	require.NoError(t, sender.Deposit(10000))
+	hold := newAuthorizedHold(t, sender, NewUser(vo.MerchantUserType), 2500)
+
+	mockRepo := &mockBalanceHoldRepository{}
+	mockRepo.On("ExpireDue", ctx, mock.AnythingOfType("time.Time"), 100, mock.AnythingOfType(expireHoldFnType)).
+		Run(func(args mock.Arguments) {
+			expireFn := args.Get(3).(func(*entity.BalanceHold) error)
+			require.NoError(t, expireFn(hold))
+		}).
+		Return(1, nil)
+
+	useCase := usecase.NewExpireHolds(mockRepo, 100, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, entity.BalanceHoldExpiredStatus, hold.Status())
+	require.Len(t, hold.Events(), 1)
+	assert.Equal(t, "BalanceHoldExpiredEventV1", hold.Events()[0].Name())
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type StatementExportRepository interface {
+	// EachStatementEntry calls fn with every entry of the statement of the
+	// user matching the filter, from the oldest, stopping at the first error.
+	EachStatementEntry(ctx context.Context, userID string, filter entity.StatementFilter, fn func(entry *entity.StatementEntry) error) error
+}
+
+type StatementBalanceRepository interface {
+	GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	GetBalanceAt(ctx context.Context, userID uuid.UUID, currency string, at time.Time) (int64, error)
+}
+
+// StatementWriter renders a statement as its entries are read, so exports
+// of long periods are never held in memory.
+type StatementWriter interface {
+	Begin(header entity.StatementHeader) error
+	WriteEntry(entry *entity.StatementEntry) error
+	End(summary entity.StatementSummary) error
+}
+
+// StatementWriterFactory creates the writer of a format rendering to w, or
+// fails with errs.ErrUnsupportedStatementFormat.
+type StatementWriterFactory func(format string, w io.Writer) (StatementWriter, error)
+
+type ExportStatement struct {
+	statementRepository StatementExportRepository
+	balanceRepository   StatementBalanceRepository
+	newWriter           StatementWriterFactory
+	otel                telemetry.Telemetry
+}
+
+type ExportStatementInput struct {
+	UserID uuid.UUID
+	Format string
+	// Currency of the statement, the default currency when empty
+	Currency string
+	// From is inclusive and To exclusive
+	From time.Time
+	To   time.Time
+}
+
+// Execute writes to w the statement of the user for the period in the
+// format, from its opening balance to its closing balance.
+func (es *ExportStatement) Execute(ctx context.Context, input ExportStatementInput, w io.Writer) error {
+	ctx, span := es.otel.Start(ctx, "ExportStatement")
+	defer span.End()
+
+	if input.From.IsZero() || input.To.IsZero() {
+		return errs.ErrStatementPeriodRequired
+	}
+	currency := input.Currency
+	if currency == "" {
+		currency = vo.DefaultCurrency
+	}
+	filter := entity.StatementFilter{From: input.From, To: input.To, Currency: currency}
+	err := filter.Validate()
+	if err != nil {
+		return err
+	}
+
+	writer, err := es.newWriter(input.Format, w)
+	if err != nil {
+		return err
+	}
+
+	owner, err := es.balanceRepository.GetUserByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+	openingBalance, err := es.balanceRepository.GetBalanceAt(ctx, input.UserID, currency, input.From)
+	if err != nil {
+		return err
+	}
+	closingBalance, err := es.balanceRepository.GetBalanceAt(ctx, input.UserID, currency, input.To)
+	if err != nil {
+		return err
+	}
+
+	err = writer.Begin(entity.StatementHeader{
+		Owner:          owner,
+		Currency:       currency,
+		From:           input.From,
+		To:             input.To,
+		OpeningBalance: openingBalance,
+	})
+	if err != nil {
+		return err
+	}
+
+	summary := entity.StatementSummary{ClosingBalance: closingBalance}
+	err = es.statementRepository.EachStatementEntry(ctx, input.UserID.String(), filter, func(entry *entity.StatementEntry) error {
+		summary.Add(entry)
+		return writer.WriteEntry(entry)
+	})
+	if err != nil {
+		return err
+	}
+
+	return writer.End(summary)
+}
+
+func NewExportStatement(
+	statementRepository StatementExportRepository,
+	balanceRepository StatementBalanceRepository,
+	newWriter StatementWriterFactory,
+	otel telemetry.Telemetry,
+) *ExportStatement {
+	return &ExportStatement{
+		statementRepository: statementRepository,
+		balanceRepository:   balanceRepository,
+		newWriter:           newWriter,
+		otel:                otel,
+	}
+}
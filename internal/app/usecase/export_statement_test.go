@@ -0,0 +1,161 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStatementExportRepository struct {
+	mock.Mock
+}
+
+func (m *mockStatementExportRepository) EachStatementEntry(ctx context.Context, userID string, filter entity.StatementFilter, fn func(entry *entity.StatementEntry) error) error {
+	args := m.Called(ctx, userID, filter, fn)
+	entries, _ := args.Get(0).([]*entity.StatementEntry)
+	for _, entry := range entries {
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+type mockStatementBalanceRepository struct {
+	mock.Mock
+}
+
+func (m *mockStatementBalanceRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	args := m.Called(ctx, id)
+	user, _ := args.Get(0).(*entity.User)
+	return user, args.Error(1)
+}
+
+func (m *mockStatementBalanceRepository) GetBalanceAt(ctx context.Context, userID uuid.UUID, currency string, at time.Time) (int64, error) {
+	args := m.Called(ctx, userID, currency, at)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// recordingStatementWriter keeps what it is asked to write.
+type recordingStatementWriter struct {
+	header  entity.StatementHeader
+	entries []*entity.StatementEntry
+	summary *entity.StatementSummary
+}
+
+func (rw *recordingStatementWriter) Begin(header entity.StatementHeader) error {
+	rw.header = header
+	return nil
+}
+
+func (rw *recordingStatementWriter) WriteEntry(entry *entity.StatementEntry) error {
+	rw.entries = append(rw.entries, entry)
+	return nil
+}
+
+func (rw *recordingStatementWriter) End(summary entity.StatementSummary) error {
+	rw.summary = &summary
+	return nil
+}
+
+func (rw *recordingStatementWriter) factory(format string, _ io.Writer) (usecase.StatementWriter, error) {
+	if format != "csv" {
+		return nil, errs.ErrUnsupportedStatementFormat
+	}
+	return rw, nil
+}
+
+func TestExportStatement_Execute_ShouldWriteTheEntriesBetweenTheOpeningAndClosingBalances(t *testing.T) {
+	// Arrange
+	owner, err := entity.NewUser("Fulano de Tal", "fulano@example.com", "password123", "52998224725", "", vo.CommonUserType)
+	require.NoError(t, err)
+	ownerID := uuid.MustParse(owner.ID())
+	from := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 11, 1, 3, 0, 0, 0, time.UTC)
+	sent, err := entity.NewTransaction(1050, owner.ID(), uuid.NewString())
+	require.NoError(t, err)
+	received, err := entity.NewTransaction(2500, uuid.NewString(), owner.ID())
+	require.NoError(t, err)
+	entries := []*entity.StatementEntry{
+		entity.NewStatementEntry(sent, owner.ID(), 8950),
+		entity.NewStatementEntry(received, owner.ID(), 11450),
+	}
+
+	statementRepo := &mockStatementExportRepository{}
+	statementRepo.On("EachStatementEntry", mock.Anything, owner.ID(), entity.StatementFilter{From: from, To: to, Currency: vo.BRL}, mock.Anything).Return(entries, nil)
+	balanceRepo := &mockStatementBalanceRepository{}
+	balanceRepo.On("GetUserByID", mock.Anything, ownerID).Return(owner, nil)
+	balanceRepo.On("GetBalanceAt", mock.Anything, ownerID, vo.BRL, from).Return(int64(10000), nil)
+	balanceRepo.On("GetBalanceAt", mock.Anything, ownerID, vo.BRL, to).Return(int64(11450), nil)
+	writer := &recordingStatementWriter{}
+	useCase := usecase.NewExportStatement(statementRepo, balanceRepo, writer.factory, telemetry.NewMockTelemetry())
+
+	// Act
+	err = useCase.Execute(context.Background(), usecase.ExportStatementInput{UserID: ownerID, Format: "csv", From: from, To: to}, io.Discard)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, owner, writer.header.Owner)
+	assert.Equal(t, vo.BRL, writer.header.Currency)
+	assert.Equal(t, int64(10000), writer.header.OpeningBalance)
+	assert.Equal(t, entries, writer.entries)
+	require.NotNil(t, writer.summary)
+	assert.Equal(t, entity.StatementSummary{Entries: 2, Credits: 2500, Debits: 1050, ClosingBalance: 11450}, *writer.summary)
+	statementRepo.AssertExpectations(t)
+	balanceRepo.AssertExpectations(t)
+}
+
+func TestExportStatement_Execute_ShouldRequireAPeriod(t *testing.T) {
+	// Arrange
+	writer := &recordingStatementWriter{}
+	useCase := usecase.NewExportStatement(&mockStatementExportRepository{}, &mockStatementBalanceRepository{}, writer.factory, telemetry.NewMockTelemetry())
+
+	// Act
+	err := useCase.Execute(context.Background(), usecase.ExportStatementInput{UserID: uuid.New(), Format: "csv", From: time.Now()}, io.Discard)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrStatementPeriodRequired)
+}
+
+func TestExportStatement_Execute_ShouldRejectUnsupportedFormats(t *testing.T) {
+	// Arrange
+	writer := &recordingStatementWriter{}
+	useCase := usecase.NewExportStatement(&mockStatementExportRepository{}, &mockStatementBalanceRepository{}, writer.factory, telemetry.NewMockTelemetry())
+	to := time.Now()
+
+	// Act
+	err := useCase.Execute(context.Background(), usecase.ExportStatementInput{UserID: uuid.New(), Format: "xlsx", From: to.AddDate(0, -1, 0), To: to}, io.Discard)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrUnsupportedStatementFormat)
+}
+
+func TestExportStatement_Execute_ShouldNotWriteAnythingWhenTheUserDoesNotExist(t *testing.T) {
+	// Arrange
+	userID := uuid.New()
+	balanceRepo := &mockStatementBalanceRepository{}
+	balanceRepo.On("GetUserByID", mock.Anything, userID).Return(nil, errs.ErrUserNotFound)
+	writer := &recordingStatementWriter{}
+	useCase := usecase.NewExportStatement(&mockStatementExportRepository{}, balanceRepo, writer.factory, telemetry.NewMockTelemetry())
+	to := time.Now()
+
+	// Act
+	err := useCase.Execute(context.Background(), usecase.ExportStatementInput{UserID: userID, Format: "csv", From: to.AddDate(0, -1, 0), To: to}, io.Discard)
+
+	// Assert
+	assert.True(t, errors.Is(err, errs.ErrUserNotFound))
+	assert.Nil(t, writer.header.Owner)
+	assert.Nil(t, writer.summary)
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type GenerateChargePixCode struct {
+	chargeRepository ChargeRepository
+	userRepository   UserRepository
+	locationURL      string
+	merchantCity     string
+	otel             telemetry.Telemetry
+}
+
+// Execute returns the dynamic PIX code of a pending charge in BRL, pointing at
+// the charge so it can only be paid once.
+func (gc *GenerateChargePixCode) Execute(ctx context.Context, chargeID uuid.UUID) (*vo.PixCode, error) {
+	ctx, span := gc.otel.Start(ctx, "GenerateChargePixCode")
+	defer span.End()
+
+	charge, err := gc.chargeRepository.GetCharge(ctx, chargeID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !charge.IsPending() {
+		return nil, errs.ErrChargeNotPending
+	}
+	if charge.Currency() != vo.BRL {
+		return nil, errs.ErrPixCurrencyNotSupported
+	}
+
+	merchant, err := gc.userRepository.GetUserByID(ctx, uuid.MustParse(charge.MerchantID()))
+	if err != nil {
+		return nil, err
+	}
+
+	return vo.NewDynamicPixCode(chargePixURL(gc.locationURL, charge.ID()), merchant.Name(), gc.merchantCity, charge.Amount())
+}
+
+func chargePixURL(locationURL, chargeID string) string {
+	return strings.TrimSuffix(locationURL, "/") + "/" + chargeID
+}
+
+func NewGenerateChargePixCode(
+	chargeRepository ChargeRepository,
+	userRepository UserRepository,
+	locationURL string,
+	merchantCity string,
+	otel telemetry.Telemetry,
+) *GenerateChargePixCode {
+	return &GenerateChargePixCode{
+		chargeRepository: chargeRepository,
+		userRepository:   userRepository,
+		locationURL:      locationURL,
+		merchantCity:     merchantCity,
+		otel:             otel,
+	}
+}
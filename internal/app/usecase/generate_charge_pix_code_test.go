@@ -0,0 +1,96 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPixLocationURL = "pix.example.com/v1/charges"
+
+func TestGenerateChargePixCode_Execute_ShouldPointAtTheCharge(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	merchant := NewUser(vo.MerchantUserType)
+	charge := newPendingCharge(t, merchant, 4990)
+
+	mockChargeRepo := &mockChargeRepository{}
+	mockChargeRepo.On("GetCharge", ctx, charge.ID()).Return(charge, nil)
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, uuid.MustParse(merchant.ID())).Return(merchant, nil)
+
+	useCase := usecase.NewGenerateChargePixCode(mockChargeRepo, mockUserRepo, testPixLocationURL, "SAO PAULO", telemetry.NewMockTelemetry())
+
+	// Act
+	code, err := useCase.Execute(ctx, uuid.MustParse(charge.ID()))
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, code.IsDynamic())
+	assert.Equal(t, testPixLocationURL+"/"+charge.ID(), code.URL())
+	assert.Equal(t, int64(4990), code.Amount())
+	assert.Equal(t, "SAO PAULO", code.MerchantCity())
+}
+
+func TestGenerateChargePixCode_Execute_ShouldRejectChargesThatCannotBePaid(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	merchant := NewUser(vo.MerchantUserType)
+	cancelled := newPendingCharge(t, merchant, 4990)
+	require.NoError(t, cancelled.Cancel(cancelled.CreatedAt()))
+	usdAmount, err := vo.NewMoney(4990, vo.USD)
+	require.NoError(t, err)
+	inUSD, err := entity.NewCharge(merchant.ID(), "order-43", "", usdAmount, cancelled.ExpiresAt(), cancelled.CreatedAt())
+	require.NoError(t, err)
+
+	mockChargeRepo := &mockChargeRepository{}
+	mockChargeRepo.On("GetCharge", ctx, cancelled.ID()).Return(cancelled, nil)
+	mockChargeRepo.On("GetCharge", ctx, inUSD.ID()).Return(inUSD, nil)
+
+	useCase := usecase.NewGenerateChargePixCode(mockChargeRepo, &mockUserRepository{}, testPixLocationURL, "SAO PAULO", telemetry.NewMockTelemetry())
+
+	// Act
+	_, cancelledErr := useCase.Execute(ctx, uuid.MustParse(cancelled.ID()))
+	_, usdErr := useCase.Execute(ctx, uuid.MustParse(inUSD.ID()))
+
+	// Assert
+	assert.ErrorIs(t, cancelledErr, errs.ErrChargeNotPending)
+	assert.ErrorIs(t, usdErr, errs.ErrPixCurrencyNotSupported)
+}
+
+func TestGenerateStaticPixCode_Execute_ShouldUseTheMerchantIDAsKey(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	merchant := NewUser(vo.MerchantUserType)
+	user := NewUser(vo.CommonUserType)
+
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, uuid.MustParse(merchant.ID())).Return(merchant, nil)
+	mockUserRepo.On("GetUserByID", ctx, uuid.MustParse(user.ID())).Return(user, nil)
+
+	useCase := usecase.NewGenerateStaticPixCode(mockUserRepo, "SAO PAULO", telemetry.NewMockTelemetry())
+
+	// Act
+	code, err := useCase.Execute(ctx, usecase.GenerateStaticPixCodeInput{
+		MerchantID: uuid.MustParse(merchant.ID()),
+		Amount:     1000,
+		Reference:  "CAIXA1",
+	})
+	_, userErr := useCase.Execute(ctx, usecase.GenerateStaticPixCodeInput{MerchantID: uuid.MustParse(user.ID())})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, code.IsDynamic())
+	assert.Equal(t, merchant.ID(), code.Key())
+	assert.Equal(t, int64(1000), code.Amount())
+	assert.Equal(t, "CAIXA1", code.Reference())
+	assert.ErrorIs(t, userErr, errs.ErrOnlyMerchantsCanCharge)
+}
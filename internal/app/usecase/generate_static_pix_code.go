@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type GenerateStaticPixCode struct {
+	userRepository UserRepository
+	merchantCity   string
+	otel           telemetry.Telemetry
+}
+
+type GenerateStaticPixCodeInput struct {
+	MerchantID uuid.UUID
+	// Amount in cents of BRL to pay, zero to let the payer choose it
+	Amount int64
+	// Reference identifies the payments made with the code, at most 25
+	// letters or digits.
+	Reference   string
+	Description string
+}
+
+// Execute returns a static PIX code paying the merchant, e.g. to be printed
+// at a point of sale. The key of the code is the merchant's id.
+func (gs *GenerateStaticPixCode) Execute(ctx context.Context, input GenerateStaticPixCodeInput) (*vo.PixCode, error) {
+	ctx, span := gs.otel.Start(ctx, "GenerateStaticPixCode")
+	defer span.End()
+
+	merchant, err := gs.userRepository.GetUserByID(ctx, input.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+	if !merchant.IsMerchant() {
+		return nil, errs.ErrOnlyMerchantsCanCharge
+	}
+
+	return vo.NewStaticPixCode(merchant.ID(), merchant.Name(), gs.merchantCity, input.Amount, input.Reference, input.Description)
+}
+
+func NewGenerateStaticPixCode(
+	userRepository UserRepository,
+	merchantCity string,
+	otel telemetry.Telemetry,
+) *GenerateStaticPixCode {
+	return &GenerateStaticPixCode{
+		userRepository: userRepository,
+		merchantCity:   merchantCity,
+		otel:           otel,
+	}
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type BalanceRepository interface {
+	GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	// GetBalancesAt derives the balances of the user in every currency from
+	// the ledger entries posted before at.
+	GetBalancesAt(ctx context.Context, userID uuid.UUID, at time.Time) ([]*vo.Money, error)
+}
+
+type GetBalance struct {
+	balanceRepository BalanceRepository
+	otel              telemetry.Telemetry
+}
+
+type GetBalanceInput struct {
+	UserID uuid.UUID
+	// At asks for the balance as of a past moment, the current balance when
+	// zero
+	At time.Time
+}
+
+// UserBalances are the balances of a user in each currency. At is zero for
+// the current balances.
+type UserBalances struct {
+	UserID   string
+	At       time.Time
+	Balances []UserBalance
+}
+
+// UserBalance is the balance of a user in a currency, in cents. Ledger is
+// what was posted to the user, Held what balance holds reserve out of it and
+// Available what can be spent. Held and Available are only known for the
+// current balance.
+type UserBalance struct {
+	Currency  string
+	Ledger    int64
+	Held      int64
+	Available int64
+}
+
+// Execute returns the current balances of the user, or the ones derived from
+// its ledger as of the moment asked for.
+func (gb *GetBalance) Execute(ctx context.Context, input GetBalanceInput) (*UserBalances, error) {
+	ctx, span := gb.otel.Start(ctx, "GetBalance")
+	defer span.End()
+
+	if input.At.After(time.Now()) {
+		return nil, errs.ErrBalanceTimeInFuture
+	}
+
+	user, err := gb.balanceRepository.GetUserByID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UserBalances{UserID: user.ID(), At: input.At}
+	if input.At.IsZero() {
+		for _, balance := range user.Balances() {
+			result.Balances = append(result.Balances, UserBalance{
+				Currency:  balance.Currency(),
+				Ledger:    balance.Value(),
+				Held:      user.HeldIn(balance.Currency()),
+				Available: user.AvailableBalanceIn(balance.Currency()),
+			})
+		}
+		return result, nil
+	}
+
+	balances, err := gb.balanceRepository.GetBalancesAt(ctx, input.UserID, input.At)
+	if err != nil {
+		return nil, err
+	}
+	for _, balance := range balances {
+		result.Balances = append(result.Balances, UserBalance{Currency: balance.Currency(), Ledger: balance.Value()})
+	}
+	return result, nil
+}
+
+func NewGetBalance(
+	balanceRepository BalanceRepository,
+	otel telemetry.Telemetry,
+) *GetBalance {
+	return &GetBalance{
+		balanceRepository: balanceRepository,
+		otel:              otel,
+	}
+}
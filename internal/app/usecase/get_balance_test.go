@@ -0,0 +1,107 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBalanceRepository struct {
+	mock.Mock
+}
+
+func (m *mockBalanceRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	args := m.Called(ctx, id)
+	user, _ := args.Get(0).(*entity.User)
+	return user, args.Error(1)
+}
+
+func (m *mockBalanceRepository) GetBalancesAt(ctx context.Context, userID uuid.UUID, at time.Time) ([]*vo.Money, error) {
+	args := m.Called(ctx, userID, at)
+	balances, _ := args.Get(0).([]*vo.Money)
+	return balances, args.Error(1)
+}
+
+func TestGetBalance_Execute_ShouldReturnTheCurrentLedgerHeldAndAvailableBalances(t *testing.T) {
+	// Arrange
+	user, err := entity.NewUser("Fulano de Tal", "fulano@example.com", "password123", "52998224725", "", vo.CommonUserType)
+	require.NoError(t, err)
+	require.NoError(t, user.Deposit(10000))
+	require.NoError(t, user.Hold(vo.BRL, 2500))
+	userID := uuid.MustParse(user.ID())
+	mockRepo := &mockBalanceRepository{}
+	mockRepo.On("GetUserByID", mock.Anything, userID).Return(user, nil)
+	useCase := usecase.NewGetBalance(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	balances, err := useCase.Execute(context.Background(), usecase.GetBalanceInput{UserID: userID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, balances.At.IsZero())
+	assert.Equal(t, []usecase.UserBalance{{Currency: vo.BRL, Ledger: 10000, Held: 2500, Available: 7500}}, balances.Balances)
+	mockRepo.AssertNotCalled(t, "GetBalancesAt", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetBalance_Execute_ShouldDeriveThePastBalancesFromTheLedger(t *testing.T) {
+	// Arrange
+	user, err := entity.NewUser("Fulano de Tal", "fulano@example.com", "password123", "52998224725", "", vo.CommonUserType)
+	require.NoError(t, err)
+	userID := uuid.MustParse(user.ID())
+	at := time.Now().Add(-24 * time.Hour)
+	brl, err := vo.NewMoney(4200, vo.BRL)
+	require.NoError(t, err)
+	usd, err := vo.NewMoney(150, vo.USD)
+	require.NoError(t, err)
+	mockRepo := &mockBalanceRepository{}
+	mockRepo.On("GetUserByID", mock.Anything, userID).Return(user, nil)
+	mockRepo.On("GetBalancesAt", mock.Anything, userID, at).Return([]*vo.Money{brl, usd}, nil)
+	useCase := usecase.NewGetBalance(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	balances, err := useCase.Execute(context.Background(), usecase.GetBalanceInput{UserID: userID, At: at})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, at, balances.At)
+	assert.Equal(t, []usecase.UserBalance{{Currency: vo.BRL, Ledger: 4200}, {Currency: vo.USD, Ledger: 150}}, balances.Balances)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetBalance_Execute_ShouldRejectMomentsInTheFuture(t *testing.T) {
+	// Arrange
+	mockRepo := &mockBalanceRepository{}
+	useCase := usecase.NewGetBalance(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(context.Background(), usecase.GetBalanceInput{UserID: uuid.New(), At: time.Now().Add(time.Hour)})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrBalanceTimeInFuture)
+	mockRepo.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+}
+
+func TestGetBalance_Execute_ShouldFailWhenTheUserDoesNotExist(t *testing.T) {
+	// Arrange
+	userID := uuid.New()
+	mockRepo := &mockBalanceRepository{}
+	mockRepo.On("GetUserByID", mock.Anything, userID).Return(nil, errs.ErrUserNotFound)
+	useCase := usecase.NewGetBalance(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(context.Background(), usecase.GetBalanceInput{UserID: userID, At: time.Now().Add(-time.Hour)})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+	mockRepo.AssertNotCalled(t, "GetBalancesAt", mock.Anything, mock.Anything, mock.Anything)
+}
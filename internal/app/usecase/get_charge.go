@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type GetCharge struct {
+	chargeRepository ChargeRepository
+	otel             telemetry.Telemetry
+}
+
+func (gc *GetCharge) Execute(ctx context.Context, id uuid.UUID) (*entity.Charge, error) {
+	ctx, span := gc.otel.Start(ctx, "GetCharge")
+	defer span.End()
+
+	return gc.chargeRepository.GetCharge(ctx, id.String())
+}
+
+func NewGetCharge(
+	chargeRepository ChargeRepository,
+	otel telemetry.Telemetry,
+) *GetCharge {
+	return &GetCharge{
+		chargeRepository: chargeRepository,
+		otel:             otel,
+	}
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type GetDispute struct {
+	disputeRepository DisputeRepository
+	otel              telemetry.Telemetry
+}
+
+func (gd *GetDispute) Execute(ctx context.Context, id uuid.UUID) (*entity.Dispute, error) {
+	ctx, span := gd.otel.Start(ctx, "GetDispute")
+	defer span.End()
+
+	return gd.disputeRepository.GetDispute(ctx, id.String())
+}
+
+func NewGetDispute(
+	disputeRepository DisputeRepository,
+	otel telemetry.Telemetry,
+) *GetDispute {
+	return &GetDispute{
+		disputeRepository: disputeRepository,
+		otel:              otel,
+	}
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type GetEscrow struct {
+	escrowRepository EscrowRepository
+	otel             telemetry.Telemetry
+}
+
+func (ge *GetEscrow) Execute(ctx context.Context, id uuid.UUID) (*entity.Escrow, error) {
+	ctx, span := ge.otel.Start(ctx, "GetEscrow")
+	defer span.End()
+
+	return ge.escrowRepository.GetEscrow(ctx, id.String())
+}
+
+func NewGetEscrow(
+	escrowRepository EscrowRepository,
+	otel telemetry.Telemetry,
+) *GetEscrow {
+	return &GetEscrow{
+		escrowRepository: escrowRepository,
+		otel:             otel,
+	}
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type GetHold struct {
+	balanceHoldRepository BalanceHoldRepository
+	otel                  telemetry.Telemetry
+}
+
+func (gh *GetHold) Execute(ctx context.Context, id uuid.UUID) (*entity.BalanceHold, error) {
+	ctx, span := gh.otel.Start(ctx, "GetHold")
+	defer span.End()
+
+	return gh.balanceHoldRepository.GetBalanceHold(ctx, id.String())
+}
+
+func NewGetHold(
+	balanceHoldRepository BalanceHoldRepository,
+	otel telemetry.Telemetry,
+) *GetHold {
+	return &GetHold{
+		balanceHoldRepository: balanceHoldRepository,
+		otel:                  otel,
+	}
+}
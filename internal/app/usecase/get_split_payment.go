@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type GetSplitPayment struct {
+	splitPaymentRepository SplitPaymentRepository
+	otel                   telemetry.Telemetry
+}
+
+func (gs *GetSplitPayment) Execute(ctx context.Context, id uuid.UUID) (*entity.SplitPayment, error) {
+	ctx, span := gs.otel.Start(ctx, "GetSplitPayment")
+	defer span.End()
+
+	return gs.splitPaymentRepository.GetSplitPayment(ctx, id.String())
+}
+
+func NewGetSplitPayment(
+	splitPaymentRepository SplitPaymentRepository,
+	otel telemetry.Telemetry,
+) *GetSplitPayment {
+	return &GetSplitPayment{
+		splitPaymentRepository: splitPaymentRepository,
+		otel:                   otel,
+	}
+}
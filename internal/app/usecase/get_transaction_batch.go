@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type GetTransactionBatch struct {
+	transactionBatchRepository TransactionBatchRepository
+	otel                       telemetry.Telemetry
+}
+
+func (gb *GetTransactionBatch) Execute(ctx context.Context, id uuid.UUID) (*entity.TransactionBatch, error) {
+	ctx, span := gb.otel.Start(ctx, "GetTransactionBatch")
+	defer span.End()
+
+	return gb.transactionBatchRepository.GetTransactionBatch(ctx, id.String())
+}
+
+func NewGetTransactionBatch(
+	transactionBatchRepository TransactionBatchRepository,
+	otel telemetry.Telemetry,
+) *GetTransactionBatch {
+	return &GetTransactionBatch{
+		transactionBatchRepository: transactionBatchRepository,
+		otel:                       otel,
+	}
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ListAliasKeys struct {
+	aliasKeyRepository AliasKeyRepository
+	otel               telemetry.Telemetry
+}
+
+// Execute returns every key the user registered.
+func (lk *ListAliasKeys) Execute(ctx context.Context, userID uuid.UUID) ([]*entity.AliasKey, error) {
+	ctx, span := lk.otel.Start(ctx, "ListAliasKeys")
+	defer span.End()
+
+	return lk.aliasKeyRepository.ListByUser(ctx, userID.String())
+}
+
+func NewListAliasKeys(
+	aliasKeyRepository AliasKeyRepository,
+	otel telemetry.Telemetry,
+) *ListAliasKeys {
+	return &ListAliasKeys{
+		aliasKeyRepository: aliasKeyRepository,
+		otel:               otel,
+	}
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ListDisputes struct {
+	disputeRepository DisputeRepository
+	otel              telemetry.Telemetry
+}
+
+// Execute lists the disputes a user opened as a sender or has to answer as a
+// receiver, from the most recent.
+func (ld *ListDisputes) Execute(ctx context.Context, userID uuid.UUID) ([]*entity.Dispute, error) {
+	ctx, span := ld.otel.Start(ctx, "ListDisputes")
+	defer span.End()
+
+	return ld.disputeRepository.ListUserDisputes(ctx, userID.String())
+}
+
+func NewListDisputes(
+	disputeRepository DisputeRepository,
+	otel telemetry.Telemetry,
+) *ListDisputes {
+	return &ListDisputes{
+		disputeRepository: disputeRepository,
+		otel:              otel,
+	}
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ListMandateExecutions struct {
+	mandateRepository MandateRepository
+	otel              telemetry.Telemetry
+}
+
+// Execute returns the executions of the mandate, successful or not.
+func (le *ListMandateExecutions) Execute(ctx context.Context, mandateID uuid.UUID) ([]*entity.MandateExecution, error) {
+	ctx, span := le.otel.Start(ctx, "ListMandateExecutions")
+	defer span.End()
+
+	return le.mandateRepository.ListExecutions(ctx, mandateID.String())
+}
+
+func NewListMandateExecutions(
+	mandateRepository MandateRepository,
+	otel telemetry.Telemetry,
+) *ListMandateExecutions {
+	return &ListMandateExecutions{
+		mandateRepository: mandateRepository,
+		otel:              otel,
+	}
+}
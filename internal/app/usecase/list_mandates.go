@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ListMandates struct {
+	mandateRepository MandateRepository
+	otel              telemetry.Telemetry
+}
+
+// Execute returns every mandate of the user, whatever its status.
+func (lm *ListMandates) Execute(ctx context.Context, senderID uuid.UUID) ([]*entity.Mandate, error) {
+	ctx, span := lm.otel.Start(ctx, "ListMandates")
+	defer span.End()
+
+	return lm.mandateRepository.ListBySender(ctx, senderID.String())
+}
+
+func NewListMandates(
+	mandateRepository MandateRepository,
+	otel telemetry.Telemetry,
+) *ListMandates {
+	return &ListMandates{
+		mandateRepository: mandateRepository,
+		otel:              otel,
+	}
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ListScheduledTransfers struct {
+	scheduledTransferRepository ScheduledTransferRepository
+	otel                        telemetry.Telemetry
+}
+
+// Execute returns every transfer scheduled by the user, whatever its status.
+func (ls *ListScheduledTransfers) Execute(ctx context.Context, senderID uuid.UUID) ([]*entity.ScheduledTransfer, error) {
+	ctx, span := ls.otel.Start(ctx, "ListScheduledTransfers")
+	defer span.End()
+
+	return ls.scheduledTransferRepository.ListBySender(ctx, senderID.String())
+}
+
+func NewListScheduledTransfers(
+	scheduledTransferRepository ScheduledTransferRepository,
+	otel telemetry.Telemetry,
+) *ListScheduledTransfers {
+	return &ListScheduledTransfers{
+		scheduledTransferRepository: scheduledTransferRepository,
+		otel:                        otel,
+	}
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ListSettlementTransactions struct {
+	settlementRepository SettlementRepository
+	otel                 telemetry.Telemetry
+}
+
+// Execute lists the transfers grouped in a settlement, from the oldest, so
+// merchants can reconcile its totals with their own records.
+func (lt *ListSettlementTransactions) Execute(ctx context.Context, settlementID uuid.UUID) ([]*entity.Transaction, error) {
+	ctx, span := lt.otel.Start(ctx, "ListSettlementTransactions")
+	defer span.End()
+
+	return lt.settlementRepository.ListSettlementTransactions(ctx, settlementID.String())
+}
+
+func NewListSettlementTransactions(
+	settlementRepository SettlementRepository,
+	otel telemetry.Telemetry,
+) *ListSettlementTransactions {
+	return &ListSettlementTransactions{
+		settlementRepository: settlementRepository,
+		otel:                 otel,
+	}
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ListSettlements struct {
+	userRepository       UserRepository
+	settlementRepository SettlementRepository
+	otel                 telemetry.Telemetry
+}
+
+type ListSettlementsInput struct {
+	MerchantID uuid.UUID
+	// Status keeps the settlements in it, all of them when empty
+	Status string
+}
+
+// Execute lists the daily settlements of a merchant, from the most recent.
+func (ls *ListSettlements) Execute(ctx context.Context, input ListSettlementsInput) ([]*entity.Settlement, error) {
+	ctx, span := ls.otel.Start(ctx, "ListSettlements")
+	defer span.End()
+
+	switch input.Status {
+	case "", entity.SettlementOpenStatus, entity.SettlementClosedStatus, entity.SettlementPaidStatus:
+	default:
+		return nil, errs.ErrInvalidSettlementStatus
+	}
+
+	merchant, err := ls.userRepository.GetUserByID(ctx, input.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+	if !merchant.IsMerchant() {
+		return nil, errs.ErrOnlyMerchantsHaveSettlements
+	}
+
+	return ls.settlementRepository.ListMerchantSettlements(ctx, merchant.ID(), input.Status)
+}
+
+func NewListSettlements(
+	userRepository UserRepository,
+	settlementRepository SettlementRepository,
+	otel telemetry.Telemetry,
+) *ListSettlements {
+	return &ListSettlements{
+		userRepository:       userRepository,
+		settlementRepository: settlementRepository,
+		otel:                 otel,
+	}
+}
@@ -0,0 +1,71 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSettlements_Execute_ShouldListTheMerchantSettlementsInTheStatus(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	merchant := NewUser(vo.MerchantUserType)
+	merchantID := uuid.MustParse(merchant.ID())
+	settlement := entity.NewSettlement(merchant.ID(), vo.BRL, time.Now(), time.Now())
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, merchantID).Return(merchant, nil)
+	mockRepo := &mockSettlementRepository{}
+	mockRepo.On("ListMerchantSettlements", ctx, merchant.ID(), entity.SettlementOpenStatus).Return([]*entity.Settlement{settlement}, nil)
+
+	useCase := usecase.NewListSettlements(mockUserRepo, mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	settlements, err := useCase.Execute(ctx, usecase.ListSettlementsInput{MerchantID: merchantID, Status: entity.SettlementOpenStatus})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.Settlement{settlement}, settlements)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListSettlements_Execute_ShouldRejectUsersThatAreNotMerchants(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	user := NewUser(vo.CommonUserType)
+	userID := uuid.MustParse(user.ID())
+	mockUserRepo := &mockUserRepository{}
+	mockUserRepo.On("GetUserByID", ctx, userID).Return(user, nil)
+	mockRepo := &mockSettlementRepository{}
+
+	useCase := usecase.NewListSettlements(mockUserRepo, mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx, usecase.ListSettlementsInput{MerchantID: userID})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrOnlyMerchantsHaveSettlements)
+	mockRepo.AssertNotCalled(t, "ListMerchantSettlements", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListSettlements_Execute_ShouldRejectUnknownStatuses(t *testing.T) {
+	// Arrange
+	mockUserRepo := &mockUserRepository{}
+	useCase := usecase.NewListSettlements(mockUserRepo, &mockSettlementRepository{}, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(context.Background(), usecase.ListSettlementsInput{MerchantID: uuid.New(), Status: "pending"})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrInvalidSettlementStatus)
+	mockUserRepo.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type StatementRepository interface {
+	// ListStatement returns up to limit entries of the statement of the user
+	// matching the filter, from the most recent, starting after the cursor
+	// when one is given.
+	ListStatement(ctx context.Context, userID string, filter entity.StatementFilter, after *entity.StatementCursor, limit int) ([]*entity.StatementEntry, error)
+}
+
+type ListTransactions struct {
+	statementRepository StatementRepository
+	pageSize            int
+	maxPageSize         int
+	otel                telemetry.Telemetry
+}
+
+type ListTransactionsInput struct {
+	UserID uuid.UUID
+	Filter entity.StatementFilter
+	// Cursor is the NextCursor of the previous page, empty for the first one
+	Cursor string
+	// Limit is how many transactions to list, the default page size when zero
+	// and at most the maximum page size
+	Limit int
+}
+
+// StatementPage is a page of the statement of a user. NextCursor is empty on
+// the last page.
+type StatementPage struct {
+	Entries    []*entity.StatementEntry
+	NextCursor string
+}
+
+// Execute lists the transactions sent and received by the user, from the
+// most recent, along with the balance of the user after each of them.
+func (lt *ListTransactions) Execute(ctx context.Context, input ListTransactionsInput) (*StatementPage, error) {
+	ctx, span := lt.otel.Start(ctx, "ListTransactions")
+	defer span.End()
+
+	err := input.Filter.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var after *entity.StatementCursor
+	if input.Cursor != "" {
+		after, err = entity.ParseStatementCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = lt.pageSize
+	}
+	limit = min(limit, lt.maxPageSize)
+
+	// One more entry tells whether there is a next page
+	entries, err := lt.statementRepository.ListStatement(ctx, input.UserID.String(), input.Filter, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &StatementPage{Entries: entries}
+	if len(entries) > limit {
+		page.Entries = entries[:limit]
+		page.NextCursor = page.Entries[limit-1].Cursor().Encode()
+	}
+	return page, nil
+}
+
+func NewListTransactions(
+	statementRepository StatementRepository,
+	pageSize int,
+	maxPageSize int,
+	otel telemetry.Telemetry,
+) *ListTransactions {
+	return &ListTransactions{
+		statementRepository: statementRepository,
+		pageSize:            pageSize,
+		maxPageSize:         maxPageSize,
+		otel:                otel,
+	}
+}
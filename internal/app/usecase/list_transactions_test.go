@@ -0,0 +1,114 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStatementRepository struct {
+	mock.Mock
+}
+
+func (m *mockStatementRepository) ListStatement(ctx context.Context, userID string, filter entity.StatementFilter, after *entity.StatementCursor, limit int) ([]*entity.StatementEntry, error) {
+	args := m.Called(ctx, userID, filter, after, limit)
+	entries, _ := args.Get(0).([]*entity.StatementEntry)
+	return entries, args.Error(1)
+}
+
+func newStatementEntries(t *testing.T, userID string, count int) []*entity.StatementEntry {
+	t.Helper()
+	entries := make([]*entity.StatementEntry, 0, count)
+	for range count {
+		transaction, err := entity.NewTransaction(1000, userID, uuid.NewString())
+		require.NoError(t, err)
+		entries = append(entries, entity.NewStatementEntry(transaction, userID, 0))
+	}
+	return entries
+}
+
+func TestListTransactions_Execute_ShouldReturnTheCursorOfTheLastEntryWhenThereIsANextPage(t *testing.T) {
+	// Arrange
+	userID := uuid.New()
+	entries := newStatementEntries(t, userID.String(), 3)
+	mockRepo := &mockStatementRepository{}
+	mockRepo.On("ListStatement", mock.Anything, userID.String(), entity.StatementFilter{}, (*entity.StatementCursor)(nil), 3).Return(entries, nil)
+	useCase := usecase.NewListTransactions(mockRepo, 50, 200, telemetry.NewMockTelemetry())
+
+	// Act
+	page, err := useCase.Execute(context.Background(), usecase.ListTransactionsInput{UserID: userID, Limit: 2})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, page.Entries, 2)
+	assert.Equal(t, entries[1].Cursor().Encode(), page.NextCursor)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListTransactions_Execute_ShouldNotReturnACursorOnTheLastPage(t *testing.T) {
+	// Arrange
+	userID := uuid.New()
+	entries := newStatementEntries(t, userID.String(), 2)
+	mockRepo := &mockStatementRepository{}
+	mockRepo.On("ListStatement", mock.Anything, userID.String(), mock.Anything, mock.Anything, 51).Return(entries, nil)
+	useCase := usecase.NewListTransactions(mockRepo, 50, 200, telemetry.NewMockTelemetry())
+
+	// Act
+	page, err := useCase.Execute(context.Background(), usecase.ListTransactionsInput{UserID: userID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, page.Entries, 2)
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestListTransactions_Execute_ShouldContinueAfterTheCursor(t *testing.T) {
+	// Arrange
+	userID := uuid.New()
+	cursor := newStatementEntries(t, userID.String(), 1)[0].Cursor()
+	mockRepo := &mockStatementRepository{}
+	mockRepo.On(
+		"ListStatement",
+		mock.Anything,
+		userID.String(),
+		mock.Anything,
+		mock.MatchedBy(func(after *entity.StatementCursor) bool {
+			return after.TransactionID == cursor.TransactionID && after.CreatedAt.Equal(cursor.CreatedAt)
+		}),
+		201,
+	).Return(nil, nil)
+	useCase := usecase.NewListTransactions(mockRepo, 50, 200, telemetry.NewMockTelemetry())
+
+	// Act
+	page, err := useCase.Execute(context.Background(), usecase.ListTransactionsInput{UserID: userID, Cursor: cursor.Encode(), Limit: 1000})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, page.Entries)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListTransactions_Execute_ShouldRejectInvalidFilters(t *testing.T) {
+	// Arrange
+	mockRepo := &mockStatementRepository{}
+	useCase := usecase.NewListTransactions(mockRepo, 50, 200, telemetry.NewMockTelemetry())
+
+	// Act
+	page, err := useCase.Execute(context.Background(), usecase.ListTransactionsInput{
+		UserID: uuid.New(),
+		Filter: entity.StatementFilter{Direction: "both"},
+	})
+
+	// Assert
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, errs.ErrInvalidStatementDirection)
+	mockRepo.AssertNotCalled(t, "ListStatement")
+}
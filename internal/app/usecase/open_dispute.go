@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type DisputeRepository interface {
+	Open(ctx context.Context, transactionID string, openFn func(transaction *entity.Transaction, refundedAmount int64, receiver *entity.User) (*entity.Dispute, error)) error
+	GetDispute(ctx context.Context, id string) (*entity.Dispute, error)
+	ListUserDisputes(ctx context.Context, userID string) ([]*entity.Dispute, error)
+	Respond(ctx context.Context, id string, respondFn func(dispute *entity.Dispute) error) error
+	Resolve(ctx context.Context, id string, resolveFn func(dispute *entity.Dispute, transaction *entity.Transaction, refundedAmount int64, receiver, sender *entity.User) (*entity.Transaction, error)) error
+	ResolveOverdue(ctx context.Context, now time.Time, limit int, resolveFn func(dispute *entity.Dispute, transaction *entity.Transaction, refundedAmount int64, receiver, sender *entity.User) (*entity.Transaction, error)) (int, error)
+}
+
+type OpenDispute struct {
+	disputeRepository DisputeRepository
+	responseWindow    time.Duration
+	otel              telemetry.Telemetry
+}
+
+type OpenDisputeInput struct {
+	TransactionID uuid.UUID
+	SenderID      uuid.UUID
+	Reason        string
+	Evidence      string
+}
+
+// Execute opens a dispute of the sender over a transfer and freezes the
+// disputed amount on the receiver, who can respond during the response
+// window. A receiver who already spent part of the amount gets what is left
+// frozen.
+func (od *OpenDispute) Execute(ctx context.Context, input OpenDisputeInput) (*entity.Dispute, error) {
+	ctx, span := od.otel.Start(ctx, "OpenDispute")
+	defer span.End()
+
+	var opened *entity.Dispute
+	err := od.disputeRepository.Open(ctx, input.TransactionID.String(), func(transaction *entity.Transaction, refundedAmount int64, receiver *entity.User) (*entity.Dispute, error) {
+		dispute, err := entity.NewDispute(transaction, input.SenderID.String(), refundedAmount, input.Reason, input.Evidence, od.responseWindow, time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		err = dispute.FreezeOn(receiver)
+		if err != nil {
+			return nil, err
+		}
+
+		dispute.RecordEvent(event.NewDisputeOpenedEventV1(
+			dispute.ID(),
+			dispute.TransactionID(),
+			uuid.MustParse(dispute.SenderID()),
+			uuid.MustParse(dispute.ReceiverID()),
+			event.Amount{InCents: dispute.Amount(), Currency: dispute.Currency()},
+			dispute.Reason(),
+			dispute.Status(),
+			dispute.RespondBy(),
+		))
+		opened = dispute
+		return dispute, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return opened, nil
+}
+
+func NewOpenDispute(
+	disputeRepository DisputeRepository,
+	responseWindow time.Duration,
+	otel telemetry.Telemetry,
+) *OpenDispute {
+	return &OpenDispute{
+		disputeRepository: disputeRepository,
+		responseWindow:    responseWindow,
+		otel:              otel,
+	}
+}
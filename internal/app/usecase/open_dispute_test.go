@@ -0,0 +1,191 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	openDisputeFnType    = "func(*entity.Transaction, int64, *entity.User) (*entity.Dispute, error)"
+	respondDisputeFnType = "func(*entity.Dispute) error"
+	resolveDisputeFnType = "func(*entity.Dispute, *entity.Transaction, int64, *entity.User, *entity.User) (*entity.Transaction, error)"
+)
+
+type mockDisputeRepository struct {
+	mock.Mock
+}
+
+func (m *mockDisputeRepository) Open(ctx context.Context, transactionID string, openFn func(transaction *entity.Transaction, refundedAmount int64, receiver *entity.User) (*entity.Dispute, error)) error {
+	args := m.Called(ctx, transactionID, openFn)
+	return args.Error(0)
+}
+
+func (m *mockDisputeRepository) GetDispute(ctx context.Context, id string) (*entity.Dispute, error) {
+	args := m.Called(ctx, id)
+	dispute, _ := args.Get(0).(*entity.Dispute)
+	return dispute, args.Error(1)
+}
+
+func (m *mockDisputeRepository) ListUserDisputes(ctx context.Context, userID string) ([]*entity.Dispute, error) {
+	args := m.Called(ctx, userID)
+	disputes, _ := args.Get(0).([]*entity.Dispute)
+	return disputes, args.Error(1)
+}
+
+func (m *mockDisputeRepository) Respond(ctx context.Context, id string, respondFn func(dispute *entity.Dispute) error) error {
+	args := m.Called(ctx, id, respondFn)
+	return args.Error(0)
+}
+
+func (m *mockDisputeRepository) Resolve(ctx context.Context, id string, resolveFn func(dispute *entity.Dispute, transaction *entity.Transaction, refundedAmount int64, receiver, sender *entity.User) (*entity.Transaction, error)) error {
+	args := m.Called(ctx, id, resolveFn)
+	return args.Error(0)
+}
+
+func (m *mockDisputeRepository) ResolveOverdue(ctx context.Context, now time.Time, limit int, resolveFn func(dispute *entity.Dispute, transaction *entity.Transaction, refundedAmount int64, receiver, sender *entity.User) (*entity.Transaction, error)) (int, error) {
+	args := m.Called(ctx, now, limit, resolveFn)
+	return args.Int(0), args.Error(1)
+}
+
+// newDisputedTransfer moves amount from a new sender to a new merchant.
+func newDisputedTransfer(t *testing.T, amount int64) (*entity.Transaction, *entity.User, *entity.User) {
+	sender := NewUser(vo.CommonUserType)
+	merchant := NewUser(vo.MerchantUserType)
+	require.NoError(t, merchant.Deposit(amount))
+	transaction, err := entity.NewTransaction(amount, sender.ID(), merchant.ID())
+	require.NoError(t, err)
+	return transaction, sender, merchant
+}
+
+func TestOpenDispute_Execute_ShouldFreezeTheDisputedAmountOnTheReceiver(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	transaction, sender, merchant := newDisputedTransfer(t, 10000)
+	mockRepo := &mockDisputeRepository{}
+	mockRepo.On("Open", ctx, transaction.ID(), mock.AnythingOfType(openDisputeFnType)).
+		Run(func(args mock.Arguments) {
+			openFn := args.Get(2).(func(*entity.Transaction, int64, *entity.User) (*entity.Dispute, error))
+			_, err := openFn(transaction, 2000, merchant)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewOpenDispute(mockRepo, 48*time.Hour, telemetry.NewMockTelemetry())
+
+	// Act
+	dispute, err := useCase.Execute(ctx, usecase.OpenDisputeInput{
+		TransactionID: uuid.MustParse(transaction.ID()),
+		SenderID:      uuid.MustParse(sender.ID()),
+		Reason:        "not delivered",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(8000), dispute.Amount())
+	assert.Equal(t, int64(8000), merchant.HeldIn(vo.BRL))
+	assert.Equal(t, int64(2000), merchant.AvailableBalanceIn(vo.BRL))
+	require.Len(t, dispute.Events(), 1)
+	assert.Equal(t, "DisputeOpenedEventV1", dispute.Events()[0].Name())
+}
+
+func TestOpenDispute_Execute_ShouldFreezeWhatIsLeftWhenTheReceiverSpentTheFunds(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	transaction, sender, merchant := newDisputedTransfer(t, 10000)
+	require.NoError(t, merchant.Withdraw(7000))
+	mockRepo := &mockDisputeRepository{}
+	mockRepo.On("Open", ctx, transaction.ID(), mock.AnythingOfType(openDisputeFnType)).
+		Run(func(args mock.Arguments) {
+			openFn := args.Get(2).(func(*entity.Transaction, int64, *entity.User) (*entity.Dispute, error))
+			_, err := openFn(transaction, 0, merchant)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewOpenDispute(mockRepo, 48*time.Hour, telemetry.NewMockTelemetry())
+
+	// Act
+	dispute, err := useCase.Execute(ctx, usecase.OpenDisputeInput{
+		TransactionID: uuid.MustParse(transaction.ID()),
+		SenderID:      uuid.MustParse(sender.ID()),
+		Reason:        "not delivered",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(10000), dispute.Amount())
+	assert.Equal(t, int64(3000), dispute.FrozenAmount())
+	assert.Equal(t, int64(7000), dispute.Shortfall())
+	assert.Equal(t, int64(3000), merchant.HeldIn(vo.BRL))
+	assert.Equal(t, int64(0), merchant.AvailableBalanceIn(vo.BRL))
+}
+
+func TestOpenDispute_Execute_ShouldOpenWhenTheReceiverSpentEverything(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	transaction, sender, merchant := newDisputedTransfer(t, 10000)
+	require.NoError(t, merchant.Withdraw(10000))
+	mockRepo := &mockDisputeRepository{}
+	mockRepo.On("Open", ctx, transaction.ID(), mock.AnythingOfType(openDisputeFnType)).
+		Run(func(args mock.Arguments) {
+			openFn := args.Get(2).(func(*entity.Transaction, int64, *entity.User) (*entity.Dispute, error))
+			_, err := openFn(transaction, 0, merchant)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewOpenDispute(mockRepo, 48*time.Hour, telemetry.NewMockTelemetry())
+
+	// Act
+	dispute, err := useCase.Execute(ctx, usecase.OpenDisputeInput{
+		TransactionID: uuid.MustParse(transaction.ID()),
+		SenderID:      uuid.MustParse(sender.ID()),
+		Reason:        "not delivered",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), dispute.FrozenAmount())
+	assert.Equal(t, int64(10000), dispute.Shortfall())
+	assert.Equal(t, int64(0), merchant.HeldIn(vo.BRL))
+}
+
+func TestRespondDispute_Execute_ShouldRecordTheReceiverResponse(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	transaction, sender, merchant := newDisputedTransfer(t, 10000)
+	dispute, err := entity.NewDispute(transaction, sender.ID(), 0, "not delivered", "", time.Hour, time.Now())
+	require.NoError(t, err)
+	mockRepo := &mockDisputeRepository{}
+	mockRepo.On("Respond", ctx, dispute.ID(), mock.AnythingOfType(respondDisputeFnType)).
+		Run(func(args mock.Arguments) {
+			respondFn := args.Get(2).(func(*entity.Dispute) error)
+			require.NoError(t, respondFn(dispute))
+		}).
+		Return(nil)
+
+	useCase := usecase.NewRespondDispute(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	responded, err := useCase.Execute(ctx, usecase.RespondDisputeInput{
+		DisputeID:  uuid.MustParse(dispute.ID()),
+		ReceiverID: uuid.MustParse(merchant.ID()),
+		Response:   "delivered, tracking BR123",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.DisputeRespondedStatus, responded.Status())
+	require.Len(t, responded.Events(), 1)
+	assert.Equal(t, "DisputeRespondedEventV1", responded.Events()[0].Name())
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type PayCharge struct {
+	chargeRepository      ChargeRepository
+	transactionAuthorizer TransactionAuthorizerGateway
+	transferLimits        vo.TransferLimitPolicy
+	fees                  vo.FeePolicy
+	otel                  telemetry.Telemetry
+}
+
+type PayChargeInput struct {
+	ChargeID uuid.UUID
+	PayerID  uuid.UUID
+}
+
+// Execute pays the charge with a transfer from the payer to the merchant,
+// under the same rules as any other transfer, and links the transfer to the
+// charge.
+func (pc *PayCharge) Execute(ctx context.Context, input PayChargeInput) (*entity.Charge, error) {
+	ctx, span := pc.otel.Start(ctx, "PayCharge")
+	defer span.End()
+
+	if !pc.transactionAuthorizer.IsTransactionAllowed(ctx) {
+		return nil, errs.ErrTransactionNotAllowed
+	}
+
+	var paid *entity.Charge
+	err := pc.chargeRepository.Pay(ctx, input.ChargeID.String(), input.PayerID.String(), func(charge *entity.Charge, payer, merchant *entity.User) (*entity.Transaction, error) {
+		err := charge.Pay(payer.ID(), time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		exchangeRate, err := vo.NewIdentityExchangeRate(charge.Currency())
+		if err != nil {
+			return nil, err
+		}
+		transaction, err := transfer(payer, merchant, charge.Money(), exchangeRate, pc.transferLimits, pc.fees)
+		if err != nil {
+			return nil, err
+		}
+		charge.AttachTransaction(transaction.ID())
+
+		charge.RecordEvent(event.NewChargePaidEventV1(
+			charge.ID(),
+			uuid.MustParse(merchant.ID()),
+			charge.Reference(),
+			event.Amount{InCents: charge.Amount(), Currency: charge.Currency()},
+			charge.Status(),
+			input.PayerID,
+			transaction.ID(),
+		))
+		paid = charge
+		return transaction, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paid, nil
+}
+
+func NewPayCharge(
+	chargeRepository ChargeRepository,
+	transactionAuthorizer TransactionAuthorizerGateway,
+	transferLimits vo.TransferLimitPolicy,
+	fees vo.FeePolicy,
+	otel telemetry.Telemetry,
+) *PayCharge {
+	return &PayCharge{
+		chargeRepository:      chargeRepository,
+		transactionAuthorizer: transactionAuthorizer,
+		transferLimits:        transferLimits,
+		fees:                  fees,
+		otel:                  otel,
+	}
+}
@@ -0,0 +1,108 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayCharge_Execute_ShouldTransferAmountToMerchantAndLinkTransaction(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	payer := NewUser(vo.CommonUserType)
+	require.NoError(t, payer.Deposit(10000))
+	merchant := NewUser(vo.MerchantUserType)
+	charge := newPendingCharge(t, merchant, 4990)
+
+	var transaction *entity.Transaction
+	mockRepo := &mockChargeRepository{}
+	mockRepo.On("Pay", ctx, charge.ID(), payer.ID(), mock.AnythingOfType(payChargeFnType)).
+		Run(func(args mock.Arguments) {
+			payFn := args.Get(3).(func(*entity.Charge, *entity.User, *entity.User) (*entity.Transaction, error))
+			var err error
+			transaction, err = payFn(charge, payer, merchant)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+
+	useCase := usecase.NewPayCharge(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	paid, err := useCase.Execute(ctx, usecase.PayChargeInput{
+		ChargeID: uuid.MustParse(charge.ID()),
+		PayerID:  uuid.MustParse(payer.ID()),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.ChargePaidStatus, paid.Status())
+	assert.Equal(t, transaction.ID(), paid.TransactionID())
+	assert.Equal(t, payer.ID(), paid.PayerID())
+	assert.Equal(t, int64(5010), payer.Balance())
+	assert.Equal(t, int64(4990), merchant.Balance())
+	require.Len(t, paid.Events(), 1)
+	assert.Equal(t, "ChargePaidEventV1", paid.Events()[0].Name())
+	require.Len(t, transaction.Events(), 1)
+}
+
+func TestPayCharge_Execute_ShouldApplyTransferRules(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	payer := NewUser(vo.MerchantUserType)
+	require.NoError(t, payer.Deposit(10000))
+	merchant := NewUser(vo.MerchantUserType)
+	charge := newPendingCharge(t, merchant, 4990)
+
+	mockRepo := &mockChargeRepository{}
+	mockRepo.On("Pay", ctx, charge.ID(), payer.ID(), mock.AnythingOfType(payChargeFnType)).
+		Run(func(args mock.Arguments) {
+			payFn := args.Get(3).(func(*entity.Charge, *entity.User, *entity.User) (*entity.Transaction, error))
+			_, err := payFn(charge, payer, merchant)
+			assert.ErrorIs(t, err, errs.ErrMerchantCannotSendMoney)
+		}).
+		Return(errs.ErrMerchantCannotSendMoney)
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(true)
+
+	useCase := usecase.NewPayCharge(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	paid, err := useCase.Execute(ctx, usecase.PayChargeInput{
+		ChargeID: uuid.MustParse(charge.ID()),
+		PayerID:  uuid.MustParse(payer.ID()),
+	})
+
+	// Assert
+	assert.Nil(t, paid)
+	assert.ErrorIs(t, err, errs.ErrMerchantCannotSendMoney)
+	assert.Equal(t, int64(10000), payer.Balance())
+}
+
+func TestPayCharge_Execute_ShouldReturnErrorWhenNotAllowedByAuthorizer(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := &mockChargeRepository{}
+	mockAuthorizer := &mockTransactionAuthorizerGateway{}
+	mockAuthorizer.On("IsTransactionAllowed", ctx).Return(false)
+
+	useCase := usecase.NewPayCharge(mockRepo, mockAuthorizer, vo.TransferLimitPolicy{}, vo.FeePolicy{}, telemetry.NewMockTelemetry())
+
+	// Act
+	paid, err := useCase.Execute(ctx, usecase.PayChargeInput{ChargeID: uuid.New(), PayerID: uuid.New()})
+
+	// Assert
+	assert.Nil(t, paid)
+	assert.ErrorIs(t, err, errs.ErrTransactionNotAllowed)
+	mockRepo.AssertNotCalled(t, "Pay", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
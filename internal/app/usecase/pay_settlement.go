@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type PaySettlement struct {
+	settlementRepository SettlementRepository
+	otel                 telemetry.Telemetry
+}
+
+// Execute records that a closed settlement was paid out to its merchant.
+func (ps *PaySettlement) Execute(ctx context.Context, id uuid.UUID) error {
+	ctx, span := ps.otel.Start(ctx, "PaySettlement")
+	defer span.End()
+
+	return ps.settlementRepository.MarkPaid(ctx, id.String(), func(settlement *entity.Settlement) error {
+		err := settlement.MarkPaid(time.Now())
+		if err != nil {
+			return err
+		}
+
+		settlement.RecordEvent(event.NewSettlementPaidEventV1(
+			settlement.ID(),
+			uuid.MustParse(settlement.MerchantID()),
+			settlement.Date(),
+			settlement.Currency(),
+			settlement.Gross(),
+			settlement.Fee(),
+			settlement.TransactionCount(),
+			settlement.Status(),
+		))
+		return nil
+	})
+}
+
+func NewPaySettlement(
+	settlementRepository SettlementRepository,
+	otel telemetry.Telemetry,
+) *PaySettlement {
+	return &PaySettlement{
+		settlementRepository: settlementRepository,
+		otel:                 otel,
+	}
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/metrics"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type ReconciliationRepository interface {
+	ListBalanceChecks(ctx context.Context) ([]*entity.BalanceCheck, error)
+}
+
+type DriftReportWriter interface {
+	Write(ctx context.Context, report *entity.ReconciliationReport) (string, error)
+}
+
+type ReconcileBalances struct {
+	reconciliationRepository ReconciliationRepository
+	driftReportWriter        DriftReportWriter
+	otel                     telemetry.Telemetry
+}
+
+// Execute compares every cached balance with the balance expected from its
+// history, exports the drift as Prometheus gauges and writes the drift
+// report. It returns the report along with where it was written.
+func (rb *ReconcileBalances) Execute(ctx context.Context) (*entity.ReconciliationReport, string, error) {
+	ctx, span := rb.otel.Start(ctx, "ReconcileBalances")
+	defer span.End()
+
+	checks, err := rb.reconciliationRepository.ListBalanceChecks(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	report := entity.NewReconciliationReport(checks, time.Now())
+
+	for _, currency := range report.Currencies() {
+		metrics.BalanceDriftAccounts.WithLabelValues(currency).Set(float64(report.DriftedAccounts(currency)))
+		metrics.BalanceDriftAmount.WithLabelValues(currency).Set(float64(report.TotalDrift(currency)) / 100)
+	}
+
+	path, err := rb.driftReportWriter.Write(ctx, report)
+	if err != nil {
+		return nil, "", err
+	}
+
+	span.SetAttributes(
+		attribute.Int("reconciliation.accounts_checked", report.AccountsChecked()),
+		attribute.Int("reconciliation.drifted_accounts", len(report.Drifts())),
+	)
+	return report, path, nil
+}
+
+func NewReconcileBalances(
+	reconciliationRepository ReconciliationRepository,
+	driftReportWriter DriftReportWriter,
+	otel telemetry.Telemetry,
+) *ReconcileBalances {
+	return &ReconcileBalances{
+		reconciliationRepository: reconciliationRepository,
+		driftReportWriter:        driftReportWriter,
+		otel:                     otel,
+	}
+}
@@ -0,0 +1,90 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/metrics"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileBalances_Execute_ShouldReportDriftsAndExportGauges(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := &mockReconciliationRepository{}
+	mockRepo.On("ListBalanceChecks", ctx).Return([]*entity.BalanceCheck{
+		entity.NewBalanceCheck("user-1", vo.BRL, 10000, 10000),
+		entity.NewBalanceCheck("user-2", vo.BRL, 9999, 10050),
+		entity.NewBalanceCheck("user-2", vo.USD, 500, 500),
+	}, nil)
+
+	var written *entity.ReconciliationReport
+	mockWriter := &mockDriftReportWriter{}
+	mockWriter.On("Write", ctx, mock.AnythingOfType("*entity.ReconciliationReport")).
+		Run(func(args mock.Arguments) {
+			written = args.Get(1).(*entity.ReconciliationReport)
+		}).
+		Return("reports/balance-drift.json", nil)
+
+	useCase := usecase.NewReconcileBalances(mockRepo, mockWriter, telemetry.NewMockTelemetry())
+
+	// Act
+	report, path, err := useCase.Execute(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "reports/balance-drift.json", path)
+	assert.Same(t, report, written)
+	assert.Equal(t, 3, report.AccountsChecked())
+	require.Len(t, report.Drifts(), 1)
+	assert.Equal(t, "user-2", report.Drifts()[0].UserID())
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.BalanceDriftAccounts.WithLabelValues(vo.BRL)))
+	assert.Equal(t, 0.51, testutil.ToFloat64(metrics.BalanceDriftAmount.WithLabelValues(vo.BRL)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.BalanceDriftAccounts.WithLabelValues(vo.USD)))
+}
+
+func TestReconcileBalances_Execute_ShouldReturnErrorWhenBalancesCannotBeLoaded(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	expectedErr := errors.New("database unavailable")
+	mockRepo := &mockReconciliationRepository{}
+	mockRepo.On("ListBalanceChecks", ctx).Return(nil, expectedErr)
+	mockWriter := &mockDriftReportWriter{}
+
+	useCase := usecase.NewReconcileBalances(mockRepo, mockWriter, telemetry.NewMockTelemetry())
+
+	// Act
+	report, _, err := useCase.Execute(ctx)
+
+	// Assert
+	assert.Nil(t, report)
+	assert.ErrorIs(t, err, expectedErr)
+	mockWriter.AssertNotCalled(t, "Write", mock.Anything, mock.Anything)
+}
+
+type mockReconciliationRepository struct {
+	mock.Mock
+}
+
+func (m *mockReconciliationRepository) ListBalanceChecks(ctx context.Context) ([]*entity.BalanceCheck, error) {
+	args := m.Called(ctx)
+	checks, _ := args.Get(0).([]*entity.BalanceCheck)
+	return checks, args.Error(1)
+}
+
+type mockDriftReportWriter struct {
+	mock.Mock
+}
+
+func (m *mockDriftReportWriter) Write(ctx context.Context, report *entity.ReconciliationReport) (string, error) {
+	args := m.Called(ctx, report)
+	return args.String(0), args.Error(1)
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type TransactionRepository interface {
+	Refund(ctx context.Context, transactionID string, refundFn func(original *entity.Transaction, refundedAmount int64, payer, payee *entity.User) (*entity.Transaction, error)) error
+}
+
+type RefundTransaction struct {
+	transactionRepository TransactionRepository
+	otel                  telemetry.Telemetry
+}
+
+type RefundTransactionInput struct {
+	TransactionID uuid.UUID
+	// Amount to refund in cents of the currency the transaction was received
+	// in. Zero refunds everything not refunded yet.
+	Amount int64
+}
+
+// Execute refunds a completed transfer, fully or partially. Unlike regular
+// transfers, refunds are allowed for merchants since they are the ones giving
+// the money back.
+func (rt *RefundTransaction) Execute(ctx context.Context, input RefundTransactionInput) (string, error) {
+	ctx, span := rt.otel.Start(ctx, "RefundTransaction")
+	defer span.End()
+
+	var refundID string
+
+	err := rt.transactionRepository.Refund(ctx, input.TransactionID.String(), func(original *entity.Transaction, refundedAmount int64, payer, payee *entity.User) (*entity.Transaction, error) {
+		refund, err := entity.NewRefundTransaction(original, input.Amount, refundedAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		err = payer.WithdrawIn(refund.Currency(), refund.Amount())
+		if err != nil {
+			return nil, err
+		}
+
+		err = payee.DepositIn(refund.ReceivedCurrency(), refund.ReceivedAmount())
+		if err != nil {
+			return nil, err
+		}
+
+		refundID = refund.ID()
+
+		refund.RecordEvent(event.NewRefundTransactionEventV1(
+			refundID,
+			original.ID(),
+			event.Amount{InCents: refund.Amount(), Currency: refund.Currency()},
+			event.Amount{InCents: refund.ReceivedAmount(), Currency: refund.ReceivedCurrency()},
+			uuid.MustParse(payer.ID()),
+			uuid.MustParse(payee.ID()),
+		))
+
+		return refund, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return refundID, nil
+}
+
+func NewRefundTransaction(
+	transactionRepository TransactionRepository,
+	otel telemetry.Telemetry,
+) *RefundTransaction {
+	return &RefundTransaction{
+		transactionRepository: transactionRepository,
+		otel:                  otel,
+	}
+}
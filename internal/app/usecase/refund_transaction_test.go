@@ -0,0 +1,147 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const refundFnType = "func(*entity.Transaction, int64, *entity.User, *entity.User) (*entity.Transaction, error)"
+
+func TestRefundTransaction_Execute_ShouldAllowMerchantToRefundTheWholeTransaction(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTransactionRepo := &mockTransactionRepository{}
+
+	customer := NewUser(vo.CommonUserType)
+	merchant := NewUser(vo.MerchantUserType)
+	require.NoError(t, merchant.Deposit(15000))
+	original, err := entity.NewTransaction(10000, customer.ID(), merchant.ID())
+	require.NoError(t, err)
+
+	var capturedRefund *entity.Transaction
+	mockTransactionRepo.On("Refund", ctx, original.ID(), mock.AnythingOfType(refundFnType)).
+		Run(func(args mock.Arguments) {
+			refundFn := args.Get(2).(func(*entity.Transaction, int64, *entity.User, *entity.User) (*entity.Transaction, error))
+			capturedRefund, err = refundFn(original, 0, merchant, customer)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewRefundTransaction(mockTransactionRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	refundID, err := useCase.Execute(ctx, usecase.RefundTransactionInput{
+		TransactionID: uuid.MustParse(original.ID()),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, capturedRefund.ID(), refundID)
+	assert.Equal(t, int64(10000), capturedRefund.Amount())
+	assert.Equal(t, int64(5000), merchant.Balance())
+	assert.Equal(t, int64(10000), customer.Balance())
+	require.Len(t, capturedRefund.Events(), 1)
+	assert.Equal(t, "RefundTransactionEventV1", capturedRefund.Events()[0].Name())
+}
+
+func TestRefundTransaction_Execute_ShouldRefundOnlyTheRequestedAmount(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTransactionRepo := &mockTransactionRepository{}
+
+	customer := NewUser(vo.CommonUserType)
+	merchant := NewUser(vo.MerchantUserType)
+	require.NoError(t, merchant.Deposit(10000))
+	original, err := entity.NewTransaction(10000, customer.ID(), merchant.ID())
+	require.NoError(t, err)
+
+	mockTransactionRepo.On("Refund", ctx, original.ID(), mock.AnythingOfType(refundFnType)).
+		Run(func(args mock.Arguments) {
+			refundFn := args.Get(2).(func(*entity.Transaction, int64, *entity.User, *entity.User) (*entity.Transaction, error))
+			_, err = refundFn(original, 2000, merchant, customer)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewRefundTransaction(mockTransactionRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err = useCase.Execute(ctx, usecase.RefundTransactionInput{
+		TransactionID: uuid.MustParse(original.ID()),
+		Amount:        2550,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7450), merchant.Balance())
+	assert.Equal(t, int64(2550), customer.Balance())
+}
+
+func TestRefundTransaction_Execute_ShouldReturnErrorWhenPayerHasInsufficientBalance(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTransactionRepo := &mockTransactionRepository{}
+
+	customer := NewUser(vo.CommonUserType)
+	merchant := NewUser(vo.MerchantUserType)
+	original, err := entity.NewTransaction(10000, customer.ID(), merchant.ID())
+	require.NoError(t, err)
+
+	mockTransactionRepo.On("Refund", ctx, original.ID(), mock.AnythingOfType(refundFnType)).
+		Run(func(args mock.Arguments) {
+			refundFn := args.Get(2).(func(*entity.Transaction, int64, *entity.User, *entity.User) (*entity.Transaction, error))
+			_, err := refundFn(original, 0, merchant, customer)
+			assert.ErrorIs(t, err, errs.ErrInsufficientBalance)
+		}).
+		Return(errs.ErrInsufficientBalance)
+
+	useCase := usecase.NewRefundTransaction(mockTransactionRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	refundID, err := useCase.Execute(ctx, usecase.RefundTransactionInput{
+		TransactionID: uuid.MustParse(original.ID()),
+	})
+
+	// Assert
+	assert.Empty(t, refundID)
+	assert.ErrorIs(t, err, errs.ErrInsufficientBalance)
+}
+
+func TestRefundTransaction_Execute_ShouldPropagateErrorsFromRepositoryLayer(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTransactionRepo := &mockTransactionRepository{}
+	transactionID := uuid.New()
+	expectedError := errors.New("database connection error")
+
+	mockTransactionRepo.On("Refund", ctx, transactionID.String(), mock.AnythingOfType(refundFnType)).Return(expectedError)
+
+	useCase := usecase.NewRefundTransaction(mockTransactionRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	refundID, err := useCase.Execute(ctx, usecase.RefundTransactionInput{TransactionID: transactionID})
+
+	// Assert
+	assert.Empty(t, refundID)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+type mockTransactionRepository struct {
+	mock.Mock
+}
+
+func (m *mockTransactionRepository) Refund(ctx context.Context, transactionID string, refundFn func(original *entity.Transaction, refundedAmount int64, payer, payee *entity.User) (*entity.Transaction, error)) error {
+	args := m.Called(ctx, transactionID, refundFn)
+	return args.Error(0)
+}
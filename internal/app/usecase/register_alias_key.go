@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type AliasKeyRepository interface {
+	Create(ctx context.Context, userID string, createFn func(owner *entity.User) (*entity.AliasKey, error)) error
+	ListByUser(ctx context.Context, userID string) ([]*entity.AliasKey, error)
+	GetByValue(ctx context.Context, keyType, value string) (*entity.AliasKey, error)
+	Delete(ctx context.Context, userID, id string) error
+}
+
+type RegisterAliasKey struct {
+	aliasKeyRepository AliasKeyRepository
+	otel               telemetry.Telemetry
+}
+
+type RegisterAliasKeyInput struct {
+	UserID uuid.UUID
+	// Type is one of the entity alias key types, e.g.
+	// entity.EmailAliasKeyType
+	Type string
+	// Value of the key, ignored for random keys as the wallet generates them
+	Value string
+}
+
+// Execute registers a key in the directory so others can send money to the
+// user by it.
+func (rk *RegisterAliasKey) Execute(ctx context.Context, input RegisterAliasKeyInput) (*entity.AliasKey, error) {
+	ctx, span := rk.otel.Start(ctx, "RegisterAliasKey")
+	defer span.End()
+
+	var key *entity.AliasKey
+	err := rk.aliasKeyRepository.Create(ctx, input.UserID.String(), func(owner *entity.User) (*entity.AliasKey, error) {
+		var err error
+		key, err = entity.NewAliasKey(owner, input.Type, input.Value, time.Now())
+		return key, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func NewRegisterAliasKey(
+	aliasKeyRepository AliasKeyRepository,
+	otel telemetry.Telemetry,
+) *RegisterAliasKey {
+	return &RegisterAliasKey{
+		aliasKeyRepository: aliasKeyRepository,
+		otel:               otel,
+	}
+}
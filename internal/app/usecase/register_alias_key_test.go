@@ -0,0 +1,128 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const createAliasKeyFnType = "func(*entity.User) (*entity.AliasKey, error)"
+
+func TestRegisterAliasKey_Execute_ShouldRegisterTheKeyOfTheOwner(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	owner := NewUser(vo.CommonUserType)
+
+	mockRepo := &mockAliasKeyRepository{}
+	mockRepo.On("Create", ctx, owner.ID(), mock.AnythingOfType(createAliasKeyFnType)).
+		Run(func(args mock.Arguments) {
+			createFn := args.Get(2).(func(*entity.User) (*entity.AliasKey, error))
+			_, err := createFn(owner)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewRegisterAliasKey(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	key, err := useCase.Execute(ctx, usecase.RegisterAliasKeyInput{
+		UserID: uuid.MustParse(owner.ID()),
+		Type:   entity.PhoneAliasKeyType,
+		Value:  "+55 11 98765-4321",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, owner.ID(), key.UserID())
+	assert.Equal(t, "+5511987654321", key.Value())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRegisterAliasKey_Execute_ShouldReturnErrorWhenKeyIsTaken(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	owner := NewUser(vo.CommonUserType)
+
+	mockRepo := &mockAliasKeyRepository{}
+	mockRepo.On("Create", ctx, owner.ID(), mock.AnythingOfType(createAliasKeyFnType)).Return(errs.ErrAliasKeyTaken)
+
+	useCase := usecase.NewRegisterAliasKey(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	key, err := useCase.Execute(ctx, usecase.RegisterAliasKeyInput{
+		UserID: uuid.MustParse(owner.ID()),
+		Type:   entity.EmailAliasKeyType,
+		Value:  "fulano@example.com",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrAliasKeyTaken)
+	assert.Nil(t, key)
+}
+
+func TestResolveAliasKey_Execute_ShouldReturnTheOwnerOfTheKey(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	owner := NewUser(vo.CommonUserType)
+	key, err := entity.NewAliasKey(owner, entity.EmailAliasKeyType, "fulano@example.com", owner.CreatedAt())
+	require.NoError(t, err)
+
+	mockRepo := &mockAliasKeyRepository{}
+	mockRepo.On("GetByValue", ctx, entity.EmailAliasKeyType, "fulano@example.com").Return(key, nil)
+
+	useCase := usecase.NewResolveAliasKey(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	userID, err := useCase.Execute(ctx, " Fulano@Example.com")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, owner.ID(), userID.String())
+}
+
+func TestResolveAliasKey_Execute_ShouldRejectKeysThatCannotBeParsed(t *testing.T) {
+	// Arrange
+	useCase := usecase.NewResolveAliasKey(&mockAliasKeyRepository{}, telemetry.NewMockTelemetry())
+
+	// Act
+	userID, err := useCase.Execute(context.Background(), "fulano")
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrInvalidAliasKey)
+	assert.Equal(t, uuid.Nil, userID)
+}
+
+type mockAliasKeyRepository struct {
+	mock.Mock
+}
+
+func (m *mockAliasKeyRepository) Create(ctx context.Context, userID string, createFn func(owner *entity.User) (*entity.AliasKey, error)) error {
+	args := m.Called(ctx, userID, createFn)
+	return args.Error(0)
+}
+
+func (m *mockAliasKeyRepository) ListByUser(ctx context.Context, userID string) ([]*entity.AliasKey, error) {
+	args := m.Called(ctx, userID)
+	keys, _ := args.Get(0).([]*entity.AliasKey)
+	return keys, args.Error(1)
+}
+
+func (m *mockAliasKeyRepository) GetByValue(ctx context.Context, keyType, value string) (*entity.AliasKey, error) {
+	args := m.Called(ctx, keyType, value)
+	key, _ := args.Get(0).(*entity.AliasKey)
+	return key, args.Error(1)
+}
+
+func (m *mockAliasKeyRepository) Delete(ctx context.Context, userID, id string) error {
+	args := m.Called(ctx, userID, id)
+	return args.Error(0)
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type PayoutDestinationRepository interface {
+	Save(ctx context.Context, destination *entity.PayoutDestination) error
+	GetPayoutDestination(ctx context.Context, id string) (*entity.PayoutDestination, error)
+}
+
+type RegisterPayoutDestination struct {
+	payoutDestinationRepository PayoutDestinationRepository
+	otel                        telemetry.Telemetry
+}
+
+type RegisterPayoutDestinationInput struct {
+	UserID uuid.UUID
+	// Kind is either entity.BankAccountDestinationKind or
+	// entity.PixKeyDestinationKind
+	Kind          string
+	BankCode      string
+	Branch        string
+	AccountNumber string
+	PixKey        string
+}
+
+func (rp *RegisterPayoutDestination) Execute(ctx context.Context, input RegisterPayoutDestinationInput) (string, error) {
+	ctx, span := rp.otel.Start(ctx, "RegisterPayoutDestination")
+	defer span.End()
+
+	var destination *entity.PayoutDestination
+	var err error
+	switch input.Kind {
+	case entity.BankAccountDestinationKind:
+		destination, err = entity.NewBankAccountDestination(input.UserID.String(), input.BankCode, input.Branch, input.AccountNumber)
+	case entity.PixKeyDestinationKind:
+		destination, err = entity.NewPixKeyDestination(input.UserID.String(), input.PixKey)
+	default:
+		err = errs.ErrInvalidPayoutDestinationKind
+	}
+	if err != nil {
+		return "", err
+	}
+
+	err = rp.payoutDestinationRepository.Save(ctx, destination)
+	if err != nil {
+		return "", err
+	}
+
+	return destination.ID(), nil
+}
+
+func NewRegisterPayoutDestination(
+	payoutDestinationRepository PayoutDestinationRepository,
+	otel telemetry.Telemetry,
+) *RegisterPayoutDestination {
+	return &RegisterPayoutDestination{
+		payoutDestinationRepository: payoutDestinationRepository,
+		otel:                        otel,
+	}
+}
@@ -0,0 +1,78 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRegisterPayoutDestination_Execute_ShouldSaveBankAccount(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	mockDestinationRepo := &mockPayoutDestinationRepository{}
+	mockDestinationRepo.On("Save", ctx, mock.MatchedBy(func(d *entity.PayoutDestination) bool {
+		return d.UserID() == userID.String() && d.Kind() == entity.BankAccountDestinationKind && d.AccountNumber() == "12345-6"
+	})).Return(nil)
+
+	useCase := usecase.NewRegisterPayoutDestination(mockDestinationRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	destinationID, err := useCase.Execute(ctx, usecase.RegisterPayoutDestinationInput{
+		UserID:        userID,
+		Kind:          entity.BankAccountDestinationKind,
+		BankCode:      "341",
+		Branch:        "0001",
+		AccountNumber: "12345-6",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotEmpty(t, destinationID)
+	mockDestinationRepo.AssertExpectations(t)
+}
+
+func TestRegisterPayoutDestination_Execute_ShouldSavePixKey(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockDestinationRepo := &mockPayoutDestinationRepository{}
+	mockDestinationRepo.On("Save", ctx, mock.MatchedBy(func(d *entity.PayoutDestination) bool {
+		return d.Kind() == entity.PixKeyDestinationKind && d.PixKey() == "+5511999999999"
+	})).Return(nil)
+
+	useCase := usecase.NewRegisterPayoutDestination(mockDestinationRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx, usecase.RegisterPayoutDestinationInput{
+		UserID: uuid.New(),
+		Kind:   entity.PixKeyDestinationKind,
+		PixKey: "+5511999999999",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	mockDestinationRepo.AssertExpectations(t)
+}
+
+func TestRegisterPayoutDestination_Execute_ShouldReturnErrorWhenKindIsUnknown(t *testing.T) {
+	// Arrange
+	mockDestinationRepo := &mockPayoutDestinationRepository{}
+	useCase := usecase.NewRegisterPayoutDestination(mockDestinationRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(context.Background(), usecase.RegisterPayoutDestinationInput{
+		UserID: uuid.New(),
+		Kind:   "card",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrInvalidPayoutDestinationKind)
+	mockDestinationRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
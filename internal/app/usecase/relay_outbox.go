@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+)
+
+type Queue interface {
+	Send(ctx context.Context, message []byte) error
+}
+
+type OutboxRepository interface {
+	ProcessPending(ctx context.Context, limit int, processFn func(message *entity.OutboxMessage)) (int, error)
+}
+
+type RelayOutbox struct {
+	outboxRepository OutboxRepository
+	queue            Queue
+	batchSize        int
+	otel             telemetry.Telemetry
+}
+
+// Execute publishes a batch of pending outbox messages. Messages that fail to
+// be published are retried later with a backoff. It returns how many messages
+// were processed so callers can drain the outbox.
+func (ro *RelayOutbox) Execute(ctx context.Context) (int, error) {
+	ctx, span := ro.otel.Start(ctx, "RelayOutbox")
+	defer span.End()
+
+	return ro.outboxRepository.ProcessPending(ctx, ro.batchSize, func(message *entity.OutboxMessage) {
+		err := ro.queue.Send(ctx, message.Payload())
+		if err != nil {
+			message.MarkFailed(err, time.Now())
+			return
+		}
+		message.MarkSent(time.Now())
+	})
+}
+
+func NewRelayOutbox(
+	outboxRepository OutboxRepository,
+	queue Queue,
+	batchSize int,
+	otel telemetry.Telemetry,
+) *RelayOutbox {
+	return &RelayOutbox{
+		outboxRepository: outboxRepository,
+		queue:            queue,
+		batchSize:        batchSize,
+		otel:             otel,
+	}
+}
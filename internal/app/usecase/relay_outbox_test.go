@@ -0,0 +1,107 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const processFnType = "func(*entity.OutboxMessage)"
+
+type mockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *mockOutboxRepository) ProcessPending(ctx context.Context, limit int, processFn func(message *entity.OutboxMessage)) (int, error) {
+	args := m.Called(ctx, limit, processFn)
+	return args.Int(0), args.Error(1)
+}
+
+func newOutboxMessage() *entity.OutboxMessage {
+	return entity.NewOutboxMessage(event.NewCreateTransactionEventV1(uuid.NewString(), event.Amount{InCents: 1000, Currency: "BRL"}, event.Amount{InCents: 1000, Currency: "BRL"}, event.Amount{Currency: "BRL"}, "1", uuid.New(), uuid.New()))
+}
+
+func TestRelayOutbox_Execute_ShouldMarkMessageAsSentWhenPublished(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOutboxRepo := &mockOutboxRepository{}
+	mockQueue := &mockQueue{}
+	message := newOutboxMessage()
+
+	mockOutboxRepo.On("ProcessPending", ctx, 50, mock.AnythingOfType(processFnType)).
+		Run(func(args mock.Arguments) {
+			processFn := args.Get(2).(func(*entity.OutboxMessage))
+			processFn(message)
+		}).
+		Return(1, nil)
+	mockQueue.On("Send", ctx, message.Payload()).Return(nil)
+
+	useCase := usecase.NewRelayOutbox(mockOutboxRepo, mockQueue, 50, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, entity.OutboxSentStatus, message.Status())
+	assert.Equal(t, 1, message.Attempts())
+	assert.NotNil(t, message.SentAt())
+}
+
+func TestRelayOutbox_Execute_ShouldScheduleRetryWhenPublishFails(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOutboxRepo := &mockOutboxRepository{}
+	mockQueue := &mockQueue{}
+	message := newOutboxMessage()
+	firstAttemptAt := message.NextAttemptAt()
+
+	mockOutboxRepo.On("ProcessPending", ctx, 50, mock.AnythingOfType(processFnType)).
+		Run(func(args mock.Arguments) {
+			processFn := args.Get(2).(func(*entity.OutboxMessage))
+			processFn(message)
+		}).
+		Return(1, nil)
+	mockQueue.On("Send", ctx, message.Payload()).Return(errors.New("queue unavailable"))
+
+	useCase := usecase.NewRelayOutbox(mockOutboxRepo, mockQueue, 50, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, entity.OutboxPendingStatus, message.Status())
+	assert.Equal(t, "queue unavailable", message.LastError())
+	assert.True(t, message.NextAttemptAt().After(firstAttemptAt))
+	assert.Nil(t, message.SentAt())
+}
+
+func TestRelayOutbox_Execute_ShouldPropagateRepositoryErrors(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOutboxRepo := &mockOutboxRepository{}
+	mockQueue := &mockQueue{}
+	expectedErr := errors.New("database error")
+
+	mockOutboxRepo.On("ProcessPending", ctx, 50, mock.AnythingOfType(processFnType)).Return(0, expectedErr)
+
+	useCase := usecase.NewRelayOutbox(mockOutboxRepo, mockQueue, 50, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx)
+
+	// Assert
+	assert.ErrorIs(t, err, expectedErr)
+	mockQueue.AssertNotCalled(t, "Send")
+}
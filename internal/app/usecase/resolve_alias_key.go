@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ResolveAliasKey struct {
+	aliasKeyRepository AliasKeyRepository
+	otel               telemetry.Telemetry
+}
+
+// Execute returns the id of the user owning a key typed by a sender, e.g. an
+// e-mail or a phone number.
+func (rk *ResolveAliasKey) Execute(ctx context.Context, key string) (uuid.UUID, error) {
+	ctx, span := rk.otel.Start(ctx, "ResolveAliasKey")
+	defer span.End()
+
+	return resolveAliasKey(ctx, rk.aliasKeyRepository, key)
+}
+
+func resolveAliasKey(ctx context.Context, aliasKeyRepository AliasKeyRepository, key string) (uuid.UUID, error) {
+	keyType, value, err := entity.ParseAliasKey(key)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	aliasKey, err := aliasKeyRepository.GetByValue(ctx, keyType, value)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.Parse(aliasKey.UserID())
+}
+
+func NewResolveAliasKey(
+	aliasKeyRepository AliasKeyRepository,
+	otel telemetry.Telemetry,
+) *ResolveAliasKey {
+	return &ResolveAliasKey{
+		aliasKeyRepository: aliasKeyRepository,
+		otel:               otel,
+	}
+}
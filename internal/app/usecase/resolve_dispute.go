@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ResolveDispute struct {
+	disputeRepository DisputeRepository
+	otel              telemetry.Telemetry
+}
+
+type ResolveDisputeInput struct {
+	DisputeID uuid.UUID
+	// Outcome is won when the sender gets the disputed amount back, lost
+	// when the receiver keeps it
+	Outcome string
+}
+
+// Execute resolves a pending dispute, releasing the frozen amount on the
+// receiver. A won dispute gives the amount back to the sender through a
+// chargeback.
+func (rd *ResolveDispute) Execute(ctx context.Context, input ResolveDisputeInput) (*entity.Dispute, error) {
+	ctx, span := rd.otel.Start(ctx, "ResolveDispute")
+	defer span.End()
+
+	if input.Outcome != entity.DisputeWonStatus && input.Outcome != entity.DisputeLostStatus {
+		return nil, errs.ErrInvalidDisputeOutcome
+	}
+
+	var resolved *entity.Dispute
+	err := rd.disputeRepository.Resolve(ctx, input.DisputeID.String(), func(dispute *entity.Dispute, transaction *entity.Transaction, refundedAmount int64, receiver, sender *entity.User) (*entity.Transaction, error) {
+		resolved = dispute
+		return resolveDispute(dispute, transaction, refundedAmount, receiver, sender, input.Outcome == entity.DisputeWonStatus, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved, nil
+}
+
+// resolveDispute releases the amount the dispute froze on the receiver and
+// records the outcome on the dispute. When the sender won, the disputed
+// amount is moved back to it by the returned chargeback, limited to what the
+// receiver has available when the dispute froze less than the whole amount.
+// No chargeback is returned when the receiver has nothing left.
+func resolveDispute(dispute *entity.Dispute, transaction *entity.Transaction, refundedAmount int64, receiver, sender *entity.User, won bool, now time.Time) (*entity.Transaction, error) {
+	amount := event.Amount{InCents: dispute.Amount(), Currency: dispute.Currency()}
+	if !won {
+		err := dispute.Lose(now)
+		if err != nil {
+			return nil, err
+		}
+		receiver.ReleaseHold(dispute.Currency(), dispute.FrozenAmount())
+
+		dispute.RecordEvent(event.NewDisputeLostEventV1(
+			dispute.ID(),
+			dispute.TransactionID(),
+			uuid.MustParse(dispute.SenderID()),
+			uuid.MustParse(dispute.ReceiverID()),
+			amount,
+			dispute.Reason(),
+			dispute.Status(),
+			dispute.RespondBy(),
+		))
+		return nil, nil
+	}
+
+	err := dispute.Win(now)
+	if err != nil {
+		return nil, err
+	}
+	receiver.ReleaseHold(dispute.Currency(), dispute.FrozenAmount())
+
+	var chargeback *entity.Transaction
+	recoverable := min(dispute.Amount(), receiver.AvailableBalanceIn(dispute.Currency()))
+	if recoverable > 0 {
+		chargeback, err = entity.NewChargebackTransaction(transaction, recoverable, refundedAmount)
+		if err != nil {
+			return nil, err
+		}
+		err = receiver.WithdrawIn(chargeback.Currency(), chargeback.Amount())
+		if err != nil {
+			return nil, err
+		}
+		err = sender.DepositIn(chargeback.ReceivedCurrency(), chargeback.ReceivedAmount())
+		if err != nil {
+			return nil, err
+		}
+		dispute.AttachChargeback(chargeback.ID())
+	}
+
+	dispute.RecordEvent(event.NewDisputeWonEventV1(
+		dispute.ID(),
+		dispute.TransactionID(),
+		uuid.MustParse(dispute.SenderID()),
+		uuid.MustParse(dispute.ReceiverID()),
+		amount,
+		dispute.Reason(),
+		dispute.Status(),
+		dispute.RespondBy(),
+		dispute.ChargebackID(),
+	))
+	return chargeback, nil
+}
+
+func NewResolveDispute(
+	disputeRepository DisputeRepository,
+	otel telemetry.Telemetry,
+) *ResolveDispute {
+	return &ResolveDispute{
+		disputeRepository: disputeRepository,
+		otel:              otel,
+	}
+}
@@ -0,0 +1,160 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newFrozenDispute opens a dispute over a transfer of amount, freezing it on
+// the merchant that received it.
+func newFrozenDispute(t *testing.T, amount int64, window time.Duration) (*entity.Dispute, *entity.Transaction, *entity.User, *entity.User) {
+	transaction, sender, merchant := newDisputedTransfer(t, amount)
+	dispute, err := entity.NewDispute(transaction, sender.ID(), 0, "not delivered", "", window, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, dispute.FreezeOn(merchant))
+	return dispute, transaction, sender, merchant
+}
+
+func TestResolveDispute_Execute_WonShouldChargeTheAmountBack(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	dispute, transaction, sender, merchant := newFrozenDispute(t, 10000, time.Hour)
+	var chargeback *entity.Transaction
+	mockRepo := &mockDisputeRepository{}
+	mockRepo.On("Resolve", ctx, dispute.ID(), mock.AnythingOfType(resolveDisputeFnType)).
+		Run(func(args mock.Arguments) {
+			resolveFn := args.Get(2).(func(*entity.Dispute, *entity.Transaction, int64, *entity.User, *entity.User) (*entity.Transaction, error))
+			var err error
+			chargeback, err = resolveFn(dispute, transaction, 0, merchant, sender)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewResolveDispute(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	resolved, err := useCase.Execute(ctx, usecase.ResolveDisputeInput{DisputeID: uuid.MustParse(dispute.ID()), Outcome: entity.DisputeWonStatus})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.DisputeWonStatus, resolved.Status())
+	require.NotNil(t, chargeback)
+	assert.Equal(t, entity.ChargebackTransactionKind, chargeback.Kind())
+	assert.Equal(t, chargeback.ID(), resolved.ChargebackID())
+	assert.Equal(t, int64(0), merchant.BalanceIn(vo.BRL))
+	assert.Equal(t, int64(0), merchant.HeldIn(vo.BRL))
+	assert.Equal(t, int64(10000), sender.BalanceIn(vo.BRL))
+	require.Len(t, resolved.Events(), 1)
+	assert.Equal(t, "DisputeWonEventV1", resolved.Events()[0].Name())
+}
+
+func TestResolveDispute_Execute_WonShouldChargeBackWhatTheReceiverHasLeft(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	transaction, sender, merchant := newDisputedTransfer(t, 10000)
+	require.NoError(t, merchant.Withdraw(7000))
+	dispute, err := entity.NewDispute(transaction, sender.ID(), 0, "not delivered", "", time.Hour, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, dispute.FreezeOn(merchant))
+	var chargeback *entity.Transaction
+	mockRepo := &mockDisputeRepository{}
+	mockRepo.On("Resolve", ctx, dispute.ID(), mock.AnythingOfType(resolveDisputeFnType)).
+		Run(func(args mock.Arguments) {
+			resolveFn := args.Get(2).(func(*entity.Dispute, *entity.Transaction, int64, *entity.User, *entity.User) (*entity.Transaction, error))
+			var err error
+			chargeback, err = resolveFn(dispute, transaction, 0, merchant, sender)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewResolveDispute(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	resolved, err := useCase.Execute(ctx, usecase.ResolveDisputeInput{DisputeID: uuid.MustParse(dispute.ID()), Outcome: entity.DisputeWonStatus})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.DisputeWonStatus, resolved.Status())
+	require.NotNil(t, chargeback)
+	assert.Equal(t, int64(3000), chargeback.Amount())
+	assert.Equal(t, int64(0), merchant.BalanceIn(vo.BRL))
+	assert.Equal(t, int64(0), merchant.HeldIn(vo.BRL))
+	assert.Equal(t, int64(3000), sender.BalanceIn(vo.BRL))
+}
+
+func TestResolveDispute_Execute_LostShouldReleaseTheAmount(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	dispute, transaction, sender, merchant := newFrozenDispute(t, 10000, time.Hour)
+	mockRepo := &mockDisputeRepository{}
+	mockRepo.On("Resolve", ctx, dispute.ID(), mock.AnythingOfType(resolveDisputeFnType)).
+		Run(func(args mock.Arguments) {
+			resolveFn := args.Get(2).(func(*entity.Dispute, *entity.Transaction, int64, *entity.User, *entity.User) (*entity.Transaction, error))
+			chargeback, err := resolveFn(dispute, transaction, 0, merchant, sender)
+			require.NoError(t, err)
+			assert.Nil(t, chargeback)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewResolveDispute(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	resolved, err := useCase.Execute(ctx, usecase.ResolveDisputeInput{DisputeID: uuid.MustParse(dispute.ID()), Outcome: entity.DisputeLostStatus})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.DisputeLostStatus, resolved.Status())
+	assert.Empty(t, resolved.ChargebackID())
+	assert.Equal(t, int64(10000), merchant.AvailableBalanceIn(vo.BRL))
+	require.Len(t, resolved.Events(), 1)
+	assert.Equal(t, "DisputeLostEventV1", resolved.Events()[0].Name())
+}
+
+func TestResolveDispute_Execute_ShouldRejectUnknownOutcomes(t *testing.T) {
+	// Arrange
+	mockRepo := &mockDisputeRepository{}
+	useCase := usecase.NewResolveDispute(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(context.Background(), usecase.ResolveDisputeInput{DisputeID: uuid.New(), Outcome: "responded"})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrInvalidDisputeOutcome)
+	mockRepo.AssertNotCalled(t, "Resolve", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestResolveOverdueDisputes_Execute_ShouldResolveInFavorOfTheSender(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	dispute, transaction, sender, merchant := newFrozenDispute(t, 4000, time.Hour)
+	mockRepo := &mockDisputeRepository{}
+	mockRepo.On("ResolveOverdue", ctx, mock.AnythingOfType("time.Time"), 100, mock.AnythingOfType(resolveDisputeFnType)).
+		Run(func(args mock.Arguments) {
+			resolveFn := args.Get(3).(func(*entity.Dispute, *entity.Transaction, int64, *entity.User, *entity.User) (*entity.Transaction, error))
+			_, err := resolveFn(dispute, transaction, 0, merchant, sender)
+			require.NoError(t, err)
+		}).
+		Return(1, nil)
+
+	useCase := usecase.NewResolveOverdueDisputes(mockRepo, 100, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, entity.DisputeWonStatus, dispute.Status())
+	assert.Equal(t, int64(4000), sender.BalanceIn(vo.BRL))
+}
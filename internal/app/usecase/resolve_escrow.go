@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ResolveEscrow struct {
+	escrowRepository EscrowRepository
+	fees             vo.FeePolicy
+	otel             telemetry.Telemetry
+}
+
+type ResolveEscrowInput struct {
+	EscrowID uuid.UUID
+	// Outcome is released when the receiver gets the money, returned when it
+	// goes back to the sender
+	Outcome string
+}
+
+// Execute settles a pending escrow as decided by an operator, e.g. when the
+// sender and the receiver disagree on the delivery.
+func (re *ResolveEscrow) Execute(ctx context.Context, input ResolveEscrowInput) (*entity.Escrow, error) {
+	ctx, span := re.otel.Start(ctx, "ResolveEscrow")
+	defer span.End()
+
+	if input.Outcome != entity.EscrowReleasedStatus && input.Outcome != entity.EscrowReturnedStatus {
+		return nil, errs.ErrInvalidEscrowOutcome
+	}
+
+	var resolved *entity.Escrow
+	err := re.escrowRepository.Settle(ctx, input.EscrowID.String(), func(escrow *entity.Escrow, sender, receiver *entity.User) (*entity.Transaction, error) {
+		resolved = escrow
+		now := time.Now()
+		if input.Outcome == entity.EscrowReleasedStatus {
+			err := escrow.Release(now)
+			if err != nil {
+				return nil, err
+			}
+			return releaseEscrow(escrow, sender, receiver, re.fees)
+		}
+
+		err := escrow.Return(now)
+		if err != nil {
+			return nil, err
+		}
+		sender.ReleaseHold(escrow.Currency(), escrow.Amount())
+		escrow.RecordEvent(event.NewEscrowReturnedEventV1(
+			escrow.ID(),
+			uuid.MustParse(sender.ID()),
+			uuid.MustParse(receiver.ID()),
+			event.Amount{InCents: escrow.Amount(), Currency: escrow.Currency()},
+			escrow.Status(),
+		))
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved, nil
+}
+
+func NewResolveEscrow(
+	escrowRepository EscrowRepository,
+	fees vo.FeePolicy,
+	otel telemetry.Telemetry,
+) *ResolveEscrow {
+	return &ResolveEscrow{
+		escrowRepository: escrowRepository,
+		fees:             fees,
+		otel:             otel,
+	}
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+)
+
+type ResolveOverdueDisputes struct {
+	disputeRepository DisputeRepository
+	batchSize         int
+	otel              telemetry.Telemetry
+}
+
+// Execute resolves a batch of the disputes whose receiver did not respond in
+// time in favor of their senders, charging the disputed amounts back. It
+// returns how many disputes were processed so callers can drain the backlog.
+func (ro *ResolveOverdueDisputes) Execute(ctx context.Context) (int, error) {
+	ctx, span := ro.otel.Start(ctx, "ResolveOverdueDisputes")
+	defer span.End()
+
+	now := time.Now()
+	return ro.disputeRepository.ResolveOverdue(ctx, now, ro.batchSize, func(dispute *entity.Dispute, transaction *entity.Transaction, refundedAmount int64, receiver, sender *entity.User) (*entity.Transaction, error) {
+		return resolveDispute(dispute, transaction, refundedAmount, receiver, sender, true, now)
+	})
+}
+
+func NewResolveOverdueDisputes(
+	disputeRepository DisputeRepository,
+	batchSize int,
+	otel telemetry.Telemetry,
+) *ResolveOverdueDisputes {
+	return &ResolveOverdueDisputes{
+		disputeRepository: disputeRepository,
+		batchSize:         batchSize,
+		otel:              otel,
+	}
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type RespondDispute struct {
+	disputeRepository DisputeRepository
+	otel              telemetry.Telemetry
+}
+
+type RespondDisputeInput struct {
+	DisputeID  uuid.UUID
+	ReceiverID uuid.UUID
+	Response   string
+}
+
+// Execute records the side of the receiver of a disputed transfer, as long
+// as the response window is open.
+func (rd *RespondDispute) Execute(ctx context.Context, input RespondDisputeInput) (*entity.Dispute, error) {
+	ctx, span := rd.otel.Start(ctx, "RespondDispute")
+	defer span.End()
+
+	var responded *entity.Dispute
+	err := rd.disputeRepository.Respond(ctx, input.DisputeID.String(), func(dispute *entity.Dispute) error {
+		err := dispute.Respond(input.ReceiverID.String(), input.Response, time.Now())
+		if err != nil {
+			return err
+		}
+
+		dispute.RecordEvent(event.NewDisputeRespondedEventV1(
+			dispute.ID(),
+			dispute.TransactionID(),
+			uuid.MustParse(dispute.SenderID()),
+			uuid.MustParse(dispute.ReceiverID()),
+			event.Amount{InCents: dispute.Amount(), Currency: dispute.Currency()},
+			dispute.Reason(),
+			dispute.Status(),
+			dispute.RespondBy(),
+		))
+		responded = dispute
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return responded, nil
+}
+
+func NewRespondDispute(
+	disputeRepository DisputeRepository,
+	otel telemetry.Telemetry,
+) *RespondDispute {
+	return &RespondDispute{
+		disputeRepository: disputeRepository,
+		otel:              otel,
+	}
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type RunMandates struct {
+	mandateRepository  MandateRepository
+	transactionCreator TransactionCreator
+	batchSize          int
+	otel               telemetry.Telemetry
+}
+
+// Execute runs a batch of mandates that are due through the transfer use
+// case and records each execution. Executions that fail, e.g. because the
+// sender does not have enough money, publish a failure event and the mandate
+// moves on to its next date. It returns how many mandates were processed so
+// callers can drain the backlog.
+func (rm *RunMandates) Execute(ctx context.Context) (int, error) {
+	ctx, span := rm.otel.Start(ctx, "RunMandates")
+	defer span.End()
+
+	return rm.mandateRepository.ProcessDue(ctx, time.Now(), rm.batchSize, func(mandate *entity.Mandate) *entity.MandateExecution {
+		transactionID, err := rm.transactionCreator.Execute(ctx, CreateTransactionInput{
+			Amount:           mandate.Amount(),
+			Currency:         mandate.Currency(),
+			ReceiverCurrency: mandate.ReceiverCurrency(),
+			SenderID:         uuid.MustParse(mandate.SenderID()),
+			ReceiverID:       uuid.MustParse(mandate.ReceiverID()),
+			IdempotencyKey:   mandate.NextIdempotencyKey(),
+		})
+		if ctx.Err() != nil {
+			// Shutting down, the mandate is picked up again on the next run
+			return nil
+		}
+		if err != nil {
+			execution := mandate.RecordExecution("", err.Error(), time.Now())
+			mandate.RecordEvent(event.NewMandateExecutionFailedEventV1(
+				mandate.ID(),
+				execution.ID(),
+				execution.Sequence(),
+				event.Amount{InCents: mandate.Amount(), Currency: mandate.Currency()},
+				uuid.MustParse(mandate.SenderID()),
+				uuid.MustParse(mandate.ReceiverID()),
+				execution.ScheduledFor(),
+				err.Error(),
+			))
+			return execution
+		}
+		return mandate.RecordExecution(transactionID, "", time.Now())
+	})
+}
+
+func NewRunMandates(
+	mandateRepository MandateRepository,
+	transactionCreator TransactionCreator,
+	batchSize int,
+	otel telemetry.Telemetry,
+) *RunMandates {
+	return &RunMandates{
+		mandateRepository:  mandateRepository,
+		transactionCreator: transactionCreator,
+		batchSize:          batchSize,
+		otel:               otel,
+	}
+}
@@ -0,0 +1,91 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunMandates_Execute_ShouldExecuteDueMandates(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mandate := newWeeklyMandate(t, 2990)
+	firstExecutionAt := mandate.NextExecutionAt()
+	transactionID := uuid.NewString()
+
+	var execution *entity.MandateExecution
+	mockRepo := &mockMandateRepository{}
+	mockRepo.On("ProcessDue", ctx, mock.AnythingOfType("time.Time"), 50, mock.AnythingOfType(processMandateFnType)).
+		Run(func(args mock.Arguments) {
+			processFn := args.Get(3).(func(*entity.Mandate) *entity.MandateExecution)
+			execution = processFn(mandate)
+		}).
+		Return(1, nil)
+
+	mockCreator := &mockTransactionCreator{}
+	mockCreator.On("Execute", ctx, usecase.CreateTransactionInput{
+		Amount:           2990,
+		Currency:         mandate.Currency(),
+		ReceiverCurrency: mandate.ReceiverCurrency(),
+		SenderID:         uuid.MustParse(mandate.SenderID()),
+		ReceiverID:       uuid.MustParse(mandate.ReceiverID()),
+		IdempotencyKey:   "mandate:" + mandate.ID() + ":1",
+	}).Return(transactionID, nil)
+
+	useCase := usecase.NewRunMandates(mockRepo, mockCreator, 50, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	require.NotNil(t, execution)
+	assert.Equal(t, entity.MandateExecutionExecutedStatus, execution.Status())
+	assert.Equal(t, transactionID, execution.TransactionID())
+	assert.Equal(t, 1, mandate.ExecutionCount())
+	assert.True(t, firstExecutionAt.AddDate(0, 0, 7).Equal(mandate.NextExecutionAt()))
+	assert.Empty(t, mandate.Events())
+	mockCreator.AssertExpectations(t)
+}
+
+func TestRunMandates_Execute_ShouldRecordFailedExecutionsWhenFundsAreShort(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mandate := newWeeklyMandate(t, 2990)
+
+	var execution *entity.MandateExecution
+	mockRepo := &mockMandateRepository{}
+	mockRepo.On("ProcessDue", ctx, mock.AnythingOfType("time.Time"), 50, mock.AnythingOfType(processMandateFnType)).
+		Run(func(args mock.Arguments) {
+			processFn := args.Get(3).(func(*entity.Mandate) *entity.MandateExecution)
+			execution = processFn(mandate)
+		}).
+		Return(1, nil)
+
+	mockCreator := &mockTransactionCreator{}
+	mockCreator.On("Execute", ctx, mock.Anything).Return("", errs.ErrInsufficientBalance)
+
+	useCase := usecase.NewRunMandates(mockRepo, mockCreator, 50, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	require.NotNil(t, execution)
+	assert.Equal(t, entity.MandateExecutionFailedStatus, execution.Status())
+	assert.Equal(t, errs.ErrInsufficientBalance.Error(), execution.FailureReason())
+	assert.Equal(t, entity.MandateActiveStatus, mandate.Status())
+	require.Len(t, mandate.Events(), 1)
+	assert.Equal(t, "MandateExecutionFailedEventV1", mandate.Events()[0].Name())
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+// TransactionCreator runs a transfer through the same path as
+// POST /v1/transactions.
+type TransactionCreator interface {
+	Execute(ctx context.Context, input CreateTransactionInput) (string, error)
+}
+
+type RunScheduledTransfers struct {
+	scheduledTransferRepository ScheduledTransferRepository
+	transactionCreator          TransactionCreator
+	batchSize                   int
+	otel                        telemetry.Telemetry
+}
+
+// Execute runs a batch of scheduled transfers that are due. Transfers that
+// cannot be executed, e.g. because the sender does not have enough money, are
+// marked as failed and a failure event is published. It returns how many
+// transfers were processed so callers can drain the backlog.
+func (rs *RunScheduledTransfers) Execute(ctx context.Context) (int, error) {
+	ctx, span := rs.otel.Start(ctx, "RunScheduledTransfers")
+	defer span.End()
+
+	return rs.scheduledTransferRepository.ProcessDue(ctx, time.Now(), rs.batchSize, func(scheduledTransfer *entity.ScheduledTransfer) {
+		transactionID, err := rs.transactionCreator.Execute(ctx, CreateTransactionInput{
+			Amount:           scheduledTransfer.Amount(),
+			Currency:         scheduledTransfer.Currency(),
+			ReceiverCurrency: scheduledTransfer.ReceiverCurrency(),
+			SenderID:         uuid.MustParse(scheduledTransfer.SenderID()),
+			ReceiverID:       uuid.MustParse(scheduledTransfer.ReceiverID()),
+			IdempotencyKey:   scheduledTransfer.IdempotencyKey(),
+		})
+		if ctx.Err() != nil {
+			// Shutting down, the transfer is picked up again on the next run
+			return
+		}
+		if err != nil {
+			scheduledTransfer.MarkFailed(err.Error(), time.Now())
+			scheduledTransfer.RecordEvent(event.NewScheduledTransferFailedEventV1(
+				scheduledTransfer.ID(),
+				event.Amount{InCents: scheduledTransfer.Amount(), Currency: scheduledTransfer.Currency()},
+				uuid.MustParse(scheduledTransfer.SenderID()),
+				uuid.MustParse(scheduledTransfer.ReceiverID()),
+				scheduledTransfer.ExecuteAt(),
+				err.Error(),
+			))
+			return
+		}
+		scheduledTransfer.MarkExecuted(transactionID, time.Now())
+	})
+}
+
+func NewRunScheduledTransfers(
+	scheduledTransferRepository ScheduledTransferRepository,
+	transactionCreator TransactionCreator,
+	batchSize int,
+	otel telemetry.Telemetry,
+) *RunScheduledTransfers {
+	return &RunScheduledTransfers{
+		scheduledTransferRepository: scheduledTransferRepository,
+		transactionCreator:          transactionCreator,
+		batchSize:                   batchSize,
+		otel:                        otel,
+	}
+}
@@ -0,0 +1,93 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunScheduledTransfers_Execute_ShouldExecuteDueTransfers(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	scheduledTransfer := newScheduledTransfer(t, 2500, time.Now().Add(time.Hour))
+	transactionID := uuid.NewString()
+
+	mockRepo := &mockScheduledTransferRepository{}
+	mockRepo.On("ProcessDue", ctx, mock.AnythingOfType("time.Time"), 50, mock.AnythingOfType(processScheduledTransferFnType)).
+		Run(func(args mock.Arguments) {
+			processFn := args.Get(3).(func(*entity.ScheduledTransfer))
+			processFn(scheduledTransfer)
+		}).
+		Return(1, nil)
+
+	mockCreator := &mockTransactionCreator{}
+	mockCreator.On("Execute", ctx, usecase.CreateTransactionInput{
+		Amount:           2500,
+		Currency:         scheduledTransfer.Currency(),
+		ReceiverCurrency: scheduledTransfer.ReceiverCurrency(),
+		SenderID:         uuid.MustParse(scheduledTransfer.SenderID()),
+		ReceiverID:       uuid.MustParse(scheduledTransfer.ReceiverID()),
+		IdempotencyKey:   scheduledTransfer.IdempotencyKey(),
+	}).Return(transactionID, nil)
+
+	useCase := usecase.NewRunScheduledTransfers(mockRepo, mockCreator, 50, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, entity.ScheduledTransferExecutedStatus, scheduledTransfer.Status())
+	assert.Equal(t, transactionID, scheduledTransfer.TransactionID())
+	assert.Empty(t, scheduledTransfer.Events())
+	mockCreator.AssertExpectations(t)
+}
+
+func TestRunScheduledTransfers_Execute_ShouldFailTransfersWhenFundsAreShort(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	scheduledTransfer := newScheduledTransfer(t, 2500, time.Now().Add(time.Hour))
+
+	mockRepo := &mockScheduledTransferRepository{}
+	mockRepo.On("ProcessDue", ctx, mock.AnythingOfType("time.Time"), 50, mock.AnythingOfType(processScheduledTransferFnType)).
+		Run(func(args mock.Arguments) {
+			processFn := args.Get(3).(func(*entity.ScheduledTransfer))
+			processFn(scheduledTransfer)
+		}).
+		Return(1, nil)
+
+	mockCreator := &mockTransactionCreator{}
+	mockCreator.On("Execute", ctx, mock.Anything).Return("", errs.ErrInsufficientBalance)
+
+	useCase := usecase.NewRunScheduledTransfers(mockRepo, mockCreator, 50, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, entity.ScheduledTransferFailedStatus, scheduledTransfer.Status())
+	assert.Equal(t, errs.ErrInsufficientBalance.Error(), scheduledTransfer.FailureReason())
+	require.Len(t, scheduledTransfer.Events(), 1)
+	assert.Equal(t, "ScheduledTransferFailedEventV1", scheduledTransfer.Events()[0].Name())
+}
+
+type mockTransactionCreator struct {
+	mock.Mock
+}
+
+func (m *mockTransactionCreator) Execute(ctx context.Context, input usecase.CreateTransactionInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type SettlementRepository interface {
+	SettleTransactions(ctx context.Context, limit int, addFn func(settlement *entity.Settlement, transaction *entity.Transaction) (*entity.Settlement, error)) (int, error)
+	CloseDue(ctx context.Context, before time.Time, limit int, closeFn func(settlement *entity.Settlement) error) (int, error)
+	MarkPaid(ctx context.Context, id string, payFn func(settlement *entity.Settlement) error) error
+	ListMerchantSettlements(ctx context.Context, merchantID, status string) ([]*entity.Settlement, error)
+	ListSettlementTransactions(ctx context.Context, id string) ([]*entity.Transaction, error)
+}
+
+type RunSettlements struct {
+	settlementRepository SettlementRepository
+	closeDelay           time.Duration
+	batchSize            int
+	otel                 telemetry.Telemetry
+}
+
+// Execute adds a batch of the transactions of merchants, the transfers they
+// received and the refunds and chargebacks they paid back, to the settlement
+// of their day. Once no transaction is left to settle, it closes a batch of
+// the settlements whose day ended more than the close delay ago and
+// publishes an event for each of them. The delay leaves time for transfers
+// made right before midnight to be settled on their day, and waiting for the
+// backlog to drain keeps days from being closed while transactions a stopped
+// worker left behind are still to be added to them. It returns how many
+// transactions and settlements were processed so callers can drain the
+// backlog.
+func (rs *RunSettlements) Execute(ctx context.Context) (int, error) {
+	ctx, span := rs.otel.Start(ctx, "RunSettlements")
+	defer span.End()
+
+	now := time.Now()
+	// Days up to the one of openSince are still open
+	openSince := now.Add(-rs.closeDelay)
+
+	settled, err := rs.settlementRepository.SettleTransactions(ctx, rs.batchSize, func(settlement *entity.Settlement, transaction *entity.Transaction) (*entity.Settlement, error) {
+		if settlement == nil {
+			merchantID, currency := entity.SettlementAccount(transaction)
+			settlement = entity.NewSettlement(merchantID, currency, transaction.CreatedAt(), now)
+		}
+		return settlement, settlement.Add(transaction, now)
+	})
+	if err != nil || settled == rs.batchSize {
+		return settled, err
+	}
+
+	closed, err := rs.settlementRepository.CloseDue(ctx, openSince, rs.batchSize, func(settlement *entity.Settlement) error {
+		err := settlement.Close(now)
+		if err != nil {
+			return err
+		}
+		settlement.RecordEvent(event.NewSettlementClosedEventV1(
+			settlement.ID(),
+			uuid.MustParse(settlement.MerchantID()),
+			settlement.Date(),
+			settlement.Currency(),
+			settlement.Gross(),
+			settlement.Fee(),
+			settlement.TransactionCount(),
+			settlement.Status(),
+		))
+		return nil
+	})
+	return settled + closed, err
+}
+
+func NewRunSettlements(
+	settlementRepository SettlementRepository,
+	closeDelay time.Duration,
+	batchSize int,
+	otel telemetry.Telemetry,
+) *RunSettlements {
+	return &RunSettlements{
+		settlementRepository: settlementRepository,
+		closeDelay:           closeDelay,
+		batchSize:            batchSize,
+		otel:                 otel,
+	}
+}
@@ -0,0 +1,183 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	settleTransactionFnType = "func(*entity.Settlement, *entity.Transaction) (*entity.Settlement, error)"
+	settlementFnType        = "func(*entity.Settlement) error"
+)
+
+type mockSettlementRepository struct {
+	mock.Mock
+}
+
+func (m *mockSettlementRepository) SettleTransactions(ctx context.Context, limit int, addFn func(settlement *entity.Settlement, transaction *entity.Transaction) (*entity.Settlement, error)) (int, error) {
+	args := m.Called(ctx, limit, addFn)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockSettlementRepository) CloseDue(ctx context.Context, before time.Time, limit int, closeFn func(settlement *entity.Settlement) error) (int, error) {
+	args := m.Called(ctx, before, limit, closeFn)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockSettlementRepository) MarkPaid(ctx context.Context, id string, payFn func(settlement *entity.Settlement) error) error {
+	args := m.Called(ctx, id, payFn)
+	return args.Error(0)
+}
+
+func (m *mockSettlementRepository) ListMerchantSettlements(ctx context.Context, merchantID, status string) ([]*entity.Settlement, error) {
+	args := m.Called(ctx, merchantID, status)
+	settlements, _ := args.Get(0).([]*entity.Settlement)
+	return settlements, args.Error(1)
+}
+
+func (m *mockSettlementRepository) ListSettlementTransactions(ctx context.Context, id string) ([]*entity.Transaction, error) {
+	args := m.Called(ctx, id)
+	transactions, _ := args.Get(0).([]*entity.Transaction)
+	return transactions, args.Error(1)
+}
+
+func TestRunSettlements_Execute_ShouldAddTransfersAndCloseDueSettlements(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	merchant := NewUser(vo.MerchantUserType)
+	yesterday := time.Now().UTC().AddDate(0, 0, -1)
+	transfer, err := entity.RestoreTransaction(uuid.New(), 10000, vo.BRL, 10000, vo.BRL, "1", 200, uuid.NewString(), merchant.ID(), entity.TransferTransactionKind, "", yesterday)
+	require.NoError(t, err)
+	var settled *entity.Settlement
+
+	mockRepo := &mockSettlementRepository{}
+	mockRepo.On("SettleTransactions", ctx, 50, mock.AnythingOfType(settleTransactionFnType)).
+		Run(func(args mock.Arguments) {
+			addFn := args.Get(2).(func(*entity.Settlement, *entity.Transaction) (*entity.Settlement, error))
+			settled, err = addFn(nil, transfer)
+			require.NoError(t, err)
+		}).
+		Return(1, nil)
+	mockRepo.On("CloseDue", ctx, mock.AnythingOfType("time.Time"), 50, mock.AnythingOfType(settlementFnType)).
+		Run(func(args mock.Arguments) {
+			closeFn := args.Get(3).(func(*entity.Settlement) error)
+			require.NoError(t, closeFn(settled))
+		}).
+		Return(1, nil)
+
+	useCase := usecase.NewRunSettlements(mockRepo, time.Hour, 50, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, processed)
+	require.NotNil(t, settled)
+	assert.Equal(t, merchant.ID(), settled.MerchantID())
+	assert.Equal(t, entity.SettlementDate(yesterday), settled.Date())
+	assert.Equal(t, int64(9800), settled.Net())
+	assert.Equal(t, entity.SettlementClosedStatus, settled.Status())
+	require.Len(t, settled.Events(), 1)
+	closed, ok := settled.Events()[0].(*event.SettlementEventV1)
+	require.True(t, ok)
+	assert.Equal(t, "SettlementClosedEventV1", closed.Name())
+	assert.Equal(t, int64(10000), closed.GrossInCents)
+	assert.Equal(t, int64(200), closed.FeeInCents)
+	assert.Equal(t, int64(9800), closed.NetInCents)
+	assert.Equal(t, 1, closed.TransactionCount)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRunSettlements_Execute_ShouldStopWhenSettlingFails(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := &mockSettlementRepository{}
+	mockRepo.On("SettleTransactions", ctx, 50, mock.Anything).Return(0, assert.AnError)
+
+	useCase := usecase.NewRunSettlements(mockRepo, time.Hour, 50, telemetry.NewMockTelemetry())
+
+	// Act
+	_, err := useCase.Execute(ctx)
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+	mockRepo.AssertNotCalled(t, "CloseDue", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRunSettlements_Execute_ShouldNotCloseSettlementsWhileTransactionsAreLeftToSettle(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := &mockSettlementRepository{}
+	mockRepo.On("SettleTransactions", ctx, 50, mock.Anything).Return(50, nil)
+
+	useCase := usecase.NewRunSettlements(mockRepo, time.Hour, 50, telemetry.NewMockTelemetry())
+
+	// Act
+	processed, err := useCase.Execute(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 50, processed)
+	mockRepo.AssertNotCalled(t, "CloseDue", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPaySettlement_Execute_ShouldMarkTheClosedSettlementAsPaid(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	day := time.Now().UTC().AddDate(0, 0, -2)
+	settlement := entity.NewSettlement(uuid.NewString(), vo.BRL, day, day)
+	require.NoError(t, settlement.Close(day.AddDate(0, 0, 1)))
+	mockRepo := &mockSettlementRepository{}
+	mockRepo.On("MarkPaid", ctx, settlement.ID(), mock.AnythingOfType(settlementFnType)).
+		Run(func(args mock.Arguments) {
+			payFn := args.Get(2).(func(*entity.Settlement) error)
+			require.NoError(t, payFn(settlement))
+		}).
+		Return(nil)
+
+	useCase := usecase.NewPaySettlement(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	err := useCase.Execute(ctx, uuid.MustParse(settlement.ID()))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.SettlementPaidStatus, settlement.Status())
+	require.Len(t, settlement.Events(), 1)
+	assert.Equal(t, "SettlementPaidEventV1", settlement.Events()[0].Name())
+}
+
+func TestPaySettlement_Execute_ShouldRejectOpenSettlements(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	settlement := entity.NewSettlement(uuid.NewString(), vo.BRL, time.Now(), time.Now())
+	mockRepo := &mockSettlementRepository{}
+	mockRepo.On("MarkPaid", ctx, settlement.ID(), mock.AnythingOfType(settlementFnType)).
+		Run(func(args mock.Arguments) {
+			payFn := args.Get(2).(func(*entity.Settlement) error)
+			assert.ErrorIs(t, payFn(settlement), errs.ErrSettlementNotClosed)
+		}).
+		Return(errs.ErrSettlementNotClosed)
+
+	useCase := usecase.NewPaySettlement(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	err := useCase.Execute(ctx, uuid.MustParse(settlement.ID()))
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrSettlementNotClosed)
+	assert.Empty(t, settlement.Events())
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ScheduledTransferRepository interface {
+	Save(ctx context.Context, scheduledTransfer *entity.ScheduledTransfer) error
+	GetByRequestKey(ctx context.Context, key string) (*entity.ScheduledTransfer, error)
+	ListBySender(ctx context.Context, senderID string) ([]*entity.ScheduledTransfer, error)
+	Update(ctx context.Context, id string, updateFn func(scheduledTransfer *entity.ScheduledTransfer) error) error
+	ProcessDue(ctx context.Context, now time.Time, limit int, processFn func(scheduledTransfer *entity.ScheduledTransfer)) (int, error)
+}
+
+type ScheduleTransfer struct {
+	scheduledTransferRepository ScheduledTransferRepository
+	otel                        telemetry.Telemetry
+}
+
+type ScheduleTransferInput struct {
+	// Amount in cents of Currency
+	Amount int64
+	// Currency the sender pays in, the default currency when empty
+	Currency string
+	// ReceiverCurrency the receiver is credited in, Currency when empty
+	ReceiverCurrency string
+	SenderID         uuid.UUID
+	ReceiverID       uuid.UUID
+	// ExecuteAt is when the transfer runs, it must be in the future
+	ExecuteAt time.Time
+	// IdempotencyKey is optional. Retries carrying the same key and the same
+	// request return the transfer scheduled by the first attempt.
+	IdempotencyKey string
+}
+
+// requestHash fingerprints the request so a reused idempotency key can be
+// told apart from a retry.
+func (i ScheduleTransferInput) requestHash(currency string) string {
+	receiverCurrency := i.ReceiverCurrency
+	if receiverCurrency == "" {
+		receiverCurrency = currency
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf(
+		"%d|%s|%s|%s|%s|%s",
+		i.Amount,
+		currency,
+		receiverCurrency,
+		i.SenderID,
+		i.ReceiverID,
+		i.ExecuteAt.UTC().Format(time.RFC3339Nano),
+	)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Execute schedules a transfer. Balances, limits and authorization are only
+// checked when the transfer runs.
+func (st *ScheduleTransfer) Execute(ctx context.Context, input ScheduleTransferInput) (string, error) {
+	ctx, span := st.otel.Start(ctx, "ScheduleTransfer")
+	defer span.End()
+
+	currency := input.Currency
+	if currency == "" {
+		currency = vo.DefaultCurrency
+	}
+	amount, err := vo.NewMoney(input.Amount, currency)
+	if err != nil {
+		return "", err
+	}
+
+	if input.IdempotencyKey != "" {
+		scheduledTransfer, err := st.scheduledTransferRepository.GetByRequestKey(ctx, input.IdempotencyKey)
+		if err == nil {
+			if !scheduledTransfer.MatchesRequest(input.requestHash(currency)) {
+				return "", errs.ErrIdempotencyKeyReused
+			}
+			return scheduledTransfer.ID(), nil
+		}
+		if !errors.Is(err, errs.ErrScheduledTransferNotFound) {
+			return "", err
+		}
+	}
+
+	scheduledTransfer, err := entity.NewScheduledTransfer(
+		input.SenderID.String(),
+		input.ReceiverID.String(),
+		amount,
+		input.ReceiverCurrency,
+		input.ExecuteAt,
+		time.Now(),
+	)
+	if err != nil {
+		return "", err
+	}
+	if input.IdempotencyKey != "" {
+		err = scheduledTransfer.AttachRequestKey(input.IdempotencyKey, input.requestHash(currency))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = st.scheduledTransferRepository.Save(ctx, scheduledTransfer)
+	if err != nil {
+		return "", err
+	}
+
+	return scheduledTransfer.ID(), nil
+}
+
+func NewScheduleTransfer(
+	scheduledTransferRepository ScheduledTransferRepository,
+	otel telemetry.Telemetry,
+) *ScheduleTransfer {
+	return &ScheduleTransfer{
+		scheduledTransferRepository: scheduledTransferRepository,
+		otel:                        otel,
+	}
+}
@@ -0,0 +1,205 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	updateScheduledTransferFnType  = "func(*entity.ScheduledTransfer) error"
+	processScheduledTransferFnType = "func(*entity.ScheduledTransfer)"
+)
+
+func newScheduledTransfer(t *testing.T, amount int64, executeAt time.Time) *entity.ScheduledTransfer {
+	t.Helper()
+	money, err := vo.NewMoney(amount, vo.BRL)
+	require.NoError(t, err)
+	scheduledTransfer, err := entity.NewScheduledTransfer(uuid.NewString(), uuid.NewString(), money, "", executeAt, executeAt.Add(-time.Hour))
+	require.NoError(t, err)
+	return scheduledTransfer
+}
+
+func TestScheduleTransfer_Execute_ShouldSaveTheScheduledTransfer(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	senderID, receiverID := uuid.New(), uuid.New()
+	executeAt := time.Now().Add(24 * time.Hour)
+
+	var captured *entity.ScheduledTransfer
+	mockRepo := &mockScheduledTransferRepository{}
+	mockRepo.On("Save", ctx, mock.AnythingOfType("*entity.ScheduledTransfer")).
+		Run(func(args mock.Arguments) {
+			captured = args.Get(1).(*entity.ScheduledTransfer)
+		}).
+		Return(nil)
+
+	useCase := usecase.NewScheduleTransfer(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	id, err := useCase.Execute(ctx, usecase.ScheduleTransferInput{
+		Amount:     2500,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+		ExecuteAt:  executeAt,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, captured.ID(), id)
+	assert.Equal(t, senderID.String(), captured.SenderID())
+	assert.Equal(t, receiverID.String(), captured.ReceiverID())
+	assert.Equal(t, int64(2500), captured.Amount())
+	assert.Equal(t, vo.DefaultCurrency, captured.Currency())
+	assert.Equal(t, vo.DefaultCurrency, captured.ReceiverCurrency())
+	assert.Equal(t, entity.ScheduledTransferScheduledStatus, captured.Status())
+}
+
+func TestScheduleTransfer_Execute_ShouldReturnErrorWhenExecuteAtIsInThePast(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockRepo := &mockScheduledTransferRepository{}
+
+	useCase := usecase.NewScheduleTransfer(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	id, err := useCase.Execute(ctx, usecase.ScheduleTransferInput{
+		Amount:     2500,
+		SenderID:   uuid.New(),
+		ReceiverID: uuid.New(),
+		ExecuteAt:  time.Now().Add(-time.Minute),
+	})
+
+	// Assert
+	assert.Empty(t, id)
+	assert.ErrorIs(t, err, errs.ErrExecuteAtNotInTheFuture)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestScheduleTransfer_Execute_ShouldReturnTheScheduledTransferOfARetriedRequest(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	input := usecase.ScheduleTransferInput{
+		Amount:         2500,
+		SenderID:       uuid.New(),
+		ReceiverID:     uuid.New(),
+		ExecuteAt:      time.Now().Add(24 * time.Hour),
+		IdempotencyKey: "schedule-1",
+	}
+
+	var saved *entity.ScheduledTransfer
+	mockRepo := &mockScheduledTransferRepository{}
+	mockRepo.On("GetByRequestKey", ctx, "schedule-1").Return(nil, errs.ErrScheduledTransferNotFound).Once()
+	mockRepo.On("Save", ctx, mock.AnythingOfType("*entity.ScheduledTransfer")).
+		Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*entity.ScheduledTransfer)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := usecase.NewScheduleTransfer(mockRepo, telemetry.NewMockTelemetry())
+	firstID, err := useCase.Execute(ctx, input)
+	require.NoError(t, err)
+	mockRepo.On("GetByRequestKey", ctx, "schedule-1").Return(saved, nil)
+
+	// Act
+	retriedID, retryErr := useCase.Execute(ctx, input)
+	input.Amount = 5000
+	_, reusedErr := useCase.Execute(ctx, input)
+
+	// Assert
+	assert.Equal(t, "schedule-1", saved.RequestKey())
+	assert.NoError(t, retryErr)
+	assert.Equal(t, firstID, retriedID)
+	assert.ErrorIs(t, reusedErr, errs.ErrIdempotencyKeyReused)
+	mockRepo.AssertNumberOfCalls(t, "Save", 1)
+}
+
+func TestCancelScheduledTransfer_Execute_ShouldCancelTheScheduledTransfer(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	scheduledTransfer := newScheduledTransfer(t, 2500, time.Now().Add(time.Hour))
+
+	mockRepo := &mockScheduledTransferRepository{}
+	mockRepo.On("Update", ctx, scheduledTransfer.ID(), mock.AnythingOfType(updateScheduledTransferFnType)).
+		Run(func(args mock.Arguments) {
+			updateFn := args.Get(2).(func(*entity.ScheduledTransfer) error)
+			require.NoError(t, updateFn(scheduledTransfer))
+		}).
+		Return(nil)
+
+	useCase := usecase.NewCancelScheduledTransfer(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	err := useCase.Execute(ctx, uuid.MustParse(scheduledTransfer.ID()))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entity.ScheduledTransferCancelledStatus, scheduledTransfer.Status())
+}
+
+func TestCancelScheduledTransfer_Execute_ShouldReturnErrorWhenAlreadyExecuted(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	scheduledTransfer := newScheduledTransfer(t, 2500, time.Now().Add(time.Hour))
+	scheduledTransfer.MarkExecuted(uuid.NewString(), time.Now())
+
+	mockRepo := &mockScheduledTransferRepository{}
+	mockRepo.On("Update", ctx, scheduledTransfer.ID(), mock.AnythingOfType(updateScheduledTransferFnType)).
+		Return(errs.ErrScheduledTransferNotCancellable).
+		Run(func(args mock.Arguments) {
+			updateFn := args.Get(2).(func(*entity.ScheduledTransfer) error)
+			assert.ErrorIs(t, updateFn(scheduledTransfer), errs.ErrScheduledTransferNotCancellable)
+		})
+
+	useCase := usecase.NewCancelScheduledTransfer(mockRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	err := useCase.Execute(ctx, uuid.MustParse(scheduledTransfer.ID()))
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrScheduledTransferNotCancellable)
+	assert.Equal(t, entity.ScheduledTransferExecutedStatus, scheduledTransfer.Status())
+}
+
+type mockScheduledTransferRepository struct {
+	mock.Mock
+}
+
+func (m *mockScheduledTransferRepository) Save(ctx context.Context, scheduledTransfer *entity.ScheduledTransfer) error {
+	args := m.Called(ctx, scheduledTransfer)
+	return args.Error(0)
+}
+
+func (m *mockScheduledTransferRepository) GetByRequestKey(ctx context.Context, key string) (*entity.ScheduledTransfer, error) {
+	args := m.Called(ctx, key)
+	scheduledTransfer, _ := args.Get(0).(*entity.ScheduledTransfer)
+	return scheduledTransfer, args.Error(1)
+}
+
+func (m *mockScheduledTransferRepository) ListBySender(ctx context.Context, senderID string) ([]*entity.ScheduledTransfer, error) {
+	args := m.Called(ctx, senderID)
+	scheduledTransfers, _ := args.Get(0).([]*entity.ScheduledTransfer)
+	return scheduledTransfers, args.Error(1)
+}
+
+func (m *mockScheduledTransferRepository) Update(ctx context.Context, id string, updateFn func(scheduledTransfer *entity.ScheduledTransfer) error) error {
+	args := m.Called(ctx, id, updateFn)
+	return args.Error(0)
+}
+
+func (m *mockScheduledTransferRepository) ProcessDue(ctx context.Context, now time.Time, limit int, processFn func(scheduledTransfer *entity.ScheduledTransfer)) (int, error) {
+	args := m.Called(ctx, now, limit, processFn)
+	return args.Int(0), args.Error(1)
+}
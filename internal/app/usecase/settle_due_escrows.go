@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type SettleDueEscrows struct {
+	escrowRepository EscrowRepository
+	batchSize        int
+	fees             vo.FeePolicy
+	otel             telemetry.Telemetry
+}
+
+// Execute settles a batch of escrows whose deadline passed: shipped escrows
+// the sender did not confirm in time are released to the receiver, and the
+// ones not shipped in time expire, giving the money back to the sender. It
+// returns how many escrows were processed so callers can drain the backlog.
+func (sd *SettleDueEscrows) Execute(ctx context.Context) (int, error) {
+	ctx, span := sd.otel.Start(ctx, "SettleDueEscrows")
+	defer span.End()
+
+	now := time.Now()
+	return sd.escrowRepository.SettleDue(ctx, now, sd.batchSize, func(escrow *entity.Escrow, sender, receiver *entity.User) (*entity.Transaction, error) {
+		if escrow.IsShipped() {
+			err := escrow.Release(now)
+			if err != nil {
+				return nil, err
+			}
+			return releaseEscrow(escrow, sender, receiver, sd.fees)
+		}
+
+		err := escrow.Expire(now)
+		if err != nil {
+			return nil, err
+		}
+		escrow.RecordEvent(event.NewEscrowExpiredEventV1(
+			escrow.ID(),
+			uuid.MustParse(sender.ID()),
+			uuid.MustParse(receiver.ID()),
+			event.Amount{InCents: escrow.Amount(), Currency: escrow.Currency()},
+			escrow.Status(),
+		))
+		return nil, nil
+	})
+}
+
+func NewSettleDueEscrows(
+	escrowRepository EscrowRepository,
+	batchSize int,
+	fees vo.FeePolicy,
+	otel telemetry.Telemetry,
+) *SettleDueEscrows {
+	return &SettleDueEscrows{
+		escrowRepository: escrowRepository,
+		batchSize:        batchSize,
+		fees:             fees,
+		otel:             otel,
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type SettleWithdrawal struct {
+	withdrawalRepository WithdrawalRepository
+	otel                 telemetry.Telemetry
+}
+
+type SettleWithdrawalInput struct {
+	WithdrawalID uuid.UUID
+	// Succeeded tells whether the payout gateway paid the withdrawal out. The
+	// held money is released back to the user otherwise.
+	Succeeded     bool
+	FailureReason string
+}
+
+// Execute applies the result of a payout reported by the payout gateway,
+// completing the withdrawal or releasing its hold.
+func (sw *SettleWithdrawal) Execute(ctx context.Context, input SettleWithdrawalInput) (string, error) {
+	ctx, span := sw.otel.Start(ctx, "SettleWithdrawal")
+	defer span.End()
+
+	var status string
+	err := sw.withdrawalRepository.Settle(ctx, input.WithdrawalID.String(), func(withdrawal *entity.Withdrawal, user *entity.User) error {
+		err := settleWithdrawalFn(input.Succeeded, input.FailureReason)(withdrawal, user)
+		if err != nil {
+			return err
+		}
+		status = withdrawal.Status()
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return status, nil
+}
+
+// settleWithdrawalFn completes the withdrawal, or fails it and gives the held
+// money back to the user, recording the matching event.
+func settleWithdrawalFn(succeeded bool, failureReason string) func(withdrawal *entity.Withdrawal, user *entity.User) error {
+	return func(withdrawal *entity.Withdrawal, user *entity.User) error {
+		amount := event.Amount{InCents: withdrawal.Amount(), Currency: withdrawal.Currency()}
+		userID := uuid.MustParse(user.ID())
+
+		if succeeded {
+			err := withdrawal.Complete(time.Now())
+			if err != nil {
+				return err
+			}
+			withdrawal.RecordEvent(event.NewWithdrawalCompletedEventV1(withdrawal.ID(), userID, withdrawal.DestinationID(), amount, withdrawal.Status()))
+			return nil
+		}
+
+		err := withdrawal.Fail(failureReason, time.Now())
+		if err != nil {
+			return err
+		}
+		err = user.DepositIn(withdrawal.Currency(), withdrawal.Amount())
+		if err != nil {
+			return err
+		}
+		withdrawal.RecordEvent(event.NewWithdrawalFailedEventV1(withdrawal.ID(), userID, withdrawal.DestinationID(), amount, withdrawal.Status(), withdrawal.FailureReason()))
+		return nil
+	}
+}
+
+func NewSettleWithdrawal(
+	withdrawalRepository WithdrawalRepository,
+	otel telemetry.Telemetry,
+) *SettleWithdrawal {
+	return &SettleWithdrawal{
+		withdrawalRepository: withdrawalRepository,
+		otel:                 otel,
+	}
+}
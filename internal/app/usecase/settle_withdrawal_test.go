@@ -0,0 +1,113 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newPendingWithdrawal(t *testing.T, user *entity.User, amount int64) *entity.Withdrawal {
+	t.Helper()
+	money, err := vo.NewMoney(amount, vo.BRL)
+	require.NoError(t, err)
+	withdrawal, err := entity.NewWithdrawal(user.ID(), uuid.NewString(), money)
+	require.NoError(t, err)
+	return withdrawal
+}
+
+func TestSettleWithdrawal_Execute_ShouldCompleteTheWithdrawal(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	user := NewUser(vo.CommonUserType)
+	withdrawal := newPendingWithdrawal(t, user, 4000)
+
+	mockWithdrawalRepo := &mockWithdrawalRepository{}
+	mockWithdrawalRepo.On("Settle", ctx, withdrawal.ID(), mock.AnythingOfType(settleFnType)).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(2).(func(*entity.Withdrawal, *entity.User) error)
+			require.NoError(t, settleFn(withdrawal, user))
+		}).
+		Return(nil)
+
+	useCase := usecase.NewSettleWithdrawal(mockWithdrawalRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	status, err := useCase.Execute(ctx, usecase.SettleWithdrawalInput{
+		WithdrawalID: uuid.MustParse(withdrawal.ID()),
+		Succeeded:    true,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entity.WithdrawalCompletedStatus, status)
+	assert.Equal(t, int64(0), user.Balance())
+	require.Len(t, withdrawal.Events(), 1)
+	assert.Equal(t, "WithdrawalCompletedEventV1", withdrawal.Events()[0].Name())
+}
+
+func TestSettleWithdrawal_Execute_ShouldReleaseTheHoldWhenPayoutFailed(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	user := NewUser(vo.CommonUserType)
+	withdrawal := newPendingWithdrawal(t, user, 4000)
+
+	mockWithdrawalRepo := &mockWithdrawalRepository{}
+	mockWithdrawalRepo.On("Settle", ctx, withdrawal.ID(), mock.AnythingOfType(settleFnType)).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(2).(func(*entity.Withdrawal, *entity.User) error)
+			require.NoError(t, settleFn(withdrawal, user))
+		}).
+		Return(nil)
+
+	useCase := usecase.NewSettleWithdrawal(mockWithdrawalRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	status, err := useCase.Execute(ctx, usecase.SettleWithdrawalInput{
+		WithdrawalID:  uuid.MustParse(withdrawal.ID()),
+		FailureReason: "account closed",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entity.WithdrawalFailedStatus, status)
+	assert.Equal(t, int64(4000), user.Balance())
+	require.Len(t, withdrawal.Events(), 1)
+	assert.Equal(t, "WithdrawalFailedEventV1", withdrawal.Events()[0].Name())
+}
+
+func TestSettleWithdrawal_Execute_ShouldReturnErrorWhenAlreadySettled(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	user := NewUser(vo.CommonUserType)
+	withdrawal := newPendingWithdrawal(t, user, 4000)
+
+	mockWithdrawalRepo := &mockWithdrawalRepository{}
+	mockWithdrawalRepo.On("Settle", ctx, withdrawal.ID(), mock.AnythingOfType(settleFnType)).
+		Return(errs.ErrWithdrawalAlreadySettled).
+		Run(func(args mock.Arguments) {
+			settleFn := args.Get(2).(func(*entity.Withdrawal, *entity.User) error)
+			require.NoError(t, settleFn(withdrawal, user))
+			assert.ErrorIs(t, settleFn(withdrawal, user), errs.ErrWithdrawalAlreadySettled)
+		})
+
+	useCase := usecase.NewSettleWithdrawal(mockWithdrawalRepo, telemetry.NewMockTelemetry())
+
+	// Act
+	status, err := useCase.Execute(ctx, usecase.SettleWithdrawalInput{
+		WithdrawalID: uuid.MustParse(withdrawal.ID()),
+		Succeeded:    true,
+	})
+
+	// Assert
+	assert.Empty(t, status)
+	assert.ErrorIs(t, err, errs.ErrWithdrawalAlreadySettled)
+}
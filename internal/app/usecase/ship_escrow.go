@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type ShipEscrow struct {
+	escrowRepository EscrowRepository
+	releaseWindow    time.Duration
+	otel             telemetry.Telemetry
+}
+
+type ShipEscrowInput struct {
+	EscrowID   uuid.UUID
+	ReceiverID uuid.UUID
+}
+
+// Execute records that the receiver shipped the goods paid by the escrow,
+// starting the window the sender has to confirm the delivery before the money
+// is released anyway.
+func (se *ShipEscrow) Execute(ctx context.Context, input ShipEscrowInput) (*entity.Escrow, error) {
+	ctx, span := se.otel.Start(ctx, "ShipEscrow")
+	defer span.End()
+
+	var shipped *entity.Escrow
+	err := se.escrowRepository.Ship(ctx, input.EscrowID.String(), func(escrow *entity.Escrow) error {
+		err := escrow.Ship(input.ReceiverID.String(), se.releaseWindow, time.Now())
+		if err != nil {
+			return err
+		}
+		escrow.RecordEvent(event.NewEscrowShippedEventV1(
+			escrow.ID(),
+			uuid.MustParse(escrow.SenderID()),
+			input.ReceiverID,
+			event.Amount{InCents: escrow.Amount(), Currency: escrow.Currency()},
+			escrow.Status(),
+			*escrow.ReleaseAt(),
+		))
+		shipped = escrow
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return shipped, nil
+}
+
+func NewShipEscrow(
+	escrowRepository EscrowRepository,
+	releaseWindow time.Duration,
+	otel telemetry.Telemetry,
+) *ShipEscrow {
+	return &ShipEscrow{
+		escrowRepository: escrowRepository,
+		releaseWindow:    releaseWindow,
+		otel:             otel,
+	}
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/google/uuid"
+)
+
+type VoidHold struct {
+	balanceHoldRepository BalanceHoldRepository
+	otel                  telemetry.Telemetry
+}
+
+// Execute releases the whole hold back to the sender's available balance
+// without moving any money.
+func (vh *VoidHold) Execute(ctx context.Context, id uuid.UUID) error {
+	ctx, span := vh.otel.Start(ctx, "VoidHold")
+	defer span.End()
+
+	return vh.balanceHoldRepository.Settle(ctx, id.String(), func(hold *entity.BalanceHold, sender, receiver *entity.User) (*entity.Transaction, error) {
+		err := hold.Void(time.Now())
+		if err != nil {
+			return nil, err
+		}
+		sender.ReleaseHold(hold.Currency(), hold.Amount())
+
+		hold.RecordEvent(event.NewBalanceHoldVoidedEventV1(
+			hold.ID(),
+			uuid.MustParse(sender.ID()),
+			uuid.MustParse(receiver.ID()),
+			event.Amount{InCents: hold.Amount(), Currency: hold.Currency()},
+			hold.Status(),
+		))
+		return nil, nil
+	})
+}
+
+func NewVoidHold(
+	balanceHoldRepository BalanceHoldRepository,
+	otel telemetry.Telemetry,
+) *VoidHold {
+	return &VoidHold{
+		balanceHoldRepository: balanceHoldRepository,
+		otel:                  otel,
+	}
+}
@@ -0,0 +1,27 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Every runs fn on each tick of interval until ctx is cancelled. Errors are
+// logged and do not stop the worker, the next tick simply tries again.
+func Every(ctx context.Context, interval time.Duration, name string, fn func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Printf("Worker %s running every %s", name, interval)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("Worker %s stopped", name)
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				log.Printf("Worker %s failed, err: %v", name, err)
+			}
+		}
+	}
+}
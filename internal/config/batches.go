@@ -0,0 +1,12 @@
+package config
+
+type TransactionBatchConfig struct {
+	// MaxItems is how many transfers a single batch can carry
+	MaxItems int
+}
+
+func GetTransactionBatchConfig() TransactionBatchConfig {
+	return TransactionBatchConfig{
+		MaxItems: getEnvAsInt("TRANSACTION_BATCH_MAX_ITEMS", 1000),
+	}
+}
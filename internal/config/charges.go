@@ -0,0 +1,19 @@
+package config
+
+import "time"
+
+type ChargeConfig struct {
+	// Expiry is how long charges can be paid when the request does not set
+	// when they expire
+	Expiry         time.Duration
+	ExpiryInterval time.Duration
+	BatchSize      int
+}
+
+func GetChargeConfig() ChargeConfig {
+	return ChargeConfig{
+		Expiry:         getEnvAsDuration("CHARGE_EXPIRY", 24*time.Hour),
+		ExpiryInterval: getEnvAsDuration("CHARGE_EXPIRY_INTERVAL", time.Minute),
+		BatchSize:      getEnvAsInt("CHARGE_BATCH_SIZE", 100),
+	}
+}
@@ -0,0 +1,19 @@
+package config
+
+import "time"
+
+type DisputeConfig struct {
+	// ResponseWindow is how long the receiver of a disputed transfer has to
+	// respond before the dispute is resolved in favor of the sender
+	ResponseWindow time.Duration
+	Interval       time.Duration
+	BatchSize      int
+}
+
+func GetDisputeConfig() DisputeConfig {
+	return DisputeConfig{
+		ResponseWindow: getEnvAsDuration("DISPUTE_RESPONSE_WINDOW", 7*24*time.Hour),
+		Interval:       getEnvAsDuration("DISPUTE_INTERVAL", 5*time.Minute),
+		BatchSize:      getEnvAsInt("DISPUTE_BATCH_SIZE", 100),
+	}
+}
@@ -0,0 +1,23 @@
+package config
+
+import "time"
+
+type EscrowConfig struct {
+	// Expiry is how long the receiver has to ship when the request does not
+	// set when the escrow expires
+	Expiry time.Duration
+	// ReleaseWindow is how long the sender has to confirm the delivery once
+	// shipped before the money is released to the receiver
+	ReleaseWindow time.Duration
+	Interval      time.Duration
+	BatchSize     int
+}
+
+func GetEscrowConfig() EscrowConfig {
+	return EscrowConfig{
+		Expiry:        getEnvAsDuration("ESCROW_EXPIRY", 7*24*time.Hour),
+		ReleaseWindow: getEnvAsDuration("ESCROW_RELEASE_WINDOW", 14*24*time.Hour),
+		Interval:      getEnvAsDuration("ESCROW_INTERVAL", time.Minute),
+		BatchSize:     getEnvAsInt("ESCROW_BATCH_SIZE", 100),
+	}
+}
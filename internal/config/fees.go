@@ -0,0 +1,13 @@
+package config
+
+type FeeConfig struct {
+	// SchedulesFile is a JSON file with the fee schedules of each receiver
+	// user type and merchant. No fees are charged when it is empty.
+	SchedulesFile string
+}
+
+func GetFeeConfig() FeeConfig {
+	return FeeConfig{
+		SchedulesFile: getEnv("FEE_SCHEDULES_FILE", ""),
+	}
+}
@@ -0,0 +1,17 @@
+package config
+
+type FundingConfig struct {
+	// GatewayURL is where deposits are confirmed. It is required unless
+	// UseFakeGateway is set.
+	GatewayURL string
+	// UseFakeGateway confirms every deposit without a provider, so anyone can
+	// create money. It is meant for local development only.
+	UseFakeGateway bool
+}
+
+func GetFundingConfig() FundingConfig {
+	return FundingConfig{
+		GatewayURL:     getEnv("FUNDING_GATEWAY_URL", ""),
+		UseFakeGateway: getEnvAsBool("FUNDING_USE_FAKE_GATEWAY", false),
+	}
+}
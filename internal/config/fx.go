@@ -0,0 +1,13 @@
+package config
+
+type FXConfig struct {
+	// RatesFile is a JSON file mapping currency pairs to rates. The built-in
+	// indicative rates are used when it is empty.
+	RatesFile string
+}
+
+func GetFXConfig() FXConfig {
+	return FXConfig{
+		RatesFile: getEnv("FX_RATES_FILE", ""),
+	}
+}
@@ -0,0 +1,19 @@
+package config
+
+import "time"
+
+type HoldConfig struct {
+	// Expiry is how long holds can be captured when the request does not
+	// set when they expire
+	Expiry         time.Duration
+	ExpiryInterval time.Duration
+	BatchSize      int
+}
+
+func GetHoldConfig() HoldConfig {
+	return HoldConfig{
+		Expiry:         getEnvAsDuration("HOLD_EXPIRY", 7*24*time.Hour),
+		ExpiryInterval: getEnvAsDuration("HOLD_EXPIRY_INTERVAL", time.Minute),
+		BatchSize:      getEnvAsInt("HOLD_BATCH_SIZE", 100),
+	}
+}
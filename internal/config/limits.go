@@ -0,0 +1,29 @@
+package config
+
+// TransferLimitsConfig holds the limits in cents a user type can send. Zero
+// disables a limit.
+type TransferLimitsConfig struct {
+	PerTransaction int64
+	Daily          int64
+	Weekly         int64
+	Monthly        int64
+}
+
+// GetTransferLimitsConfig returns the limits of common users and merchants,
+// keyed by user type.
+func GetTransferLimitsConfig() map[string]TransferLimitsConfig {
+	return map[string]TransferLimitsConfig{
+		"common": {
+			PerTransaction: int64(getEnvAsInt("COMMON_PER_TRANSACTION_LIMIT", 500000)),
+			Daily:          int64(getEnvAsInt("COMMON_DAILY_LIMIT", 1000000)),
+			Weekly:         int64(getEnvAsInt("COMMON_WEEKLY_LIMIT", 3000000)),
+			Monthly:        int64(getEnvAsInt("COMMON_MONTHLY_LIMIT", 10000000)),
+		},
+		"merchant": {
+			PerTransaction: int64(getEnvAsInt("MERCHANT_PER_TRANSACTION_LIMIT", 0)),
+			Daily:          int64(getEnvAsInt("MERCHANT_DAILY_LIMIT", 0)),
+			Weekly:         int64(getEnvAsInt("MERCHANT_WEEKLY_LIMIT", 0)),
+			Monthly:        int64(getEnvAsInt("MERCHANT_MONTHLY_LIMIT", 0)),
+		},
+	}
+}
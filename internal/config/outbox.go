@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+type OutboxConfig struct {
+	RelayInterval time.Duration
+	BatchSize     int
+}
+
+func GetOutboxConfig() OutboxConfig {
+	return OutboxConfig{
+		RelayInterval: getEnvAsDuration("OUTBOX_RELAY_INTERVAL", time.Second),
+		BatchSize:     getEnvAsInt("OUTBOX_BATCH_SIZE", 100),
+	}
+}
@@ -0,0 +1,21 @@
+package config
+
+import "time"
+
+type PayoutConfig struct {
+	// GatewayURL is where payouts are submitted. The stub gateway, which pays
+	// every withdrawal out after StubDelay, is used when it is empty.
+	GatewayURL string
+	StubDelay  time.Duration
+	// ResultSecret signs the payout results the provider reports, which are
+	// refused when it is empty.
+	ResultSecret string
+}
+
+func GetPayoutConfig() PayoutConfig {
+	return PayoutConfig{
+		GatewayURL:   getEnv("PAYOUT_GATEWAY_URL", ""),
+		StubDelay:    getEnvAsDuration("PAYOUT_STUB_DELAY", 2*time.Second),
+		ResultSecret: getEnv("PAYOUT_RESULT_SECRET", ""),
+	}
+}
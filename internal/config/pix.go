@@ -0,0 +1,16 @@
+package config
+
+type PixConfig struct {
+	// LocationURL is where the charges behind dynamic PIX codes are found,
+	// without its scheme, e.g. pix.example.com/v1/charges
+	LocationURL string
+	// MerchantCity is the city printed on PIX codes of merchants
+	MerchantCity string
+}
+
+func GetPixConfig() PixConfig {
+	return PixConfig{
+		LocationURL:  getEnv("PIX_LOCATION_URL", "pix.simplified-wallet.com.br/v1/charges"),
+		MerchantCity: getEnv("PIX_MERCHANT_CITY", "SAO PAULO"),
+	}
+}
@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+type ReconciliationConfig struct {
+	Interval  time.Duration
+	ReportDir string
+}
+
+func GetReconciliationConfig() ReconciliationConfig {
+	return ReconciliationConfig{
+		Interval:  getEnvAsDuration("RECONCILIATION_INTERVAL", time.Hour),
+		ReportDir: getEnv("RECONCILIATION_REPORT_DIR", "reports"),
+	}
+}
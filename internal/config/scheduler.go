@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+type SchedulerConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+func GetSchedulerConfig() SchedulerConfig {
+	return SchedulerConfig{
+		Interval:  getEnvAsDuration("SCHEDULER_INTERVAL", 10*time.Second),
+		BatchSize: getEnvAsInt("SCHEDULER_BATCH_SIZE", 50),
+	}
+}
@@ -0,0 +1,19 @@
+package config
+
+import "time"
+
+type SettlementConfig struct {
+	Interval time.Duration
+	// CloseDelay is how long after midnight UTC the settlements of the day
+	// that ended are closed
+	CloseDelay time.Duration
+	BatchSize  int
+}
+
+func GetSettlementConfig() SettlementConfig {
+	return SettlementConfig{
+		Interval:   getEnvAsDuration("SETTLEMENT_INTERVAL", 5*time.Minute),
+		CloseDelay: getEnvAsDuration("SETTLEMENT_CLOSE_DELAY", time.Hour),
+		BatchSize:  getEnvAsInt("SETTLEMENT_BATCH_SIZE", 100),
+	}
+}
@@ -0,0 +1,16 @@
+package config
+
+type StatementConfig struct {
+	// PageSize is how many transactions a statement page lists when the
+	// request does not set a limit
+	PageSize int
+	// MaxPageSize caps the limit a request can set
+	MaxPageSize int
+}
+
+func GetStatementConfig() StatementConfig {
+	return StatementConfig{
+		PageSize:    getEnvAsInt("STATEMENT_PAGE_SIZE", 50),
+		MaxPageSize: getEnvAsInt("STATEMENT_MAX_PAGE_SIZE", 200),
+	}
+}
@@ -0,0 +1,155 @@
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	EmailAliasKeyType  = "email"
+	CPFAliasKeyType    = "cpf"
+	CNPJAliasKeyType   = "cnpj"
+	PhoneAliasKeyType  = "phone"
+	RandomAliasKeyType = "random"
+)
+
+var documentSeparatorsRegex = regexp.MustCompile(`[\s./-]`)
+
+// AliasKey is a key a user registers in the key directory so others can send
+// it money without knowing its wallet id: its e-mail, its own CPF or CNPJ, a
+// phone number or a random key generated by the wallet. Each key belongs to a
+// single user.
+type AliasKey struct {
+	id        uuid.UUID
+	userID    string
+	keyType   string
+	value     string
+	createdAt time.Time
+}
+
+func (k *AliasKey) ID() string {
+	return k.id.String()
+}
+
+func (k *AliasKey) UserID() string {
+	return k.userID
+}
+
+func (k *AliasKey) Type() string {
+	return k.keyType
+}
+
+// Value returns the key in its normalized form, e.g. a CPF without
+// punctuation or a phone in the E.164 format.
+func (k *AliasKey) Value() string {
+	return k.value
+}
+
+func (k *AliasKey) CreatedAt() time.Time {
+	return k.createdAt
+}
+
+// NewAliasKey creates a key of the given type owned by the user. CPF and CNPJ
+// keys must be the owner's own document, and random keys are generated, so
+// their value is ignored.
+func NewAliasKey(owner *User, keyType, value string, now time.Time) (*AliasKey, error) {
+	if keyType == RandomAliasKeyType {
+		value = uuid.NewString()
+	}
+	value, err := normalizeAliasKey(keyType, value)
+	if err != nil {
+		return nil, err
+	}
+	if keyType == CPFAliasKeyType && value != normalizeDocument(owner.CPF()) ||
+		keyType == CNPJAliasKeyType && value != normalizeDocument(owner.CNPJ()) {
+		return nil, errs.ErrAliasKeyNotOwnDocument
+	}
+	return &AliasKey{
+		id:        uuid.New(),
+		userID:    owner.ID(),
+		keyType:   keyType,
+		value:     value,
+		createdAt: now,
+	}, nil
+}
+
+// ParseAliasKey tells the type of a key typed by a sender and normalizes it
+// the way it was registered. Phone numbers must start with their country
+// code, e.g. +55, to tell them apart from documents.
+func ParseAliasKey(value string) (keyType, normalized string, err error) {
+	value = strings.TrimSpace(value)
+	switch {
+	case uuid.Validate(value) == nil:
+		keyType = RandomAliasKeyType
+	case strings.Contains(value, "@"):
+		keyType = EmailAliasKeyType
+	case strings.HasPrefix(value, "+"):
+		keyType = PhoneAliasKeyType
+	case len(normalizeDocument(value)) == vo.CNPJ_VALID_LENGTH:
+		keyType = CNPJAliasKeyType
+	default:
+		keyType = CPFAliasKeyType
+	}
+	normalized, err = normalizeAliasKey(keyType, value)
+	if err != nil {
+		return "", "", err
+	}
+	return keyType, normalized, nil
+}
+
+func normalizeAliasKey(keyType, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch keyType {
+	case EmailAliasKeyType:
+		email, err := vo.NewEmail(strings.ToLower(value))
+		if err != nil || len(email.GetValue()) > maxPixKeyLength {
+			return "", errs.ErrInvalidAliasKey
+		}
+		return email.GetValue(), nil
+	case CPFAliasKeyType:
+		cpf, err := vo.NewCPF(value)
+		if err != nil {
+			return "", errs.ErrInvalidAliasKey
+		}
+		return normalizeDocument(cpf.GetValue()), nil
+	case CNPJAliasKeyType:
+		cnpj, err := vo.NewCNPJ(value)
+		if err != nil {
+			return "", errs.ErrInvalidAliasKey
+		}
+		return cnpj.GetValue(), nil
+	case PhoneAliasKeyType:
+		phone, err := vo.NewPhone(value)
+		if err != nil {
+			return "", errs.ErrInvalidAliasKey
+		}
+		return phone.GetValue(), nil
+	case RandomAliasKeyType:
+		key, err := uuid.Parse(value)
+		if err != nil {
+			return "", errs.ErrInvalidAliasKey
+		}
+		return key.String(), nil
+	default:
+		return "", errs.ErrInvalidAliasKeyType
+	}
+}
+
+func normalizeDocument(document string) string {
+	return documentSeparatorsRegex.ReplaceAllString(document, "")
+}
+
+func RestoreAliasKey(id uuid.UUID, userID, keyType, value string, createdAt time.Time) *AliasKey {
+	return &AliasKey{
+		id:        id,
+		userID:    userID,
+		keyType:   keyType,
+		value:     value,
+		createdAt: createdAt,
+	}
+}
@@ -0,0 +1,103 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAliasKeyOwner(t *testing.T) *entity.User {
+	t.Helper()
+	user, err := entity.NewUser("Fulano de Tal", "fulano@example.com", "password123", "529.982.247-25", "", vo.CommonUserType)
+	require.NoError(t, err)
+	return user
+}
+
+func TestNewAliasKey_ShouldNormalizeEachKeyType(t *testing.T) {
+	owner := newAliasKeyOwner(t)
+	tests := []struct {
+		keyType, value, expected string
+	}{
+		{entity.EmailAliasKeyType, " Fulano@Example.com ", "fulano@example.com"},
+		{entity.CPFAliasKeyType, "529.982.247-25", "52998224725"},
+		{entity.PhoneAliasKeyType, "(11) 98765-4321", "+5511987654321"},
+	}
+	for _, tt := range tests {
+		// Act
+		key, err := entity.NewAliasKey(owner, tt.keyType, tt.value, time.Now())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, tt.keyType, key.Type())
+		assert.Equal(t, tt.expected, key.Value())
+		assert.Equal(t, owner.ID(), key.UserID())
+	}
+}
+
+func TestNewAliasKey_ShouldGenerateRandomKeys(t *testing.T) {
+	// Act
+	key, err := entity.NewAliasKey(newAliasKeyOwner(t), entity.RandomAliasKeyType, "ignored", time.Now())
+
+	// Assert
+	require.NoError(t, err)
+	assert.NoError(t, uuid.Validate(key.Value()))
+}
+
+func TestNewAliasKey_ShouldRejectInvalidKeys(t *testing.T) {
+	owner := newAliasKeyOwner(t)
+	tests := []struct {
+		keyType, value string
+		err            error
+	}{
+		{"iban", "value", errs.ErrInvalidAliasKeyType},
+		{entity.EmailAliasKeyType, "fulano", errs.ErrInvalidAliasKey},
+		{entity.CPFAliasKeyType, "123.456.789", errs.ErrInvalidAliasKey},
+		{entity.PhoneAliasKeyType, "987", errs.ErrInvalidAliasKey},
+		{entity.CPFAliasKeyType, "863.958.390-04", errs.ErrAliasKeyNotOwnDocument},
+		{entity.CNPJAliasKeyType, "71.627.571/0001-07", errs.ErrAliasKeyNotOwnDocument},
+	}
+	for _, tt := range tests {
+		// Act
+		key, err := entity.NewAliasKey(owner, tt.keyType, tt.value, time.Now())
+
+		// Assert
+		assert.ErrorIs(t, err, tt.err, tt.value)
+		assert.Nil(t, key)
+	}
+}
+
+func TestParseAliasKey_ShouldTellTheKeyType(t *testing.T) {
+	randomKey := uuid.NewString()
+	tests := []struct {
+		value, keyType, normalized string
+	}{
+		{randomKey, entity.RandomAliasKeyType, randomKey},
+		{"Fulano@Example.com", entity.EmailAliasKeyType, "fulano@example.com"},
+		{"+55 11 98765-4321", entity.PhoneAliasKeyType, "+5511987654321"},
+		{"529.982.247-25", entity.CPFAliasKeyType, "52998224725"},
+		{"71627571000107", entity.CNPJAliasKeyType, "71627571000107"},
+	}
+	for _, tt := range tests {
+		// Act
+		keyType, normalized, err := entity.ParseAliasKey(tt.value)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, tt.keyType, keyType)
+		assert.Equal(t, tt.normalized, normalized)
+	}
+}
+
+func TestParseAliasKey_ShouldRejectUnknownKeys(t *testing.T) {
+	// Act
+	_, _, err := entity.ParseAliasKey("fulano")
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrInvalidAliasKey)
+}
@@ -0,0 +1,201 @@
+package entity
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	BalanceHoldAuthorizedStatus = "authorized"
+	BalanceHoldCapturedStatus   = "captured"
+	BalanceHoldVoidedStatus     = "voided"
+	BalanceHoldExpiredStatus    = "expired"
+)
+
+// BalanceHold reserves money of the sender for the receiver, e.g. while a
+// merchant confirms an order. Authorized holds keep the money in the sender's
+// balance but out of its available balance, until the hold is captured,
+// moving the money to the receiver, voided or expired.
+type BalanceHold struct {
+	id             uuid.UUID
+	senderID       string
+	receiverID     string
+	amount         *vo.Money
+	capturedAmount int64
+	status         string
+	transactionID  string
+	expiresAt      time.Time
+	events         []event.Event
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+func (h *BalanceHold) ID() string {
+	return h.id.String()
+}
+
+func (h *BalanceHold) SenderID() string {
+	return h.senderID
+}
+
+func (h *BalanceHold) ReceiverID() string {
+	return h.receiverID
+}
+
+// Amount returns the held amount in cents.
+func (h *BalanceHold) Amount() int64 {
+	return h.amount.Value()
+}
+
+// FormattedAmount returns the held amount as a decimal with two places.
+func (h *BalanceHold) FormattedAmount() string {
+	return h.amount.String()
+}
+
+func (h *BalanceHold) Currency() string {
+	return h.amount.Currency()
+}
+
+// CapturedAmount returns the captured amount in cents, zero until the hold is
+// captured.
+func (h *BalanceHold) CapturedAmount() int64 {
+	return h.capturedAmount
+}
+
+func (h *BalanceHold) Status() string {
+	return h.status
+}
+
+func (h *BalanceHold) IsAuthorized() bool {
+	return h.status == BalanceHoldAuthorizedStatus
+}
+
+// TransactionID returns the transfer created by the capture, or an empty
+// string.
+func (h *BalanceHold) TransactionID() string {
+	return h.transactionID
+}
+
+func (h *BalanceHold) ExpiresAt() time.Time {
+	return h.expiresAt
+}
+
+// IsExpired tells whether the hold can no longer be captured at now, even if
+// it has not been marked as expired yet.
+func (h *BalanceHold) IsExpired(now time.Time) bool {
+	return !now.Before(h.expiresAt)
+}
+
+func (h *BalanceHold) CreatedAt() time.Time {
+	return h.createdAt
+}
+
+func (h *BalanceHold) UpdatedAt() time.Time {
+	return h.updatedAt
+}
+
+// RecordEvent queues an event to be stored in the outbox along with the hold.
+func (h *BalanceHold) RecordEvent(e event.Event) {
+	h.events = append(h.events, e)
+}
+
+func (h *BalanceHold) Events() []event.Event {
+	return h.events
+}
+
+// Capture settles the hold for amount cents, at most the held amount. A zero
+// amount captures everything, and whatever is not captured goes back to the
+// sender's available balance.
+func (h *BalanceHold) Capture(amount int64, now time.Time) error {
+	if !h.IsAuthorized() {
+		return errs.ErrBalanceHoldNotAuthorized
+	}
+	if h.IsExpired(now) {
+		return errs.ErrBalanceHoldExpired
+	}
+	if amount < 0 {
+		return errs.ErrZeroOrNegativeAmount
+	}
+	if amount == 0 {
+		amount = h.Amount()
+	}
+	if amount > h.Amount() {
+		return errs.ErrCaptureExceedsHold
+	}
+	h.status = BalanceHoldCapturedStatus
+	h.capturedAmount = amount
+	h.updatedAt = now
+	return nil
+}
+
+// AttachTransaction records the transfer moving the captured amount.
+func (h *BalanceHold) AttachTransaction(transactionID string) {
+	h.transactionID = transactionID
+}
+
+// Void releases the whole hold without moving any money.
+func (h *BalanceHold) Void(now time.Time) error {
+	if !h.IsAuthorized() {
+		return errs.ErrBalanceHoldNotAuthorized
+	}
+	h.status = BalanceHoldVoidedStatus
+	h.updatedAt = now
+	return nil
+}
+
+// Expire releases a hold that was not captured before it expired.
+func (h *BalanceHold) Expire(now time.Time) error {
+	if !h.IsAuthorized() {
+		return errs.ErrBalanceHoldNotAuthorized
+	}
+	h.status = BalanceHoldExpiredStatus
+	h.updatedAt = now
+	return nil
+}
+
+// NewBalanceHold authorizes a hold of amount on the sender for the receiver
+// that can be captured until expiresAt.
+func NewBalanceHold(senderID, receiverID string, amount *vo.Money, expiresAt, now time.Time) (*BalanceHold, error) {
+	if amount.Value() <= 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	if senderID == receiverID {
+		return nil, errs.ErrTransactionInvalidSender
+	}
+	if !expiresAt.After(now) {
+		return nil, errs.ErrHoldExpiryNotInTheFuture
+	}
+	return &BalanceHold{
+		id:         uuid.New(),
+		senderID:   senderID,
+		receiverID: receiverID,
+		amount:     amount,
+		status:     BalanceHoldAuthorizedStatus,
+		expiresAt:  expiresAt,
+		createdAt:  now,
+		updatedAt:  now,
+	}, nil
+}
+
+func RestoreBalanceHold(id uuid.UUID, senderID, receiverID string, amount int64, currency string, capturedAmount int64, status, transactionID string, expiresAt, createdAt, updatedAt time.Time) (*BalanceHold, error) {
+	money, err := vo.NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &BalanceHold{
+		id:             id,
+		senderID:       senderID,
+		receiverID:     receiverID,
+		amount:         money,
+		capturedAmount: capturedAmount,
+		status:         status,
+		transactionID:  transactionID,
+		expiresAt:      expiresAt,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}, nil
+}
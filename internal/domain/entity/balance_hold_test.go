@@ -0,0 +1,96 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBalanceHold_ShouldAuthorizeHoldUntilExpiry(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+
+	// Act
+	hold, err := entity.NewBalanceHold("sender123", "receiver456", money(t, 5000, vo.BRL), expiresAt, now)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entity.BalanceHoldAuthorizedStatus, hold.Status())
+	assert.True(t, hold.IsAuthorized())
+	assert.Equal(t, "50.00", hold.FormattedAmount())
+	assert.False(t, hold.IsExpired(now))
+	assert.True(t, hold.IsExpired(expiresAt))
+}
+
+func TestNewBalanceHold_ShouldReturnErrorWhenExpiryIsNotInTheFuture(t *testing.T) {
+	// Arrange
+	now := time.Now()
+
+	// Act
+	hold, err := entity.NewBalanceHold("sender123", "receiver456", money(t, 5000, vo.BRL), now, now)
+
+	// Assert
+	assert.Nil(t, hold)
+	assert.ErrorIs(t, err, errs.ErrHoldExpiryNotInTheFuture)
+}
+
+func TestBalanceHold_Capture_ShouldCaptureFullOrPartialAmount(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	full, err := entity.NewBalanceHold("sender123", "receiver456", money(t, 5000, vo.BRL), now.Add(time.Hour), now)
+	require.NoError(t, err)
+	partial, err := entity.NewBalanceHold("sender123", "receiver456", money(t, 5000, vo.BRL), now.Add(time.Hour), now)
+	require.NoError(t, err)
+
+	// Act
+	fullErr := full.Capture(0, now)
+	partialErr := partial.Capture(1500, now)
+
+	// Assert
+	assert.NoError(t, fullErr)
+	assert.Equal(t, int64(5000), full.CapturedAmount())
+	assert.NoError(t, partialErr)
+	assert.Equal(t, int64(1500), partial.CapturedAmount())
+	assert.Equal(t, entity.BalanceHoldCapturedStatus, partial.Status())
+}
+
+func TestBalanceHold_Capture_ShouldReturnErrorWhenHoldCannotBeCaptured(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	hold, err := entity.NewBalanceHold("sender123", "receiver456", money(t, 5000, vo.BRL), now.Add(time.Hour), now)
+	require.NoError(t, err)
+
+	// Act
+	exceedsErr := hold.Capture(5001, now)
+	expiredErr := hold.Capture(1000, now.Add(time.Hour))
+	require.NoError(t, hold.Void(now))
+	voidedErr := hold.Capture(1000, now)
+
+	// Assert
+	assert.ErrorIs(t, exceedsErr, errs.ErrCaptureExceedsHold)
+	assert.ErrorIs(t, expiredErr, errs.ErrBalanceHoldExpired)
+	assert.ErrorIs(t, voidedErr, errs.ErrBalanceHoldNotAuthorized)
+	assert.Equal(t, int64(0), hold.CapturedAmount())
+}
+
+func TestBalanceHold_Expire_ShouldOnlyExpireAuthorizedHolds(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	hold, err := entity.NewBalanceHold("sender123", "receiver456", money(t, 5000, vo.BRL), now.Add(time.Hour), now)
+	require.NoError(t, err)
+
+	// Act
+	expireErr := hold.Expire(now.Add(time.Hour))
+	voidErr := hold.Void(now.Add(time.Hour))
+
+	// Assert
+	assert.NoError(t, expireErr)
+	assert.Equal(t, entity.BalanceHoldExpiredStatus, hold.Status())
+	assert.ErrorIs(t, voidErr, errs.ErrBalanceHoldNotAuthorized)
+}
@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// BalanceCheck compares the cached balance of a user in a currency with the
+// balance expected from the history of its movements.
+type BalanceCheck struct {
+	userID          string
+	currency        string
+	balance         int64
+	expectedBalance int64
+}
+
+func (c *BalanceCheck) UserID() string {
+	return c.userID
+}
+
+func (c *BalanceCheck) Currency() string {
+	return c.currency
+}
+
+// Balance returns the cached balance in cents.
+func (c *BalanceCheck) Balance() int64 {
+	return c.balance
+}
+
+// ExpectedBalance returns the balance in cents derived from the history.
+func (c *BalanceCheck) ExpectedBalance() int64 {
+	return c.expectedBalance
+}
+
+// Drift returns how many cents the cached balance is above the expected one,
+// negative when it is below.
+func (c *BalanceCheck) Drift() int64 {
+	return c.balance - c.expectedBalance
+}
+
+func (c *BalanceCheck) HasDrift() bool {
+	return c.Drift() != 0
+}
+
+func NewBalanceCheck(userID, currency string, balance, expectedBalance int64) *BalanceCheck {
+	return &BalanceCheck{
+		userID:          userID,
+		currency:        currency,
+		balance:         balance,
+		expectedBalance: expectedBalance,
+	}
+}
+
+// ReconciliationReport is the outcome of checking every balance against its
+// history, keeping only the balances that drifted.
+type ReconciliationReport struct {
+	checkedAt       time.Time
+	accountsChecked int
+	currencies      []string
+	drifts          []*BalanceCheck
+}
+
+func (r *ReconciliationReport) CheckedAt() time.Time {
+	return r.checkedAt
+}
+
+// AccountsChecked returns how many balances were checked, one per user and
+// currency.
+func (r *ReconciliationReport) AccountsChecked() int {
+	return r.accountsChecked
+}
+
+// Currencies returns the currencies of the checked balances, sorted.
+func (r *ReconciliationReport) Currencies() []string {
+	return r.currencies
+}
+
+func (r *ReconciliationReport) Drifts() []*BalanceCheck {
+	return r.drifts
+}
+
+func (r *ReconciliationReport) HasDrift() bool {
+	return len(r.drifts) > 0
+}
+
+// DriftedAccounts returns how many balances in the currency drifted.
+func (r *ReconciliationReport) DriftedAccounts(currency string) int {
+	var count int
+	for _, drift := range r.drifts {
+		if drift.Currency() == currency {
+			count++
+		}
+	}
+	return count
+}
+
+// TotalDrift returns the sum in cents of the absolute drifts in the currency,
+// so drifts in opposite directions do not cancel each other out.
+func (r *ReconciliationReport) TotalDrift(currency string) int64 {
+	var total int64
+	for _, drift := range r.drifts {
+		if drift.Currency() != currency {
+			continue
+		}
+		if drift.Drift() < 0 {
+			total -= drift.Drift()
+		} else {
+			total += drift.Drift()
+		}
+	}
+	return total
+}
+
+func NewReconciliationReport(checks []*BalanceCheck, checkedAt time.Time) *ReconciliationReport {
+	report := &ReconciliationReport{
+		checkedAt:       checkedAt,
+		accountsChecked: len(checks),
+	}
+	seen := map[string]bool{}
+	for _, check := range checks {
+		if !seen[check.Currency()] {
+			seen[check.Currency()] = true
+			report.currencies = append(report.currencies, check.Currency())
+		}
+		if check.HasDrift() {
+			report.drifts = append(report.drifts, check)
+		}
+	}
+	sort.Strings(report.currencies)
+	return report
+}
@@ -0,0 +1,49 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReconciliationReport_ShouldKeepOnlyDriftedBalances(t *testing.T) {
+	// Arrange
+	checks := []*entity.BalanceCheck{
+		entity.NewBalanceCheck("user-1", vo.BRL, 10000, 10000),
+		entity.NewBalanceCheck("user-2", vo.BRL, 9999, 10050),
+		entity.NewBalanceCheck("user-3", vo.BRL, 500, 450),
+		entity.NewBalanceCheck("user-3", vo.USD, 2000, 2000),
+	}
+	checkedAt := time.Now()
+
+	// Act
+	report := entity.NewReconciliationReport(checks, checkedAt)
+
+	// Assert
+	assert.Equal(t, checkedAt, report.CheckedAt())
+	assert.Equal(t, 4, report.AccountsChecked())
+	assert.Equal(t, []string{vo.BRL, vo.USD}, report.Currencies())
+	assert.True(t, report.HasDrift())
+	require.Len(t, report.Drifts(), 2)
+	assert.Equal(t, int64(-51), report.Drifts()[0].Drift())
+	assert.Equal(t, int64(50), report.Drifts()[1].Drift())
+	assert.Equal(t, 2, report.DriftedAccounts(vo.BRL))
+	assert.Equal(t, int64(101), report.TotalDrift(vo.BRL))
+	assert.Equal(t, 0, report.DriftedAccounts(vo.USD))
+	assert.Equal(t, int64(0), report.TotalDrift(vo.USD))
+}
+
+func TestNewReconciliationReport_WithoutDrift_ShouldBeClean(t *testing.T) {
+	// Act
+	report := entity.NewReconciliationReport([]*entity.BalanceCheck{
+		entity.NewBalanceCheck("user-1", vo.BRL, 10000, 10000),
+	}, time.Now())
+
+	// Assert
+	assert.False(t, report.HasDrift())
+	assert.Empty(t, report.Drifts())
+}
@@ -0,0 +1,215 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	ChargePendingStatus   = "pending"
+	ChargePaidStatus      = "paid"
+	ChargeExpiredStatus   = "expired"
+	ChargeCancelledStatus = "cancelled"
+
+	maxChargeReferenceLength   = 64
+	maxChargeDescriptionLength = 140
+)
+
+// Charge is a payment request of a merchant, e.g. for an order, that a user
+// pays with a transfer. The merchant identifies it by its own reference, so
+// it can tell which transfer paid which order.
+type Charge struct {
+	id            uuid.UUID
+	merchantID    string
+	reference     string
+	description   string
+	amount        *vo.Money
+	status        string
+	payerID       string
+	transactionID string
+	expiresAt     time.Time
+	events        []event.Event
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func (c *Charge) ID() string {
+	return c.id.String()
+}
+
+func (c *Charge) MerchantID() string {
+	return c.merchantID
+}
+
+// Reference returns the identifier the merchant gave the charge, unique
+// among its charges.
+func (c *Charge) Reference() string {
+	return c.reference
+}
+
+func (c *Charge) Description() string {
+	return c.description
+}
+
+// Amount returns the charged amount in cents.
+func (c *Charge) Amount() int64 {
+	return c.amount.Value()
+}
+
+// FormattedAmount returns the charged amount as a decimal with two places.
+func (c *Charge) FormattedAmount() string {
+	return c.amount.String()
+}
+
+func (c *Charge) Money() *vo.Money {
+	return c.amount
+}
+
+func (c *Charge) Currency() string {
+	return c.amount.Currency()
+}
+
+func (c *Charge) Status() string {
+	return c.status
+}
+
+func (c *Charge) IsPending() bool {
+	return c.status == ChargePendingStatus
+}
+
+// PayerID returns the user that paid the charge, or an empty string.
+func (c *Charge) PayerID() string {
+	return c.payerID
+}
+
+// TransactionID returns the transfer that paid the charge, or an empty
+// string.
+func (c *Charge) TransactionID() string {
+	return c.transactionID
+}
+
+func (c *Charge) ExpiresAt() time.Time {
+	return c.expiresAt
+}
+
+// IsExpired tells whether the charge can no longer be paid at now, even if it
+// has not been marked as expired yet.
+func (c *Charge) IsExpired(now time.Time) bool {
+	return !now.Before(c.expiresAt)
+}
+
+func (c *Charge) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Charge) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// RecordEvent queues an event to be stored in the outbox along with the
+// charge.
+func (c *Charge) RecordEvent(e event.Event) {
+	c.events = append(c.events, e)
+}
+
+func (c *Charge) Events() []event.Event {
+	return c.events
+}
+
+// Pay marks the charge as paid by the payer. The transfer moving the money is
+// attached once created.
+func (c *Charge) Pay(payerID string, now time.Time) error {
+	if !c.IsPending() {
+		return errs.ErrChargeNotPending
+	}
+	if c.IsExpired(now) {
+		return errs.ErrChargeExpired
+	}
+	if payerID == c.merchantID {
+		return errs.ErrTransactionInvalidSender
+	}
+	c.status = ChargePaidStatus
+	c.payerID = payerID
+	c.updatedAt = now
+	return nil
+}
+
+// AttachTransaction records the transfer that paid the charge.
+func (c *Charge) AttachTransaction(transactionID string) {
+	c.transactionID = transactionID
+}
+
+// Cancel withdraws a charge that was not paid yet.
+func (c *Charge) Cancel(now time.Time) error {
+	if !c.IsPending() {
+		return errs.ErrChargeNotPending
+	}
+	c.status = ChargeCancelledStatus
+	c.updatedAt = now
+	return nil
+}
+
+// Expire closes a charge that was not paid before it expired.
+func (c *Charge) Expire(now time.Time) error {
+	if !c.IsPending() {
+		return errs.ErrChargeNotPending
+	}
+	c.status = ChargeExpiredStatus
+	c.updatedAt = now
+	return nil
+}
+
+// NewCharge creates a pending charge of the merchant that can be paid until
+// expiresAt.
+func NewCharge(merchantID, reference, description string, amount *vo.Money, expiresAt, now time.Time) (*Charge, error) {
+	if amount.Value() <= 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	reference = strings.TrimSpace(reference)
+	if reference == "" || utf8.RuneCountInString(reference) > maxChargeReferenceLength {
+		return nil, errs.ErrInvalidChargeReference
+	}
+	if utf8.RuneCountInString(description) > maxChargeDescriptionLength {
+		return nil, errs.ErrChargeDescriptionTooLong
+	}
+	if !expiresAt.After(now) {
+		return nil, errs.ErrChargeExpiryNotInTheFuture
+	}
+	return &Charge{
+		id:          uuid.New(),
+		merchantID:  merchantID,
+		reference:   reference,
+		description: description,
+		amount:      amount,
+		status:      ChargePendingStatus,
+		expiresAt:   expiresAt,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+func RestoreCharge(id uuid.UUID, merchantID, reference, description string, amount int64, currency, status, payerID, transactionID string, expiresAt, createdAt, updatedAt time.Time) (*Charge, error) {
+	money, err := vo.NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &Charge{
+		id:            id,
+		merchantID:    merchantID,
+		reference:     reference,
+		description:   description,
+		amount:        money,
+		status:        status,
+		payerID:       payerID,
+		transactionID: transactionID,
+		expiresAt:     expiresAt,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCharge_ShouldCreatePendingChargeUntilExpiry(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+
+	// Act
+	charge, err := entity.NewCharge("merchant123", " order-42 ", "Order #42", money(t, 5000, vo.BRL), expiresAt, now)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.ChargePendingStatus, charge.Status())
+	assert.Equal(t, "order-42", charge.Reference())
+	assert.Equal(t, "50.00", charge.FormattedAmount())
+	assert.False(t, charge.IsExpired(now))
+	assert.True(t, charge.IsExpired(expiresAt))
+}
+
+func TestNewCharge_ShouldRejectInvalidCharges(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name        string
+		reference   string
+		description string
+		expiresAt   time.Time
+		want        error
+	}{
+		{name: "empty reference", reference: " ", expiresAt: now.Add(time.Hour), want: errs.ErrInvalidChargeReference},
+		{name: "long reference", reference: strings.Repeat("a", 65), expiresAt: now.Add(time.Hour), want: errs.ErrInvalidChargeReference},
+		{name: "long description", reference: "order-42", description: strings.Repeat("a", 141), expiresAt: now.Add(time.Hour), want: errs.ErrChargeDescriptionTooLong},
+		{name: "expiry in the past", reference: "order-42", expiresAt: now.Add(-time.Hour), want: errs.ErrChargeExpiryNotInTheFuture},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			charge, err := entity.NewCharge("merchant123", tt.reference, tt.description, money(t, 5000, vo.BRL), tt.expiresAt, now)
+
+			// Assert
+			assert.Nil(t, charge)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestCharge_Pay_ShouldMarkChargeAsPaidByPayer(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	charge, err := entity.NewCharge("merchant123", "order-42", "", money(t, 5000, vo.BRL), now.Add(time.Hour), now)
+	require.NoError(t, err)
+
+	// Act
+	err = charge.Pay("payer456", now)
+	charge.AttachTransaction("transaction789")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.ChargePaidStatus, charge.Status())
+	assert.Equal(t, "payer456", charge.PayerID())
+	assert.Equal(t, "transaction789", charge.TransactionID())
+	assert.ErrorIs(t, charge.Pay("payer456", now), errs.ErrChargeNotPending)
+	assert.ErrorIs(t, charge.Cancel(now), errs.ErrChargeNotPending)
+}
+
+func TestCharge_Pay_ShouldReturnErrorWhenExpiredOrPaidByMerchant(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	charge, err := entity.NewCharge("merchant123", "order-42", "", money(t, 5000, vo.BRL), now.Add(time.Hour), now)
+	require.NoError(t, err)
+
+	// Act
+	expiredErr := charge.Pay("payer456", now.Add(time.Hour))
+	merchantErr := charge.Pay("merchant123", now)
+
+	// Assert
+	assert.ErrorIs(t, expiredErr, errs.ErrChargeExpired)
+	assert.ErrorIs(t, merchantErr, errs.ErrTransactionInvalidSender)
+	assert.True(t, charge.IsPending())
+}
+
+func TestCharge_Expire_ShouldOnlyExpirePendingCharges(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	charge, err := entity.NewCharge("merchant123", "order-42", "", money(t, 5000, vo.BRL), now.Add(time.Hour), now)
+	require.NoError(t, err)
+	require.NoError(t, charge.Cancel(now))
+
+	// Act
+	err = charge.Expire(now.Add(time.Hour))
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrChargeNotPending)
+	assert.Equal(t, entity.ChargeCancelledStatus, charge.Status())
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// FundingAccountID is the system account that balances money entering the
+// wallet from outside, such as deposits confirmed by the funding gateway.
+const FundingAccountID = "system:funding"
+
+// Deposit is money added to a user's wallet from an external funding source.
+type Deposit struct {
+	id             uuid.UUID
+	userID         string
+	amount         *vo.Money
+	events         []event.Event
+	createdAt      time.Time
+	idempotencyKey *IdempotencyKey
+}
+
+func (d *Deposit) ID() string {
+	return d.id.String()
+}
+
+func (d *Deposit) UserID() string {
+	return d.userID
+}
+
+// Amount returns the deposited amount in cents.
+func (d *Deposit) Amount() int64 {
+	return d.amount.Value()
+}
+
+func (d *Deposit) Currency() string {
+	return d.amount.Currency()
+}
+
+func (d *Deposit) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// IdempotencyKey returns the key the deposit was requested with, if any.
+func (d *Deposit) IdempotencyKey() *IdempotencyKey {
+	return d.idempotencyKey
+}
+
+// AttachIdempotencyKey binds the key to the deposit so retries of the same
+// request replay it instead of crediting the user again.
+func (d *Deposit) AttachIdempotencyKey(key *IdempotencyKey) {
+	key.transactionID = d.ID()
+	d.idempotencyKey = key
+}
+
+// RecordEvent queues an event to be stored in the outbox along with the
+// deposit.
+func (d *Deposit) RecordEvent(e event.Event) {
+	d.events = append(d.events, e)
+}
+
+func (d *Deposit) Events() []event.Event {
+	return d.events
+}
+
+// LedgerEntries returns the balanced postings of the deposit: a debit on the
+// funding account and a credit on the user's account.
+func (d *Deposit) LedgerEntries() ([]*LedgerEntry, error) {
+	debit, err := NewLedgerEntry(d.ID(), FundingAccountID, DebitDirection, d.amount, d.createdAt)
+	if err != nil {
+		return nil, err
+	}
+	credit, err := NewLedgerEntry(d.ID(), d.userID, CreditDirection, d.amount, d.createdAt)
+	if err != nil {
+		return nil, err
+	}
+	return []*LedgerEntry{debit, credit}, nil
+}
+
+func NewDeposit(userID string, amount *vo.Money) (*Deposit, error) {
+	if amount.Value() <= 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	return &Deposit{
+		id:        uuid.New(),
+		userID:    userID,
+		amount:    amount,
+		createdAt: time.Now(),
+	}, nil
+}
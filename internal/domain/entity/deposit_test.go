@@ -0,0 +1,50 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDeposit_ShouldCreateDepositForUser(t *testing.T) {
+	// Act
+	deposit, err := entity.NewDeposit("user123", money(t, 5000, vo.USD))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotEmpty(t, deposit.ID())
+	assert.Equal(t, "user123", deposit.UserID())
+	assert.Equal(t, int64(5000), deposit.Amount())
+	assert.Equal(t, vo.USD, deposit.Currency())
+}
+
+func TestNewDeposit_ShouldReturnErrorWhenAmountIsZero(t *testing.T) {
+	// Act
+	deposit, err := entity.NewDeposit("user123", money(t, 0, vo.BRL))
+
+	// Assert
+	assert.Nil(t, deposit)
+	assert.ErrorIs(t, err, errs.ErrZeroOrNegativeAmount)
+}
+
+func TestDeposit_LedgerEntries_ShouldDebitFundingAccountAndCreditUser(t *testing.T) {
+	// Arrange
+	deposit, err := entity.NewDeposit("user123", money(t, 5000, vo.BRL))
+	assert.NoError(t, err)
+
+	// Act
+	entries, err := deposit.LedgerEntries()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, entity.FundingAccountID, entries[0].AccountID())
+	assert.Equal(t, entity.DebitDirection, entries[0].Direction())
+	assert.Equal(t, "user123", entries[1].AccountID())
+	assert.Equal(t, entity.CreditDirection, entries[1].Direction())
+	assert.Equal(t, deposit.ID(), entries[1].TransactionID())
+	assert.True(t, entity.IsBalanced(entries))
+}
@@ -0,0 +1,278 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	DisputeOpenStatus      = "open"
+	DisputeRespondedStatus = "responded"
+	DisputeWonStatus       = "won"
+	DisputeLostStatus      = "lost"
+
+	maxDisputeReasonLength   = 140
+	maxDisputeEvidenceLength = 2000
+)
+
+// Dispute is a claim of the sender of a transfer against its receiver, e.g.
+// for goods never delivered. The disputed amount is frozen on the receiver,
+// as much of it as the receiver has not spent yet, until the dispute is
+// resolved: won by the sender, who gets the amount back through a chargeback,
+// or lost, releasing the amount to the receiver. The receiver can respond
+// with its side until respondBy.
+type Dispute struct {
+	id            uuid.UUID
+	transactionID string
+	senderID      string
+	receiverID    string
+	amount        *vo.Money
+	frozenAmount  int64
+	reason        string
+	evidence      string
+	response      string
+	status        string
+	respondBy     time.Time
+	chargebackID  string
+	resolvedAt    *time.Time
+	events        []event.Event
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func (d *Dispute) ID() string {
+	return d.id.String()
+}
+
+// TransactionID returns the disputed transfer.
+func (d *Dispute) TransactionID() string {
+	return d.transactionID
+}
+
+func (d *Dispute) SenderID() string {
+	return d.senderID
+}
+
+func (d *Dispute) ReceiverID() string {
+	return d.receiverID
+}
+
+// Amount returns the disputed amount in cents of the currency the transfer
+// was received in.
+func (d *Dispute) Amount() int64 {
+	return d.amount.Value()
+}
+
+func (d *Dispute) Currency() string {
+	return d.amount.Currency()
+}
+
+// FrozenAmount returns the part of the disputed amount frozen on the
+// receiver, in cents of the currency of the dispute.
+func (d *Dispute) FrozenAmount() int64 {
+	return d.frozenAmount
+}
+
+// Shortfall returns the part of the disputed amount the receiver had already
+// spent when the dispute was opened, so it could not be frozen.
+func (d *Dispute) Shortfall() int64 {
+	return d.Amount() - d.frozenAmount
+}
+
+// FreezeOn freezes the disputed amount on the receiver, up to what the
+// receiver has available. The rest is the shortfall.
+func (d *Dispute) FreezeOn(receiver *User) error {
+	frozenAmount := min(d.Amount(), max(receiver.AvailableBalanceIn(d.Currency()), 0))
+	if frozenAmount > 0 {
+		err := receiver.Hold(d.Currency(), frozenAmount)
+		if err != nil {
+			return err
+		}
+	}
+	d.frozenAmount = frozenAmount
+	return nil
+}
+
+func (d *Dispute) Reason() string {
+	return d.reason
+}
+
+func (d *Dispute) Evidence() string {
+	return d.evidence
+}
+
+// Response returns what the receiver answered, or an empty string.
+func (d *Dispute) Response() string {
+	return d.response
+}
+
+func (d *Dispute) Status() string {
+	return d.status
+}
+
+// IsPending tells whether the dispute is not resolved yet, so its amount is
+// still frozen.
+func (d *Dispute) IsPending() bool {
+	return d.status == DisputeOpenStatus || d.status == DisputeRespondedStatus
+}
+
+// RespondBy returns until when the receiver can respond.
+func (d *Dispute) RespondBy() time.Time {
+	return d.respondBy
+}
+
+// IsResponseOverdue tells whether the receiver let the response window pass
+// without responding.
+func (d *Dispute) IsResponseOverdue(now time.Time) bool {
+	return d.status == DisputeOpenStatus && !now.Before(d.respondBy)
+}
+
+// ChargebackID returns the transaction that gave the amount back to the
+// sender of a won dispute, or an empty string.
+func (d *Dispute) ChargebackID() string {
+	return d.chargebackID
+}
+
+// ResolvedAt returns when the dispute was won or lost, or nil while it is
+// pending.
+func (d *Dispute) ResolvedAt() *time.Time {
+	return d.resolvedAt
+}
+
+func (d *Dispute) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *Dispute) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// RecordEvent queues an event to be stored in the outbox along with the
+// dispute.
+func (d *Dispute) RecordEvent(e event.Event) {
+	d.events = append(d.events, e)
+}
+
+func (d *Dispute) Events() []event.Event {
+	return d.events
+}
+
+// Respond records the side of the receiver while the response window is open.
+func (d *Dispute) Respond(receiverID, response string, now time.Time) error {
+	if receiverID != d.receiverID {
+		return errs.ErrOnlyReceiverCanRespond
+	}
+	if d.status != DisputeOpenStatus {
+		return errs.ErrDisputeNotOpen
+	}
+	if !now.Before(d.respondBy) {
+		return errs.ErrDisputeResponseWindowClosed
+	}
+	response = strings.TrimSpace(response)
+	if response == "" || utf8.RuneCountInString(response) > maxDisputeEvidenceLength {
+		return errs.ErrInvalidDisputeResponse
+	}
+	d.status = DisputeRespondedStatus
+	d.response = response
+	d.updatedAt = now
+	return nil
+}
+
+// Win resolves the dispute in favor of the sender. The chargeback giving the
+// amount back is attached once created.
+func (d *Dispute) Win(now time.Time) error {
+	return d.resolve(DisputeWonStatus, now)
+}
+
+// AttachChargeback records the transaction that gave the disputed amount back
+// to the sender.
+func (d *Dispute) AttachChargeback(transactionID string) {
+	d.chargebackID = transactionID
+}
+
+// Lose resolves the dispute in favor of the receiver, who keeps the amount.
+func (d *Dispute) Lose(now time.Time) error {
+	return d.resolve(DisputeLostStatus, now)
+}
+
+func (d *Dispute) resolve(status string, now time.Time) error {
+	if !d.IsPending() {
+		return errs.ErrDisputeAlreadyResolved
+	}
+	d.status = status
+	d.resolvedAt = &now
+	d.updatedAt = now
+	return nil
+}
+
+// NewDispute opens a dispute of the sender over the refundable amount of the
+// transfer not refunded yet, refundedAmount being the amount in cents of the
+// received currency already refunded. The receiver can respond for
+// responseWindow.
+func NewDispute(transaction *Transaction, senderID string, refundedAmount int64, reason, evidence string, responseWindow time.Duration, now time.Time) (*Dispute, error) {
+	if transaction.IsRefund() {
+		return nil, errs.ErrRefundCannotBeDisputed
+	}
+	if senderID != transaction.SenderID() {
+		return nil, errs.ErrOnlySenderCanDispute
+	}
+	remaining := transaction.RefundableAmount() - refundedAmount
+	if remaining <= 0 {
+		return nil, errs.ErrTransactionAlreadyRefunded
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > maxDisputeReasonLength {
+		return nil, errs.ErrInvalidDisputeReason
+	}
+	evidence = strings.TrimSpace(evidence)
+	if utf8.RuneCountInString(evidence) > maxDisputeEvidenceLength {
+		return nil, errs.ErrDisputeEvidenceTooLong
+	}
+	amount, err := vo.NewMoney(remaining, transaction.ReceivedCurrency())
+	if err != nil {
+		return nil, err
+	}
+	return &Dispute{
+		id:            uuid.New(),
+		transactionID: transaction.ID(),
+		senderID:      transaction.SenderID(),
+		receiverID:    transaction.ReceiverID(),
+		amount:        amount,
+		reason:        reason,
+		evidence:      evidence,
+		status:        DisputeOpenStatus,
+		respondBy:     now.Add(responseWindow),
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+func RestoreDispute(id uuid.UUID, transactionID, senderID, receiverID string, amount, frozenAmount int64, currency, reason, evidence, response, status string, respondBy time.Time, chargebackID string, resolvedAt *time.Time, createdAt, updatedAt time.Time) (*Dispute, error) {
+	money, err := vo.NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &Dispute{
+		id:            id,
+		transactionID: transactionID,
+		senderID:      senderID,
+		receiverID:    receiverID,
+		amount:        money,
+		frozenAmount:  frozenAmount,
+		reason:        reason,
+		evidence:      evidence,
+		response:      response,
+		status:        status,
+		respondBy:     respondBy,
+		chargebackID:  chargebackID,
+		resolvedAt:    resolvedAt,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}, nil
+}
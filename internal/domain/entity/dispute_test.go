@@ -0,0 +1,146 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openDispute(t *testing.T, now time.Time) *entity.Dispute {
+	t.Helper()
+	transaction, err := entity.NewTransaction(10000, "sender123", "receiver456")
+	require.NoError(t, err)
+	dispute, err := entity.NewDispute(transaction, "sender123", 0, "not delivered", "order #42", 24*time.Hour, now)
+	require.NoError(t, err)
+	return dispute
+}
+
+func TestNewDispute_ShouldDisputeWhatWasNotRefundedYet(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	transaction, err := entity.NewTransaction(10000, "sender123", "receiver456")
+	require.NoError(t, err)
+
+	// Act
+	dispute, err := entity.NewDispute(transaction, "sender123", 2500, " not delivered ", "order #42", 24*time.Hour, now)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.DisputeOpenStatus, dispute.Status())
+	assert.Equal(t, int64(7500), dispute.Amount())
+	assert.Equal(t, "not delivered", dispute.Reason())
+	assert.Equal(t, "receiver456", dispute.ReceiverID())
+	assert.Equal(t, now.Add(24*time.Hour), dispute.RespondBy())
+	assert.True(t, dispute.IsPending())
+}
+
+func TestNewDispute_ShouldNotDisputeTheFeeKeptByThePlatform(t *testing.T) {
+	// Arrange
+	transaction, err := entity.NewTransaction(10000, "sender123", "receiver456")
+	require.NoError(t, err)
+	schedule, err := vo.NewPercentageFee(199)
+	require.NoError(t, err)
+	require.NoError(t, transaction.ChargeFee(*schedule))
+
+	// Act
+	dispute, err := entity.NewDispute(transaction, "sender123", 0, "not delivered", "", 24*time.Hour, time.Now())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(9801), dispute.Amount())
+}
+
+func TestNewDispute_ShouldRejectInvalidDisputes(t *testing.T) {
+	transaction, err := entity.NewTransaction(10000, "sender123", "receiver456")
+	require.NoError(t, err)
+	refund, err := entity.NewRefundTransaction(transaction, 0, 0)
+	require.NoError(t, err)
+	tests := []struct {
+		name           string
+		transaction    *entity.Transaction
+		senderID       string
+		refundedAmount int64
+		reason         string
+		evidence       string
+		want           error
+	}{
+		{name: "refund", transaction: refund, senderID: "receiver456", reason: "reason", want: errs.ErrRefundCannotBeDisputed},
+		{name: "not the sender", transaction: transaction, senderID: "receiver456", reason: "reason", want: errs.ErrOnlySenderCanDispute},
+		{name: "fully refunded", transaction: transaction, senderID: "sender123", refundedAmount: 10000, reason: "reason", want: errs.ErrTransactionAlreadyRefunded},
+		{name: "empty reason", transaction: transaction, senderID: "sender123", reason: " ", want: errs.ErrInvalidDisputeReason},
+		{name: "long reason", transaction: transaction, senderID: "sender123", reason: strings.Repeat("a", 141), want: errs.ErrInvalidDisputeReason},
+		{name: "long evidence", transaction: transaction, senderID: "sender123", reason: "reason", evidence: strings.Repeat("a", 2001), want: errs.ErrDisputeEvidenceTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			dispute, err := entity.NewDispute(tt.transaction, tt.senderID, tt.refundedAmount, tt.reason, tt.evidence, time.Hour, time.Now())
+
+			// Assert
+			assert.Nil(t, dispute)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestDispute_Respond_ShouldOnlyAcceptTheReceiverWithinTheWindow(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	dispute := openDispute(t, now)
+
+	// Act
+	errNotReceiver := dispute.Respond("sender123", "delivered", now)
+	errLate := dispute.Respond("receiver456", "delivered", now.Add(24*time.Hour))
+	err := dispute.Respond("receiver456", "delivered", now.Add(time.Hour))
+
+	// Assert
+	assert.ErrorIs(t, errNotReceiver, errs.ErrOnlyReceiverCanRespond)
+	assert.ErrorIs(t, errLate, errs.ErrDisputeResponseWindowClosed)
+	require.NoError(t, err)
+	assert.Equal(t, entity.DisputeRespondedStatus, dispute.Status())
+	assert.Equal(t, "delivered", dispute.Response())
+	assert.ErrorIs(t, dispute.Respond("receiver456", "again", now.Add(time.Hour)), errs.ErrDisputeNotOpen)
+}
+
+func TestDispute_IsResponseOverdue_ShouldOnlyHoldForUnansweredDisputes(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	unanswered := openDispute(t, now)
+	answered := openDispute(t, now)
+	require.NoError(t, answered.Respond("receiver456", "delivered", now))
+
+	// Act & Assert
+	assert.False(t, unanswered.IsResponseOverdue(now))
+	assert.True(t, unanswered.IsResponseOverdue(now.Add(24*time.Hour)))
+	assert.False(t, answered.IsResponseOverdue(now.Add(24*time.Hour)))
+}
+
+func TestDispute_Resolve_ShouldOnlyResolvePendingDisputes(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	won := openDispute(t, now)
+	lost := openDispute(t, now)
+
+	// Act
+	errWin := won.Win(now)
+	won.AttachChargeback("chargeback789")
+	errLose := lost.Lose(now)
+
+	// Assert
+	require.NoError(t, errWin)
+	require.NoError(t, errLose)
+	assert.Equal(t, entity.DisputeWonStatus, won.Status())
+	assert.Equal(t, "chargeback789", won.ChargebackID())
+	assert.Equal(t, entity.DisputeLostStatus, lost.Status())
+	assert.Equal(t, now, *lost.ResolvedAt())
+	assert.False(t, won.IsPending())
+	assert.ErrorIs(t, won.Lose(now), errs.ErrDisputeAlreadyResolved)
+	assert.ErrorIs(t, lost.Win(now), errs.ErrDisputeAlreadyResolved)
+}
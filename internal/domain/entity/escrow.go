@@ -0,0 +1,241 @@
+package entity
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	EscrowHeldStatus     = "held"
+	EscrowShippedStatus  = "shipped"
+	EscrowReleasedStatus = "released"
+	EscrowReturnedStatus = "returned"
+	EscrowExpiredStatus  = "expired"
+)
+
+// Escrow keeps money of the sender out of its available balance until the
+// goods it pays for are delivered. The receiver has until expiresAt to ship
+// them, otherwise the money goes back to the sender. Once shipped, the money
+// is released to the receiver when the sender confirms the delivery or, if it
+// does not, at releaseAt.
+type Escrow struct {
+	id            uuid.UUID
+	senderID      string
+	receiverID    string
+	amount        *vo.Money
+	status        string
+	expiresAt     time.Time
+	shippedAt     *time.Time
+	releaseAt     *time.Time
+	transactionID string
+	events        []event.Event
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func (e *Escrow) ID() string {
+	return e.id.String()
+}
+
+func (e *Escrow) SenderID() string {
+	return e.senderID
+}
+
+func (e *Escrow) ReceiverID() string {
+	return e.receiverID
+}
+
+// Amount returns the escrowed amount in cents.
+func (e *Escrow) Amount() int64 {
+	return e.amount.Value()
+}
+
+func (e *Escrow) Currency() string {
+	return e.amount.Currency()
+}
+
+func (e *Escrow) Status() string {
+	return e.status
+}
+
+// IsPending tells whether the money is still in escrow.
+func (e *Escrow) IsPending() bool {
+	return e.status == EscrowHeldStatus || e.status == EscrowShippedStatus
+}
+
+func (e *Escrow) IsShipped() bool {
+	return e.status == EscrowShippedStatus
+}
+
+func (e *Escrow) ExpiresAt() time.Time {
+	return e.expiresAt
+}
+
+// IsExpired tells whether the receiver can no longer ship at now, even if the
+// escrow has not been marked as expired yet.
+func (e *Escrow) IsExpired(now time.Time) bool {
+	return e.status == EscrowHeldStatus && !now.Before(e.expiresAt)
+}
+
+// ShippedAt returns when the receiver shipped the goods, or nil.
+func (e *Escrow) ShippedAt() *time.Time {
+	return e.shippedAt
+}
+
+// ReleaseAt returns when the money goes to the receiver without the sender
+// confirming, or nil until the goods are shipped.
+func (e *Escrow) ReleaseAt() *time.Time {
+	return e.releaseAt
+}
+
+// IsReleaseDue tells whether a shipped escrow reached its release deadline.
+func (e *Escrow) IsReleaseDue(now time.Time) bool {
+	return e.IsShipped() && !now.Before(*e.releaseAt)
+}
+
+// TransactionID returns the transfer that released the money, or an empty
+// string.
+func (e *Escrow) TransactionID() string {
+	return e.transactionID
+}
+
+func (e *Escrow) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+func (e *Escrow) UpdatedAt() time.Time {
+	return e.updatedAt
+}
+
+// RecordEvent queues an event to be stored in the outbox along with the
+// escrow.
+func (e *Escrow) RecordEvent(ev event.Event) {
+	e.events = append(e.events, ev)
+}
+
+func (e *Escrow) Events() []event.Event {
+	return e.events
+}
+
+// Ship records that the receiver shipped the goods, starting releaseWindow
+// for the sender to confirm the delivery.
+func (e *Escrow) Ship(receiverID string, releaseWindow time.Duration, now time.Time) error {
+	if receiverID != e.receiverID {
+		return errs.ErrOnlyReceiverCanShipEscrow
+	}
+	if e.IsShipped() {
+		return errs.ErrEscrowAlreadyShipped
+	}
+	if !e.IsPending() {
+		return errs.ErrEscrowNotPending
+	}
+	if e.IsExpired(now) {
+		return errs.ErrEscrowExpired
+	}
+	releaseAt := now.Add(releaseWindow)
+	e.status = EscrowShippedStatus
+	e.shippedAt = &now
+	e.releaseAt = &releaseAt
+	e.updatedAt = now
+	return nil
+}
+
+// Confirm releases the money once the sender confirms the delivery.
+func (e *Escrow) Confirm(senderID string, now time.Time) error {
+	if senderID != e.senderID {
+		return errs.ErrOnlySenderCanConfirmEscrow
+	}
+	return e.Release(now)
+}
+
+// Release marks the money as going to the receiver. Escrows that expired
+// before being shipped can no longer be released.
+func (e *Escrow) Release(now time.Time) error {
+	if !e.IsPending() {
+		return errs.ErrEscrowNotPending
+	}
+	if e.IsExpired(now) {
+		return errs.ErrEscrowExpired
+	}
+	e.status = EscrowReleasedStatus
+	e.updatedAt = now
+	return nil
+}
+
+// Return gives the money back to the sender, e.g. when the goods never
+// arrived.
+func (e *Escrow) Return(now time.Time) error {
+	if !e.IsPending() {
+		return errs.ErrEscrowNotPending
+	}
+	e.status = EscrowReturnedStatus
+	e.updatedAt = now
+	return nil
+}
+
+// Expire gives the money back to the sender of an escrow that was not shipped
+// in time.
+func (e *Escrow) Expire(now time.Time) error {
+	if e.IsShipped() {
+		return errs.ErrEscrowAlreadyShipped
+	}
+	if !e.IsPending() {
+		return errs.ErrEscrowNotPending
+	}
+	e.status = EscrowExpiredStatus
+	e.updatedAt = now
+	return nil
+}
+
+// AttachTransaction records the transfer that released the money.
+func (e *Escrow) AttachTransaction(transactionID string) {
+	e.transactionID = transactionID
+}
+
+// NewEscrow puts amount of the sender in escrow for the receiver, who has
+// until expiresAt to ship the goods.
+func NewEscrow(senderID, receiverID string, amount *vo.Money, expiresAt, now time.Time) (*Escrow, error) {
+	if amount.Value() <= 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	if senderID == receiverID {
+		return nil, errs.ErrTransactionInvalidSender
+	}
+	if !expiresAt.After(now) {
+		return nil, errs.ErrHoldExpiryNotInTheFuture
+	}
+	return &Escrow{
+		id:         uuid.New(),
+		senderID:   senderID,
+		receiverID: receiverID,
+		amount:     amount,
+		status:     EscrowHeldStatus,
+		expiresAt:  expiresAt,
+		createdAt:  now,
+		updatedAt:  now,
+	}, nil
+}
+
+func RestoreEscrow(id uuid.UUID, senderID, receiverID string, amount int64, currency, status string, expiresAt time.Time, shippedAt, releaseAt *time.Time, transactionID string, createdAt, updatedAt time.Time) (*Escrow, error) {
+	money, err := vo.NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &Escrow{
+		id:            id,
+		senderID:      senderID,
+		receiverID:    receiverID,
+		amount:        money,
+		status:        status,
+		expiresAt:     expiresAt,
+		shippedAt:     shippedAt,
+		releaseAt:     releaseAt,
+		transactionID: transactionID,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}, nil
+}
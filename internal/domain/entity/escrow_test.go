@@ -0,0 +1,114 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEscrow(t *testing.T, now time.Time) *entity.Escrow {
+	t.Helper()
+	escrow, err := entity.NewEscrow("sender123", "receiver456", money(t, 5000, vo.BRL), now.Add(24*time.Hour), now)
+	require.NoError(t, err)
+	return escrow
+}
+
+func TestNewEscrow_ShouldHoldTheMoneyUntilShipped(t *testing.T) {
+	// Arrange
+	now := time.Now()
+
+	// Act
+	escrow := newEscrow(t, now)
+
+	// Assert
+	assert.Equal(t, entity.EscrowHeldStatus, escrow.Status())
+	assert.True(t, escrow.IsPending())
+	assert.Nil(t, escrow.ReleaseAt())
+	assert.False(t, escrow.IsExpired(now))
+	assert.True(t, escrow.IsExpired(now.Add(24*time.Hour)))
+}
+
+func TestNewEscrow_ShouldRejectInvalidEscrows(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		receiverID string
+		amount     int64
+		expiresAt  time.Time
+		want       error
+	}{
+		{name: "zero amount", receiverID: "receiver456", amount: 0, expiresAt: now.Add(time.Hour), want: errs.ErrZeroOrNegativeAmount},
+		{name: "to the sender", receiverID: "sender123", amount: 5000, expiresAt: now.Add(time.Hour), want: errs.ErrTransactionInvalidSender},
+		{name: "expiry in the past", receiverID: "receiver456", amount: 5000, expiresAt: now, want: errs.ErrHoldExpiryNotInTheFuture},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			escrow, err := entity.NewEscrow("sender123", tt.receiverID, money(t, tt.amount, vo.BRL), tt.expiresAt, now)
+
+			// Assert
+			assert.Nil(t, escrow)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestEscrow_Ship_ShouldStartTheReleaseWindow(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	escrow := newEscrow(t, now)
+	shippedAt := now.Add(time.Hour)
+
+	// Act
+	errNotReceiver := escrow.Ship("sender123", 48*time.Hour, shippedAt)
+	err := escrow.Ship("receiver456", 48*time.Hour, shippedAt)
+
+	// Assert
+	assert.ErrorIs(t, errNotReceiver, errs.ErrOnlyReceiverCanShipEscrow)
+	require.NoError(t, err)
+	assert.Equal(t, entity.EscrowShippedStatus, escrow.Status())
+	assert.Equal(t, shippedAt.Add(48*time.Hour), *escrow.ReleaseAt())
+	assert.False(t, escrow.IsExpired(now.Add(24*time.Hour)))
+	assert.False(t, escrow.IsReleaseDue(shippedAt))
+	assert.True(t, escrow.IsReleaseDue(shippedAt.Add(48*time.Hour)))
+	assert.ErrorIs(t, escrow.Ship("receiver456", time.Hour, shippedAt), errs.ErrEscrowAlreadyShipped)
+	assert.ErrorIs(t, escrow.Expire(shippedAt), errs.ErrEscrowAlreadyShipped)
+}
+
+func TestEscrow_Ship_ShouldFailAfterExpiry(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	escrow := newEscrow(t, now)
+
+	// Act
+	err := escrow.Ship("receiver456", time.Hour, now.Add(24*time.Hour))
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrEscrowExpired)
+	assert.ErrorIs(t, escrow.Release(now.Add(24*time.Hour)), errs.ErrEscrowExpired)
+	assert.NoError(t, escrow.Expire(now.Add(24*time.Hour)))
+	assert.Equal(t, entity.EscrowExpiredStatus, escrow.Status())
+}
+
+func TestEscrow_Confirm_ShouldOnlyBeDoneByTheSender(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	escrow := newEscrow(t, now)
+
+	// Act
+	errNotSender := escrow.Confirm("receiver456", now)
+	err := escrow.Confirm("sender123", now)
+
+	// Assert
+	assert.ErrorIs(t, errNotSender, errs.ErrOnlySenderCanConfirmEscrow)
+	require.NoError(t, err)
+	assert.Equal(t, entity.EscrowReleasedStatus, escrow.Status())
+	assert.False(t, escrow.IsPending())
+	assert.ErrorIs(t, escrow.Return(now), errs.ErrEscrowNotPending)
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+)
+
+// IdempotencyKeyTTL is how long a key keeps replaying its original result.
+const IdempotencyKeyTTL = 24 * time.Hour
+
+const maxIdempotencyKeyLength = 255
+
+// IdempotencyKey ties a client supplied key to the request it was first used
+// with and to the transaction or deposit that request created.
+type IdempotencyKey struct {
+	key           string
+	requestHash   string
+	transactionID string
+	createdAt     time.Time
+	expiresAt     time.Time
+}
+
+func (k *IdempotencyKey) Key() string {
+	return k.key
+}
+
+func (k *IdempotencyKey) RequestHash() string {
+	return k.requestHash
+}
+
+func (k *IdempotencyKey) TransactionID() string {
+	return k.transactionID
+}
+
+func (k *IdempotencyKey) CreatedAt() time.Time {
+	return k.createdAt
+}
+
+func (k *IdempotencyKey) ExpiresAt() time.Time {
+	return k.expiresAt
+}
+
+// Matches reports whether the key is being reused with the same request.
+func (k *IdempotencyKey) Matches(requestHash string) bool {
+	return k.requestHash == requestHash
+}
+
+func NewIdempotencyKey(key, requestHash string) (*IdempotencyKey, error) {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return nil, errs.ErrInvalidIdempotencyKey
+	}
+	createdAt := time.Now()
+	return &IdempotencyKey{
+		key:         key,
+		requestHash: requestHash,
+		createdAt:   createdAt,
+		expiresAt:   createdAt.Add(IdempotencyKeyTTL),
+	}, nil
+}
+
+func RestoreIdempotencyKey(key, requestHash, transactionID string, createdAt, expiresAt time.Time) *IdempotencyKey {
+	return &IdempotencyKey{
+		key:           key,
+		requestHash:   requestHash,
+		transactionID: transactionID,
+		createdAt:     createdAt,
+		expiresAt:     expiresAt,
+	}
+}
@@ -0,0 +1,56 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIdempotencyKey_ShouldExpireAfterTTL(t *testing.T) {
+	// Act
+	key, err := entity.NewIdempotencyKey("retry-key", "hash")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "retry-key", key.Key())
+	assert.Equal(t, entity.IdempotencyKeyTTL, key.ExpiresAt().Sub(key.CreatedAt()))
+}
+
+func TestNewIdempotencyKey_ShouldReturnErrorWhenKeyIsEmptyOrTooLong(t *testing.T) {
+	for _, value := range []string{"", strings.Repeat("k", 256)} {
+		// Act
+		key, err := entity.NewIdempotencyKey(value, "hash")
+
+		// Assert
+		assert.Nil(t, key)
+		assert.ErrorIs(t, err, errs.ErrInvalidIdempotencyKey)
+	}
+}
+
+func TestIdempotencyKey_Matches_ShouldCompareRequestHashes(t *testing.T) {
+	// Arrange
+	key, err := entity.NewIdempotencyKey("retry-key", "hash")
+	assert.NoError(t, err)
+
+	// Act & Assert
+	assert.True(t, key.Matches("hash"))
+	assert.False(t, key.Matches("other-hash"))
+}
+
+func TestTransaction_AttachIdempotencyKey_ShouldBindKeyToTransaction(t *testing.T) {
+	// Arrange
+	transaction, err := entity.NewTransaction(1000, "sender123", "receiver456")
+	assert.NoError(t, err)
+	key, err := entity.NewIdempotencyKey("retry-key", "hash")
+	assert.NoError(t, err)
+
+	// Act
+	transaction.AttachIdempotencyKey(key)
+
+	// Assert
+	assert.Equal(t, key, transaction.IdempotencyKey())
+	assert.Equal(t, transaction.ID(), key.TransactionID())
+}
@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	DebitDirection  = "debit"
+	CreditDirection = "credit"
+)
+
+// OpeningBalanceAccountID is the system account that balances the entries
+// posted for wallets that already had money before the ledger existed.
+const OpeningBalanceAccountID = "system:opening-balance"
+
+// FXAccountID is the system account that buys the currency a converted
+// transfer is paid in and sells the currency it is received in, keeping the
+// entries of each currency balanced.
+const FXAccountID = "system:fx"
+
+// PlatformAccountID is the system account credited with the fees charged on
+// transfers, the revenue of the platform.
+const PlatformAccountID = "system:platform"
+
+type LedgerEntry struct {
+	id            uuid.UUID
+	transactionID string
+	accountID     string
+	direction     string
+	amount        int64
+	currency      string
+	createdAt     time.Time
+}
+
+func (e *LedgerEntry) ID() string {
+	return e.id.String()
+}
+
+func (e *LedgerEntry) TransactionID() string {
+	return e.transactionID
+}
+
+func (e *LedgerEntry) AccountID() string {
+	return e.accountID
+}
+
+func (e *LedgerEntry) Direction() string {
+	return e.direction
+}
+
+// Returns the entry amount in cents.
+func (e *LedgerEntry) Amount() int64 {
+	return e.amount
+}
+
+// SignedAmount returns the effect of the entry on the account balance:
+// credits increase it and debits decrease it.
+func (e *LedgerEntry) SignedAmount() int64 {
+	if e.direction == DebitDirection {
+		return -e.amount
+	}
+	return e.amount
+}
+
+func (e *LedgerEntry) Currency() string {
+	return e.currency
+}
+
+func (e *LedgerEntry) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+func NewLedgerEntry(transactionID, accountID, direction string, amount *vo.Money, createdAt time.Time) (*LedgerEntry, error) {
+	if direction != DebitDirection && direction != CreditDirection {
+		return nil, errs.ErrInvalidLedgerDirection
+	}
+	return &LedgerEntry{
+		id:            uuid.New(),
+		transactionID: transactionID,
+		accountID:     accountID,
+		direction:     direction,
+		amount:        amount.Value(),
+		currency:      amount.Currency(),
+		createdAt:     createdAt,
+	}, nil
+}
+
+// IsBalanced reports whether the debits and credits of the entries sum up to
+// the same amount in every currency.
+func IsBalanced(entries []*LedgerEntry) bool {
+	totals := make(map[string]int64)
+	for _, entry := range entries {
+		totals[entry.currency] += entry.SignedAmount()
+	}
+	for _, total := range totals {
+		if total != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func money(t *testing.T, cents int64, currency string) *vo.Money {
+	t.Helper()
+	m, err := vo.NewMoney(cents, currency)
+	require.NoError(t, err)
+	return m
+}
+
+func TestNewLedgerEntry_ShouldReturnErrorWhenDirectionIsInvalid(t *testing.T) {
+	// Act
+	entry, err := entity.NewLedgerEntry("transaction123", "account123", "sideways", money(t, 100, vo.BRL), time.Now())
+
+	// Assert
+	assert.Nil(t, entry)
+	assert.ErrorIs(t, err, errs.ErrInvalidLedgerDirection)
+}
+
+func TestNewLedgerEntry_ShouldKeepTheCurrencyOfTheAmount(t *testing.T) {
+	// Act
+	entry, err := entity.NewLedgerEntry("transaction123", "account123", entity.CreditDirection, money(t, 100, vo.USD), time.Now())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), entry.Amount())
+	assert.Equal(t, vo.USD, entry.Currency())
+}
+
+func TestLedgerEntry_SignedAmount_ShouldBeNegativeForDebitsAndPositiveForCredits(t *testing.T) {
+	// Arrange
+	debit, err := entity.NewLedgerEntry("transaction123", "account123", entity.DebitDirection, money(t, 150, vo.BRL), time.Now())
+	require.NoError(t, err)
+	credit, err := entity.NewLedgerEntry("transaction123", "account456", entity.CreditDirection, money(t, 150, vo.BRL), time.Now())
+	require.NoError(t, err)
+
+	// Act & Assert
+	assert.Equal(t, int64(-150), debit.SignedAmount())
+	assert.Equal(t, int64(150), credit.SignedAmount())
+}
+
+func TestIsBalanced_ShouldDetectWhetherDebitsAndCreditsMatch(t *testing.T) {
+	// Arrange
+	debit, err := entity.NewLedgerEntry("transaction123", "account123", entity.DebitDirection, money(t, 150, vo.BRL), time.Now())
+	require.NoError(t, err)
+	credit, err := entity.NewLedgerEntry("transaction123", "account456", entity.CreditDirection, money(t, 150, vo.BRL), time.Now())
+	require.NoError(t, err)
+	partialCredit, err := entity.NewLedgerEntry("transaction123", "account456", entity.CreditDirection, money(t, 100, vo.BRL), time.Now())
+	require.NoError(t, err)
+
+	// Act & Assert
+	assert.True(t, entity.IsBalanced([]*entity.LedgerEntry{debit, credit}))
+	assert.False(t, entity.IsBalanced([]*entity.LedgerEntry{debit, partialCredit}))
+	assert.True(t, entity.IsBalanced(nil))
+}
+
+func TestIsBalanced_ShouldBalanceEachCurrencySeparately(t *testing.T) {
+	// Arrange
+	debit, err := entity.NewLedgerEntry("transaction123", "account123", entity.DebitDirection, money(t, 150, vo.USD), time.Now())
+	require.NoError(t, err)
+	credit, err := entity.NewLedgerEntry("transaction123", "account456", entity.CreditDirection, money(t, 150, vo.BRL), time.Now())
+	require.NoError(t, err)
+
+	// Act & Assert
+	assert.False(t, entity.IsBalanced([]*entity.LedgerEntry{debit, credit}))
+}
@@ -0,0 +1,339 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	MandateActiveStatus    = "active"
+	MandatePausedStatus    = "paused"
+	MandateCancelledStatus = "cancelled"
+	MandateCompletedStatus = "completed"
+)
+
+const (
+	MandateExecutionExecutedStatus = "executed"
+	MandateExecutionFailedStatus   = "failed"
+)
+
+// Mandate authorizes recurring transfers of the same amount from a sender to a
+// receiver, e.g. a subscription to a merchant. It runs until it is cancelled,
+// its end date is reached or it has run its maximum number of executions.
+type Mandate struct {
+	id               uuid.UUID
+	senderID         string
+	receiverID       string
+	amount           *vo.Money
+	receiverCurrency string
+	recurrence       *vo.Recurrence
+	startAt          time.Time
+	endAt            *time.Time
+	maxExecutions    int
+	executionCount   int
+	nextExecutionAt  time.Time
+	status           string
+	events           []event.Event
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+func (m *Mandate) ID() string {
+	return m.id.String()
+}
+
+func (m *Mandate) SenderID() string {
+	return m.senderID
+}
+
+func (m *Mandate) ReceiverID() string {
+	return m.receiverID
+}
+
+// Amount returns the amount in cents the sender pays on every execution.
+func (m *Mandate) Amount() int64 {
+	return m.amount.Value()
+}
+
+// FormattedAmount returns the amount as a decimal with two places.
+func (m *Mandate) FormattedAmount() string {
+	return m.amount.String()
+}
+
+func (m *Mandate) Currency() string {
+	return m.amount.Currency()
+}
+
+// ReceiverCurrency returns the currency the receiver is credited in.
+func (m *Mandate) ReceiverCurrency() string {
+	return m.receiverCurrency
+}
+
+func (m *Mandate) Recurrence() *vo.Recurrence {
+	return m.recurrence
+}
+
+// StartAt returns the first execution date, which weekly and monthly
+// recurrences repeat from.
+func (m *Mandate) StartAt() time.Time {
+	return m.startAt
+}
+
+// EndAt returns the date after which the mandate no longer runs, or nil.
+func (m *Mandate) EndAt() *time.Time {
+	return m.endAt
+}
+
+// MaxExecutions returns how many times the mandate runs, zero being unlimited.
+func (m *Mandate) MaxExecutions() int {
+	return m.maxExecutions
+}
+
+func (m *Mandate) ExecutionCount() int {
+	return m.executionCount
+}
+
+func (m *Mandate) NextExecutionAt() time.Time {
+	return m.nextExecutionAt
+}
+
+func (m *Mandate) Status() string {
+	return m.status
+}
+
+func (m *Mandate) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func (m *Mandate) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
+// NextIdempotencyKey is the key the next execution runs with, so an execution
+// retried after a crash replays its transaction instead of paying twice.
+func (m *Mandate) NextIdempotencyKey() string {
+	return "mandate:" + m.ID() + ":" + strconv.Itoa(m.executionCount+1)
+}
+
+func (m *Mandate) RecordEvent(e event.Event) {
+	m.events = append(m.events, e)
+}
+
+func (m *Mandate) Events() []event.Event {
+	return m.events
+}
+
+// Pause stops an active mandate from running until it is resumed.
+func (m *Mandate) Pause(now time.Time) error {
+	if m.status != MandateActiveStatus {
+		return errs.ErrMandateNotActive
+	}
+	m.status = MandatePausedStatus
+	m.updatedAt = now
+	return nil
+}
+
+// Resume reactivates a paused mandate. Executions missed while it was paused
+// are skipped rather than charged all at once.
+func (m *Mandate) Resume(now time.Time) error {
+	if m.status != MandatePausedStatus {
+		return errs.ErrMandateNotPaused
+	}
+	m.status = MandateActiveStatus
+	m.updatedAt = now
+	if m.nextExecutionAt.Before(now) {
+		m.scheduleNextExecution(now)
+	}
+	return nil
+}
+
+// Cancel stops the mandate for good.
+func (m *Mandate) Cancel(now time.Time) error {
+	if m.status != MandateActiveStatus && m.status != MandatePausedStatus {
+		return errs.ErrMandateAlreadyFinished
+	}
+	m.status = MandateCancelledStatus
+	m.updatedAt = now
+	return nil
+}
+
+// RecordExecution records the outcome of the execution due at
+// NextExecutionAt, a failure when failureReason is not empty, and schedules
+// the next one. Executions missed while the scheduler was down are skipped.
+func (m *Mandate) RecordExecution(transactionID, failureReason string, now time.Time) *MandateExecution {
+	m.executionCount++
+	execution := &MandateExecution{
+		id:            uuid.New(),
+		mandateID:     m.ID(),
+		sequence:      m.executionCount,
+		scheduledFor:  m.nextExecutionAt,
+		status:        MandateExecutionExecutedStatus,
+		transactionID: transactionID,
+		createdAt:     now,
+	}
+	if failureReason != "" {
+		execution.status = MandateExecutionFailedStatus
+		execution.transactionID = ""
+		execution.failureReason = failureReason
+	}
+
+	m.updatedAt = now
+	if m.maxExecutions > 0 && m.executionCount >= m.maxExecutions {
+		m.status = MandateCompletedStatus
+		return execution
+	}
+	after := m.nextExecutionAt
+	if now.After(after) {
+		after = now
+	}
+	m.scheduleNextExecution(after)
+	return execution
+}
+
+// scheduleNextExecution moves the mandate to its first occurrence after the
+// given time, completing it when there is none before its end date.
+func (m *Mandate) scheduleNextExecution(after time.Time) {
+	next := m.recurrence.Next(m.startAt, after)
+	if next.IsZero() || (m.endAt != nil && next.After(*m.endAt)) {
+		m.status = MandateCompletedStatus
+		return
+	}
+	m.nextExecutionAt = next
+}
+
+// NewMandate creates a mandate transferring amount on every occurrence of the
+// recurrence from startAt on. endAt and maxExecutions are optional limits, nil
+// and zero meaning no limit.
+func NewMandate(senderID, receiverID string, amount *vo.Money, receiverCurrency string, recurrence *vo.Recurrence, startAt time.Time, endAt *time.Time, maxExecutions int, now time.Time) (*Mandate, error) {
+	if amount.Value() <= 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	if startAt.Before(now) {
+		return nil, errs.ErrMandateStartInThePast
+	}
+	if endAt != nil && !endAt.After(startAt) {
+		return nil, errs.ErrInvalidMandateEnd
+	}
+	if maxExecutions < 0 {
+		return nil, errs.ErrInvalidMandateMaxExecutions
+	}
+	if receiverCurrency == "" {
+		receiverCurrency = amount.Currency()
+	}
+	if _, err := vo.NewCurrency(receiverCurrency); err != nil {
+		return nil, err
+	}
+
+	firstExecutionAt := recurrence.Next(startAt, startAt.Add(-time.Nanosecond))
+	if firstExecutionAt.IsZero() || (endAt != nil && firstExecutionAt.After(*endAt)) {
+		return nil, errs.ErrRecurrenceNeverOccurs
+	}
+
+	return &Mandate{
+		id:               uuid.New(),
+		senderID:         senderID,
+		receiverID:       receiverID,
+		amount:           amount,
+		receiverCurrency: receiverCurrency,
+		recurrence:       recurrence,
+		startAt:          startAt,
+		endAt:            endAt,
+		maxExecutions:    maxExecutions,
+		nextExecutionAt:  firstExecutionAt,
+		status:           MandateActiveStatus,
+		createdAt:        now,
+		updatedAt:        now,
+	}, nil
+}
+
+func RestoreMandate(id uuid.UUID, senderID, receiverID string, amount int64, currency, receiverCurrency, frequency, cronExpression string, startAt time.Time, endAt *time.Time, maxExecutions, executionCount int, nextExecutionAt time.Time, status string, createdAt, updatedAt time.Time) (*Mandate, error) {
+	money, err := vo.NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	recurrence, err := vo.NewRecurrence(frequency, cronExpression)
+	if err != nil {
+		return nil, err
+	}
+	return &Mandate{
+		id:               id,
+		senderID:         senderID,
+		receiverID:       receiverID,
+		amount:           money,
+		receiverCurrency: receiverCurrency,
+		recurrence:       recurrence,
+		startAt:          startAt,
+		endAt:            endAt,
+		maxExecutions:    maxExecutions,
+		executionCount:   executionCount,
+		nextExecutionAt:  nextExecutionAt,
+		status:           status,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}, nil
+}
+
+// MandateExecution is the outcome of one run of a mandate.
+type MandateExecution struct {
+	id            uuid.UUID
+	mandateID     string
+	sequence      int
+	scheduledFor  time.Time
+	status        string
+	transactionID string
+	failureReason string
+	createdAt     time.Time
+}
+
+func (e *MandateExecution) ID() string {
+	return e.id.String()
+}
+
+func (e *MandateExecution) MandateID() string {
+	return e.mandateID
+}
+
+// Sequence returns the position of the execution, starting at one.
+func (e *MandateExecution) Sequence() int {
+	return e.sequence
+}
+
+func (e *MandateExecution) ScheduledFor() time.Time {
+	return e.scheduledFor
+}
+
+func (e *MandateExecution) Status() string {
+	return e.status
+}
+
+// TransactionID returns the transaction created by the execution, or an empty
+// string when it failed.
+func (e *MandateExecution) TransactionID() string {
+	return e.transactionID
+}
+
+func (e *MandateExecution) FailureReason() string {
+	return e.failureReason
+}
+
+func (e *MandateExecution) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+func RestoreMandateExecution(id uuid.UUID, mandateID string, sequence int, scheduledFor time.Time, status, transactionID, failureReason string, createdAt time.Time) *MandateExecution {
+	return &MandateExecution{
+		id:            id,
+		mandateID:     mandateID,
+		sequence:      sequence,
+		scheduledFor:  scheduledFor,
+		status:        status,
+		transactionID: transactionID,
+		failureReason: failureReason,
+		createdAt:     createdAt,
+	}
+}
@@ -0,0 +1,159 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recurrence(t *testing.T, frequency, expression string) *vo.Recurrence {
+	t.Helper()
+	r, err := vo.NewRecurrence(frequency, expression)
+	require.NoError(t, err)
+	return r
+}
+
+func TestNewMandate_ShouldScheduleTheFirstExecutionAtStart(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	startAt := now.Add(time.Hour)
+
+	// Act
+	mandate, err := entity.NewMandate("sender123", "receiver456", money(t, 2990, vo.BRL), "", recurrence(t, vo.MonthlyFrequency, ""), startAt, nil, 0, now)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entity.MandateActiveStatus, mandate.Status())
+	assert.Equal(t, startAt, mandate.NextExecutionAt())
+	assert.Equal(t, vo.BRL, mandate.ReceiverCurrency())
+	assert.Equal(t, "mandate:"+mandate.ID()+":1", mandate.NextIdempotencyKey())
+}
+
+func TestNewMandate_ShouldRejectInvalidLimits(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	weekly := recurrence(t, vo.WeeklyFrequency, "")
+	endBeforeStart := now.Add(-time.Hour)
+	endBeforeFirstRun := time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		recurrence    *vo.Recurrence
+		startAt       time.Time
+		endAt         *time.Time
+		maxExecutions int
+		err           error
+	}{
+		{"start in the past", weekly, now.Add(-time.Minute), nil, 0, errs.ErrMandateStartInThePast},
+		{"end before start", weekly, now, &endBeforeStart, 0, errs.ErrInvalidMandateEnd},
+		{"negative max executions", weekly, now, nil, -1, errs.ErrInvalidMandateMaxExecutions},
+		// Fridays only, the first one falls after the end
+		{"never occurs", recurrence(t, vo.CronFrequency, "0 9 * * 5"), now, &endBeforeFirstRun, 0, errs.ErrRecurrenceNeverOccurs},
+	}
+	for _, tt := range tests {
+		// Act
+		mandate, err := entity.NewMandate("sender123", "receiver456", money(t, 2990, vo.BRL), "", tt.recurrence, tt.startAt, tt.endAt, tt.maxExecutions, now)
+
+		// Assert
+		assert.Nil(t, mandate, tt.name)
+		assert.ErrorIs(t, err, tt.err, tt.name)
+	}
+}
+
+func TestMandate_RecordExecution_ShouldTrackExecutionsAndCompleteAtMaxExecutions(t *testing.T) {
+	// Arrange
+	startAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	mandate, err := entity.NewMandate("sender123", "receiver456", money(t, 2990, vo.BRL), "", recurrence(t, vo.WeeklyFrequency, ""), startAt, nil, 2, startAt)
+	require.NoError(t, err)
+
+	// Act
+	first := mandate.RecordExecution("transaction-1", "", startAt)
+	second := mandate.RecordExecution("", "insufficient balance", startAt.AddDate(0, 0, 7))
+
+	// Assert
+	assert.Equal(t, 1, first.Sequence())
+	assert.Equal(t, startAt, first.ScheduledFor())
+	assert.Equal(t, entity.MandateExecutionExecutedStatus, first.Status())
+	assert.Equal(t, "transaction-1", first.TransactionID())
+
+	assert.Equal(t, 2, second.Sequence())
+	assert.Equal(t, startAt.AddDate(0, 0, 7), second.ScheduledFor())
+	assert.Equal(t, entity.MandateExecutionFailedStatus, second.Status())
+	assert.Equal(t, "insufficient balance", second.FailureReason())
+
+	assert.Equal(t, 2, mandate.ExecutionCount())
+	assert.Equal(t, entity.MandateCompletedStatus, mandate.Status())
+}
+
+func TestMandate_RecordExecution_ShouldCompleteAfterEndDate(t *testing.T) {
+	// Arrange
+	startAt := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+	endAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	mandate, err := entity.NewMandate("sender123", "receiver456", money(t, 2990, vo.BRL), "", recurrence(t, vo.MonthlyFrequency, ""), startAt, &endAt, 0, startAt)
+	require.NoError(t, err)
+
+	// Act
+	mandate.RecordExecution("transaction-1", "", startAt)
+	nextExecutionAt := mandate.NextExecutionAt()
+	mandate.RecordExecution("transaction-2", "", nextExecutionAt)
+
+	// Assert
+	assert.Equal(t, time.Date(2026, 2, 28, 9, 0, 0, 0, time.UTC), nextExecutionAt)
+	assert.Equal(t, entity.MandateCompletedStatus, mandate.Status())
+}
+
+func TestMandate_RecordExecution_ShouldSkipExecutionsMissedByTheScheduler(t *testing.T) {
+	// Arrange
+	startAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	mandate, err := entity.NewMandate("sender123", "receiver456", money(t, 2990, vo.BRL), "", recurrence(t, vo.WeeklyFrequency, ""), startAt, nil, 0, startAt)
+	require.NoError(t, err)
+
+	// Act
+	mandate.RecordExecution("transaction-1", "", startAt.AddDate(0, 0, 15))
+
+	// Assert
+	assert.Equal(t, startAt.AddDate(0, 0, 21), mandate.NextExecutionAt())
+	assert.Equal(t, entity.MandateActiveStatus, mandate.Status())
+}
+
+func TestMandate_PauseAndResume_ShouldSkipExecutionsMissedWhilePaused(t *testing.T) {
+	// Arrange
+	startAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	mandate, err := entity.NewMandate("sender123", "receiver456", money(t, 2990, vo.BRL), "", recurrence(t, vo.WeeklyFrequency, ""), startAt, nil, 0, startAt)
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, mandate.Pause(startAt))
+	errPausedTwice := mandate.Pause(startAt)
+	require.NoError(t, mandate.Resume(startAt.AddDate(0, 0, 10)))
+	errResumedTwice := mandate.Resume(startAt)
+
+	// Assert
+	assert.ErrorIs(t, errPausedTwice, errs.ErrMandateNotActive)
+	assert.ErrorIs(t, errResumedTwice, errs.ErrMandateNotPaused)
+	assert.Equal(t, entity.MandateActiveStatus, mandate.Status())
+	assert.Equal(t, startAt.AddDate(0, 0, 14), mandate.NextExecutionAt())
+}
+
+func TestMandate_Cancel_ShouldOnlyCancelRunningMandates(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	mandate, err := entity.NewMandate("sender123", "receiver456", money(t, 2990, vo.BRL), "", recurrence(t, vo.WeeklyFrequency, ""), now, nil, 0, now)
+	require.NoError(t, err)
+	require.NoError(t, mandate.Pause(now))
+
+	// Act
+	err = mandate.Cancel(now)
+	errCancelledTwice := mandate.Cancel(now)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entity.MandateCancelledStatus, mandate.Status())
+	assert.ErrorIs(t, errCancelledTwice, errs.ErrMandateAlreadyFinished)
+	assert.ErrorIs(t, mandate.Resume(now), errs.ErrMandateNotPaused)
+}
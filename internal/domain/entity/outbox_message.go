@@ -0,0 +1,121 @@
+package entity
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com/google/uuid"
+)
+
+const (
+	OutboxPendingStatus = "pending"
+	OutboxSentStatus    = "sent"
+	OutboxFailedStatus  = "failed"
+)
+
+const (
+	// MaxOutboxAttempts is how many times a message is published before it is
+	// given up on and left for manual inspection.
+	MaxOutboxAttempts = 10
+	outboxBaseBackoff = time.Second
+	outboxMaxBackoff  = 5 * time.Minute
+)
+
+// OutboxMessage is an event waiting to be published to the queue.
+type OutboxMessage struct {
+	id            uuid.UUID
+	eventName     string
+	payload       []byte
+	status        string
+	attempts      int
+	lastError     string
+	nextAttemptAt time.Time
+	createdAt     time.Time
+	sentAt        *time.Time
+}
+
+func (m *OutboxMessage) ID() string {
+	return m.id.String()
+}
+
+func (m *OutboxMessage) EventName() string {
+	return m.eventName
+}
+
+func (m *OutboxMessage) Payload() []byte {
+	return m.payload
+}
+
+func (m *OutboxMessage) Status() string {
+	return m.status
+}
+
+func (m *OutboxMessage) Attempts() int {
+	return m.attempts
+}
+
+func (m *OutboxMessage) LastError() string {
+	return m.lastError
+}
+
+func (m *OutboxMessage) NextAttemptAt() time.Time {
+	return m.nextAttemptAt
+}
+
+func (m *OutboxMessage) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func (m *OutboxMessage) SentAt() *time.Time {
+	return m.sentAt
+}
+
+// MarkSent records a successful publication.
+func (m *OutboxMessage) MarkSent(now time.Time) {
+	m.attempts++
+	m.status = OutboxSentStatus
+	m.lastError = ""
+	m.sentAt = &now
+}
+
+// MarkFailed records a failed publication and schedules the next attempt with
+// an exponential backoff, giving up after MaxOutboxAttempts.
+func (m *OutboxMessage) MarkFailed(err error, now time.Time) {
+	m.attempts++
+	m.lastError = err.Error()
+	if m.attempts >= MaxOutboxAttempts {
+		m.status = OutboxFailedStatus
+		return
+	}
+	backoff := outboxBaseBackoff << (m.attempts - 1)
+	if backoff > outboxMaxBackoff {
+		backoff = outboxMaxBackoff
+	}
+	m.nextAttemptAt = now.Add(backoff)
+}
+
+func NewOutboxMessage(e event.Event) *OutboxMessage {
+	now := time.Now()
+	return &OutboxMessage{
+		id:            uuid.New(),
+		eventName:     e.Name(),
+		payload:       e.ToJSON(),
+		status:        OutboxPendingStatus,
+		nextAttemptAt: now,
+		createdAt:     now,
+	}
+}
+
+func RestoreOutboxMessage(id uuid.UUID, eventName string, payload []byte, status string, attempts int, lastError string, nextAttemptAt, createdAt time.Time, sentAt *time.Time) *OutboxMessage {
+	return &OutboxMessage{
+		id:            id,
+		eventName:     eventName,
+		payload:       payload,
+		status:        status,
+		attempts:      attempts,
+		lastError:     lastError,
+		nextAttemptAt: nextAttemptAt,
+		createdAt:     createdAt,
+		sentAt:        sentAt,
+	}
+}
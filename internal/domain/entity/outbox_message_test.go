@@ -0,0 +1,55 @@
+package entity_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOutboxMessage_ShouldBePendingWithEventPayload(t *testing.T) {
+	// Arrange
+	e := event.NewCreateTransactionEventV1(uuid.NewString(), event.Amount{InCents: 1000, Currency: "BRL"}, event.Amount{InCents: 1000, Currency: "BRL"}, event.Amount{Currency: "BRL"}, "1", uuid.New(), uuid.New())
+
+	// Act
+	message := entity.NewOutboxMessage(e)
+
+	// Assert
+	assert.Equal(t, "CreateTransactionEventV1", message.EventName())
+	assert.Equal(t, e.ToJSON(), message.Payload())
+	assert.Equal(t, entity.OutboxPendingStatus, message.Status())
+	assert.Zero(t, message.Attempts())
+}
+
+func TestOutboxMessage_MarkFailed_ShouldBackOffExponentially(t *testing.T) {
+	// Arrange
+	message := entity.NewOutboxMessage(event.NewCreateTransactionEventV1(uuid.NewString(), event.Amount{InCents: 1000, Currency: "BRL"}, event.Amount{InCents: 1000, Currency: "BRL"}, event.Amount{Currency: "BRL"}, "1", uuid.New(), uuid.New()))
+	now := time.Now()
+
+	// Act & Assert
+	message.MarkFailed(errors.New("boom"), now)
+	assert.Equal(t, now.Add(time.Second), message.NextAttemptAt())
+
+	message.MarkFailed(errors.New("boom"), now)
+	assert.Equal(t, now.Add(2*time.Second), message.NextAttemptAt())
+	assert.Equal(t, entity.OutboxPendingStatus, message.Status())
+}
+
+func TestOutboxMessage_MarkFailed_ShouldGiveUpAfterMaxAttempts(t *testing.T) {
+	// Arrange
+	message := entity.NewOutboxMessage(event.NewCreateTransactionEventV1(uuid.NewString(), event.Amount{InCents: 1000, Currency: "BRL"}, event.Amount{InCents: 1000, Currency: "BRL"}, event.Amount{Currency: "BRL"}, "1", uuid.New(), uuid.New()))
+
+	// Act
+	for range entity.MaxOutboxAttempts {
+		message.MarkFailed(errors.New("boom"), time.Now())
+	}
+
+	// Assert
+	assert.Equal(t, entity.OutboxFailedStatus, message.Status())
+	assert.Equal(t, entity.MaxOutboxAttempts, message.Attempts())
+	assert.Equal(t, "boom", message.LastError())
+}
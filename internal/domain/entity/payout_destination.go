@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com/google/uuid"
+)
+
+const (
+	BankAccountDestinationKind = "bank_account"
+	PixKeyDestinationKind      = "pix_key"
+)
+
+// maxPixKeyLength is the longest key accepted by PIX, a random (EVP) key
+// being 36 characters and e-mails being up to 77.
+const maxPixKeyLength = 77
+
+var (
+	bankCodeRegex      = regexp.MustCompile(`^\d{3}$`)
+	branchRegex        = regexp.MustCompile(`^\d{1,5}$`)
+	accountNumberRegex = regexp.MustCompile(`^\d{1,12}(-[\dXx])?$`)
+)
+
+// PayoutDestination is a bank account or a PIX key registered by a user to
+// withdraw money to.
+type PayoutDestination struct {
+	id            uuid.UUID
+	userID        string
+	kind          string
+	bankCode      string
+	branch        string
+	accountNumber string
+	pixKey        string
+	createdAt     time.Time
+}
+
+func (d *PayoutDestination) ID() string {
+	return d.id.String()
+}
+
+func (d *PayoutDestination) UserID() string {
+	return d.userID
+}
+
+func (d *PayoutDestination) Kind() string {
+	return d.kind
+}
+
+func (d *PayoutDestination) BankCode() string {
+	return d.bankCode
+}
+
+func (d *PayoutDestination) Branch() string {
+	return d.branch
+}
+
+func (d *PayoutDestination) AccountNumber() string {
+	return d.accountNumber
+}
+
+func (d *PayoutDestination) PixKey() string {
+	return d.pixKey
+}
+
+func (d *PayoutDestination) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// BelongsTo reports whether the destination was registered by the user.
+func (d *PayoutDestination) BelongsTo(userID string) bool {
+	return d.userID == userID
+}
+
+func NewBankAccountDestination(userID, bankCode, branch, accountNumber string) (*PayoutDestination, error) {
+	if !bankCodeRegex.MatchString(bankCode) || !branchRegex.MatchString(branch) || !accountNumberRegex.MatchString(accountNumber) {
+		return nil, errs.ErrInvalidBankAccount
+	}
+	return &PayoutDestination{
+		id:            uuid.New(),
+		userID:        userID,
+		kind:          BankAccountDestinationKind,
+		bankCode:      bankCode,
+		branch:        branch,
+		accountNumber: strings.ToUpper(accountNumber),
+		createdAt:     time.Now(),
+	}, nil
+}
+
+func NewPixKeyDestination(userID, pixKey string) (*PayoutDestination, error) {
+	pixKey = strings.TrimSpace(pixKey)
+	if pixKey == "" || len(pixKey) > maxPixKeyLength {
+		return nil, errs.ErrInvalidPixKey
+	}
+	return &PayoutDestination{
+		id:        uuid.New(),
+		userID:    userID,
+		kind:      PixKeyDestinationKind,
+		pixKey:    pixKey,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func RestorePayoutDestination(id uuid.UUID, userID, kind, bankCode, branch, accountNumber, pixKey string, createdAt time.Time) *PayoutDestination {
+	return &PayoutDestination{
+		id:            id,
+		userID:        userID,
+		kind:          kind,
+		bankCode:      bankCode,
+		branch:        branch,
+		accountNumber: accountNumber,
+		pixKey:        pixKey,
+		createdAt:     createdAt,
+	}
+}
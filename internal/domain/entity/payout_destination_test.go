@@ -0,0 +1,57 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBankAccountDestination_ShouldCreateBankAccount(t *testing.T) {
+	// Act
+	destination, err := entity.NewBankAccountDestination("user123", "341", "0001", "12345-x")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entity.BankAccountDestinationKind, destination.Kind())
+	assert.Equal(t, "341", destination.BankCode())
+	assert.Equal(t, "0001", destination.Branch())
+	assert.Equal(t, "12345-X", destination.AccountNumber())
+	assert.True(t, destination.BelongsTo("user123"))
+	assert.False(t, destination.BelongsTo("user456"))
+}
+
+func TestNewBankAccountDestination_ShouldReturnErrorWhenAccountIsInvalid(t *testing.T) {
+	tests := []struct {
+		bankCode, branch, accountNumber string
+	}{
+		{"34", "0001", "12345"},
+		{"341", "", "12345"},
+		{"341", "0001", ""},
+		{"341", "0001", "12a45"},
+	}
+	for _, tt := range tests {
+		// Act
+		destination, err := entity.NewBankAccountDestination("user123", tt.bankCode, tt.branch, tt.accountNumber)
+
+		// Assert
+		assert.Nil(t, destination)
+		assert.ErrorIs(t, err, errs.ErrInvalidBankAccount)
+	}
+}
+
+func TestNewPixKeyDestination_ShouldValidateKeyLength(t *testing.T) {
+	// Act
+	destination, err := entity.NewPixKeyDestination("user123", " john@mail.com ")
+	_, emptyErr := entity.NewPixKeyDestination("user123", "  ")
+	_, longErr := entity.NewPixKeyDestination("user123", strings.Repeat("k", 78))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entity.PixKeyDestinationKind, destination.Kind())
+	assert.Equal(t, "john@mail.com", destination.PixKey())
+	assert.ErrorIs(t, emptyErr, errs.ErrInvalidPixKey)
+	assert.ErrorIs(t, longErr, errs.ErrInvalidPixKey)
+}
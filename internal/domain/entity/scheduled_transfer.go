@@ -0,0 +1,213 @@
+package entity
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	ScheduledTransferScheduledStatus = "scheduled"
+	ScheduledTransferExecutedStatus  = "executed"
+	ScheduledTransferFailedStatus    = "failed"
+	ScheduledTransferCancelledStatus = "cancelled"
+)
+
+// ScheduledTransfer is a transfer requested now to be executed at a later
+// date, going through the same checks as an immediate transfer at that time.
+type ScheduledTransfer struct {
+	id               uuid.UUID
+	senderID         string
+	receiverID       string
+	amount           *vo.Money
+	receiverCurrency string
+	executeAt        time.Time
+	status           string
+	transactionID    string
+	failureReason    string
+	requestKey       string
+	requestHash      string
+	events           []event.Event
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+func (s *ScheduledTransfer) ID() string {
+	return s.id.String()
+}
+
+func (s *ScheduledTransfer) SenderID() string {
+	return s.senderID
+}
+
+func (s *ScheduledTransfer) ReceiverID() string {
+	return s.receiverID
+}
+
+// Amount returns the amount in cents the sender will pay.
+func (s *ScheduledTransfer) Amount() int64 {
+	return s.amount.Value()
+}
+
+// FormattedAmount returns the amount as a decimal with two places.
+func (s *ScheduledTransfer) FormattedAmount() string {
+	return s.amount.String()
+}
+
+func (s *ScheduledTransfer) Currency() string {
+	return s.amount.Currency()
+}
+
+// ReceiverCurrency returns the currency the receiver will be credited in.
+func (s *ScheduledTransfer) ReceiverCurrency() string {
+	return s.receiverCurrency
+}
+
+func (s *ScheduledTransfer) ExecuteAt() time.Time {
+	return s.executeAt
+}
+
+func (s *ScheduledTransfer) Status() string {
+	return s.status
+}
+
+// TransactionID returns the transaction created by the execution, or an
+// empty string.
+func (s *ScheduledTransfer) TransactionID() string {
+	return s.transactionID
+}
+
+// FailureReason returns why the execution failed, or an empty string.
+func (s *ScheduledTransfer) FailureReason() string {
+	return s.failureReason
+}
+
+func (s *ScheduledTransfer) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *ScheduledTransfer) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// RequestKey returns the idempotency key the client scheduled the transfer
+// with, or an empty string.
+func (s *ScheduledTransfer) RequestKey() string {
+	return s.requestKey
+}
+
+// RequestHash fingerprints the request the transfer was scheduled with.
+func (s *ScheduledTransfer) RequestHash() string {
+	return s.requestHash
+}
+
+// AttachRequestKey ties the transfer to the idempotency key of the request
+// scheduling it, so a retry of that request finds it instead of scheduling
+// the transfer again.
+func (s *ScheduledTransfer) AttachRequestKey(key, requestHash string) error {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return errs.ErrInvalidIdempotencyKey
+	}
+	s.requestKey = key
+	s.requestHash = requestHash
+	return nil
+}
+
+// MatchesRequest reports whether the request key is being reused with the
+// same request.
+func (s *ScheduledTransfer) MatchesRequest(requestHash string) bool {
+	return s.requestHash == requestHash
+}
+
+// IdempotencyKey is the key the transfer is executed with, so an execution
+// retried after a crash replays the transaction instead of paying twice.
+func (s *ScheduledTransfer) IdempotencyKey() string {
+	return "scheduled-transfer:" + s.ID()
+}
+
+func (s *ScheduledTransfer) RecordEvent(e event.Event) {
+	s.events = append(s.events, e)
+}
+
+func (s *ScheduledTransfer) Events() []event.Event {
+	return s.events
+}
+
+// Cancel prevents a transfer that has not been executed yet from running.
+func (s *ScheduledTransfer) Cancel(now time.Time) error {
+	if s.status != ScheduledTransferScheduledStatus {
+		return errs.ErrScheduledTransferNotCancellable
+	}
+	s.status = ScheduledTransferCancelledStatus
+	s.updatedAt = now
+	return nil
+}
+
+// MarkExecuted records the transaction created when the transfer ran.
+func (s *ScheduledTransfer) MarkExecuted(transactionID string, now time.Time) {
+	s.status = ScheduledTransferExecutedStatus
+	s.transactionID = transactionID
+	s.failureReason = ""
+	s.updatedAt = now
+}
+
+// MarkFailed records why the transfer could not be executed, e.g. the sender
+// not having enough money at that time.
+func (s *ScheduledTransfer) MarkFailed(reason string, now time.Time) {
+	s.status = ScheduledTransferFailedStatus
+	s.failureReason = reason
+	s.updatedAt = now
+}
+
+// NewScheduledTransfer schedules a transfer of amount to be executed at
+// executeAt, which must be in the future.
+func NewScheduledTransfer(senderID, receiverID string, amount *vo.Money, receiverCurrency string, executeAt, now time.Time) (*ScheduledTransfer, error) {
+	if amount.Value() <= 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	if !executeAt.After(now) {
+		return nil, errs.ErrExecuteAtNotInTheFuture
+	}
+	if receiverCurrency == "" {
+		receiverCurrency = amount.Currency()
+	}
+	if _, err := vo.NewCurrency(receiverCurrency); err != nil {
+		return nil, err
+	}
+	return &ScheduledTransfer{
+		id:               uuid.New(),
+		senderID:         senderID,
+		receiverID:       receiverID,
+		amount:           amount,
+		receiverCurrency: receiverCurrency,
+		executeAt:        executeAt,
+		status:           ScheduledTransferScheduledStatus,
+		createdAt:        now,
+		updatedAt:        now,
+	}, nil
+}
+
+func RestoreScheduledTransfer(id uuid.UUID, senderID, receiverID string, amount int64, currency, receiverCurrency string, executeAt time.Time, status, transactionID, failureReason, requestKey, requestHash string, createdAt, updatedAt time.Time) (*ScheduledTransfer, error) {
+	money, err := vo.NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &ScheduledTransfer{
+		id:               id,
+		senderID:         senderID,
+		receiverID:       receiverID,
+		amount:           money,
+		receiverCurrency: receiverCurrency,
+		executeAt:        executeAt,
+		status:           status,
+		transactionID:    transactionID,
+		failureReason:    failureReason,
+		requestKey:       requestKey,
+		requestHash:      requestHash,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}, nil
+}
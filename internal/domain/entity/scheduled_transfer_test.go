@@ -0,0 +1,73 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScheduledTransfer_ShouldScheduleTransferInTheFuture(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	executeAt := now.Add(24 * time.Hour)
+
+	// Act
+	scheduled, err := entity.NewScheduledTransfer("sender123", "receiver456", money(t, 150000, vo.BRL), "", executeAt, now)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entity.ScheduledTransferScheduledStatus, scheduled.Status())
+	assert.Equal(t, executeAt, scheduled.ExecuteAt())
+	assert.Equal(t, vo.BRL, scheduled.ReceiverCurrency())
+	assert.Equal(t, "1500.00", scheduled.FormattedAmount())
+	assert.Equal(t, "scheduled-transfer:"+scheduled.ID(), scheduled.IdempotencyKey())
+}
+
+func TestNewScheduledTransfer_ShouldReturnErrorWhenExecuteAtIsNotInTheFuture(t *testing.T) {
+	// Arrange
+	now := time.Now()
+
+	// Act
+	scheduled, err := entity.NewScheduledTransfer("sender123", "receiver456", money(t, 1000, vo.BRL), "", now, now)
+
+	// Assert
+	assert.Nil(t, scheduled)
+	assert.ErrorIs(t, err, errs.ErrExecuteAtNotInTheFuture)
+}
+
+func TestNewScheduledTransfer_ShouldReturnErrorWhenReceiverCurrencyIsUnsupported(t *testing.T) {
+	// Arrange
+	now := time.Now()
+
+	// Act
+	scheduled, err := entity.NewScheduledTransfer("sender123", "receiver456", money(t, 1000, vo.BRL), "JPY", now.Add(time.Hour), now)
+
+	// Assert
+	assert.Nil(t, scheduled)
+	assert.ErrorIs(t, err, errs.ErrUnsupportedCurrency)
+}
+
+func TestScheduledTransfer_Cancel_ShouldOnlyCancelTransfersNotExecutedYet(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	scheduled, err := entity.NewScheduledTransfer("sender123", "receiver456", money(t, 1000, vo.BRL), "", now.Add(time.Hour), now)
+	require.NoError(t, err)
+	executed, err := entity.NewScheduledTransfer("sender123", "receiver456", money(t, 1000, vo.BRL), "", now.Add(time.Hour), now)
+	require.NoError(t, err)
+	executed.MarkExecuted("transaction123", now)
+
+	// Act
+	cancelErr := scheduled.Cancel(now)
+	executedCancelErr := executed.Cancel(now)
+
+	// Assert
+	assert.NoError(t, cancelErr)
+	assert.Equal(t, entity.ScheduledTransferCancelledStatus, scheduled.Status())
+	assert.ErrorIs(t, executedCancelErr, errs.ErrScheduledTransferNotCancellable)
+	assert.Equal(t, "transaction123", executed.TransactionID())
+}
@@ -0,0 +1,206 @@
+package entity
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com/google/uuid"
+)
+
+const (
+	SettlementOpenStatus   = "open"
+	SettlementClosedStatus = "closed"
+	SettlementPaidStatus   = "paid"
+)
+
+// Settlement groups the transfers a merchant received in a currency on a
+// day, in UTC, along with the refunds and chargebacks it paid back, so they
+// can be reconciled and paid out together. It is open while the day's
+// transactions are added, closed once the day is over and paid when the
+// money reached the merchant.
+type Settlement struct {
+	id               uuid.UUID
+	merchantID       string
+	date             time.Time
+	currency         string
+	gross            int64
+	fee              int64
+	transactionCount int
+	status           string
+	closedAt         *time.Time
+	paidAt           *time.Time
+	events           []event.Event
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+func (s *Settlement) ID() string {
+	return s.id.String()
+}
+
+func (s *Settlement) MerchantID() string {
+	return s.merchantID
+}
+
+// Date returns the day the settlement groups transfers of, at midnight UTC.
+func (s *Settlement) Date() time.Time {
+	return s.date
+}
+
+func (s *Settlement) Currency() string {
+	return s.currency
+}
+
+// Gross returns the cents the payers sent the merchant, before fees, less the
+// cents it refunded or was charged back.
+func (s *Settlement) Gross() int64 {
+	return s.gross
+}
+
+// Fee returns the cents charged as fees on the transfers.
+func (s *Settlement) Fee() int64 {
+	return s.fee
+}
+
+// Net returns the cents the merchant was credited, net of the fees.
+func (s *Settlement) Net() int64 {
+	return s.gross - s.fee
+}
+
+func (s *Settlement) TransactionCount() int {
+	return s.transactionCount
+}
+
+func (s *Settlement) Status() string {
+	return s.status
+}
+
+func (s *Settlement) IsOpen() bool {
+	return s.status == SettlementOpenStatus
+}
+
+// ClosedAt returns when the settlement was closed, or nil while it is open.
+func (s *Settlement) ClosedAt() *time.Time {
+	return s.closedAt
+}
+
+// PaidAt returns when the settlement was paid, or nil until it is.
+func (s *Settlement) PaidAt() *time.Time {
+	return s.paidAt
+}
+
+func (s *Settlement) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Settlement) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// RecordEvent queues an event to be stored in the outbox along with the
+// settlement.
+func (s *Settlement) RecordEvent(e event.Event) {
+	s.events = append(s.events, e)
+}
+
+func (s *Settlement) Events() []event.Event {
+	return s.events
+}
+
+// Add accounts for a transaction of the merchant on the day of the
+// settlement: a transfer it received, or a refund or chargeback it paid back,
+// which is taken off the gross. The fee of the refunded transfer stays with
+// the platform, so refunds take nothing off the fee.
+func (s *Settlement) Add(transaction *Transaction, now time.Time) error {
+	if !s.IsOpen() {
+		return errs.ErrSettlementNotOpen
+	}
+	merchantID, currency := SettlementAccount(transaction)
+	if merchantID != s.merchantID || currency != s.currency ||
+		!SettlementDate(transaction.CreatedAt()).Equal(s.date) {
+		return errs.ErrTransactionNotInSettlement
+	}
+	if transaction.IsRefund() {
+		s.gross -= transaction.Amount()
+	} else {
+		s.gross += transaction.ReceivedAmount()
+		s.fee += transaction.Fee()
+	}
+	s.transactionCount++
+	s.updatedAt = now
+	return nil
+}
+
+// Close ends the settlement once its day is over, so no more transfers are
+// added to it.
+func (s *Settlement) Close(now time.Time) error {
+	if !s.IsOpen() {
+		return errs.ErrSettlementNotOpen
+	}
+	if now.Before(s.date.AddDate(0, 0, 1)) {
+		return errs.ErrSettlementDayNotOver
+	}
+	s.status = SettlementClosedStatus
+	s.closedAt = &now
+	s.updatedAt = now
+	return nil
+}
+
+// MarkPaid records the settlement was paid out to the merchant.
+func (s *Settlement) MarkPaid(now time.Time) error {
+	if s.status != SettlementClosedStatus {
+		return errs.ErrSettlementNotClosed
+	}
+	s.status = SettlementPaidStatus
+	s.paidAt = &now
+	s.updatedAt = now
+	return nil
+}
+
+// SettlementDate returns the day, in UTC, a transfer made at t is settled on.
+func SettlementDate(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// SettlementAccount returns the merchant and the currency of the settlements
+// a transaction belongs to: the receiver of a transfer, or the sender of a
+// refund or chargeback, which moves the merchant's money back.
+func SettlementAccount(transaction *Transaction) (merchantID, currency string) {
+	if transaction.IsRefund() {
+		return transaction.SenderID(), transaction.Currency()
+	}
+	return transaction.ReceiverID(), transaction.ReceivedCurrency()
+}
+
+// NewSettlement creates the open settlement of the transfers the merchant
+// receives in the currency on the day of date.
+func NewSettlement(merchantID, currency string, date, now time.Time) *Settlement {
+	return &Settlement{
+		id:         uuid.New(),
+		merchantID: merchantID,
+		date:       SettlementDate(date),
+		currency:   currency,
+		status:     SettlementOpenStatus,
+		createdAt:  now,
+		updatedAt:  now,
+	}
+}
+
+func RestoreSettlement(id uuid.UUID, merchantID string, date time.Time, currency string, gross, fee int64, transactionCount int, status string, closedAt, paidAt *time.Time, createdAt, updatedAt time.Time) *Settlement {
+	return &Settlement{
+		id:               id,
+		merchantID:       merchantID,
+		date:             SettlementDate(date),
+		currency:         currency,
+		gross:            gross,
+		fee:              fee,
+		transactionCount: transactionCount,
+		status:           status,
+		closedAt:         closedAt,
+		paidAt:           paidAt,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}
+}
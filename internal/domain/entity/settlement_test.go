@@ -0,0 +1,141 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func settlementTransfer(t *testing.T, merchantID string, amount, fee int64, createdAt time.Time) *entity.Transaction {
+	t.Helper()
+	transaction, err := entity.RestoreTransaction(uuid.New(), amount, vo.BRL, amount, vo.BRL, "1", fee, uuid.NewString(), merchantID, entity.TransferTransactionKind, "", createdAt)
+	require.NoError(t, err)
+	return transaction
+}
+
+func TestSettlementDate_ShouldReturnTheUTCDay(t *testing.T) {
+	// Arrange
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+
+	// Act
+	date := entity.SettlementDate(time.Date(2026, 3, 10, 22, 30, 0, 0, saoPaulo))
+
+	// Assert
+	assert.Equal(t, time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), date)
+}
+
+func TestSettlement_Add_ShouldAccumulateGrossFeeAndNet(t *testing.T) {
+	// Arrange
+	merchantID := uuid.NewString()
+	day := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	settlement := entity.NewSettlement(merchantID, vo.BRL, day, day)
+
+	// Act
+	require.NoError(t, settlement.Add(settlementTransfer(t, merchantID, 10000, 150, day), day))
+	require.NoError(t, settlement.Add(settlementTransfer(t, merchantID, 5000, 0, day.Add(10*time.Hour)), day))
+
+	// Assert
+	assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), settlement.Date())
+	assert.Equal(t, int64(15000), settlement.Gross())
+	assert.Equal(t, int64(150), settlement.Fee())
+	assert.Equal(t, int64(14850), settlement.Net())
+	assert.Equal(t, 2, settlement.TransactionCount())
+	assert.True(t, settlement.IsOpen())
+}
+
+func TestSettlement_Add_ShouldTakeRefundsAndChargebacksOffTheGross(t *testing.T) {
+	// Arrange
+	merchantID := uuid.NewString()
+	day := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	settlement := entity.NewSettlement(merchantID, vo.BRL, day, day)
+	transfer := settlementTransfer(t, merchantID, 10000, 200, day)
+	refund, err := entity.RestoreTransaction(uuid.New(), 3000, vo.BRL, 3000, vo.BRL, "1", 0, merchantID, transfer.SenderID(), entity.RefundTransactionKind, transfer.ID(), day.Add(time.Hour))
+	require.NoError(t, err)
+	chargeback, err := entity.RestoreTransaction(uuid.New(), 1000, vo.BRL, 1000, vo.BRL, "1", 0, merchantID, transfer.SenderID(), entity.ChargebackTransactionKind, transfer.ID(), day.Add(2*time.Hour))
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, settlement.Add(transfer, day))
+	require.NoError(t, settlement.Add(refund, day))
+	require.NoError(t, settlement.Add(chargeback, day))
+
+	// Assert
+	assert.Equal(t, int64(6000), settlement.Gross())
+	assert.Equal(t, int64(200), settlement.Fee())
+	assert.Equal(t, int64(5800), settlement.Net())
+	assert.Equal(t, 3, settlement.TransactionCount())
+}
+
+func TestSettlement_Add_ShouldRejectTransfersOfOtherSettlements(t *testing.T) {
+	merchantID := uuid.NewString()
+	day := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		transaction func(t *testing.T) *entity.Transaction
+	}{
+		{name: "other merchant", transaction: func(t *testing.T) *entity.Transaction {
+			return settlementTransfer(t, uuid.NewString(), 1000, 0, day)
+		}},
+		{name: "other day", transaction: func(t *testing.T) *entity.Transaction {
+			return settlementTransfer(t, merchantID, 1000, 0, day.AddDate(0, 0, 1))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			settlement := entity.NewSettlement(merchantID, vo.BRL, day, day)
+
+			// Act
+			err := settlement.Add(tt.transaction(t), day)
+
+			// Assert
+			assert.ErrorIs(t, err, errs.ErrTransactionNotInSettlement)
+			assert.Zero(t, settlement.TransactionCount())
+		})
+	}
+}
+
+func TestSettlement_Close_ShouldOnlyCloseOnceTheDayIsOver(t *testing.T) {
+	// Arrange
+	merchantID := uuid.NewString()
+	day := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	settlement := entity.NewSettlement(merchantID, vo.BRL, day, day)
+	nextDay := time.Date(2026, 3, 11, 1, 0, 0, 0, time.UTC)
+
+	// Act
+	errBeforeEnd := settlement.Close(day.Add(time.Hour))
+	err := settlement.Close(nextDay)
+
+	// Assert
+	assert.ErrorIs(t, errBeforeEnd, errs.ErrSettlementDayNotOver)
+	require.NoError(t, err)
+	assert.Equal(t, entity.SettlementClosedStatus, settlement.Status())
+	assert.Equal(t, nextDay, *settlement.ClosedAt())
+	assert.ErrorIs(t, settlement.Add(settlementTransfer(t, merchantID, 1000, 0, day), nextDay), errs.ErrSettlementNotOpen)
+}
+
+func TestSettlement_MarkPaid_ShouldRequireAClosedSettlement(t *testing.T) {
+	// Arrange
+	day := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	settlement := entity.NewSettlement(uuid.NewString(), vo.BRL, day, day)
+	paidAt := day.AddDate(0, 0, 2)
+
+	// Act
+	errWhileOpen := settlement.MarkPaid(paidAt)
+	require.NoError(t, settlement.Close(day.AddDate(0, 0, 1)))
+	err := settlement.MarkPaid(paidAt)
+
+	// Assert
+	assert.ErrorIs(t, errWhileOpen, errs.ErrSettlementNotClosed)
+	require.NoError(t, err)
+	assert.Equal(t, entity.SettlementPaidStatus, settlement.Status())
+	assert.Equal(t, paidAt, *settlement.PaidAt())
+	assert.ErrorIs(t, settlement.MarkPaid(paidAt), errs.ErrSettlementNotClosed)
+}
@@ -0,0 +1,206 @@
+package entity
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// WholePercentage is 100% in basis points, the unit split percentages are
+// expressed in.
+const WholePercentage = 10000
+
+// SplitShareSpec requests the share of a receiver in a split payment, either
+// a fixed Amount in cents or a Percentage in basis points of the whole amount.
+type SplitShareSpec struct {
+	ReceiverID string
+	Amount     int64
+	Percentage int64
+}
+
+// SplitPayment is a payment of one sender divided between several receivers,
+// e.g. a marketplace sale split between the seller and the platform. Each
+// share is moved as a transfer of its own, all of them in the same database
+// transaction.
+type SplitPayment struct {
+	id        uuid.UUID
+	senderID  string
+	amount    *vo.Money
+	shares    []*SplitShare
+	createdAt time.Time
+}
+
+func (s *SplitPayment) ID() string {
+	return s.id.String()
+}
+
+func (s *SplitPayment) SenderID() string {
+	return s.senderID
+}
+
+// Amount returns the whole amount in cents the sender pays.
+func (s *SplitPayment) Amount() int64 {
+	return s.amount.Value()
+}
+
+// FormattedAmount returns the amount as a decimal with two places.
+func (s *SplitPayment) FormattedAmount() string {
+	return s.amount.String()
+}
+
+func (s *SplitPayment) Money() *vo.Money {
+	return s.amount
+}
+
+func (s *SplitPayment) Currency() string {
+	return s.amount.Currency()
+}
+
+// Shares returns the shares of the receivers in the order they were requested.
+func (s *SplitPayment) Shares() []*SplitShare {
+	return s.shares
+}
+
+// ReceiverIDs returns the receivers of the shares, in order.
+func (s *SplitPayment) ReceiverIDs() []string {
+	receiverIDs := make([]string, 0, len(s.shares))
+	for _, share := range s.shares {
+		receiverIDs = append(receiverIDs, share.receiverID)
+	}
+	return receiverIDs
+}
+
+func (s *SplitPayment) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// NewSplitPayment divides amount between the receivers of the specs. Shares
+// are either all amounts, which must add up to amount, or all percentages,
+// which must add up to 100%. Percentages are rounded down to the cent and the
+// cents left over go to the first receiver.
+func NewSplitPayment(senderID string, amount *vo.Money, specs []SplitShareSpec, now time.Time) (*SplitPayment, error) {
+	if amount.Value() <= 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	if len(specs) < 2 {
+		return nil, errs.ErrSplitPaymentNeedsReceivers
+	}
+
+	byPercentage := specs[0].Percentage > 0
+	receivers := map[string]bool{}
+	var total int64
+	for _, spec := range specs {
+		if spec.Amount < 0 || spec.Percentage < 0 || (spec.Amount > 0) == (spec.Percentage > 0) {
+			return nil, errs.ErrInvalidSplitShare
+		}
+		if (spec.Percentage > 0) != byPercentage {
+			return nil, errs.ErrMixedSplitShares
+		}
+		if spec.ReceiverID == senderID {
+			return nil, errs.ErrTransactionInvalidSender
+		}
+		if receivers[spec.ReceiverID] {
+			return nil, errs.ErrDuplicateSplitReceiver
+		}
+		receivers[spec.ReceiverID] = true
+		total += spec.Amount + spec.Percentage
+	}
+	if (byPercentage && total != WholePercentage) || (!byPercentage && total != amount.Value()) {
+		return nil, errs.ErrSplitSharesMismatch
+	}
+
+	shares := make([]*SplitShare, 0, len(specs))
+	var allocated int64
+	for i, spec := range specs {
+		shareAmount := spec.Amount
+		if byPercentage {
+			shareAmount = amount.Value() * spec.Percentage / WholePercentage
+		}
+		allocated += shareAmount
+		shares = append(shares, &SplitShare{
+			sequence:   i + 1,
+			receiverID: spec.ReceiverID,
+			amount:     shareAmount,
+			percentage: spec.Percentage,
+		})
+	}
+	shares[0].amount += amount.Value() - allocated
+	for _, share := range shares {
+		if share.amount <= 0 {
+			return nil, errs.ErrZeroOrNegativeAmount
+		}
+	}
+
+	return &SplitPayment{
+		id:        uuid.New(),
+		senderID:  senderID,
+		amount:    amount,
+		shares:    shares,
+		createdAt: now,
+	}, nil
+}
+
+func RestoreSplitPayment(id uuid.UUID, senderID string, amount int64, currency string, shares []*SplitShare, createdAt time.Time) (*SplitPayment, error) {
+	money, err := vo.NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &SplitPayment{
+		id:        id,
+		senderID:  senderID,
+		amount:    money,
+		shares:    shares,
+		createdAt: createdAt,
+	}, nil
+}
+
+// SplitShare is the part of a split payment credited to one receiver.
+type SplitShare struct {
+	sequence      int
+	receiverID    string
+	amount        int64
+	percentage    int64
+	transactionID string
+}
+
+// Sequence returns the position of the share, starting at one.
+func (s *SplitShare) Sequence() int {
+	return s.sequence
+}
+
+func (s *SplitShare) ReceiverID() string {
+	return s.receiverID
+}
+
+// Amount returns the amount in cents credited to the receiver.
+func (s *SplitShare) Amount() int64 {
+	return s.amount
+}
+
+// Percentage returns the requested percentage in basis points, or zero when
+// the share was requested as an amount.
+func (s *SplitShare) Percentage() int64 {
+	return s.percentage
+}
+
+// TransactionID returns the transfer that moved the share.
+func (s *SplitShare) TransactionID() string {
+	return s.transactionID
+}
+
+// AttachTransaction links the share to the transfer that moved it.
+func (s *SplitShare) AttachTransaction(transactionID string) {
+	s.transactionID = transactionID
+}
+
+func RestoreSplitShare(sequence int, receiverID string, amount, percentage int64, transactionID string) *SplitShare {
+	return &SplitShare{
+		sequence:      sequence,
+		receiverID:    receiverID,
+		amount:        amount,
+		percentage:    percentage,
+		transactionID: transactionID,
+	}
+}
@@ -0,0 +1,107 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitPayment_NewSplitPayment_ShouldGiveRoundingRemainderToFirstReceiver(t *testing.T) {
+	// Arrange
+	specs := []entity.SplitShareSpec{
+		{ReceiverID: "seller", Percentage: 3334},
+		{ReceiverID: "platform", Percentage: 3333},
+		{ReceiverID: "courier", Percentage: 3333},
+	}
+
+	// Act
+	splitPayment, err := entity.NewSplitPayment("buyer", money(t, 1001, vo.BRL), specs, time.Now())
+
+	// Assert
+	require.NoError(t, err)
+	shares := splitPayment.Shares()
+	require.Len(t, shares, 3)
+	assert.Equal(t, int64(335), shares[0].Amount())
+	assert.Equal(t, int64(333), shares[1].Amount())
+	assert.Equal(t, int64(333), shares[2].Amount())
+	assert.Equal(t, 3, shares[2].Sequence())
+	assert.Equal(t, int64(3333), shares[2].Percentage())
+	assert.Equal(t, []string{"seller", "platform", "courier"}, splitPayment.ReceiverIDs())
+}
+
+func TestSplitPayment_NewSplitPayment_ShouldKeepFixedAmounts(t *testing.T) {
+	// Arrange
+	specs := []entity.SplitShareSpec{
+		{ReceiverID: "seller", Amount: 9000},
+		{ReceiverID: "platform", Amount: 1000},
+	}
+
+	// Act
+	splitPayment, err := entity.NewSplitPayment("buyer", money(t, 10000, vo.BRL), specs, time.Now())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(9000), splitPayment.Shares()[0].Amount())
+	assert.Equal(t, int64(1000), splitPayment.Shares()[1].Amount())
+	assert.Zero(t, splitPayment.Shares()[1].Percentage())
+}
+
+func TestSplitPayment_NewSplitPayment_ShouldRejectInvalidShares(t *testing.T) {
+	tests := []struct {
+		name  string
+		specs []entity.SplitShareSpec
+		want  error
+	}{
+		{
+			name:  "single receiver",
+			specs: []entity.SplitShareSpec{{ReceiverID: "seller", Amount: 10000}},
+			want:  errs.ErrSplitPaymentNeedsReceivers,
+		},
+		{
+			name:  "amounts not adding up",
+			specs: []entity.SplitShareSpec{{ReceiverID: "seller", Amount: 9000}, {ReceiverID: "platform", Amount: 500}},
+			want:  errs.ErrSplitSharesMismatch,
+		},
+		{
+			name:  "percentages not adding up",
+			specs: []entity.SplitShareSpec{{ReceiverID: "seller", Percentage: 9000}, {ReceiverID: "platform", Percentage: 500}},
+			want:  errs.ErrSplitSharesMismatch,
+		},
+		{
+			name:  "amounts mixed with percentages",
+			specs: []entity.SplitShareSpec{{ReceiverID: "seller", Percentage: 9000}, {ReceiverID: "platform", Amount: 1000}},
+			want:  errs.ErrMixedSplitShares,
+		},
+		{
+			name:  "both amount and percentage",
+			specs: []entity.SplitShareSpec{{ReceiverID: "seller", Amount: 9000, Percentage: 9000}, {ReceiverID: "platform", Amount: 1000}},
+			want:  errs.ErrInvalidSplitShare,
+		},
+		{
+			name:  "duplicate receiver",
+			specs: []entity.SplitShareSpec{{ReceiverID: "seller", Amount: 5000}, {ReceiverID: "seller", Amount: 5000}},
+			want:  errs.ErrDuplicateSplitReceiver,
+		},
+		{
+			name:  "sender as receiver",
+			specs: []entity.SplitShareSpec{{ReceiverID: "buyer", Amount: 5000}, {ReceiverID: "seller", Amount: 5000}},
+			want:  errs.ErrTransactionInvalidSender,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			splitPayment, err := entity.NewSplitPayment("buyer", money(t, 10000, vo.BRL), tt.specs, time.Now())
+
+			// Assert
+			assert.Nil(t, splitPayment)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package entity
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// Directions of a transaction from the point of view of one of its users.
+const (
+	SentStatementDirection     = "sent"
+	ReceivedStatementDirection = "received"
+)
+
+// StatementEntry is a transaction as seen in the statement of its sender or
+// of its receiver.
+type StatementEntry struct {
+	transaction *Transaction
+	userID      string
+	balance     int64
+}
+
+// NewStatementEntry creates the entry of the transaction in the statement of
+// the user, balance being the balance of the user in the currency of the
+// entry right after the transaction.
+func NewStatementEntry(transaction *Transaction, userID string, balance int64) *StatementEntry {
+	return &StatementEntry{
+		transaction: transaction,
+		userID:      userID,
+		balance:     balance,
+	}
+}
+
+func (e *StatementEntry) Transaction() *Transaction {
+	return e.transaction
+}
+
+func (e *StatementEntry) Direction() string {
+	if e.transaction.SenderID() == e.userID {
+		return SentStatementDirection
+	}
+	return ReceivedStatementDirection
+}
+
+// CounterpartyID returns the other user of the transaction.
+func (e *StatementEntry) CounterpartyID() string {
+	if e.Direction() == SentStatementDirection {
+		return e.transaction.ReceiverID()
+	}
+	return e.transaction.SenderID()
+}
+
+// Amount returns the cents the transaction moved in the balance of the user:
+// negative for what was sent, and positive for what was received net of the
+// fee.
+func (e *StatementEntry) Amount() int64 {
+	if e.Direction() == SentStatementDirection {
+		return -e.transaction.Amount()
+	}
+	return e.transaction.NetReceivedAmount()
+}
+
+func (e *StatementEntry) Currency() string {
+	if e.Direction() == SentStatementDirection {
+		return e.transaction.Currency()
+	}
+	return e.transaction.ReceivedCurrency()
+}
+
+// Balance returns the running balance in cents of the user in the currency
+// of the entry, right after the transaction.
+func (e *StatementEntry) Balance() int64 {
+	return e.balance
+}
+
+// Cursor returns the position of the entry, so the next page starts right
+// after it.
+func (e *StatementEntry) Cursor() StatementCursor {
+	return StatementCursor{CreatedAt: e.transaction.CreatedAt(), TransactionID: e.transaction.ID()}
+}
+
+// StatementFilter narrows the transactions listed in a statement. Zero values
+// leave a criterion out. From is inclusive and To exclusive, and amounts are
+// compared with the absolute amount of the entries, in cents.
+type StatementFilter struct {
+	From           time.Time
+	To             time.Time
+	Direction      string
+	CounterpartyID string
+	// Currency keeps the entries that moved the balance of the user in the
+	// currency
+	Currency  string
+	MinAmount int64
+	MaxAmount int64
+}
+
+// Validate checks the criteria of the filter are consistent.
+func (f StatementFilter) Validate() error {
+	if f.Direction != "" && f.Direction != SentStatementDirection && f.Direction != ReceivedStatementDirection {
+		return errs.ErrInvalidStatementDirection
+	}
+	if !f.From.IsZero() && !f.To.IsZero() && !f.From.Before(f.To) {
+		return errs.ErrInvalidStatementDateRange
+	}
+	if f.Currency != "" {
+		if _, err := vo.NewCurrency(f.Currency); err != nil {
+			return err
+		}
+	}
+	if f.MinAmount < 0 || f.MaxAmount < 0 || f.MaxAmount != 0 && f.MinAmount > f.MaxAmount {
+		return errs.ErrInvalidStatementAmountRange
+	}
+	return nil
+}
+
+// StatementHeader opens a statement exported for a period, OpeningBalance
+// being the balance of the owner in the currency of the statement at From.
+type StatementHeader struct {
+	Owner          *User
+	Currency       string
+	From           time.Time
+	To             time.Time
+	OpeningBalance int64
+}
+
+// StatementSummary closes a statement exported for a period. Credits and
+// Debits are the absolute sums of the entries in cents, and ClosingBalance
+// the balance of the owner at the end of the period.
+type StatementSummary struct {
+	Entries        int
+	Credits        int64
+	Debits         int64
+	ClosingBalance int64
+}
+
+// Add accounts for the entry in the summary.
+func (s *StatementSummary) Add(entry *StatementEntry) {
+	s.Entries++
+	if entry.Amount() < 0 {
+		s.Debits -= entry.Amount()
+		return
+	}
+	s.Credits += entry.Amount()
+}
+
+// StatementCursor is the position of an entry in a statement, which is
+// ordered from the most recent transaction to the oldest.
+type StatementCursor struct {
+	CreatedAt     time.Time
+	TransactionID string
+}
+
+// Encode returns the cursor as an opaque token for clients to send back.
+func (c StatementCursor) Encode() string {
+	token := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.TransactionID
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+// ParseStatementCursor decodes a token returned by Encode.
+func ParseStatementCursor(token string) (*StatementCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errs.ErrInvalidStatementCursor
+	}
+	createdAt, transactionID, found := strings.Cut(string(decoded), "|")
+	if !found || uuid.Validate(transactionID) != nil {
+		return nil, errs.ErrInvalidStatementCursor
+	}
+	cursorTime, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, errs.ErrInvalidStatementCursor
+	}
+	return &StatementCursor{CreatedAt: cursorTime, TransactionID: transactionID}, nil
+}
@@ -0,0 +1,81 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementEntry_ShouldSignTheAmountByDirection(t *testing.T) {
+	// Arrange
+	transaction, err := entity.NewTransaction(10000, "sender123", "receiver456")
+	require.NoError(t, err)
+	schedule, err := vo.NewFixedFee(150)
+	require.NoError(t, err)
+	require.NoError(t, transaction.ChargeFee(*schedule))
+
+	// Act
+	sent := entity.NewStatementEntry(transaction, "sender123", 5000)
+	received := entity.NewStatementEntry(transaction, "receiver456", 9850)
+
+	// Assert
+	assert.Equal(t, entity.SentStatementDirection, sent.Direction())
+	assert.Equal(t, "receiver456", sent.CounterpartyID())
+	assert.Equal(t, int64(-10000), sent.Amount())
+	assert.Equal(t, entity.ReceivedStatementDirection, received.Direction())
+	assert.Equal(t, "sender123", received.CounterpartyID())
+	assert.Equal(t, int64(9850), received.Amount())
+	assert.Equal(t, int64(9850), received.Balance())
+}
+
+func TestStatementCursor_ShouldSurviveEncoding(t *testing.T) {
+	// Arrange
+	cursor := entity.StatementCursor{
+		CreatedAt:     time.Date(2025, 3, 10, 14, 30, 15, 123456000, time.UTC),
+		TransactionID: uuid.NewString(),
+	}
+
+	// Act
+	parsed, err := entity.ParseStatementCursor(cursor.Encode())
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(parsed.CreatedAt))
+	assert.Equal(t, cursor.TransactionID, parsed.TransactionID)
+}
+
+func TestParseStatementCursor_ShouldRejectTamperedCursors(t *testing.T) {
+	for _, token := range []string{"not base64!", "bm90IGEgY3Vyc29y", entity.StatementCursor{CreatedAt: time.Now()}.Encode()} {
+		// Act
+		cursor, err := entity.ParseStatementCursor(token)
+
+		// Assert
+		assert.Nil(t, cursor)
+		assert.ErrorIs(t, err, errs.ErrInvalidStatementCursor, token)
+	}
+}
+
+func TestStatementFilter_Validate_ShouldRejectInconsistentCriteria(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		filter entity.StatementFilter
+		err    error
+	}{
+		{entity.StatementFilter{Direction: "both"}, errs.ErrInvalidStatementDirection},
+		{entity.StatementFilter{From: now, To: now}, errs.ErrInvalidStatementDateRange},
+		{entity.StatementFilter{MinAmount: 500, MaxAmount: 100}, errs.ErrInvalidStatementAmountRange},
+	}
+	for _, tt := range tests {
+		// Act
+		err := tt.filter.Validate()
+
+		// Assert
+		assert.ErrorIs(t, err, tt.err)
+	}
+}
@@ -3,26 +3,102 @@ package entity
 import (
 	"time"
 
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
 	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
 	"github.com/google/uuid"
 )
 
+const (
+	TransferTransactionKind   = "transfer"
+	RefundTransactionKind     = "refund"
+	ChargebackTransactionKind = "chargeback"
+
+	// TransactionCompletedStatus is the status of every stored transaction,
+	// as balances are moved in the same database transaction
+	TransactionCompletedStatus = "completed"
+)
+
 type Transaction struct {
-	id         uuid.UUID
-	amount     *vo.Money
-	senderID   string
-	receiverID string
-	createdAt  time.Time
+	id                    uuid.UUID
+	amount                *vo.Money
+	receivedAmount        *vo.Money
+	exchangeRate          *vo.ExchangeRate
+	fee                   int64
+	senderID              string
+	receiverID            string
+	kind                  string
+	originalTransactionID string
+	idempotencyKey        *IdempotencyKey
+	events                []event.Event
+	createdAt             time.Time
 }
 
 func (t *Transaction) ID() string {
 	return t.id.String()
 }
 
+// Amount returns the amount in cents debited from the sender.
 func (t *Transaction) Amount() int64 {
 	return t.amount.Value()
 }
 
+// Currency returns the currency the sender paid in.
+func (t *Transaction) Currency() string {
+	return t.amount.Currency()
+}
+
+// ReceivedAmount returns the amount in cents credited to the receiver, which
+// differs from Amount when the transfer is converted.
+func (t *Transaction) ReceivedAmount() int64 {
+	return t.receivedAmount.Value()
+}
+
+func (t *Transaction) ReceivedCurrency() string {
+	return t.receivedAmount.Currency()
+}
+
+// ExchangeRate returns the rate the amount was converted with, an identity
+// rate for transfers within the same currency.
+func (t *Transaction) ExchangeRate() *vo.ExchangeRate {
+	return t.exchangeRate
+}
+
+// Fee returns the fee in cents of the received currency kept by the platform
+// out of the received amount.
+func (t *Transaction) Fee() int64 {
+	return t.fee
+}
+
+// NetReceivedAmount returns the amount in cents credited to the receiver once
+// the fee is taken.
+func (t *Transaction) NetReceivedAmount() int64 {
+	return t.ReceivedAmount() - t.fee
+}
+
+// ChargeFee takes the fee of the schedule out of the amount the receiver
+// gets. The fee is kept when the transfer is refunded.
+func (t *Transaction) ChargeFee(schedule vo.FeeSchedule) error {
+	fee := schedule.Fee(t.ReceivedAmount())
+	if fee > 0 && fee >= t.ReceivedAmount() {
+		return errs.ErrAmountDoesNotCoverFee
+	}
+	t.fee = fee
+	return nil
+}
+
+// RefundableAmount returns the amount in cents of the received currency that
+// refunds and chargebacks can move back, which is what the receiver was
+// credited. The fee stays with the platform, so the sender gets back at most
+// the amount net of the fee.
+func (t *Transaction) RefundableAmount() int64 {
+	return t.NetReceivedAmount()
+}
+
+func (t *Transaction) IsConverted() bool {
+	return !t.exchangeRate.IsIdentity()
+}
+
 func (t *Transaction) SenderID() string {
 	return t.senderID
 }
@@ -31,25 +107,213 @@ func (t *Transaction) ReceiverID() string {
 	return t.receiverID
 }
 
+func (t *Transaction) Kind() string {
+	return t.kind
+}
+
+// IsRefund tells whether the transaction moves the money of another one
+// back, either as a refund or as the chargeback of a dispute.
+func (t *Transaction) IsRefund() bool {
+	return t.kind == RefundTransactionKind || t.kind == ChargebackTransactionKind
+}
+
+// OriginalTransactionID returns the transaction a refund or a chargeback
+// compensates, or an empty string for regular transfers.
+func (t *Transaction) OriginalTransactionID() string {
+	return t.originalTransactionID
+}
+
 func (t *Transaction) CreatedAt() time.Time {
 	return t.createdAt
 }
 
-func NewTransaction(amount float64, senderID, receiverID string) (*Transaction, error) {
-	id := uuid.New()
+// IdempotencyKey returns the key the transaction was requested with, if any.
+func (t *Transaction) IdempotencyKey() *IdempotencyKey {
+	return t.idempotencyKey
+}
+
+// AttachIdempotencyKey binds the key to the transaction so retries of the same
+// request replay it instead of creating a new one.
+func (t *Transaction) AttachIdempotencyKey(key *IdempotencyKey) {
+	key.transactionID = t.ID()
+	t.idempotencyKey = key
+}
+
+// RecordEvent queues an event to be stored in the outbox along with the
+// transaction, so it is published if and only if the transaction commits.
+func (t *Transaction) RecordEvent(e event.Event) {
+	t.events = append(t.events, e)
+}
+
+func (t *Transaction) Events() []event.Event {
+	return t.events
+}
+
+// LedgerEntries returns the balanced postings of the transaction: a debit on
+// the sender's account and a credit on the receiver's account. Converted
+// transactions go through the FX account so each currency stays balanced, and
+// the fee, if any, is credited to the platform account instead of the
+// receiver.
+func (t *Transaction) LedgerEntries() ([]*LedgerEntry, error) {
+	type posting struct {
+		accountID string
+		direction string
+		amount    *vo.Money
+	}
+	netReceivedAmount, err := vo.NewMoney(t.NetReceivedAmount(), t.ReceivedCurrency())
+	if err != nil {
+		return nil, err
+	}
+	postings := []posting{
+		{t.senderID, DebitDirection, t.amount},
+	}
+	if t.IsConverted() {
+		postings = append(postings,
+			posting{FXAccountID, CreditDirection, t.amount},
+			posting{FXAccountID, DebitDirection, t.receivedAmount},
+		)
+	}
+	postings = append(postings, posting{t.receiverID, CreditDirection, netReceivedAmount})
+	if t.fee > 0 {
+		fee, err := vo.NewMoney(t.fee, t.ReceivedCurrency())
+		if err != nil {
+			return nil, err
+		}
+		postings = append(postings, posting{PlatformAccountID, CreditDirection, fee})
+	}
+
+	entries := make([]*LedgerEntry, 0, len(postings))
+	for _, p := range postings {
+		entry, err := NewLedgerEntry(t.ID(), p.accountID, p.direction, p.amount, t.createdAt)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// NewTransaction creates a transfer of amount cents of the default currency
+// from the sender to the receiver.
+func NewTransaction(amount int64, senderID, receiverID string) (*Transaction, error) {
+	money, err := vo.NewMoney(amount, vo.DefaultCurrency)
+	if err != nil {
+		return nil, err
+	}
+	exchangeRate, err := vo.NewIdentityExchangeRate(vo.DefaultCurrency)
+	if err != nil {
+		return nil, err
+	}
+	return NewConvertedTransaction(money, exchangeRate, senderID, receiverID)
+}
 
-	money, err := vo.NewMoney(amount)
+// NewConvertedTransaction creates a transfer paid in the currency of amount
+// and received in the target currency of the exchange rate.
+func NewConvertedTransaction(amount *vo.Money, exchangeRate *vo.ExchangeRate, senderID, receiverID string) (*Transaction, error) {
+	receivedAmount, err := exchangeRate.Convert(amount)
 	if err != nil {
 		return nil, err
 	}
 
 	transaction := &Transaction{
-		id:         id,
-		amount:     money,
-		senderID:   senderID,
-		receiverID: receiverID,
-		createdAt:  time.Now(),
+		id:             uuid.New(),
+		amount:         amount,
+		receivedAmount: receivedAmount,
+		exchangeRate:   exchangeRate,
+		senderID:       senderID,
+		receiverID:     receiverID,
+		kind:           TransferTransactionKind,
+		createdAt:      time.Now(),
 	}
 
 	return transaction, nil
 }
+
+// NewRefundTransaction creates the compensating transaction of a transfer,
+// moving money back from its receiver to its sender, up to its refundable
+// amount. A zero amount refunds everything that has not been refunded yet.
+// Amounts are expressed in cents of the currency the original transaction was
+// received in, refundedAmount is the amount already refunded by previous
+// refunds. Converted transactions are converted back at their original rate.
+func NewRefundTransaction(original *Transaction, amount, refundedAmount int64) (*Transaction, error) {
+	return newCompensatingTransaction(original, amount, refundedAmount, RefundTransactionKind)
+}
+
+// NewChargebackTransaction creates the compensating transaction of a transfer
+// whose dispute the sender won, under the same rules as refunds.
+// refundedAmount includes previous chargebacks.
+func NewChargebackTransaction(original *Transaction, amount, refundedAmount int64) (*Transaction, error) {
+	return newCompensatingTransaction(original, amount, refundedAmount, ChargebackTransactionKind)
+}
+
+func newCompensatingTransaction(original *Transaction, amount, refundedAmount int64, kind string) (*Transaction, error) {
+	if original.IsRefund() {
+		return nil, errs.ErrRefundOfRefund
+	}
+
+	remaining := original.RefundableAmount() - refundedAmount
+	if remaining <= 0 {
+		return nil, errs.ErrTransactionAlreadyRefunded
+	}
+
+	if amount < 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	if amount > remaining {
+		return nil, errs.ErrRefundExceedsTransactionAmount
+	}
+	if amount == 0 {
+		amount = remaining
+	}
+
+	money, err := vo.NewMoney(amount, original.ReceivedCurrency())
+	if err != nil {
+		return nil, err
+	}
+	exchangeRate := original.ExchangeRate().Inverse()
+	receivedAmount, err := exchangeRate.Convert(money)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transaction{
+		id:                    uuid.New(),
+		amount:                money,
+		receivedAmount:        receivedAmount,
+		exchangeRate:          exchangeRate,
+		senderID:              original.ReceiverID(),
+		receiverID:            original.SenderID(),
+		kind:                  kind,
+		originalTransactionID: original.ID(),
+		createdAt:             time.Now(),
+	}, nil
+}
+
+// RestoreTransaction rebuilds a transaction previously persisted. Amounts are
+// expressed in cents.
+func RestoreTransaction(id uuid.UUID, amount int64, currency string, receivedAmount int64, receivedCurrency, exchangeRate string, fee int64, senderID, receiverID, kind, originalTransactionID string, createdAt time.Time) (*Transaction, error) {
+	money, err := vo.NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	receivedMoney, err := vo.NewMoney(receivedAmount, receivedCurrency)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := vo.NewExchangeRate(currency, receivedCurrency, exchangeRate)
+	if err != nil {
+		return nil, err
+	}
+	return &Transaction{
+		id:                    id,
+		amount:                money,
+		receivedAmount:        receivedMoney,
+		exchangeRate:          rate,
+		fee:                   fee,
+		senderID:              senderID,
+		receiverID:            receiverID,
+		kind:                  kind,
+		originalTransactionID: originalTransactionID,
+		createdAt:             createdAt,
+	}, nil
+}
@@ -0,0 +1,246 @@
+package entity
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	TransactionBatchPendingStatus            = "pending"
+	TransactionBatchCompletedStatus          = "completed"
+	TransactionBatchPartiallyCompletedStatus = "partially_completed"
+	TransactionBatchFailedStatus             = "failed"
+)
+
+const (
+	TransactionBatchItemPendingStatus   = "pending"
+	TransactionBatchItemSucceededStatus = "succeeded"
+	TransactionBatchItemFailedStatus    = "failed"
+)
+
+// TransactionBatch is a set of transfers requested at once, e.g. a payroll.
+// Each item succeeds or fails on its own unless the batch is atomic, in which
+// case a single failure fails every item.
+type TransactionBatch struct {
+	id        uuid.UUID
+	atomic    bool
+	status    string
+	items     []*TransactionBatchItem
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func (b *TransactionBatch) ID() string {
+	return b.id.String()
+}
+
+// Atomic reports whether the batch runs all-or-nothing.
+func (b *TransactionBatch) Atomic() bool {
+	return b.atomic
+}
+
+func (b *TransactionBatch) Status() string {
+	return b.status
+}
+
+// Items returns the items of the batch in the order they were requested.
+func (b *TransactionBatch) Items() []*TransactionBatchItem {
+	return b.items
+}
+
+// CountItems returns how many items of the batch are in the status.
+func (b *TransactionBatch) CountItems(status string) int {
+	count := 0
+	for _, item := range b.items {
+		if item.status == status {
+			count++
+		}
+	}
+	return count
+}
+
+func (b *TransactionBatch) CreatedAt() time.Time {
+	return b.createdAt
+}
+
+func (b *TransactionBatch) UpdatedAt() time.Time {
+	return b.updatedAt
+}
+
+// AddItem appends a transfer of amount from the sender to the receiver to the
+// batch.
+func (b *TransactionBatch) AddItem(senderID, receiverID string, amount *vo.Money) (*TransactionBatchItem, error) {
+	if amount.Value() <= 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	if senderID == receiverID {
+		return nil, errs.ErrTransactionInvalidSender
+	}
+	item := &TransactionBatchItem{
+		id:         uuid.New(),
+		batchID:    b.ID(),
+		sequence:   len(b.items) + 1,
+		senderID:   senderID,
+		receiverID: receiverID,
+		amount:     amount,
+		status:     TransactionBatchItemPendingStatus,
+		createdAt:  b.createdAt,
+	}
+	b.items = append(b.items, item)
+	return item, nil
+}
+
+// Complete settles the status of the batch once every item ran. A failed
+// item of an atomic batch fails all the others, whose transactions must then
+// be discarded.
+func (b *TransactionBatch) Complete(now time.Time) {
+	failed := b.CountItems(TransactionBatchItemFailedStatus)
+	if b.atomic && failed > 0 {
+		for _, item := range b.items {
+			if item.status != TransactionBatchItemFailedStatus {
+				item.Fail(errs.ErrTransactionBatchAborted.Error())
+			}
+		}
+		failed = len(b.items)
+	}
+
+	switch failed {
+	case 0:
+		b.status = TransactionBatchCompletedStatus
+	case len(b.items):
+		b.status = TransactionBatchFailedStatus
+	default:
+		b.status = TransactionBatchPartiallyCompletedStatus
+	}
+	b.updatedAt = now
+}
+
+// NewTransactionBatch creates an empty batch, atomic when every item must
+// succeed for any to be executed.
+func NewTransactionBatch(atomic bool, now time.Time) *TransactionBatch {
+	return &TransactionBatch{
+		id:        uuid.New(),
+		atomic:    atomic,
+		status:    TransactionBatchPendingStatus,
+		createdAt: now,
+		updatedAt: now,
+	}
+}
+
+func RestoreTransactionBatch(id uuid.UUID, atomic bool, status string, items []*TransactionBatchItem, createdAt, updatedAt time.Time) *TransactionBatch {
+	return &TransactionBatch{
+		id:        id,
+		atomic:    atomic,
+		status:    status,
+		items:     items,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// TransactionBatchItem is one transfer of a batch and its outcome.
+type TransactionBatchItem struct {
+	id            uuid.UUID
+	batchID       string
+	sequence      int
+	senderID      string
+	receiverID    string
+	amount        *vo.Money
+	status        string
+	transactionID string
+	failureReason string
+	createdAt     time.Time
+}
+
+func (i *TransactionBatchItem) ID() string {
+	return i.id.String()
+}
+
+func (i *TransactionBatchItem) BatchID() string {
+	return i.batchID
+}
+
+// Sequence returns the position of the item in the batch, starting at one.
+func (i *TransactionBatchItem) Sequence() int {
+	return i.sequence
+}
+
+func (i *TransactionBatchItem) SenderID() string {
+	return i.senderID
+}
+
+func (i *TransactionBatchItem) ReceiverID() string {
+	return i.receiverID
+}
+
+// Amount returns the amount in cents of the transfer.
+func (i *TransactionBatchItem) Amount() int64 {
+	return i.amount.Value()
+}
+
+// FormattedAmount returns the amount as a decimal with two places.
+func (i *TransactionBatchItem) FormattedAmount() string {
+	return i.amount.String()
+}
+
+func (i *TransactionBatchItem) Money() *vo.Money {
+	return i.amount
+}
+
+func (i *TransactionBatchItem) Currency() string {
+	return i.amount.Currency()
+}
+
+func (i *TransactionBatchItem) Status() string {
+	return i.status
+}
+
+// TransactionID returns the transaction created by the item, or an empty
+// string when it failed.
+func (i *TransactionBatchItem) TransactionID() string {
+	return i.transactionID
+}
+
+func (i *TransactionBatchItem) FailureReason() string {
+	return i.failureReason
+}
+
+func (i *TransactionBatchItem) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+// Succeed records the transaction the item was executed as.
+func (i *TransactionBatchItem) Succeed(transactionID string) {
+	i.status = TransactionBatchItemSucceededStatus
+	i.transactionID = transactionID
+	i.failureReason = ""
+}
+
+// Fail records why the item could not be executed.
+func (i *TransactionBatchItem) Fail(reason string) {
+	i.status = TransactionBatchItemFailedStatus
+	i.transactionID = ""
+	i.failureReason = reason
+}
+
+func RestoreTransactionBatchItem(id uuid.UUID, batchID string, sequence int, senderID, receiverID string, amount int64, currency, status, transactionID, failureReason string, createdAt time.Time) (*TransactionBatchItem, error) {
+	money, err := vo.NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &TransactionBatchItem{
+		id:            id,
+		batchID:       batchID,
+		sequence:      sequence,
+		senderID:      senderID,
+		receiverID:    receiverID,
+		amount:        money,
+		status:        status,
+		transactionID: transactionID,
+		failureReason: failureReason,
+		createdAt:     createdAt,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionBatch_AddItem_ShouldNumberItemsInOrder(t *testing.T) {
+	// Arrange
+	batch := entity.NewTransactionBatch(false, time.Now())
+
+	// Act
+	first, firstErr := batch.AddItem("sender123", "receiver456", money(t, 1000, vo.BRL))
+	second, secondErr := batch.AddItem("sender123", "receiver789", money(t, 2000, vo.BRL))
+	_, sameUserErr := batch.AddItem("sender123", "sender123", money(t, 2000, vo.BRL))
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.ErrorIs(t, sameUserErr, errs.ErrTransactionInvalidSender)
+	assert.Equal(t, 1, first.Sequence())
+	assert.Equal(t, 2, second.Sequence())
+	assert.Equal(t, batch.ID(), second.BatchID())
+	assert.Equal(t, entity.TransactionBatchItemPendingStatus, second.Status())
+	assert.Len(t, batch.Items(), 2)
+}
+
+func TestTransactionBatch_Complete_ShouldReportPartialCompletion(t *testing.T) {
+	// Arrange
+	batch := entity.NewTransactionBatch(false, time.Now())
+	first, err := batch.AddItem("sender123", "receiver456", money(t, 1000, vo.BRL))
+	require.NoError(t, err)
+	second, err := batch.AddItem("sender123", "receiver789", money(t, 2000, vo.BRL))
+	require.NoError(t, err)
+	first.Succeed("transaction123")
+	second.Fail(errs.ErrInsufficientBalance.Error())
+
+	// Act
+	batch.Complete(time.Now())
+
+	// Assert
+	assert.Equal(t, entity.TransactionBatchPartiallyCompletedStatus, batch.Status())
+	assert.Equal(t, "transaction123", first.TransactionID())
+	assert.Equal(t, 1, batch.CountItems(entity.TransactionBatchItemFailedStatus))
+}
+
+func TestTransactionBatch_Complete_ShouldFailEveryItemOfAtomicBatchWhenOneFails(t *testing.T) {
+	// Arrange
+	batch := entity.NewTransactionBatch(true, time.Now())
+	first, err := batch.AddItem("sender123", "receiver456", money(t, 1000, vo.BRL))
+	require.NoError(t, err)
+	second, err := batch.AddItem("sender123", "receiver789", money(t, 2000, vo.BRL))
+	require.NoError(t, err)
+	first.Succeed("transaction123")
+	second.Fail(errs.ErrInsufficientBalance.Error())
+
+	// Act
+	batch.Complete(time.Now())
+
+	// Assert
+	assert.Equal(t, entity.TransactionBatchFailedStatus, batch.Status())
+	assert.Equal(t, entity.TransactionBatchItemFailedStatus, first.Status())
+	assert.Empty(t, first.TransactionID())
+	assert.Equal(t, errs.ErrTransactionBatchAborted.Error(), first.FailureReason())
+	assert.Equal(t, errs.ErrInsufficientBalance.Error(), second.FailureReason())
+}
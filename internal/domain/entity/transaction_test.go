@@ -4,12 +4,15 @@ import (
 	"testing"
 
 	domain "github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewTransaction_ShouldCreateTransactionWithProvidedPositiveAmount(t *testing.T) {
 	// Arrange
-	amount := 100.50
+	amount := int64(10050)
 	senderID := "sender123"
 	receiverID := "receiver456"
 
@@ -20,7 +23,244 @@ func TestNewTransaction_ShouldCreateTransactionWithProvidedPositiveAmount(t *tes
 	assert.Nil(t, err)
 	assert.NotNil(t, transaction)
 	assert.NotEmpty(t, transaction.ID())
-	assert.Equal(t, int64(amount*100), transaction.Amount()) // Converted to pennies
+	assert.Equal(t, amount, transaction.Amount())
 	assert.Equal(t, senderID, transaction.SenderID())
 	assert.Equal(t, receiverID, transaction.ReceiverID())
 }
+
+func TestTransaction_LedgerEntries_ShouldDebitSenderAndCreditReceiver(t *testing.T) {
+	// Arrange
+	transaction, err := domain.NewTransaction(10050, "sender123", "receiver456")
+	assert.NoError(t, err)
+
+	// Act
+	entries, err := transaction.LedgerEntries()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "sender123", entries[0].AccountID())
+	assert.Equal(t, domain.DebitDirection, entries[0].Direction())
+	assert.Equal(t, "receiver456", entries[1].AccountID())
+	assert.Equal(t, domain.CreditDirection, entries[1].Direction())
+	for _, entry := range entries {
+		assert.Equal(t, transaction.ID(), entry.TransactionID())
+		assert.Equal(t, transaction.Amount(), entry.Amount())
+	}
+	assert.True(t, domain.IsBalanced(entries))
+}
+
+func TestNewRefundTransaction_ShouldRefundRemainingAmountWhenAmountIsZero(t *testing.T) {
+	// Arrange
+	original, err := domain.NewTransaction(10050, "sender123", "receiver456")
+	assert.NoError(t, err)
+
+	// Act
+	refund, err := domain.NewRefundTransaction(original, 0, 2050)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8000), refund.Amount())
+	assert.Equal(t, "receiver456", refund.SenderID())
+	assert.Equal(t, "sender123", refund.ReceiverID())
+	assert.Equal(t, original.ID(), refund.OriginalTransactionID())
+	assert.True(t, refund.IsRefund())
+}
+
+func TestNewRefundTransaction_ShouldAllowPartialRefunds(t *testing.T) {
+	// Arrange
+	original, err := domain.NewTransaction(10000, "sender123", "receiver456")
+	assert.NoError(t, err)
+
+	// Act
+	refund, err := domain.NewRefundTransaction(original, 3025, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3025), refund.Amount())
+	assert.Equal(t, domain.RefundTransactionKind, refund.Kind())
+}
+
+func TestNewChargebackTransaction_ShouldMoveTheAmountBackAsAChargeback(t *testing.T) {
+	// Arrange
+	original, err := domain.NewTransaction(10000, "sender123", "receiver456")
+	assert.NoError(t, err)
+
+	// Act
+	chargeback, err := domain.NewChargebackTransaction(original, 10000, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ChargebackTransactionKind, chargeback.Kind())
+	assert.Equal(t, "receiver456", chargeback.SenderID())
+	assert.Equal(t, "sender123", chargeback.ReceiverID())
+	assert.True(t, chargeback.IsRefund())
+
+	_, err = domain.NewRefundTransaction(chargeback, 0, 0)
+	assert.ErrorIs(t, err, errs.ErrRefundOfRefund)
+}
+
+func TestNewRefundTransaction_ShouldReturnErrorWhenAmountExceedsRefundableAmount(t *testing.T) {
+	// Arrange
+	original, err := domain.NewTransaction(10000, "sender123", "receiver456")
+	assert.NoError(t, err)
+
+	// Act
+	refund, err := domain.NewRefundTransaction(original, 6000, 5000)
+
+	// Assert
+	assert.Nil(t, refund)
+	assert.ErrorIs(t, err, errs.ErrRefundExceedsTransactionAmount)
+}
+
+func TestNewRefundTransaction_ShouldLeaveTheFeeWithThePlatform(t *testing.T) {
+	// Arrange
+	original, err := domain.NewTransaction(10000, "sender123", "receiver456")
+	assert.NoError(t, err)
+	schedule, err := vo.NewPercentageFee(199)
+	assert.NoError(t, err)
+	assert.NoError(t, original.ChargeFee(*schedule))
+
+	// Act
+	refund, err := domain.NewRefundTransaction(original, 0, 0)
+	_, exceedsErr := domain.NewChargebackTransaction(original, 10000, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9801), original.RefundableAmount())
+	assert.Equal(t, int64(9801), refund.Amount())
+	assert.Equal(t, int64(9801), refund.ReceivedAmount())
+	assert.ErrorIs(t, exceedsErr, errs.ErrRefundExceedsTransactionAmount)
+
+	_, err = domain.NewRefundTransaction(original, 0, refund.Amount())
+	assert.ErrorIs(t, err, errs.ErrTransactionAlreadyRefunded)
+}
+
+func TestNewRefundTransaction_ShouldReturnErrorWhenTransactionIsFullyRefunded(t *testing.T) {
+	// Arrange
+	original, err := domain.NewTransaction(10000, "sender123", "receiver456")
+	assert.NoError(t, err)
+
+	// Act
+	refund, err := domain.NewRefundTransaction(original, 0, 10000)
+
+	// Assert
+	assert.Nil(t, refund)
+	assert.ErrorIs(t, err, errs.ErrTransactionAlreadyRefunded)
+}
+
+func TestNewRefundTransaction_ShouldReturnErrorWhenRefundingARefund(t *testing.T) {
+	// Arrange
+	original, err := domain.NewTransaction(10000, "sender123", "receiver456")
+	assert.NoError(t, err)
+	refund, err := domain.NewRefundTransaction(original, 0, 0)
+	assert.NoError(t, err)
+
+	// Act
+	refundOfRefund, err := domain.NewRefundTransaction(refund, 0, 0)
+
+	// Assert
+	assert.Nil(t, refundOfRefund)
+	assert.ErrorIs(t, err, errs.ErrRefundOfRefund)
+}
+
+func TestNewConvertedTransaction_ShouldRecordTheRateAndBothAmounts(t *testing.T) {
+	// Arrange
+	amount, err := vo.NewMoney(1000, vo.USD)
+	require.NoError(t, err)
+	rate, err := vo.NewExchangeRate(vo.USD, vo.BRL, "5.25")
+	require.NoError(t, err)
+
+	// Act
+	transaction, err := domain.NewConvertedTransaction(amount, rate, "sender123", "receiver456")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), transaction.Amount())
+	assert.Equal(t, vo.USD, transaction.Currency())
+	assert.Equal(t, int64(5250), transaction.ReceivedAmount())
+	assert.Equal(t, vo.BRL, transaction.ReceivedCurrency())
+	assert.Equal(t, "5.25", transaction.ExchangeRate().Rate())
+	assert.True(t, transaction.IsConverted())
+}
+
+func TestTransaction_LedgerEntries_ShouldGoThroughTheFXAccountWhenConverted(t *testing.T) {
+	// Arrange
+	amount, _ := vo.NewMoney(1000, vo.USD)
+	rate, _ := vo.NewExchangeRate(vo.USD, vo.BRL, "5.25")
+	transaction, err := domain.NewConvertedTransaction(amount, rate, "sender123", "receiver456")
+	require.NoError(t, err)
+
+	// Act
+	entries, err := transaction.LedgerEntries()
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	assert.Equal(t, "sender123", entries[0].AccountID())
+	assert.Equal(t, vo.USD, entries[0].Currency())
+	assert.Equal(t, domain.FXAccountID, entries[1].AccountID())
+	assert.Equal(t, domain.FXAccountID, entries[2].AccountID())
+	assert.Equal(t, "receiver456", entries[3].AccountID())
+	assert.Equal(t, vo.BRL, entries[3].Currency())
+	assert.Equal(t, int64(5250), entries[3].Amount())
+	assert.True(t, domain.IsBalanced(entries))
+}
+
+func TestNewRefundTransaction_ShouldConvertBackAtTheOriginalRate(t *testing.T) {
+	// Arrange
+	amount, _ := vo.NewMoney(1000, vo.USD)
+	rate, _ := vo.NewExchangeRate(vo.USD, vo.BRL, "5.25")
+	original, err := domain.NewConvertedTransaction(amount, rate, "sender123", "receiver456")
+	require.NoError(t, err)
+
+	// Act
+	refund, err := domain.NewRefundTransaction(original, 2625, 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(2625), refund.Amount())
+	assert.Equal(t, vo.BRL, refund.Currency())
+	assert.Equal(t, int64(500), refund.ReceivedAmount())
+	assert.Equal(t, vo.USD, refund.ReceivedCurrency())
+}
+
+func TestTransaction_ChargeFee_ShouldCreditTheFeeToThePlatformAccount(t *testing.T) {
+	// Arrange
+	transaction, err := domain.NewTransaction(10000, "sender123", "receiver456")
+	require.NoError(t, err)
+	schedule, err := vo.NewPercentageFee(199)
+	require.NoError(t, err)
+
+	// Act
+	err = transaction.ChargeFee(*schedule)
+	require.NoError(t, err)
+	entries, err := transaction.LedgerEntries()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(199), transaction.Fee())
+	assert.Equal(t, int64(9801), transaction.NetReceivedAmount())
+	require.Len(t, entries, 3)
+	assert.Equal(t, "receiver456", entries[1].AccountID())
+	assert.Equal(t, int64(9801), entries[1].Amount())
+	assert.Equal(t, domain.PlatformAccountID, entries[2].AccountID())
+	assert.Equal(t, domain.CreditDirection, entries[2].Direction())
+	assert.Equal(t, int64(199), entries[2].Amount())
+	assert.True(t, domain.IsBalanced(entries))
+}
+
+func TestTransaction_ChargeFee_ShouldReturnErrorWhenTheFeeTakesTheWholeAmount(t *testing.T) {
+	// Arrange
+	transaction, err := domain.NewTransaction(50, "sender123", "receiver456")
+	require.NoError(t, err)
+	schedule, err := vo.NewFixedFee(50)
+	require.NoError(t, err)
+
+	// Act
+	err = transaction.ChargeFee(*schedule)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrAmountDoesNotCoverFee)
+	assert.Zero(t, transaction.Fee())
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"sort"
 	"time"
 
 	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
@@ -13,7 +14,9 @@ type User struct {
 	name      *vo.Name
 	email     *vo.Email
 	password  *vo.Password
-	balance   *vo.Money
+	balances  map[string]*vo.Money
+	held      map[string]int64
+	sent      map[string]vo.TransferUsage
 	cpf       *vo.CPF
 	cnpj      *vo.CNPJ
 	userType  *vo.UserType
@@ -38,9 +41,113 @@ func (u *User) Password() string {
 	return u.password.Value
 }
 
-// Returns the user's balance in cents.
+// Returns the user's balance in cents of the default currency.
 func (u *User) Balance() int64 {
-	return u.balance.Value()
+	return u.BalanceIn(vo.DefaultCurrency)
+}
+
+// BalanceIn returns the user's balance in cents of the given currency, which
+// is zero for currencies the user never held.
+func (u *User) BalanceIn(currency string) int64 {
+	balance, ok := u.balances[currency]
+	if !ok {
+		return 0
+	}
+	return balance.Value()
+}
+
+// Balances returns one balance per currency held by the user, sorted by
+// currency and always including the default currency.
+func (u *User) Balances() []*vo.Money {
+	balances := make([]*vo.Money, 0, len(u.balances))
+	for _, balance := range u.balances {
+		balances = append(balances, balance)
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].Currency() < balances[j].Currency()
+	})
+	return balances
+}
+
+// RestoreBalance sets a previously persisted balance of the user.
+func (u *User) RestoreBalance(balance *vo.Money) {
+	u.balances[balance.Currency()] = balance
+}
+
+// HeldIn returns the cents of the currency reserved by authorized balance
+// holds or frozen by pending disputes, which are still part of the balance
+// but cannot be spent.
+func (u *User) HeldIn(currency string) int64 {
+	return u.held[currency]
+}
+
+// AvailableBalance returns the balance in cents of the default currency that
+// is not reserved by balance holds.
+func (u *User) AvailableBalance() int64 {
+	return u.AvailableBalanceIn(vo.DefaultCurrency)
+}
+
+// AvailableBalanceIn returns the balance in cents of the currency that is not
+// reserved by balance holds.
+func (u *User) AvailableBalanceIn(currency string) int64 {
+	return u.BalanceIn(currency) - u.HeldIn(currency)
+}
+
+// RestoreHeld sets the cents of the currency reserved by the authorized
+// balance holds of the user.
+func (u *User) RestoreHeld(currency string, amount int64) {
+	u.held[currency] = amount
+}
+
+// Hold reserves an amount in cents of the currency out of the available
+// balance, without moving it.
+func (u *User) Hold(currency string, amount int64) error {
+	if amount <= 0 {
+		return errs.ErrZeroOrNegativeAmount
+	}
+	if amount > u.AvailableBalanceIn(currency) {
+		return errs.ErrInsufficientBalance
+	}
+	u.held[currency] += amount
+	return nil
+}
+
+// ReleaseHold gives an amount in cents of the currency reserved by Hold back
+// to the available balance.
+func (u *User) ReleaseHold(currency string, amount int64) {
+	u.held[currency] = max(u.held[currency]-amount, 0)
+}
+
+// RestoreTransferUsage sets how much the user already sent in the currency
+// in the current limit periods.
+func (u *User) RestoreTransferUsage(currency string, usage vo.TransferUsage) {
+	u.sent[currency] = usage
+}
+
+// TransferUsageIn returns how much the user already sent in the currency in
+// the current limit periods.
+func (u *User) TransferUsageIn(currency string) vo.TransferUsage {
+	return u.sent[currency]
+}
+
+// RecordTransferUsage adds a transfer of amount cents of the currency to what
+// the user sent in the current limit periods, so the following transfers of
+// the same unit of work are checked against it.
+func (u *User) RecordTransferUsage(currency string, amount int64) {
+	u.sent[currency] = u.sent[currency].Add(amount)
+}
+
+// CheckTransferLimits checks that the user can send amount cents of the
+// currency on top of what was already sent in every currency, under the
+// limits of its user type.
+func (u *User) CheckTransferLimits(policy vo.TransferLimitPolicy, currency string, amount int64) error {
+	return policy.Check(*u.userType, currency, amount, u.sent)
+}
+
+// FeeSchedule returns the schedule of the fees charged on transfers the user
+// receives.
+func (u *User) FeeSchedule(policy vo.FeePolicy) vo.FeeSchedule {
+	return policy.For(u.ID(), *u.userType)
 }
 
 func (u *User) CPF() string {
@@ -86,10 +193,12 @@ func NewUser(name, email, password, cpf, cnpj, userType string) (*User, error) {
 	createdAt := time.Now()
 	updatedAt := time.Now()
 
-	return CreateUser(id, 0.0, name, email, password, cpf, cnpj, userType, createdAt, updatedAt, true)
+	return CreateUser(id, 0, name, email, password, cpf, cnpj, userType, createdAt, updatedAt, true)
 }
 
-func CreateUser(id uuid.UUID, balance float64, name, email, password, cpf, cnpj string, userType string, createdAt, updatedAt time.Time, active bool) (*User, error) {
+// CreateUser rebuilds a user. The balance is expressed in cents of the default
+// currency, balances in other currencies are restored with RestoreBalance.
+func CreateUser(id uuid.UUID, balance int64, name, email, password, cpf, cnpj string, userType string, createdAt, updatedAt time.Time, active bool) (*User, error) {
 	userTypeEnum, err := vo.NewUserType(userType)
 	if err != nil {
 		return nil, err
@@ -130,7 +239,7 @@ func CreateUser(id uuid.UUID, balance float64, name, email, password, cpf, cnpj
 		return nil, err
 	}
 
-	money, err := vo.NewMoney(balance)
+	money, err := vo.NewMoney(balance, vo.DefaultCurrency)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +254,9 @@ func CreateUser(id uuid.UUID, balance float64, name, email, password, cpf, cnpj
 		name:      newName,
 		email:     emailObj,
 		password:  passwordObj,
-		balance:   money,
+		balances:  map[string]*vo.Money{money.Currency(): money},
+		held:      map[string]int64{},
+		sent:      map[string]vo.TransferUsage{},
 		cpf:       cpfObj,
 		cnpj:      cnpjObj,
 		userType:  userTypeEnum,
@@ -157,22 +268,54 @@ func CreateUser(id uuid.UUID, balance float64, name, email, password, cpf, cnpj
 	return &user, nil
 }
 
-// Deposit adds money to the user's balance
-func (u *User) Deposit(amount float64) error {
-	m, err := u.balance.Add(amount)
+// Deposit adds an amount in cents to the user's balance in the default
+// currency
+func (u *User) Deposit(amount int64) error {
+	return u.DepositIn(vo.DefaultCurrency, amount)
+}
+
+// Withdraw removes an amount in cents from the user's balance in the default
+// currency
+func (u *User) Withdraw(amount int64) error {
+	return u.WithdrawIn(vo.DefaultCurrency, amount)
+}
+
+// DepositIn adds an amount in cents to the user's balance in the currency,
+// opening that balance if the user did not hold the currency yet
+func (u *User) DepositIn(currency string, amount int64) error {
+	balance, err := u.balanceOrZero(currency)
+	if err != nil {
+		return err
+	}
+	m, err := balance.Add(amount)
 	if err != nil {
 		return err
 	}
-	u.balance = m
+	u.balances[currency] = m
 	return nil
 }
 
-// Withdraw removes money from the user's balance
-func (u *User) Withdraw(amount float64) error {
-	m, err := u.balance.Subtract(amount)
+// WithdrawIn removes an amount in cents from the user's balance in the
+// currency. Money reserved by balance holds cannot be withdrawn.
+func (u *User) WithdrawIn(currency string, amount int64) error {
+	balance, err := u.balanceOrZero(currency)
 	if err != nil {
 		return err
 	}
-	u.balance = m
+	if amount > 0 && amount > u.AvailableBalanceIn(currency) {
+		return errs.ErrInsufficientBalance
+	}
+	m, err := balance.Subtract(amount)
+	if err != nil {
+		return err
+	}
+	u.balances[currency] = m
 	return nil
 }
+
+func (u *User) balanceOrZero(currency string) (*vo.Money, error) {
+	if balance, ok := u.balances[currency]; ok {
+		return balance, nil
+	}
+	return vo.NewMoney(0, currency)
+}
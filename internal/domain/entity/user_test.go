@@ -6,6 +6,7 @@ import (
 
 	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
 	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -132,8 +133,8 @@ func TestUser_Deposit_ShouldCorrectlyUpdateBalanceWithPositiveAmount(t *testing.
 	cpf := "12345678909"
 	cnpj := ""
 	userType := "common"
-	initialBalance := 0.0
-	depositAmount := 100.0
+	initialBalance := int64(0)
+	depositAmount := int64(10000)
 
 	user, err := entity.NewUser(name, email, password, cpf, cnpj, userType)
 	assert.NoError(t, err)
@@ -144,7 +145,7 @@ func TestUser_Deposit_ShouldCorrectlyUpdateBalanceWithPositiveAmount(t *testing.
 	require.NoError(t, err)
 
 	// Assert
-	assert.Equal(t, int64(initialBalance+depositAmount)*100, user.Balance())
+	assert.Equal(t, initialBalance+depositAmount, user.Balance())
 }
 
 func TestUser_Deposit_ShouldNotChangeBalanceWithZeroAmount(t *testing.T) {
@@ -155,7 +156,7 @@ func TestUser_Deposit_ShouldNotChangeBalanceWithZeroAmount(t *testing.T) {
 	cpf := "12345678909"
 	cnpj := ""
 	userType := "common"
-	depositAmount := 0.0
+	depositAmount := int64(0)
 
 	user, err := entity.NewUser(name, email, password, cpf, cnpj, userType)
 	assert.NoError(t, err)
@@ -177,8 +178,8 @@ func TestUser_Deposit_ShouldNotChangeBalanceWithNegativeAmount(t *testing.T) {
 	cpf := "12345678909"
 	cnpj := ""
 	userType := "common"
-	initialBalance := 0.0
-	depositAmount := -50.0
+	initialBalance := int64(0)
+	depositAmount := int64(-5000)
 
 	user, err := entity.NewUser(name, email, password, cpf, cnpj, userType)
 	assert.NoError(t, err)
@@ -189,7 +190,7 @@ func TestUser_Deposit_ShouldNotChangeBalanceWithNegativeAmount(t *testing.T) {
 
 	// Assert
 	require.Error(t, err)
-	assert.Equal(t, int64(initialBalance*100), user.Balance())
+	assert.Equal(t, initialBalance, user.Balance())
 }
 
 func TestUser_Withdraw_ShouldSuccessfullyWithdrawWhenAmountIsPositiveAndLessThanBalance(t *testing.T) {
@@ -200,7 +201,7 @@ func TestUser_Withdraw_ShouldSuccessfullyWithdrawWhenAmountIsPositiveAndLessThan
 	cpf := "12345678909"
 	cnpj := ""
 	userType := "common"
-	initialBalance := 100.0
+	initialBalance := int64(10000)
 
 	user, err := entity.NewUser(name, email, password, cpf, cnpj, userType)
 	assert.NoError(t, err)
@@ -208,16 +209,16 @@ func TestUser_Withdraw_ShouldSuccessfullyWithdrawWhenAmountIsPositiveAndLessThan
 	// Set initial balance with deposit
 	err = user.Deposit(initialBalance)
 	assert.NoError(t, err)
-	assert.Equal(t, int64(initialBalance*100), user.Balance())
+	assert.Equal(t, initialBalance, user.Balance())
 
-	withdrawAmount := 50.0
+	withdrawAmount := int64(5000)
 
 	// Act
 	err = user.Withdraw(withdrawAmount)
 
 	// Assert
 	assert.NoError(t, err)
-	assert.Equal(t, int64(initialBalance-withdrawAmount)*100, user.Balance())
+	assert.Equal(t, initialBalance-withdrawAmount, user.Balance())
 }
 
 func TestUser_Withdraw_ShouldSuccessfullyWithdrawWhenAmountEqualsEntireBalance(t *testing.T) {
@@ -228,7 +229,7 @@ func TestUser_Withdraw_ShouldSuccessfullyWithdrawWhenAmountEqualsEntireBalance(t
 	cpf := "12345678909"
 	cnpj := ""
 	userType := "common"
-	initialBalance := 100.0
+	initialBalance := int64(10000)
 
 	user, err := entity.NewUser(name, email, password, cpf, cnpj, userType)
 	assert.NoError(t, err)
@@ -236,9 +237,9 @@ func TestUser_Withdraw_ShouldSuccessfullyWithdrawWhenAmountEqualsEntireBalance(t
 	// Set initial balance with deposit
 	err = user.Deposit(initialBalance)
 	assert.NoError(t, err)
-	assert.Equal(t, int64(initialBalance*100), user.Balance())
+	assert.Equal(t, initialBalance, user.Balance())
 
-	withdrawAmount := 100.0 // Withdrawing the entire balance
+	withdrawAmount := int64(10000) // Withdrawing the entire balance
 
 	// Act
 	err = user.Withdraw(withdrawAmount)
@@ -256,7 +257,7 @@ func TestUser_Withdraw_ShouldReturnFalseWhenTryingToWithdrawMoreThanAvailableBal
 	cpf := "12345678909"
 	cnpj := ""
 	userType := "common"
-	initialBalance := 50.0
+	initialBalance := int64(5000)
 
 	user, err := entity.NewUser(name, email, password, cpf, cnpj, userType)
 	assert.NoError(t, err)
@@ -264,14 +265,106 @@ func TestUser_Withdraw_ShouldReturnFalseWhenTryingToWithdrawMoreThanAvailableBal
 	// Set initial balance with deposit
 	err = user.Deposit(initialBalance)
 	assert.NoError(t, err)
-	assert.Equal(t, int64(initialBalance*100), user.Balance())
+	assert.Equal(t, initialBalance, user.Balance())
 
-	withdrawAmount := 100.0 // Attempting to withdraw more than available
+	withdrawAmount := int64(10000) // Attempting to withdraw more than available
 
 	// Act
 	err = user.Withdraw(withdrawAmount)
 
 	// Assert
 	assert.NotNil(t, err)
-	assert.Equal(t, int64(initialBalance*100), user.Balance()) // Balance should remain unchanged
+	assert.Equal(t, initialBalance, user.Balance()) // Balance should remain unchanged
+}
+
+func TestUser_DepositIn_ShouldKeepOneBalancePerCurrency(t *testing.T) {
+	// Arrange
+	user, err := entity.NewUser("John Doe", "john@example.com", "validPassword123", "12345678909", "", "common")
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, user.Deposit(1000))
+	require.NoError(t, user.DepositIn(vo.USD, 250))
+
+	// Assert
+	assert.Equal(t, int64(1000), user.Balance())
+	assert.Equal(t, int64(250), user.BalanceIn(vo.USD))
+	assert.Equal(t, int64(0), user.BalanceIn(vo.EUR))
+	balances := user.Balances()
+	require.Len(t, balances, 2)
+	assert.Equal(t, vo.BRL, balances[0].Currency())
+	assert.Equal(t, vo.USD, balances[1].Currency())
+}
+
+func TestUser_WithdrawIn_ShouldReturnErrorWhenCurrencyIsNotHeld(t *testing.T) {
+	// Arrange
+	user, err := entity.NewUser("John Doe", "john@example.com", "validPassword123", "12345678909", "", "common")
+	require.NoError(t, err)
+	require.NoError(t, user.Deposit(1000))
+
+	// Act
+	err = user.WithdrawIn(vo.EUR, 100)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrInsufficientBalance)
+	assert.Equal(t, int64(1000), user.Balance())
+}
+
+func TestUser_DepositIn_ShouldReturnErrorWhenCurrencyIsNotSupported(t *testing.T) {
+	// Arrange
+	user, err := entity.NewUser("John Doe", "john@example.com", "validPassword123", "12345678909", "", "common")
+	require.NoError(t, err)
+
+	// Act
+	err = user.DepositIn("XYZ", 100)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrUnsupportedCurrency)
+}
+
+func TestUser_CheckTransferLimits_ShouldCountWhatWasSentInEveryCurrency(t *testing.T) {
+	// Arrange
+	user, err := entity.NewUser("John Doe", "john@example.com", "validPassword123", "12345678909", "", "common")
+	require.NoError(t, err)
+	user.RestoreTransferUsage(vo.BRL, vo.TransferUsage{Daily: 5000, Weekly: 5000, Monthly: 5000})
+	user.RestoreTransferUsage(vo.USD, vo.TransferUsage{Daily: 800, Weekly: 800, Monthly: 800})
+	commonLimits, err := vo.NewTransferLimits(0, 10000, 0, 0)
+	require.NoError(t, err)
+	usdRate, err := vo.NewExchangeRate(vo.USD, vo.BRL, "5")
+	require.NoError(t, err)
+	policy, err := vo.NewTransferLimitPolicy(map[string]vo.TransferLimits{vo.CommonUserType: *commonLimits}, usdRate)
+	require.NoError(t, err)
+
+	// Act
+	withinErr := user.CheckTransferLimits(*policy, vo.USD, 200)
+	overErr := user.CheckTransferLimits(*policy, vo.USD, 201)
+	brlErr := user.CheckTransferLimits(*policy, vo.BRL, 1001)
+
+	// Assert
+	assert.NoError(t, withinErr)
+	var limitErr *errs.TransferLimitExceededError
+	require.ErrorAs(t, overErr, &limitErr)
+	assert.Equal(t, vo.DailyLimitPeriod, limitErr.Period)
+	assert.Equal(t, int64(1000), limitErr.Remaining)
+	assert.ErrorIs(t, brlErr, errs.ErrTransferLimitExceeded)
+}
+
+func TestUser_Hold_ShouldReduceAvailableBalanceButNotLedgerBalance(t *testing.T) {
+	// Arrange
+	user, err := entity.NewUser("John Doe", "john@example.com", "validPassword123", "12345678909", "", "common")
+	require.NoError(t, err)
+	require.NoError(t, user.Deposit(10000))
+
+	// Act
+	holdErr := user.Hold(vo.BRL, 7000)
+	withdrawErr := user.WithdrawIn(vo.BRL, 5000)
+	overHoldErr := user.Hold(vo.BRL, 3001)
+
+	// Assert
+	assert.NoError(t, holdErr)
+	assert.ErrorIs(t, withdrawErr, errs.ErrInsufficientBalance)
+	assert.ErrorIs(t, overHoldErr, errs.ErrInsufficientBalance)
+	assert.Equal(t, int64(10000), user.Balance())
+	assert.Equal(t, int64(3000), user.AvailableBalance())
+	assert.Equal(t, int64(7000), user.HeldIn(vo.BRL))
 }
@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	WithdrawalPendingStatus   = "pending"
+	WithdrawalCompletedStatus = "completed"
+	WithdrawalFailedStatus    = "failed"
+)
+
+// PayoutHoldAccountID is the system account holding the money of withdrawals
+// waiting for the payout gateway, so it can be given back if the payout fails.
+const PayoutHoldAccountID = "system:payout-hold"
+
+// PayoutAccountID is the system account that balances money leaving the
+// wallet through completed payouts.
+const PayoutAccountID = "system:payout"
+
+// Withdrawal moves money out of a user's wallet to a payout destination. The
+// money is put on hold when the withdrawal is requested and either paid out or
+// released back to the user once the payout gateway reports the result.
+type Withdrawal struct {
+	id            uuid.UUID
+	userID        string
+	destinationID string
+	amount        *vo.Money
+	status        string
+	failureReason string
+	events        []event.Event
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func (w *Withdrawal) ID() string {
+	return w.id.String()
+}
+
+func (w *Withdrawal) UserID() string {
+	return w.userID
+}
+
+func (w *Withdrawal) DestinationID() string {
+	return w.destinationID
+}
+
+// Amount returns the withdrawn amount in cents.
+func (w *Withdrawal) Amount() int64 {
+	return w.amount.Value()
+}
+
+func (w *Withdrawal) Currency() string {
+	return w.amount.Currency()
+}
+
+func (w *Withdrawal) Status() string {
+	return w.status
+}
+
+func (w *Withdrawal) IsPending() bool {
+	return w.status == WithdrawalPendingStatus
+}
+
+// FailureReason returns why the payout failed, or an empty string.
+func (w *Withdrawal) FailureReason() string {
+	return w.failureReason
+}
+
+func (w *Withdrawal) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+func (w *Withdrawal) UpdatedAt() time.Time {
+	return w.updatedAt
+}
+
+// RecordEvent queues an event to be stored in the outbox along with the
+// withdrawal.
+func (w *Withdrawal) RecordEvent(e event.Event) {
+	w.events = append(w.events, e)
+}
+
+func (w *Withdrawal) Events() []event.Event {
+	return w.events
+}
+
+// Complete records that the payout gateway paid the withdrawal out.
+func (w *Withdrawal) Complete(now time.Time) error {
+	if !w.IsPending() {
+		return errs.ErrWithdrawalAlreadySettled
+	}
+	w.status = WithdrawalCompletedStatus
+	w.updatedAt = now
+	return nil
+}
+
+// Fail records that the payout failed, so the held money must go back to the
+// user.
+func (w *Withdrawal) Fail(reason string, now time.Time) error {
+	if !w.IsPending() {
+		return errs.ErrWithdrawalAlreadySettled
+	}
+	w.status = WithdrawalFailedStatus
+	w.failureReason = reason
+	w.updatedAt = now
+	return nil
+}
+
+// LedgerEntries returns the balanced postings of the current status of the
+// withdrawal: pending withdrawals move the money from the user to the hold
+// account, completed ones pay it out of the hold account and failed ones
+// release it back to the user.
+func (w *Withdrawal) LedgerEntries() ([]*LedgerEntry, error) {
+	from, to := w.userID, PayoutHoldAccountID
+	switch w.status {
+	case WithdrawalCompletedStatus:
+		from, to = PayoutHoldAccountID, PayoutAccountID
+	case WithdrawalFailedStatus:
+		from, to = PayoutHoldAccountID, w.userID
+	}
+
+	debit, err := NewLedgerEntry(w.ID(), from, DebitDirection, w.amount, w.updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	credit, err := NewLedgerEntry(w.ID(), to, CreditDirection, w.amount, w.updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return []*LedgerEntry{debit, credit}, nil
+}
+
+func NewWithdrawal(userID, destinationID string, amount *vo.Money) (*Withdrawal, error) {
+	if amount.Value() <= 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	now := time.Now()
+	return &Withdrawal{
+		id:            uuid.New(),
+		userID:        userID,
+		destinationID: destinationID,
+		amount:        amount,
+		status:        WithdrawalPendingStatus,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+func RestoreWithdrawal(id uuid.UUID, userID, destinationID string, amount int64, currency, status, failureReason string, createdAt, updatedAt time.Time) (*Withdrawal, error) {
+	money, err := vo.NewMoney(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &Withdrawal{
+		id:            id,
+		userID:        userID,
+		destinationID: destinationID,
+		amount:        money,
+		status:        status,
+		failureReason: failureReason,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}, nil
+}
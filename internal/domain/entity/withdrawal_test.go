@@ -0,0 +1,101 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWithdrawal_ShouldStartPending(t *testing.T) {
+	// Act
+	withdrawal, err := entity.NewWithdrawal("user123", "destination123", money(t, 5000, vo.BRL))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entity.WithdrawalPendingStatus, withdrawal.Status())
+	assert.Equal(t, int64(5000), withdrawal.Amount())
+	assert.Equal(t, "destination123", withdrawal.DestinationID())
+}
+
+func TestNewWithdrawal_ShouldReturnErrorWhenAmountIsZero(t *testing.T) {
+	// Act
+	withdrawal, err := entity.NewWithdrawal("user123", "destination123", money(t, 0, vo.BRL))
+
+	// Assert
+	assert.Nil(t, withdrawal)
+	assert.ErrorIs(t, err, errs.ErrZeroOrNegativeAmount)
+}
+
+func TestWithdrawal_LedgerEntries_ShouldFollowTheStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		settle      func(w *entity.Withdrawal) error
+		debitAcct   string
+		creditAcct  string
+		finalStatus string
+	}{
+		{
+			name:        "pending holds the money",
+			settle:      func(w *entity.Withdrawal) error { return nil },
+			debitAcct:   "user123",
+			creditAcct:  entity.PayoutHoldAccountID,
+			finalStatus: entity.WithdrawalPendingStatus,
+		},
+		{
+			name:        "completed pays the money out",
+			settle:      func(w *entity.Withdrawal) error { return w.Complete(time.Now()) },
+			debitAcct:   entity.PayoutHoldAccountID,
+			creditAcct:  entity.PayoutAccountID,
+			finalStatus: entity.WithdrawalCompletedStatus,
+		},
+		{
+			name:        "failed releases the money",
+			settle:      func(w *entity.Withdrawal) error { return w.Fail("account closed", time.Now()) },
+			debitAcct:   entity.PayoutHoldAccountID,
+			creditAcct:  "user123",
+			finalStatus: entity.WithdrawalFailedStatus,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			withdrawal, err := entity.NewWithdrawal("user123", "destination123", money(t, 5000, vo.BRL))
+			require.NoError(t, err)
+			require.NoError(t, tt.settle(withdrawal))
+
+			// Act
+			entries, err := withdrawal.LedgerEntries()
+
+			// Assert
+			assert.NoError(t, err)
+			require.Len(t, entries, 2)
+			assert.Equal(t, tt.finalStatus, withdrawal.Status())
+			assert.Equal(t, tt.debitAcct, entries[0].AccountID())
+			assert.Equal(t, entity.DebitDirection, entries[0].Direction())
+			assert.Equal(t, tt.creditAcct, entries[1].AccountID())
+			assert.Equal(t, entity.CreditDirection, entries[1].Direction())
+			assert.True(t, entity.IsBalanced(entries))
+		})
+	}
+}
+
+func TestWithdrawal_Complete_ShouldReturnErrorWhenAlreadySettled(t *testing.T) {
+	// Arrange
+	withdrawal, err := entity.NewWithdrawal("user123", "destination123", money(t, 5000, vo.BRL))
+	require.NoError(t, err)
+	require.NoError(t, withdrawal.Fail("account closed", time.Now()))
+
+	// Act
+	completeErr := withdrawal.Complete(time.Now())
+	failErr := withdrawal.Fail("again", time.Now())
+
+	// Assert
+	assert.ErrorIs(t, completeErr, errs.ErrWithdrawalAlreadySettled)
+	assert.ErrorIs(t, failErr, errs.ErrWithdrawalAlreadySettled)
+	assert.Equal(t, "account closed", withdrawal.FailureReason())
+}
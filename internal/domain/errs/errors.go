@@ -1,25 +1,163 @@
 package errs
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrTransactionNotAllowed          = errors.New("transaction not allowed")
-	ErrTransactionInvalidAmount       = errors.New("transaction invalid amount")
-	ErrNotEnoughMoney                 = errors.New("not enough money")
-	ErrTransactionInvalidSender       = errors.New("transaction invalid sender")
-	ErrSenderNotFound                 = errors.New("sender not found")
-	ErrReceiverNotFound               = errors.New("receiver not found")
-	ErrEitherDocumentMustBeProvided   = errors.New("either document must be provided")
-	ErrCNPJMustBeProvidedForMerchant  = errors.New("cnpj must be provided for merchant user type")
-	ErrCPFMustBeProvidedForCommonUser = errors.New("cpf must be provided for common user type")
-	ErrMerchantCannotHaveCPF          = errors.New("merchant user type cannot have cpf")
-	ErrCommonCannotHaveCNPJ           = errors.New("common user type cannot have cnpj")
-	ErrZeroOrNegativeAmount           = errors.New("amount must be a positive number")
-	ErrInsufficientBalance            = errors.New("insufficient balance")
-	ErrMerchantCannotSendMoney        = errors.New("merchant user type cannot send money")
-	ErrNameLength                     = errors.New("name length must be between 3 and 50 characters")
-	ErrEmailAlreadyRegistered         = errors.New("email already registered")
-	ErrCPFAlreadyRegistered           = errors.New("cpf already registered")
-	ErrCNPJAlreadyRegistered          = errors.New("cnpj already registered")
-	ErrUserTypeNotFound               = errors.New("user type not found")
+	ErrTransactionNotAllowed           = errors.New("transaction not allowed")
+	ErrTransactionInvalidAmount        = errors.New("transaction invalid amount")
+	ErrNotEnoughMoney                  = errors.New("not enough money")
+	ErrTransactionInvalidSender        = errors.New("transaction invalid sender")
+	ErrSenderNotFound                  = errors.New("sender not found")
+	ErrReceiverNotFound                = errors.New("receiver not found")
+	ErrEitherDocumentMustBeProvided    = errors.New("either document must be provided")
+	ErrCNPJMustBeProvidedForMerchant   = errors.New("cnpj must be provided for merchant user type")
+	ErrCPFMustBeProvidedForCommonUser  = errors.New("cpf must be provided for common user type")
+	ErrMerchantCannotHaveCPF           = errors.New("merchant user type cannot have cpf")
+	ErrCommonCannotHaveCNPJ            = errors.New("common user type cannot have cnpj")
+	ErrZeroOrNegativeAmount            = errors.New("amount must be a positive number")
+	ErrInsufficientBalance             = errors.New("insufficient balance")
+	ErrMerchantCannotSendMoney         = errors.New("merchant user type cannot send money")
+	ErrNameLength                      = errors.New("name length must be between 3 and 50 characters")
+	ErrEmailAlreadyRegistered          = errors.New("email already registered")
+	ErrCPFAlreadyRegistered            = errors.New("cpf already registered")
+	ErrCNPJAlreadyRegistered           = errors.New("cnpj already registered")
+	ErrUserTypeNotFound                = errors.New("user type not found")
+	ErrInvalidLedgerDirection          = errors.New("ledger entry direction must be debit or credit")
+	ErrUnbalancedLedgerEntries         = errors.New("ledger entries are not balanced")
+	ErrLedgerBalanceMismatch           = errors.New("balance does not match ledger entries")
+	ErrTransactionNotFound             = errors.New("transaction not found")
+	ErrRefundOfRefund                  = errors.New("refund transactions cannot be refunded")
+	ErrTransactionAlreadyRefunded      = errors.New("transaction already fully refunded")
+	ErrRefundExceedsTransactionAmount  = errors.New("refund amount exceeds the refundable amount of the transaction")
+	ErrInvalidIdempotencyKey           = errors.New("idempotency key must have between 1 and 255 characters")
+	ErrIdempotencyKeyReused            = errors.New("idempotency key already used with a different request")
+	ErrIdempotencyKeyConflict          = errors.New("idempotency key is being used by a concurrent request")
+	ErrInvalidAmountFormat             = errors.New("amount must be a decimal number such as 10.50")
+	ErrAmountPrecision                 = errors.New("amount must have at most two decimal places")
+	ErrUnsupportedCurrency             = errors.New("unsupported currency")
+	ErrCurrencyMismatch                = errors.New("amounts are in different currencies")
+	ErrInvalidExchangeRate             = errors.New("exchange rate must be a positive decimal number")
+	ErrExchangeRateNotFound            = errors.New("exchange rate not available for the currency pair")
+	ErrUserNotFound                    = errors.New("user not found")
+	ErrFundsNotConfirmed               = errors.New("funds not confirmed by the funding gateway")
+	ErrInvalidPayoutDestinationKind    = errors.New("payout destination kind must be bank_account or pix_key")
+	ErrInvalidBankAccount              = errors.New("bank account must have a 3 digit bank code, a branch and an account number")
+	ErrInvalidPixKey                   = errors.New("pix key must have between 1 and 77 characters")
+	ErrPayoutDestinationNotFound       = errors.New("payout destination not found")
+	ErrWithdrawalNotFound              = errors.New("withdrawal not found")
+	ErrWithdrawalAlreadySettled        = errors.New("withdrawal already settled")
+	ErrPayoutNotAccepted               = errors.New("payout not accepted by the payout gateway")
+	ErrExecuteAtNotInTheFuture         = errors.New("execute_at must be in the future")
+	ErrScheduledTransferNotFound       = errors.New("scheduled transfer not found")
+	ErrScheduledTransferNotCancellable = errors.New("only scheduled transfers that have not run yet can be cancelled")
+	ErrInvalidRecurrenceFrequency      = errors.New("frequency must be weekly, monthly or cron")
+	ErrInvalidCronExpression           = errors.New("cron must have 5 fields (minute, hour, day of month, month and day of week) and is only allowed with the cron frequency")
+	ErrRecurrenceNeverOccurs           = errors.New("recurrence has no occurrence within the mandate period")
+	ErrMandateStartInThePast           = errors.New("start_at must not be in the past")
+	ErrInvalidMandateEnd               = errors.New("end_at must be after start_at")
+	ErrInvalidMandateMaxExecutions     = errors.New("max_executions must not be negative")
+	ErrMandateNotFound                 = errors.New("mandate not found")
+	ErrMandateNotActive                = errors.New("only active mandates can be paused")
+	ErrMandateNotPaused                = errors.New("only paused mandates can be resumed")
+	ErrInvalidMandateAction            = errors.New("mandate action must be pause, resume or cancel")
+	ErrMandateAlreadyFinished          = errors.New("mandate already cancelled or completed")
+	ErrInvalidTransferLimit            = errors.New("transfer limits must not be negative")
+	ErrTransferLimitExceeded           = errors.New("transfer limit exceeded")
+	ErrHoldExpiryNotInTheFuture        = errors.New("expires_at must be in the future")
+	ErrBalanceHoldNotFound             = errors.New("balance hold not found")
+	ErrBalanceHoldNotAuthorized        = errors.New("balance hold already captured, voided or expired")
+	ErrBalanceHoldExpired              = errors.New("balance hold expired")
+	ErrCaptureExceedsHold              = errors.New("capture amount exceeds the held amount")
+	ErrTransactionBatchEmpty           = errors.New("transaction batch must have at least one item")
+	ErrTransactionBatchTooLarge        = errors.New("transaction batch has too many items")
+	ErrTransactionBatchNotFound        = errors.New("transaction batch not found")
+	ErrTransactionBatchAborted         = errors.New("not executed because another item of the atomic batch failed")
+	ErrSplitPaymentNeedsReceivers      = errors.New("split payment must have at least two receivers")
+	ErrInvalidSplitShare               = errors.New("each split share must have either an amount or a percentage")
+	ErrMixedSplitShares                = errors.New("split shares must be all amounts or all percentages")
+	ErrDuplicateSplitReceiver          = errors.New("split payment receivers must be distinct")
+	ErrSplitSharesMismatch             = errors.New("split shares must add up to the whole amount")
+	ErrSplitPaymentNotFound            = errors.New("split payment not found")
+	ErrOnlyMerchantsCanCharge          = errors.New("only merchant users can create charges")
+	ErrInvalidChargeReference          = errors.New("reference must have between 1 and 64 characters")
+	ErrChargeDescriptionTooLong        = errors.New("description must have at most 140 characters")
+	ErrChargeExpiryNotInTheFuture      = errors.New("expires_at must be in the future")
+	ErrChargeReferenceTaken            = errors.New("reference already used by another charge of the merchant")
+	ErrChargeNotFound                  = errors.New("charge not found")
+	ErrChargeNotPending                = errors.New("charge already paid, cancelled or expired")
+	ErrChargeExpired                   = errors.New("charge expired")
+	ErrInvalidPixCode                  = errors.New("invalid PIX code")
+	ErrPixCodeChecksumMismatch         = errors.New("PIX code checksum does not match")
+	ErrPixCodeTooLong                  = errors.New("PIX key, URL and description must have at most 99 characters together")
+	ErrInvalidPixReference             = errors.New("PIX reference must have 1 to 25 letters or digits")
+	ErrPixCurrencyNotSupported         = errors.New("PIX codes only support BRL")
+	ErrPixKeyNotFound                  = errors.New("PIX key not found")
+	ErrForeignPixCode                  = errors.New("PIX code was not issued by this wallet")
+	ErrInvalidAliasKeyType             = errors.New("alias key type must be email, cpf, cnpj, phone or random")
+	ErrInvalidAliasKey                 = errors.New("invalid alias key")
+	ErrAliasKeyNotOwnDocument          = errors.New("CPF and CNPJ keys must be the owner's own document")
+	ErrAliasKeyTaken                   = errors.New("alias key already registered")
+	ErrAliasKeyNotFound                = errors.New("alias key not found")
+	ErrInvalidFeeSchedule              = errors.New("fee schedules must be a percentage between 0 and 100%, a non-negative fixed fee or ascending tiers of those")
+	ErrAmountDoesNotCoverFee           = errors.New("amount does not cover the transfer fee")
+	ErrInvalidStatementDirection       = errors.New("direction must be sent or received")
+	ErrInvalidStatementDateRange       = errors.New("from must be before to")
+	ErrInvalidStatementAmountRange     = errors.New("min_amount must not be above max_amount")
+	ErrInvalidStatementCursor          = errors.New("invalid cursor")
+	ErrStatementPeriodRequired         = errors.New("from and to are required to export a statement")
+	ErrUnsupportedStatementFormat      = errors.New("format must be csv, ofx or pdf")
+	ErrBalanceTimeInFuture             = errors.New("at must not be in the future")
+	ErrOnlyMerchantsHaveSettlements    = errors.New("only merchant users have settlements")
+	ErrSettlementNotFound              = errors.New("settlement not found")
+	ErrSettlementNotOpen               = errors.New("settlement already closed")
+	ErrSettlementNotClosed             = errors.New("only closed settlements can be paid")
+	ErrSettlementDayNotOver            = errors.New("settlement day is not over yet")
+	ErrTransactionNotInSettlement      = errors.New("transaction does not belong to the settlement")
+	ErrInvalidSettlementStatus         = errors.New("status must be open, closed or paid")
+	ErrDisputeNotFound                 = errors.New("dispute not found")
+	ErrOnlySenderCanDispute            = errors.New("only the sender of a transaction can dispute it")
+	ErrRefundCannotBeDisputed          = errors.New("refunds and chargebacks cannot be disputed")
+	ErrTransactionAlreadyDisputed      = errors.New("transaction already disputed")
+	ErrTransactionDisputed             = errors.New("transaction has a pending dispute")
+	ErrInvalidDisputeReason            = errors.New("reason must be between 1 and 140 characters")
+	ErrDisputeEvidenceTooLong          = errors.New("evidence must be at most 2000 characters")
+	ErrInvalidDisputeResponse          = errors.New("response must be between 1 and 2000 characters")
+	ErrOnlyReceiverCanRespond          = errors.New("only the receiver of the disputed transaction can respond")
+	ErrDisputeNotOpen                  = errors.New("dispute is not awaiting a response")
+	ErrDisputeResponseWindowClosed     = errors.New("dispute response window is closed")
+	ErrDisputeAlreadyResolved          = errors.New("dispute already resolved")
+	ErrInvalidDisputeOutcome           = errors.New("outcome must be won or lost")
+	ErrEscrowNotFound                  = errors.New("escrow not found")
+	ErrEscrowNotPending                = errors.New("escrow already released, returned or expired")
+	ErrEscrowExpired                   = errors.New("escrow expired before it was shipped")
+	ErrEscrowAlreadyShipped            = errors.New("escrow already shipped")
+	ErrOnlySenderCanConfirmEscrow      = errors.New("only the sender of an escrow can confirm it")
+	ErrOnlyReceiverCanShipEscrow       = errors.New("only the receiver of an escrow can ship it")
+	ErrInvalidEscrowOutcome            = errors.New("outcome must be released or returned")
 )
+
+// TransferLimitExceededError is returned when a transfer is above what the
+// sender can still send. It matches ErrTransferLimitExceeded.
+type TransferLimitExceededError struct {
+	// Period of the limit that was hit: transaction, daily, weekly or monthly
+	Period string
+	// Limit in cents of the default currency of the period
+	Limit int64
+	// Remaining is how many cents of the default currency can still be sent
+	Remaining int64
+}
+
+func (e *TransferLimitExceededError) Error() string {
+	return fmt.Sprintf("%s transfer limit of %s exceeded, %s remaining", e.Period, formatCents(e.Limit), formatCents(e.Remaining))
+}
+
+func (e *TransferLimitExceededError) Is(target error) bool {
+	return target == ErrTransferLimitExceeded
+}
+
+func formatCents(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
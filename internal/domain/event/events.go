@@ -8,26 +8,577 @@ import (
 	"github.com/google/uuid"
 )
 
+// Event is a domain event published to the queue through the outbox.
+type Event interface {
+	Name() string
+	ToJSON() []byte
+}
+
+// Amount is an amount in cents of a currency carried by events.
+type Amount struct {
+	InCents  int64
+	Currency string
+}
+
 type CreateTransactionEventV1 struct {
+	PublishedAt           string
+	TransactionID         string
+	AmountInCents         int64
+	Currency              string
+	ReceivedAmountInCents int64
+	ReceivedCurrency      string
+	ExchangeRate          string
+	FeeInCents            int64
+	FeeCurrency           string
+	SenderID              uuid.UUID
+	ReceiverID            uuid.UUID
+}
+
+// NewCreateTransactionEventV1 creates the event of a transfer. receivedAmount
+// is what the receiver got before the fee was taken.
+func NewCreateTransactionEventV1(transactionID string, amount, receivedAmount, fee Amount, exchangeRate string, senderID uuid.UUID, receiverID uuid.UUID) *CreateTransactionEventV1 {
+	publishedAt := time.Now().Format(time.RFC3339)
+	return &CreateTransactionEventV1{
+		PublishedAt:           publishedAt,
+		TransactionID:         transactionID,
+		AmountInCents:         amount.InCents,
+		Currency:              amount.Currency,
+		ReceivedAmountInCents: receivedAmount.InCents,
+		ReceivedCurrency:      receivedAmount.Currency,
+		ExchangeRate:          exchangeRate,
+		FeeInCents:            fee.InCents,
+		FeeCurrency:           fee.Currency,
+		SenderID:              senderID,
+		ReceiverID:            receiverID,
+	}
+}
+
+func (e *CreateTransactionEventV1) Name() string {
+	return "CreateTransactionEventV1"
+}
+
+func (e *CreateTransactionEventV1) ToJSON() []byte {
+	jsonData, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("Error marshalling event to JSON: %v", err)
+		return nil
+	}
+	return jsonData
+}
+
+type RefundTransactionEventV1 struct {
+	PublishedAt           string
+	TransactionID         string
+	OriginalTransactionID string
+	AmountInCents         int64
+	Currency              string
+	ReceivedAmountInCents int64
+	ReceivedCurrency      string
+	SenderID              uuid.UUID
+	ReceiverID            uuid.UUID
+}
+
+func NewRefundTransactionEventV1(transactionID, originalTransactionID string, amount, receivedAmount Amount, senderID uuid.UUID, receiverID uuid.UUID) *RefundTransactionEventV1 {
+	publishedAt := time.Now().Format(time.RFC3339)
+	return &RefundTransactionEventV1{
+		PublishedAt:           publishedAt,
+		TransactionID:         transactionID,
+		OriginalTransactionID: originalTransactionID,
+		AmountInCents:         amount.InCents,
+		Currency:              amount.Currency,
+		ReceivedAmountInCents: receivedAmount.InCents,
+		ReceivedCurrency:      receivedAmount.Currency,
+		SenderID:              senderID,
+		ReceiverID:            receiverID,
+	}
+}
+
+func (e *RefundTransactionEventV1) Name() string {
+	return "RefundTransactionEventV1"
+}
+
+func (e *RefundTransactionEventV1) ToJSON() []byte {
+	jsonData, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("Error marshalling event to JSON: %v", err)
+		return nil
+	}
+	return jsonData
+}
+
+type DepositEventV1 struct {
+	PublishedAt   string
+	DepositID     string
+	UserID        uuid.UUID
+	AmountInCents int64
+	Currency      string
+}
+
+func NewDepositEventV1(depositID string, userID uuid.UUID, amount Amount) *DepositEventV1 {
+	publishedAt := time.Now().Format(time.RFC3339)
+	return &DepositEventV1{
+		PublishedAt:   publishedAt,
+		DepositID:     depositID,
+		UserID:        userID,
+		AmountInCents: amount.InCents,
+		Currency:      amount.Currency,
+	}
+}
+
+func (e *DepositEventV1) Name() string {
+	return "DepositEventV1"
+}
+
+func (e *DepositEventV1) ToJSON() []byte {
+	jsonData, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("Error marshalling event to JSON: %v", err)
+		return nil
+	}
+	return jsonData
+}
+
+// WithdrawalEventV1 carries the state of a withdrawal. It is published under
+// a different name for each step of the withdrawal.
+type WithdrawalEventV1 struct {
+	name          string
 	PublishedAt   string
+	WithdrawalID  string
+	UserID        uuid.UUID
+	DestinationID string
+	AmountInCents int64
+	Currency      string
+	Status        string
+	FailureReason string
+}
+
+func newWithdrawalEventV1(name, withdrawalID string, userID uuid.UUID, destinationID string, amount Amount, status, failureReason string) *WithdrawalEventV1 {
+	publishedAt := time.Now().Format(time.RFC3339)
+	return &WithdrawalEventV1{
+		name:          name,
+		PublishedAt:   publishedAt,
+		WithdrawalID:  withdrawalID,
+		UserID:        userID,
+		DestinationID: destinationID,
+		AmountInCents: amount.InCents,
+		Currency:      amount.Currency,
+		Status:        status,
+		FailureReason: failureReason,
+	}
+}
+
+func NewWithdrawalRequestedEventV1(withdrawalID string, userID uuid.UUID, destinationID string, amount Amount, status string) *WithdrawalEventV1 {
+	return newWithdrawalEventV1("WithdrawalRequestedEventV1", withdrawalID, userID, destinationID, amount, status, "")
+}
+
+func NewWithdrawalCompletedEventV1(withdrawalID string, userID uuid.UUID, destinationID string, amount Amount, status string) *WithdrawalEventV1 {
+	return newWithdrawalEventV1("WithdrawalCompletedEventV1", withdrawalID, userID, destinationID, amount, status, "")
+}
+
+func NewWithdrawalFailedEventV1(withdrawalID string, userID uuid.UUID, destinationID string, amount Amount, status, failureReason string) *WithdrawalEventV1 {
+	return newWithdrawalEventV1("WithdrawalFailedEventV1", withdrawalID, userID, destinationID, amount, status, failureReason)
+}
+
+func (e *WithdrawalEventV1) Name() string {
+	return e.name
+}
+
+func (e *WithdrawalEventV1) ToJSON() []byte {
+	jsonData, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("Error marshalling event to JSON: %v", err)
+		return nil
+	}
+	return jsonData
+}
+
+// ScheduledTransferFailedEventV1 is published when a scheduled transfer could
+// not be executed at its date, e.g. because the sender did not have enough
+// money.
+type ScheduledTransferFailedEventV1 struct {
+	PublishedAt         string
+	ScheduledTransferID string
+	AmountInCents       int64
+	Currency            string
+	SenderID            uuid.UUID
+	ReceiverID          uuid.UUID
+	ExecuteAt           string
+	FailureReason       string
+}
+
+func NewScheduledTransferFailedEventV1(scheduledTransferID string, amount Amount, senderID, receiverID uuid.UUID, executeAt time.Time, failureReason string) *ScheduledTransferFailedEventV1 {
+	publishedAt := time.Now().Format(time.RFC3339)
+	return &ScheduledTransferFailedEventV1{
+		PublishedAt:         publishedAt,
+		ScheduledTransferID: scheduledTransferID,
+		AmountInCents:       amount.InCents,
+		Currency:            amount.Currency,
+		SenderID:            senderID,
+		ReceiverID:          receiverID,
+		ExecuteAt:           executeAt.Format(time.RFC3339),
+		FailureReason:       failureReason,
+	}
+}
+
+func (e *ScheduledTransferFailedEventV1) Name() string {
+	return "ScheduledTransferFailedEventV1"
+}
+
+func (e *ScheduledTransferFailedEventV1) ToJSON() []byte {
+	jsonData, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("Error marshalling event to JSON: %v", err)
+		return nil
+	}
+	return jsonData
+}
+
+// MandateExecutionFailedEventV1 is published when a run of a recurring
+// transfer mandate could not be executed, e.g. because the sender did not have
+// enough money.
+type MandateExecutionFailedEventV1 struct {
+	PublishedAt   string
+	MandateID     string
+	ExecutionID   string
+	Sequence      int
+	AmountInCents int64
+	Currency      string
+	SenderID      uuid.UUID
+	ReceiverID    uuid.UUID
+	ScheduledFor  string
+	FailureReason string
+}
+
+func NewMandateExecutionFailedEventV1(mandateID, executionID string, sequence int, amount Amount, senderID, receiverID uuid.UUID, scheduledFor time.Time, failureReason string) *MandateExecutionFailedEventV1 {
+	publishedAt := time.Now().Format(time.RFC3339)
+	return &MandateExecutionFailedEventV1{
+		PublishedAt:   publishedAt,
+		MandateID:     mandateID,
+		ExecutionID:   executionID,
+		Sequence:      sequence,
+		AmountInCents: amount.InCents,
+		Currency:      amount.Currency,
+		SenderID:      senderID,
+		ReceiverID:    receiverID,
+		ScheduledFor:  scheduledFor.Format(time.RFC3339),
+		FailureReason: failureReason,
+	}
+}
+
+func (e *MandateExecutionFailedEventV1) Name() string {
+	return "MandateExecutionFailedEventV1"
+}
+
+func (e *MandateExecutionFailedEventV1) ToJSON() []byte {
+	jsonData, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("Error marshalling event to JSON: %v", err)
+		return nil
+	}
+	return jsonData
+}
+
+// BalanceHoldEventV1 carries the state of a balance hold. It is published
+// under a different name for each step of the hold.
+type BalanceHoldEventV1 struct {
+	name                  string
+	PublishedAt           string
+	HoldID                string
+	SenderID              uuid.UUID
+	ReceiverID            uuid.UUID
+	AmountInCents         int64
+	CapturedAmountInCents int64
+	Currency              string
+	Status                string
+	TransactionID         string
+}
+
+func newBalanceHoldEventV1(name, holdID string, senderID, receiverID uuid.UUID, amount Amount, capturedAmount int64, status, transactionID string) *BalanceHoldEventV1 {
+	publishedAt := time.Now().Format(time.RFC3339)
+	return &BalanceHoldEventV1{
+		name:                  name,
+		PublishedAt:           publishedAt,
+		HoldID:                holdID,
+		SenderID:              senderID,
+		ReceiverID:            receiverID,
+		AmountInCents:         amount.InCents,
+		CapturedAmountInCents: capturedAmount,
+		Currency:              amount.Currency,
+		Status:                status,
+		TransactionID:         transactionID,
+	}
+}
+
+func NewBalanceHoldAuthorizedEventV1(holdID string, senderID, receiverID uuid.UUID, amount Amount, status string) *BalanceHoldEventV1 {
+	return newBalanceHoldEventV1("BalanceHoldAuthorizedEventV1", holdID, senderID, receiverID, amount, 0, status, "")
+}
+
+func NewBalanceHoldCapturedEventV1(holdID string, senderID, receiverID uuid.UUID, amount Amount, capturedAmount int64, status, transactionID string) *BalanceHoldEventV1 {
+	return newBalanceHoldEventV1("BalanceHoldCapturedEventV1", holdID, senderID, receiverID, amount, capturedAmount, status, transactionID)
+}
+
+func NewBalanceHoldVoidedEventV1(holdID string, senderID, receiverID uuid.UUID, amount Amount, status string) *BalanceHoldEventV1 {
+	return newBalanceHoldEventV1("BalanceHoldVoidedEventV1", holdID, senderID, receiverID, amount, 0, status, "")
+}
+
+func NewBalanceHoldExpiredEventV1(holdID string, senderID, receiverID uuid.UUID, amount Amount, status string) *BalanceHoldEventV1 {
+	return newBalanceHoldEventV1("BalanceHoldExpiredEventV1", holdID, senderID, receiverID, amount, 0, status, "")
+}
+
+func (e *BalanceHoldEventV1) Name() string {
+	return e.name
+}
+
+func (e *BalanceHoldEventV1) ToJSON() []byte {
+	jsonData, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("Error marshalling event to JSON: %v", err)
+		return nil
+	}
+	return jsonData
+}
+
+// ChargeEventV1 carries the state of a merchant charge. It is published under
+// a different name for each step of the charge.
+type ChargeEventV1 struct {
+	name          string
+	PublishedAt   string
+	ChargeID      string
+	MerchantID    uuid.UUID
+	Reference     string
+	AmountInCents int64
+	Currency      string
+	Status        string
+	PayerID       string
+	TransactionID string
+}
+
+func newChargeEventV1(name, chargeID string, merchantID uuid.UUID, reference string, amount Amount, status, payerID, transactionID string) *ChargeEventV1 {
+	publishedAt := time.Now().Format(time.RFC3339)
+	return &ChargeEventV1{
+		name:          name,
+		PublishedAt:   publishedAt,
+		ChargeID:      chargeID,
+		MerchantID:    merchantID,
+		Reference:     reference,
+		AmountInCents: amount.InCents,
+		Currency:      amount.Currency,
+		Status:        status,
+		PayerID:       payerID,
+		TransactionID: transactionID,
+	}
+}
+
+func NewChargeCreatedEventV1(chargeID string, merchantID uuid.UUID, reference string, amount Amount, status string) *ChargeEventV1 {
+	return newChargeEventV1("ChargeCreatedEventV1", chargeID, merchantID, reference, amount, status, "", "")
+}
+
+func NewChargePaidEventV1(chargeID string, merchantID uuid.UUID, reference string, amount Amount, status string, payerID uuid.UUID, transactionID string) *ChargeEventV1 {
+	return newChargeEventV1("ChargePaidEventV1", chargeID, merchantID, reference, amount, status, payerID.String(), transactionID)
+}
+
+func NewChargeCancelledEventV1(chargeID string, merchantID uuid.UUID, reference string, amount Amount, status string) *ChargeEventV1 {
+	return newChargeEventV1("ChargeCancelledEventV1", chargeID, merchantID, reference, amount, status, "", "")
+}
+
+func NewChargeExpiredEventV1(chargeID string, merchantID uuid.UUID, reference string, amount Amount, status string) *ChargeEventV1 {
+	return newChargeEventV1("ChargeExpiredEventV1", chargeID, merchantID, reference, amount, status, "", "")
+}
+
+func (e *ChargeEventV1) Name() string {
+	return e.name
+}
+
+func (e *ChargeEventV1) ToJSON() []byte {
+	jsonData, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("Error marshalling event to JSON: %v", err)
+		return nil
+	}
+	return jsonData
+}
+
+// SettlementEventV1 carries the totals of a merchant settlement, in cents of
+// Currency. It is published under a different name for each step of the
+// settlement.
+type SettlementEventV1 struct {
+	name             string
+	PublishedAt      string
+	SettlementID     string
+	MerchantID       uuid.UUID
+	Date             string
+	Currency         string
+	GrossInCents     int64
+	FeeInCents       int64
+	NetInCents       int64
+	TransactionCount int
+	Status           string
+}
+
+func newSettlementEventV1(name, settlementID string, merchantID uuid.UUID, date time.Time, currency string, gross, fee int64, transactionCount int, status string) *SettlementEventV1 {
+	publishedAt := time.Now().Format(time.RFC3339)
+	return &SettlementEventV1{
+		name:             name,
+		PublishedAt:      publishedAt,
+		SettlementID:     settlementID,
+		MerchantID:       merchantID,
+		Date:             date.Format(time.DateOnly),
+		Currency:         currency,
+		GrossInCents:     gross,
+		FeeInCents:       fee,
+		NetInCents:       gross - fee,
+		TransactionCount: transactionCount,
+		Status:           status,
+	}
+}
+
+func NewSettlementClosedEventV1(settlementID string, merchantID uuid.UUID, date time.Time, currency string, gross, fee int64, transactionCount int, status string) *SettlementEventV1 {
+	return newSettlementEventV1("SettlementClosedEventV1", settlementID, merchantID, date, currency, gross, fee, transactionCount, status)
+}
+
+func NewSettlementPaidEventV1(settlementID string, merchantID uuid.UUID, date time.Time, currency string, gross, fee int64, transactionCount int, status string) *SettlementEventV1 {
+	return newSettlementEventV1("SettlementPaidEventV1", settlementID, merchantID, date, currency, gross, fee, transactionCount, status)
+}
+
+func (e *SettlementEventV1) Name() string {
+	return e.name
+}
+
+func (e *SettlementEventV1) ToJSON() []byte {
+	jsonData, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("Error marshalling event to JSON: %v", err)
+		return nil
+	}
+	return jsonData
+}
+
+// DisputeEventV1 carries the state of a dispute over a transaction, the
+// disputed amount in cents of Currency. It is published under a different
+// name for each step of the dispute.
+type DisputeEventV1 struct {
+	name          string
+	PublishedAt   string
+	DisputeID     string
 	TransactionID string
-	Amount        float64
 	SenderID      uuid.UUID
 	ReceiverID    uuid.UUID
+	AmountInCents int64
+	Currency      string
+	Reason        string
+	Status        string
+	RespondBy     string
+	ChargebackID  string
 }
 
-func NewCreateTransactionEventV1(transactionID string, amount float64, senderID uuid.UUID, receiverID uuid.UUID) *CreateTransactionEventV1 {
+func newDisputeEventV1(name, disputeID, transactionID string, senderID, receiverID uuid.UUID, amount Amount, reason, status string, respondBy time.Time, chargebackID string) *DisputeEventV1 {
 	publishedAt := time.Now().Format(time.RFC3339)
-	return &CreateTransactionEventV1{
+	return &DisputeEventV1{
+		name:          name,
 		PublishedAt:   publishedAt,
+		DisputeID:     disputeID,
 		TransactionID: transactionID,
-		Amount:        amount,
 		SenderID:      senderID,
 		ReceiverID:    receiverID,
+		AmountInCents: amount.InCents,
+		Currency:      amount.Currency,
+		Reason:        reason,
+		Status:        status,
+		RespondBy:     respondBy.Format(time.RFC3339),
+		ChargebackID:  chargebackID,
 	}
 }
 
-func (e *CreateTransactionEventV1) ToJSON() []byte {
+func NewDisputeOpenedEventV1(disputeID, transactionID string, senderID, receiverID uuid.UUID, amount Amount, reason, status string, respondBy time.Time) *DisputeEventV1 {
+	return newDisputeEventV1("DisputeOpenedEventV1", disputeID, transactionID, senderID, receiverID, amount, reason, status, respondBy, "")
+}
+
+func NewDisputeRespondedEventV1(disputeID, transactionID string, senderID, receiverID uuid.UUID, amount Amount, reason, status string, respondBy time.Time) *DisputeEventV1 {
+	return newDisputeEventV1("DisputeRespondedEventV1", disputeID, transactionID, senderID, receiverID, amount, reason, status, respondBy, "")
+}
+
+func NewDisputeWonEventV1(disputeID, transactionID string, senderID, receiverID uuid.UUID, amount Amount, reason, status string, respondBy time.Time, chargebackID string) *DisputeEventV1 {
+	return newDisputeEventV1("DisputeWonEventV1", disputeID, transactionID, senderID, receiverID, amount, reason, status, respondBy, chargebackID)
+}
+
+func NewDisputeLostEventV1(disputeID, transactionID string, senderID, receiverID uuid.UUID, amount Amount, reason, status string, respondBy time.Time) *DisputeEventV1 {
+	return newDisputeEventV1("DisputeLostEventV1", disputeID, transactionID, senderID, receiverID, amount, reason, status, respondBy, "")
+}
+
+func (e *DisputeEventV1) Name() string {
+	return e.name
+}
+
+func (e *DisputeEventV1) ToJSON() []byte {
+	jsonData, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("Error marshalling event to JSON: %v", err)
+		return nil
+	}
+	return jsonData
+}
+
+// EscrowEventV1 carries the state of an escrow, the amount in cents of
+// Currency. It is published under a different name for each step of the
+// escrow.
+type EscrowEventV1 struct {
+	name          string
+	PublishedAt   string
+	EscrowID      string
+	SenderID      uuid.UUID
+	ReceiverID    uuid.UUID
+	AmountInCents int64
+	Currency      string
+	Status        string
+	ReleaseAt     string
+	TransactionID string
+}
+
+func newEscrowEventV1(name, escrowID string, senderID, receiverID uuid.UUID, amount Amount, status string, releaseAt *time.Time, transactionID string) *EscrowEventV1 {
+	publishedAt := time.Now().Format(time.RFC3339)
+	var formattedReleaseAt string
+	if releaseAt != nil {
+		formattedReleaseAt = releaseAt.Format(time.RFC3339)
+	}
+	return &EscrowEventV1{
+		name:          name,
+		PublishedAt:   publishedAt,
+		EscrowID:      escrowID,
+		SenderID:      senderID,
+		ReceiverID:    receiverID,
+		AmountInCents: amount.InCents,
+		Currency:      amount.Currency,
+		Status:        status,
+		ReleaseAt:     formattedReleaseAt,
+		TransactionID: transactionID,
+	}
+}
+
+func NewEscrowCreatedEventV1(escrowID string, senderID, receiverID uuid.UUID, amount Amount, status string) *EscrowEventV1 {
+	return newEscrowEventV1("EscrowCreatedEventV1", escrowID, senderID, receiverID, amount, status, nil, "")
+}
+
+func NewEscrowShippedEventV1(escrowID string, senderID, receiverID uuid.UUID, amount Amount, status string, releaseAt time.Time) *EscrowEventV1 {
+	return newEscrowEventV1("EscrowShippedEventV1", escrowID, senderID, receiverID, amount, status, &releaseAt, "")
+}
+
+func NewEscrowReleasedEventV1(escrowID string, senderID, receiverID uuid.UUID, amount Amount, status, transactionID string) *EscrowEventV1 {
+	return newEscrowEventV1("EscrowReleasedEventV1", escrowID, senderID, receiverID, amount, status, nil, transactionID)
+}
+
+func NewEscrowReturnedEventV1(escrowID string, senderID, receiverID uuid.UUID, amount Amount, status string) *EscrowEventV1 {
+	return newEscrowEventV1("EscrowReturnedEventV1", escrowID, senderID, receiverID, amount, status, nil, "")
+}
+
+func NewEscrowExpiredEventV1(escrowID string, senderID, receiverID uuid.UUID, amount Amount, status string) *EscrowEventV1 {
+	return newEscrowEventV1("EscrowExpiredEventV1", escrowID, senderID, receiverID, amount, status, nil, "")
+}
+
+func (e *EscrowEventV1) Name() string {
+	return e.name
+}
+
+func (e *EscrowEventV1) ToJSON() []byte {
 	jsonData, err := json.Marshal(e)
 	if err != nil {
 		log.Printf("Error marshalling event to JSON: %v", err)
@@ -0,0 +1,36 @@
+package vo
+
+import (
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+)
+
+// ISO-4217 codes of the supported currencies. All of them have two minor
+// units, so amounts are always expressed in cents.
+const (
+	BRL = "BRL"
+	USD = "USD"
+	EUR = "EUR"
+)
+
+// DefaultCurrency is the currency of the wallet balance every user has.
+const DefaultCurrency = BRL
+
+// SupportedCurrencies are the codes of every supported currency.
+var SupportedCurrencies = []string{BRL, USD, EUR}
+
+type Currency struct {
+	code string
+}
+
+func NewCurrency(code string) (*Currency, error) {
+	for _, validCurrency := range SupportedCurrencies {
+		if code == validCurrency {
+			return &Currency{code: code}, nil
+		}
+	}
+	return nil, errs.ErrUnsupportedCurrency
+}
+
+func (c Currency) Code() string {
+	return c.code
+}
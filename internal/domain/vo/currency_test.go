@@ -0,0 +1,31 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCurrency_ShouldAcceptSupportedCurrencies(t *testing.T) {
+	for _, code := range []string{vo.BRL, vo.USD, vo.EUR} {
+		// Act
+		currency, err := vo.NewCurrency(code)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, code, currency.Code())
+	}
+}
+
+func TestNewCurrency_ShouldRejectUnsupportedOrMalformedCodes(t *testing.T) {
+	for _, code := range []string{"", "brl", "XYZ", "REAL"} {
+		// Act
+		currency, err := vo.NewCurrency(code)
+
+		// Assert
+		assert.Nil(t, currency)
+		assert.ErrorIs(t, err, errs.ErrUnsupportedCurrency)
+	}
+}
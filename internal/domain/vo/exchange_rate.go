@@ -0,0 +1,100 @@
+package vo
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+)
+
+// ExchangeRateScale is how many decimal places of a rate are kept when it is
+// stored or displayed.
+const ExchangeRateScale = 10
+
+var decimalRateRegex = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// ExchangeRate is how many units of the target currency one unit of the source
+// currency buys. Conversions use exact rational arithmetic and only round the
+// final amount to the nearest cent.
+type ExchangeRate struct {
+	from Currency
+	to   Currency
+	rate *big.Rat
+}
+
+// NewExchangeRate creates a rate from a decimal string such as "5.4321".
+func NewExchangeRate(from, to, rate string) (*ExchangeRate, error) {
+	if !decimalRateRegex.MatchString(rate) {
+		return nil, errs.ErrInvalidExchangeRate
+	}
+	value, ok := new(big.Rat).SetString(rate)
+	if !ok || value.Sign() <= 0 {
+		return nil, errs.ErrInvalidExchangeRate
+	}
+	return newExchangeRate(from, to, value)
+}
+
+// NewIdentityExchangeRate is the rate of transfers that do not change currency.
+func NewIdentityExchangeRate(currency string) (*ExchangeRate, error) {
+	return newExchangeRate(currency, currency, big.NewRat(1, 1))
+}
+
+func newExchangeRate(from, to string, rate *big.Rat) (*ExchangeRate, error) {
+	fromCurrency, err := NewCurrency(from)
+	if err != nil {
+		return nil, err
+	}
+	toCurrency, err := NewCurrency(to)
+	if err != nil {
+		return nil, err
+	}
+	if from == to && rate.Cmp(big.NewRat(1, 1)) != 0 {
+		return nil, errs.ErrInvalidExchangeRate
+	}
+	return &ExchangeRate{from: *fromCurrency, to: *toCurrency, rate: rate}, nil
+}
+
+func (r ExchangeRate) From() string {
+	return r.from.Code()
+}
+
+func (r ExchangeRate) To() string {
+	return r.to.Code()
+}
+
+// Rate returns the rate as a decimal string with up to ExchangeRateScale
+// decimal places.
+func (r ExchangeRate) Rate() string {
+	value := r.rate.FloatString(ExchangeRateScale)
+	value = strings.TrimRight(value, "0")
+	return strings.TrimSuffix(value, ".")
+}
+
+func (r ExchangeRate) IsIdentity() bool {
+	return r.from == r.to
+}
+
+// Inverse returns the rate converting back from the target currency.
+func (r ExchangeRate) Inverse() *ExchangeRate {
+	return &ExchangeRate{from: r.to, to: r.from, rate: new(big.Rat).Inv(r.rate)}
+}
+
+// Convert converts an amount in the source currency, rounding half up to the
+// nearest cent of the target currency.
+func (r ExchangeRate) Convert(amount *Money) (*Money, error) {
+	if amount.Currency() != r.From() {
+		return nil, errs.ErrCurrencyMismatch
+	}
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt64(amount.Value()), r.rate)
+
+	// (2 * num + den) / (2 * den) rounds half up for non-negative amounts
+	num := new(big.Int).Mul(converted.Num(), big.NewInt(2))
+	num.Add(num, converted.Denom())
+	den := new(big.Int).Mul(converted.Denom(), big.NewInt(2))
+	cents := new(big.Int).Quo(num, den)
+	if !cents.IsInt64() {
+		return nil, errs.ErrInvalidAmountFormat
+	}
+	return NewMoney(cents.Int64(), r.To())
+}
@@ -0,0 +1,91 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExchangeRate_ShouldRejectInvalidRates(t *testing.T) {
+	for _, rate := range []string{"", "0", "-5.2", "abc", "1/3", "1e3"} {
+		// Act
+		exchangeRate, err := vo.NewExchangeRate(vo.USD, vo.BRL, rate)
+
+		// Assert
+		assert.Nil(t, exchangeRate, rate)
+		assert.ErrorIs(t, err, errs.ErrInvalidExchangeRate, rate)
+	}
+}
+
+func TestNewExchangeRate_ShouldRejectUnsupportedCurrencies(t *testing.T) {
+	// Act
+	exchangeRate, err := vo.NewExchangeRate("XYZ", vo.BRL, "5")
+
+	// Assert
+	assert.Nil(t, exchangeRate)
+	assert.ErrorIs(t, err, errs.ErrUnsupportedCurrency)
+}
+
+func TestExchangeRate_Convert_ShouldRoundHalfUpToTheNearestCent(t *testing.T) {
+	// Arrange
+	exchangeRate, err := vo.NewExchangeRate(vo.USD, vo.BRL, "5.4321")
+	require.NoError(t, err)
+	amount, _ := vo.NewMoney(1050, vo.USD) // 10.50 USD * 5.4321 = 57.03705 BRL
+
+	// Act
+	converted, err := exchangeRate.Convert(amount)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, vo.BRL, converted.Currency())
+	assert.Equal(t, int64(5704), converted.Value())
+}
+
+func TestExchangeRate_Convert_ShouldReturnErrorWhenAmountIsInAnotherCurrency(t *testing.T) {
+	// Arrange
+	exchangeRate, _ := vo.NewExchangeRate(vo.USD, vo.BRL, "5")
+	amount, _ := vo.NewMoney(1000, vo.EUR)
+
+	// Act
+	converted, err := exchangeRate.Convert(amount)
+
+	// Assert
+	assert.Nil(t, converted)
+	assert.ErrorIs(t, err, errs.ErrCurrencyMismatch)
+}
+
+func TestExchangeRate_Inverse_ShouldConvertBack(t *testing.T) {
+	// Arrange
+	exchangeRate, _ := vo.NewExchangeRate(vo.EUR, vo.BRL, "4")
+	amount, _ := vo.NewMoney(2000, vo.BRL)
+
+	// Act
+	inverse := exchangeRate.Inverse()
+	converted, err := inverse.Convert(amount)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, vo.BRL, inverse.From())
+	assert.Equal(t, vo.EUR, inverse.To())
+	assert.Equal(t, "0.25", inverse.Rate())
+	assert.Equal(t, int64(500), converted.Value())
+}
+
+func TestNewIdentityExchangeRate_ShouldKeepTheAmount(t *testing.T) {
+	// Arrange
+	exchangeRate, err := vo.NewIdentityExchangeRate(vo.BRL)
+	require.NoError(t, err)
+	amount, _ := vo.NewMoney(1234, vo.BRL)
+
+	// Act
+	converted, err := exchangeRate.Convert(amount)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, exchangeRate.IsIdentity())
+	assert.Equal(t, "1", exchangeRate.Rate())
+	assert.Equal(t, int64(1234), converted.Value())
+}
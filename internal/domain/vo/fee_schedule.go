@@ -0,0 +1,144 @@
+package vo
+
+import (
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+)
+
+// Kinds of fee schedules.
+const (
+	PercentageFeeKind = "percentage"
+	FixedFeeKind      = "fixed"
+	TieredFeeKind     = "tiered"
+)
+
+// wholeBasisPoints is 100% in basis points, the unit fee percentages are
+// expressed in.
+const wholeBasisPoints = 10000
+
+// FeeSchedule tells the fee charged on a transfer out of the amount the
+// receiver gets: a percentage of it, a fixed amount in cents, or the
+// percentage or fixed fee of the tier the amount falls in. The zero value
+// charges no fee.
+type FeeSchedule struct {
+	kind        string
+	basisPoints int64
+	fixed       int64
+	tiers       []FeeTier
+}
+
+// FeeTier applies its fee to amounts up to UpTo cents that are above the
+// previous tier. A zero UpTo means the tier has no upper bound.
+type FeeTier struct {
+	UpTo int64
+	Fee  FeeSchedule
+}
+
+// NewPercentageFee creates a schedule charging basisPoints of the amount,
+// rounding half cents up.
+func NewPercentageFee(basisPoints int64) (*FeeSchedule, error) {
+	if basisPoints < 0 || basisPoints > wholeBasisPoints {
+		return nil, errs.ErrInvalidFeeSchedule
+	}
+	return &FeeSchedule{kind: PercentageFeeKind, basisPoints: basisPoints}, nil
+}
+
+// NewFixedFee creates a schedule charging the same amount in cents whatever
+// is transferred.
+func NewFixedFee(cents int64) (*FeeSchedule, error) {
+	if cents < 0 {
+		return nil, errs.ErrInvalidFeeSchedule
+	}
+	return &FeeSchedule{kind: FixedFeeKind, fixed: cents}, nil
+}
+
+// NewTieredFee creates a schedule charging the fee of the tier the amount
+// falls in. Tiers must be percentage or fixed fees in ascending order of
+// their bounds, amounts above the last bounded tier are charged the fee of
+// the last tier.
+func NewTieredFee(tiers []FeeTier) (*FeeSchedule, error) {
+	if len(tiers) == 0 {
+		return nil, errs.ErrInvalidFeeSchedule
+	}
+	var previous int64
+	for i, tier := range tiers {
+		if tier.Fee.kind == TieredFeeKind || tier.Fee.kind == "" {
+			return nil, errs.ErrInvalidFeeSchedule
+		}
+		unbounded := tier.UpTo == 0
+		if unbounded && i != len(tiers)-1 || !unbounded && tier.UpTo <= previous {
+			return nil, errs.ErrInvalidFeeSchedule
+		}
+		previous = tier.UpTo
+	}
+	return &FeeSchedule{kind: TieredFeeKind, tiers: tiers}, nil
+}
+
+func (f FeeSchedule) Kind() string {
+	return f.kind
+}
+
+// BasisPoints returns the percentage of percentage fees in basis points.
+func (f FeeSchedule) BasisPoints() int64 {
+	return f.basisPoints
+}
+
+// Fixed returns the amount in cents of fixed fees.
+func (f FeeSchedule) Fixed() int64 {
+	return f.fixed
+}
+
+func (f FeeSchedule) Tiers() []FeeTier {
+	return f.tiers
+}
+
+// Fee returns the fee in cents charged on amount.
+func (f FeeSchedule) Fee(amount int64) int64 {
+	switch f.kind {
+	case PercentageFeeKind:
+		return (amount*f.basisPoints + wholeBasisPoints/2) / wholeBasisPoints
+	case FixedFeeKind:
+		return f.fixed
+	case TieredFeeKind:
+		for _, tier := range f.tiers {
+			if tier.UpTo == 0 || amount <= tier.UpTo {
+				return tier.Fee.Fee(amount)
+			}
+		}
+		return f.tiers[len(f.tiers)-1].Fee.Fee(amount)
+	default:
+		return 0
+	}
+}
+
+// FeePolicy holds the fee schedule of each receiver user type and the
+// schedules negotiated with specific merchants, which take precedence.
+// Receivers without a schedule are not charged fees.
+type FeePolicy struct {
+	userTypes map[UserType]FeeSchedule
+	merchants map[string]FeeSchedule
+}
+
+// NewFeePolicy creates a policy from the schedules of each user type value,
+// e.g. common or merchant, and of merchants keyed by their user id.
+func NewFeePolicy(userTypes map[string]FeeSchedule, merchants map[string]FeeSchedule) (*FeePolicy, error) {
+	policy := &FeePolicy{
+		userTypes: make(map[UserType]FeeSchedule, len(userTypes)),
+		merchants: merchants,
+	}
+	for value, schedule := range userTypes {
+		userType, err := NewUserType(value)
+		if err != nil {
+			return nil, err
+		}
+		policy.userTypes[*userType] = schedule
+	}
+	return policy, nil
+}
+
+// For returns the schedule charged on transfers received by the user.
+func (p FeePolicy) For(userID string, userType UserType) FeeSchedule {
+	if schedule, ok := p.merchants[userID]; ok {
+		return schedule
+	}
+	return p.userTypes[userType]
+}
@@ -0,0 +1,110 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeSchedule_Fee_ShouldRoundPercentagesHalfUp(t *testing.T) {
+	// Arrange
+	schedule, err := vo.NewPercentageFee(150)
+	require.NoError(t, err)
+
+	// Act & Assert
+	assert.Equal(t, int64(150), schedule.Fee(10000))
+	assert.Equal(t, int64(2), schedule.Fee(100))
+	assert.Equal(t, int64(1), schedule.Fee(99))
+	assert.Equal(t, int64(0), schedule.Fee(33))
+}
+
+func TestFeeSchedule_Fee_ShouldChargeFixedFeesWhateverTheAmount(t *testing.T) {
+	// Arrange
+	schedule, err := vo.NewFixedFee(75)
+	require.NoError(t, err)
+
+	// Act & Assert
+	assert.Equal(t, int64(75), schedule.Fee(1000))
+	assert.Equal(t, int64(75), schedule.Fee(1000000))
+}
+
+func TestFeeSchedule_Fee_ShouldChargeTheFeeOfTheTierTheAmountFallsIn(t *testing.T) {
+	// Arrange
+	fixed, err := vo.NewFixedFee(50)
+	require.NoError(t, err)
+	twoPercent, err := vo.NewPercentageFee(200)
+	require.NoError(t, err)
+	onePercent, err := vo.NewPercentageFee(100)
+	require.NoError(t, err)
+	schedule, err := vo.NewTieredFee([]vo.FeeTier{
+		{UpTo: 2500, Fee: *fixed},
+		{UpTo: 100000, Fee: *twoPercent},
+		{Fee: *onePercent},
+	})
+	require.NoError(t, err)
+
+	// Act & Assert
+	assert.Equal(t, int64(50), schedule.Fee(2500))
+	assert.Equal(t, int64(51), schedule.Fee(2550))
+	assert.Equal(t, int64(2000), schedule.Fee(100000))
+	assert.Equal(t, int64(1500), schedule.Fee(150000))
+}
+
+func TestFeeSchedule_Fee_ShouldChargeNothingForTheZeroValue(t *testing.T) {
+	// Act & Assert
+	assert.Equal(t, int64(0), vo.FeeSchedule{}.Fee(10000))
+}
+
+func TestNewFeeSchedule_ShouldRejectInvalidSchedules(t *testing.T) {
+	// Arrange
+	fixed, err := vo.NewFixedFee(50)
+	require.NoError(t, err)
+	tiered, err := vo.NewTieredFee([]vo.FeeTier{{Fee: *fixed}})
+	require.NoError(t, err)
+
+	for _, build := range []func() (*vo.FeeSchedule, error){
+		func() (*vo.FeeSchedule, error) { return vo.NewPercentageFee(-1) },
+		func() (*vo.FeeSchedule, error) { return vo.NewPercentageFee(10001) },
+		func() (*vo.FeeSchedule, error) { return vo.NewFixedFee(-1) },
+		func() (*vo.FeeSchedule, error) { return vo.NewTieredFee(nil) },
+		func() (*vo.FeeSchedule, error) { return vo.NewTieredFee([]vo.FeeTier{{Fee: *tiered}}) },
+		func() (*vo.FeeSchedule, error) {
+			return vo.NewTieredFee([]vo.FeeTier{{UpTo: 1000, Fee: *fixed}, {UpTo: 500, Fee: *fixed}})
+		},
+		func() (*vo.FeeSchedule, error) {
+			return vo.NewTieredFee([]vo.FeeTier{{Fee: *fixed}, {UpTo: 500, Fee: *fixed}})
+		},
+	} {
+		// Act
+		schedule, err := build()
+
+		// Assert
+		assert.Nil(t, schedule)
+		assert.ErrorIs(t, err, errs.ErrInvalidFeeSchedule)
+	}
+}
+
+func TestFeePolicy_For_ShouldPreferTheScheduleOfTheMerchant(t *testing.T) {
+	// Arrange
+	standard, err := vo.NewPercentageFee(199)
+	require.NoError(t, err)
+	negotiated, err := vo.NewPercentageFee(99)
+	require.NoError(t, err)
+	policy, err := vo.NewFeePolicy(
+		map[string]vo.FeeSchedule{vo.MerchantUserType: *standard},
+		map[string]vo.FeeSchedule{"merchant-1": *negotiated},
+	)
+	require.NoError(t, err)
+	merchant, err := vo.NewUserType(vo.MerchantUserType)
+	require.NoError(t, err)
+	common, err := vo.NewUserType(vo.CommonUserType)
+	require.NoError(t, err)
+
+	// Act & Assert
+	assert.Equal(t, int64(99), policy.For("merchant-1", *merchant).BasisPoints())
+	assert.Equal(t, int64(199), policy.For("merchant-2", *merchant).BasisPoints())
+	assert.Equal(t, "", policy.For("user-1", *common).Kind())
+}
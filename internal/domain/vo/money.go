@@ -1,37 +1,88 @@
 package vo
 
-import "github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+)
+
+var decimalAmountRegex = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// Money is an exact amount in cents of a currency.
 type Money struct {
-	value int64
+	value    int64
+	currency Currency
 }
 
-func NewMoney(value float64) (*Money, error) {
-	if value < 0 {
+// NewMoney creates a Money from an amount expressed in cents.
+func NewMoney(cents int64, currency string) (*Money, error) {
+	if cents < 0 {
 		return nil, errs.ErrZeroOrNegativeAmount
 	}
-	return &Money{value: int64(value * 100)}, nil
+	c, err := NewCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	return &Money{value: cents, currency: *c}, nil
+}
+
+// ParseMoney parses a decimal amount such as "10", "10.5" or "10.50" without
+// going through floating point. Amounts with more than two decimal places are
+// rejected instead of rounded.
+func ParseMoney(value, currency string) (*Money, error) {
+	if strings.HasPrefix(value, "-") {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	if !decimalAmountRegex.MatchString(value) {
+		return nil, errs.ErrInvalidAmountFormat
+	}
+
+	whole, fraction, _ := strings.Cut(value, ".")
+	if len(fraction) > 2 {
+		return nil, errs.ErrAmountPrecision
+	}
+
+	cents, _ := strconv.ParseInt(fraction+strings.Repeat("0", 2-len(fraction)), 10, 64)
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units > (math.MaxInt64-cents)/100 {
+		return nil, errs.ErrInvalidAmountFormat
+	}
+
+	return NewMoney(units*100+cents, currency)
 }
 
 func (m Money) Value() int64 {
 	return m.value
 }
 
-func (m Money) Subtract(amount float64) (*Money, error) {
-	other, err := NewMoney(amount)
-	if err != nil {
-		return nil, err
+func (m Money) Currency() string {
+	return m.currency.Code()
+}
+
+// String formats the amount as a decimal with two places, e.g. "10.50".
+func (m Money) String() string {
+	return fmt.Sprintf("%d.%02d", m.value/100, m.value%100)
+}
+
+// Subtract removes an amount in cents of the same currency.
+func (m Money) Subtract(cents int64) (*Money, error) {
+	if cents < 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
 	}
-	if m.value < other.value {
+	if m.value < cents {
 		return nil, errs.ErrInsufficientBalance
 	}
-	return &Money{value: m.value - other.value}, nil
+	return &Money{value: m.value - cents, currency: m.currency}, nil
 }
 
-func (m Money) Add(amount float64) (*Money, error) {
-	other, err := NewMoney(amount)
-	if err != nil {
-		return nil, err
+// Add adds an amount in cents of the same currency.
+func (m Money) Add(cents int64) (*Money, error) {
+	if cents < 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
 	}
-	return &Money{value: m.value + other.value}, nil
+	return &Money{value: m.value + cents, currency: m.currency}, nil
 }
@@ -10,45 +10,96 @@ import (
 
 func TestNewMoney_ShouldReturnErrorWhenAmountIsNegative(t *testing.T) {
 	// Arrange
-	negativeAmount := -1.0
+	negativeAmount := int64(-100)
 
 	// Act
-	money, err := vo.NewMoney(negativeAmount)
+	money, err := vo.NewMoney(negativeAmount, vo.BRL)
 
 	// Assert
 	assert.Nil(t, money)
 	assert.ErrorIs(t, err, errs.ErrZeroOrNegativeAmount)
 }
 
-func TestNewMoney_ShouldCorrectlyConvertPositiveAmountsToPennies(t *testing.T) {
+func TestNewMoney_ShouldKeepTheAmountInCents(t *testing.T) {
+	// Act
+	money, err := vo.NewMoney(10099, vo.BRL)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10099), money.Value())
+}
+
+func TestParseMoney_ShouldCorrectlyConvertPositiveAmountsToPennies(t *testing.T) {
 	// Arrange
 	testCases := []struct {
-		amount        float64
+		amount        string
 		expectedValue int64
 	}{
-		{amount: 0.0, expectedValue: 0},
-		{amount: 10.50, expectedValue: 1050},
-		{amount: 0.01, expectedValue: 1},
-		{amount: 123.45, expectedValue: 12345},
-		{amount: 999.99, expectedValue: 99999},
+		{amount: "0", expectedValue: 0},
+		{amount: "10.50", expectedValue: 1050},
+		{amount: "10.5", expectedValue: 1050},
+		{amount: "0.01", expectedValue: 1},
+		{amount: "0.29", expectedValue: 29},
+		{amount: "123.45", expectedValue: 12345},
+		{amount: "999.99", expectedValue: 99999},
+		{amount: "92233720368547758.07", expectedValue: 9223372036854775807},
 	}
 
 	for _, tc := range testCases {
 		// Act
-		money, err := vo.NewMoney(tc.amount)
+		money, err := vo.ParseMoney(tc.amount, vo.BRL)
 
 		// Assert
-		assert.NotNil(t, money)
-		assert.NoError(t, err)
-		assert.Equal(t, tc.expectedValue, money.Value())
+		assert.NoError(t, err, tc.amount)
+		if assert.NotNil(t, money, tc.amount) {
+			assert.Equal(t, tc.expectedValue, money.Value(), tc.amount)
+		}
 	}
 }
 
+func TestParseMoney_ShouldRejectAmountsWithMoreThanTwoDecimalPlaces(t *testing.T) {
+	for _, amount := range []string{"10.001", "0.125", "1.000"} {
+		// Act
+		money, err := vo.ParseMoney(amount, vo.BRL)
+
+		// Assert
+		assert.Nil(t, money, amount)
+		assert.ErrorIs(t, err, errs.ErrAmountPrecision, amount)
+	}
+}
+
+func TestParseMoney_ShouldRejectMalformedAmounts(t *testing.T) {
+	for _, amount := range []string{"", "abc", "1e2", ".5", "5.", "1,50", " 1", "92233720368547758.08", "99999999999999999999"} {
+		// Act
+		money, err := vo.ParseMoney(amount, vo.BRL)
+
+		// Assert
+		assert.Nil(t, money, amount)
+		assert.ErrorIs(t, err, errs.ErrInvalidAmountFormat, amount)
+	}
+}
+
+func TestParseMoney_ShouldReturnErrorWhenAmountIsNegative(t *testing.T) {
+	// Act
+	money, err := vo.ParseMoney("-1.00", vo.BRL)
+
+	// Assert
+	assert.Nil(t, money)
+	assert.ErrorIs(t, err, errs.ErrZeroOrNegativeAmount)
+}
+
+func TestMoney_String_ShouldFormatWithTwoDecimalPlaces(t *testing.T) {
+	// Arrange
+	money, _ := vo.NewMoney(1005, vo.BRL)
+
+	// Act & Assert
+	assert.Equal(t, "10.05", money.String())
+}
+
 func TestMoney_Subtract_ShouldReturnErrZeroOrNegativeAmountWhenSubtractingNegativeAmount(t *testing.T) {
 	// Arrange
-	initialAmount := 100.0
-	money, _ := vo.NewMoney(initialAmount)
-	negativeAmount := -20.0
+	money, _ := vo.NewMoney(10000, vo.BRL)
+	negativeAmount := int64(-2000)
 
 	// Act
 	result, err := money.Subtract(negativeAmount)
@@ -62,10 +113,9 @@ func TestMoney_Subtract_ShouldReturnErrZeroOrNegativeAmountWhenSubtractingNegati
 
 func TestMoney_Subtract_ShouldCorrectlySubtractSmallerAmountFromCurrentBalance(t *testing.T) {
 	// Arrange
-	initialAmount := 100.0
-	money, _ := vo.NewMoney(initialAmount)
-	amountToSubtract := 20.0
-	expectedValue := int64(8000) // 100.0 - 20.0 = 80.0, converted to pennies = 8000
+	money, _ := vo.NewMoney(10000, vo.BRL)
+	amountToSubtract := int64(2000)
+	expectedValue := int64(8000)
 
 	// Act
 	result, err := money.Subtract(amountToSubtract)
@@ -80,10 +130,9 @@ func TestMoney_Subtract_ShouldCorrectlySubtractSmallerAmountFromCurrentBalance(t
 
 func TestMoney_Add_ShouldCorrectlyAddPositiveAmountToCurrentBalance(t *testing.T) {
 	// Arrange
-	initialAmount := 100.0
-	money, _ := vo.NewMoney(initialAmount)
-	amountToAdd := 20.0
-	expectedValue := int64(12000) // 100.0 + 20.0 = 120.0, converted to pennies = 12000
+	money, _ := vo.NewMoney(10000, vo.BRL)
+	amountToAdd := int64(2000)
+	expectedValue := int64(12000)
 
 	// Act
 	result, err := money.Add(amountToAdd)
@@ -95,3 +144,34 @@ func TestMoney_Add_ShouldCorrectlyAddPositiveAmountToCurrentBalance(t *testing.T
 	// Verify original money object remains unchanged
 	assert.Equal(t, int64(10000), money.Value())
 }
+
+func TestNewMoney_ShouldKeepTheCurrency(t *testing.T) {
+	// Act
+	money, err := vo.NewMoney(1000, vo.USD)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, vo.USD, money.Currency())
+}
+
+func TestNewMoney_ShouldReturnErrorWhenCurrencyIsNotSupported(t *testing.T) {
+	// Act
+	money, err := vo.NewMoney(1000, "XYZ")
+
+	// Assert
+	assert.Nil(t, money)
+	assert.ErrorIs(t, err, errs.ErrUnsupportedCurrency)
+}
+
+func TestMoney_Add_ShouldKeepTheCurrency(t *testing.T) {
+	// Arrange
+	money, _ := vo.NewMoney(1000, vo.EUR)
+
+	// Act
+	result, err := money.Add(500)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, vo.EUR, result.Currency())
+	assert.Equal(t, int64(1500), result.Value())
+}
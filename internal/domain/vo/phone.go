@@ -0,0 +1,37 @@
+package vo
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidPhone = errors.New("invalid phone number")
+
+var (
+	phoneSeparatorsRegex = regexp.MustCompile(`[\s().-]`)
+	phoneRegex           = regexp.MustCompile(`^\+[1-9]\d{7,14}$`)
+)
+
+// Phone is a phone number in the international E.164 format, e.g.
+// +5511987654321. Brazilian numbers may be given without the country code.
+type Phone struct {
+	value string
+}
+
+func NewPhone(value string) (*Phone, error) {
+	value = phoneSeparatorsRegex.ReplaceAllString(strings.TrimSpace(value), "")
+	if !strings.HasPrefix(value, "+") && (len(value) == 10 || len(value) == 11) {
+		value = "+55" + value
+	}
+	if !phoneRegex.MatchString(value) {
+		return nil, ErrInvalidPhone
+	}
+	return &Phone{
+		value: value,
+	}, nil
+}
+
+func (p *Phone) GetValue() string {
+	return p.value
+}
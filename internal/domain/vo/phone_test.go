@@ -0,0 +1,40 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ValidPhones(t *testing.T) {
+	tests := map[string]string{
+		"+5511987654321":      "+5511987654321",
+		"+55 (11) 98765-4321": "+5511987654321",
+		"11987654321":         "+5511987654321",
+		"1133334444":          "+551133334444",
+		"+14155552671":        "+14155552671",
+	}
+
+	for test, expected := range tests {
+		phone, err := vo.NewPhone(test)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, phone.GetValue())
+	}
+}
+
+func Test_InvalidPhones(t *testing.T) {
+	tests := []string{
+		"",
+		"987654321",          // Neither international nor with an area code
+		"+0511987654321",     // Country codes do not start with 0
+		"+55119876543210000", // Too long
+		"+55 11 abcd-4321",   // Non-numeric
+	}
+
+	for _, test := range tests {
+		phone, err := vo.NewPhone(test)
+		assert.ErrorIs(t, err, vo.ErrInvalidPhone)
+		assert.Nil(t, phone)
+	}
+}
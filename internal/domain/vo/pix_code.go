@@ -0,0 +1,318 @@
+package vo
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+)
+
+// Fields of the EMV merchant-presented QR code used by PIX BR Codes, each one
+// written as a two digit id, a two digit length and the value.
+const (
+	pixPayloadFormatID       = "00"
+	pixInitiationMethodID    = "01"
+	pixMerchantAccountID     = "26"
+	pixMerchantCategoryID    = "52"
+	pixTransactionCurrencyID = "53"
+	pixTransactionAmountID   = "54"
+	pixCountryCodeID         = "58"
+	pixMerchantNameID        = "59"
+	pixMerchantCityID        = "60"
+	pixAdditionalDataID      = "62"
+	pixCRCID                 = "63"
+
+	// Fields nested in the merchant account information
+	pixGUIID         = "00"
+	pixKeyID         = "01"
+	pixDescriptionID = "02"
+	pixURLID         = "25"
+
+	// Field nested in the additional data
+	pixReferenceID = "05"
+)
+
+const (
+	pixPayloadFormat      = "01"
+	pixDynamicInitiation  = "12"
+	pixGUI                = "br.gov.bcb.pix"
+	pixMerchantCategory   = "0000"
+	pixCurrencyCode       = "986"
+	pixCountryCode        = "BR"
+	pixNoReference        = "***"
+	maxPixFieldLength     = 99
+	maxPixMerchantName    = 25
+	maxPixMerchantCity    = 15
+	maxPixTransactionSize = 13
+)
+
+var pixReferenceRegex = regexp.MustCompile(`^[A-Za-z0-9]{1,25}$`)
+
+// pixAccentReplacer spells out the accented letters of Portuguese names, as
+// BR Codes only carry plain ASCII.
+var pixAccentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "í", "i", "ó", "o",
+	"ô", "o", "õ", "o", "ö", "o", "ú", "u", "ü", "u", "ç", "c",
+	"Á", "A", "À", "A", "Â", "A", "Ã", "A", "Ä", "A",
+	"É", "E", "È", "E", "Ê", "E", "Í", "I", "Ó", "O",
+	"Ô", "O", "Õ", "O", "Ö", "O", "Ú", "U", "Ü", "U", "Ç", "C",
+)
+
+// PixCode is a PIX BR Code, the "copia e cola" payload behind PIX QR codes. A
+// static code carries the key of the receiver and can be paid many times,
+// while a dynamic code carries the URL of a single payment. Amounts are in
+// cents of BRL, zero letting the payer choose how much to pay.
+type PixCode struct {
+	key          string
+	url          string
+	description  string
+	amount       int64
+	merchantName string
+	merchantCity string
+	reference    string
+}
+
+// NewStaticPixCode creates a code paying the receiver identified by key. The
+// reference, at most 25 letters or digits, is optional.
+func NewStaticPixCode(key, merchantName, merchantCity string, amount int64, reference, description string) (*PixCode, error) {
+	if key == "" {
+		return nil, errs.ErrInvalidPixCode
+	}
+	if reference == "" {
+		reference = pixNoReference
+	}
+	if reference != pixNoReference && !pixReferenceRegex.MatchString(reference) {
+		return nil, errs.ErrInvalidPixReference
+	}
+	return newPixCode(&PixCode{
+		key:          key,
+		description:  pixText(description, maxPixFieldLength),
+		amount:       amount,
+		merchantName: pixText(merchantName, maxPixMerchantName),
+		merchantCity: pixText(merchantCity, maxPixMerchantCity),
+		reference:    reference,
+	})
+}
+
+// NewDynamicPixCode creates a code for the single payment found at url,
+// given without its scheme as BR Codes always use https.
+func NewDynamicPixCode(url, merchantName, merchantCity string, amount int64) (*PixCode, error) {
+	if url == "" {
+		return nil, errs.ErrInvalidPixCode
+	}
+	return newPixCode(&PixCode{
+		url:          url,
+		amount:       amount,
+		merchantName: pixText(merchantName, maxPixMerchantName),
+		merchantCity: pixText(merchantCity, maxPixMerchantCity),
+		reference:    pixNoReference,
+	})
+}
+
+func newPixCode(code *PixCode) (*PixCode, error) {
+	if code.amount < 0 {
+		return nil, errs.ErrZeroOrNegativeAmount
+	}
+	if code.merchantName == "" || code.merchantCity == "" {
+		return nil, errs.ErrInvalidPixCode
+	}
+	if utf8.RuneCountInString(code.merchantAccount()) > maxPixFieldLength || len(code.formattedAmount()) > maxPixTransactionSize {
+		return nil, errs.ErrPixCodeTooLong
+	}
+	return code, nil
+}
+
+// ParsePixCode reads a scanned BR Code, checking its structure and checksum.
+func ParsePixCode(payload string) (*PixCode, error) {
+	payload = strings.TrimSpace(payload)
+	crcStart := len(payload) - 4
+	if crcStart < 4 || payload[crcStart-4:crcStart] != pixCRCID+"04" {
+		return nil, errs.ErrInvalidPixCode
+	}
+	if !strings.EqualFold(payload[crcStart:], crc16(payload[:crcStart])) {
+		return nil, errs.ErrPixCodeChecksumMismatch
+	}
+
+	fields, err := parseEMVFields(payload)
+	if err != nil {
+		return nil, err
+	}
+	if fields[pixPayloadFormatID] != pixPayloadFormat {
+		return nil, errs.ErrInvalidPixCode
+	}
+	if currency, ok := fields[pixTransactionCurrencyID]; ok && currency != pixCurrencyCode {
+		return nil, errs.ErrPixCurrencyNotSupported
+	}
+
+	account, err := parseEMVFields(fields[pixMerchantAccountID])
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(account[pixGUIID], pixGUI) {
+		return nil, errs.ErrInvalidPixCode
+	}
+	code := &PixCode{
+		key:          account[pixKeyID],
+		url:          account[pixURLID],
+		description:  account[pixDescriptionID],
+		merchantName: fields[pixMerchantNameID],
+		merchantCity: fields[pixMerchantCityID],
+		reference:    pixNoReference,
+	}
+	if (code.key == "") == (code.url == "") || code.merchantName == "" || code.merchantCity == "" {
+		return nil, errs.ErrInvalidPixCode
+	}
+
+	if amount, ok := fields[pixTransactionAmountID]; ok {
+		money, err := ParseMoney(amount, BRL)
+		if err != nil {
+			return nil, errs.ErrInvalidPixCode
+		}
+		code.amount = money.Value()
+	}
+
+	additionalData, err := parseEMVFields(fields[pixAdditionalDataID])
+	if err != nil {
+		return nil, err
+	}
+	if reference := additionalData[pixReferenceID]; reference != "" {
+		code.reference = reference
+	}
+	return code, nil
+}
+
+// Key returns the PIX key of the receiver of a static code.
+func (p *PixCode) Key() string {
+	return p.key
+}
+
+// URL returns where the payment of a dynamic code is found.
+func (p *PixCode) URL() string {
+	return p.url
+}
+
+func (p *PixCode) IsDynamic() bool {
+	return p.url != ""
+}
+
+func (p *PixCode) Description() string {
+	return p.description
+}
+
+// Amount returns the amount in cents of BRL to pay, zero when the payer
+// chooses it.
+func (p *PixCode) Amount() int64 {
+	return p.amount
+}
+
+func (p *PixCode) MerchantName() string {
+	return p.merchantName
+}
+
+func (p *PixCode) MerchantCity() string {
+	return p.merchantCity
+}
+
+// Reference returns the identifier the receiver gave the payment, or an
+// empty string.
+func (p *PixCode) Reference() string {
+	if p.reference == pixNoReference {
+		return ""
+	}
+	return p.reference
+}
+
+// Payload returns the "copia e cola" text of the code, ending with its
+// CRC16 checksum.
+func (p *PixCode) Payload() string {
+	var payload strings.Builder
+	payload.WriteString(emvField(pixPayloadFormatID, pixPayloadFormat))
+	if p.IsDynamic() {
+		payload.WriteString(emvField(pixInitiationMethodID, pixDynamicInitiation))
+	}
+	payload.WriteString(emvField(pixMerchantAccountID, p.merchantAccount()))
+	payload.WriteString(emvField(pixMerchantCategoryID, pixMerchantCategory))
+	payload.WriteString(emvField(pixTransactionCurrencyID, pixCurrencyCode))
+	if p.amount > 0 {
+		payload.WriteString(emvField(pixTransactionAmountID, p.formattedAmount()))
+	}
+	payload.WriteString(emvField(pixCountryCodeID, pixCountryCode))
+	payload.WriteString(emvField(pixMerchantNameID, p.merchantName))
+	payload.WriteString(emvField(pixMerchantCityID, p.merchantCity))
+	payload.WriteString(emvField(pixAdditionalDataID, emvField(pixReferenceID, p.reference)))
+	payload.WriteString(pixCRCID + "04")
+	return payload.String() + crc16(payload.String())
+}
+
+func (p *PixCode) merchantAccount() string {
+	account := emvField(pixGUIID, pixGUI)
+	if p.IsDynamic() {
+		return account + emvField(pixURLID, p.url)
+	}
+	account += emvField(pixKeyID, p.key)
+	if p.description != "" {
+		account += emvField(pixDescriptionID, p.description)
+	}
+	return account
+}
+
+func (p *PixCode) formattedAmount() string {
+	return fmt.Sprintf("%d.%02d", p.amount/100, p.amount%100)
+}
+
+func emvField(id, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, utf8.RuneCountInString(value), value)
+}
+
+// parseEMVFields splits data into its fields by id. Lengths count characters,
+// not bytes, as some issuers do not strip accents.
+func parseEMVFields(data string) (map[string]string, error) {
+	fields := map[string]string{}
+	runes := []rune(data)
+	for i := 0; i < len(runes); {
+		if i+4 > len(runes) {
+			return nil, errs.ErrInvalidPixCode
+		}
+		length, err := strconv.Atoi(string(runes[i+2 : i+4]))
+		if err != nil || i+4+length > len(runes) {
+			return nil, errs.ErrInvalidPixCode
+		}
+		fields[string(runes[i:i+2])] = string(runes[i+4 : i+4+length])
+		i += 4 + length
+	}
+	return fields, nil
+}
+
+// pixText strips a free text down to the printable ASCII BR Codes carry, at
+// most limit characters long.
+func pixText(value string, limit int) string {
+	value = pixAccentReplacer.Replace(strings.TrimSpace(value))
+	text := make([]byte, 0, len(value))
+	for i := 0; i < len(value) && len(text) < limit; i++ {
+		if value[i] >= ' ' && value[i] <= '~' {
+			text = append(text, value[i])
+		}
+	}
+	return strings.TrimSpace(string(text))
+}
+
+// crc16 is the CRC-16/CCITT-FALSE checksum of BR Codes, as four uppercase
+// hexadecimal digits.
+func crc16(data string) string {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(data); i++ {
+		crc ^= uint16(data[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return fmt.Sprintf("%04X", crc)
+}
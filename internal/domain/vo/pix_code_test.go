@@ -0,0 +1,136 @@
+package vo_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticPixPayload is the static code example of the BR Code manual, for the
+// key 123e4567-e12b-12d1-a456-426655440000.
+const staticPixPayload = "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D"
+
+func Test_NewStaticPixCode_ShouldMatchTheReferencePayload(t *testing.T) {
+	code, err := vo.NewStaticPixCode("123e4567-e12b-12d1-a456-426655440000", "Fulano de Tal", "BRASILIA", 0, "", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, staticPixPayload, code.Payload())
+	assert.False(t, code.IsDynamic())
+}
+
+func Test_NewStaticPixCode_ShouldStripAccentsAndTruncateNames(t *testing.T) {
+	code, err := vo.NewStaticPixCode("key", "Padaria São João das Árvores Ltda", "São José dos Campos", 1050, "PEDIDO42", "Pão de queijo")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Padaria Sao Joao das Arvo", code.MerchantName())
+	assert.Equal(t, "Sao Jose dos Ca", code.MerchantCity())
+	assert.Equal(t, "Pao de queijo", code.Description())
+	assert.Contains(t, code.Payload(), "540510.50")
+	assert.Contains(t, code.Payload(), "0508PEDIDO42")
+}
+
+func Test_NewStaticPixCode_ShouldRejectInvalidInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		reference string
+		amount    int64
+		err       error
+	}{
+		{"missing key", "", "", 0, errs.ErrInvalidPixCode},
+		{"reference with symbols", "key", "order-42", 0, errs.ErrInvalidPixReference},
+		{"reference too long", "key", strings.Repeat("A", 26), 0, errs.ErrInvalidPixReference},
+		{"negative amount", "key", "", -1, errs.ErrZeroOrNegativeAmount},
+		{"key too long", strings.Repeat("k", 80), "", 0, errs.ErrPixCodeTooLong},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			code, err := vo.NewStaticPixCode(test.key, "Fulano de Tal", "BRASILIA", test.amount, test.reference, "")
+
+			assert.ErrorIs(t, err, test.err)
+			assert.Nil(t, code)
+		})
+	}
+}
+
+func Test_NewDynamicPixCode_ShouldRoundTrip(t *testing.T) {
+	code, err := vo.NewDynamicPixCode("pix.example.com/v1/charges/42", "Loja", "SAO PAULO", 9990)
+	require.Nil(t, err)
+
+	parsed, err := vo.ParsePixCode(code.Payload())
+
+	assert.Nil(t, err)
+	assert.True(t, parsed.IsDynamic())
+	assert.Equal(t, "pix.example.com/v1/charges/42", parsed.URL())
+	assert.Equal(t, int64(9990), parsed.Amount())
+	assert.Equal(t, "Loja", parsed.MerchantName())
+	assert.Equal(t, "SAO PAULO", parsed.MerchantCity())
+	assert.Equal(t, "", parsed.Reference())
+	assert.True(t, strings.HasPrefix(code.Payload(), "000201010212"))
+}
+
+func Test_ParsePixCode_ShouldReadStaticCode(t *testing.T) {
+	code, err := vo.ParsePixCode(staticPixPayload)
+
+	assert.Nil(t, err)
+	assert.False(t, code.IsDynamic())
+	assert.Equal(t, "123e4567-e12b-12d1-a456-426655440000", code.Key())
+	assert.Equal(t, int64(0), code.Amount())
+	assert.Equal(t, "Fulano de Tal", code.MerchantName())
+	assert.Equal(t, "BRASILIA", code.MerchantCity())
+	assert.Equal(t, "", code.Reference())
+}
+
+func Test_ParsePixCode_ShouldAcceptLowercaseChecksum(t *testing.T) {
+	code, err := vo.ParsePixCode(strings.TrimSuffix(staticPixPayload, "1D3D") + "1d3d")
+
+	assert.Nil(t, err)
+	assert.NotNil(t, code)
+}
+
+func Test_ParsePixCode_ShouldRejectInvalidPayloads(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		err     error
+	}{
+		{"empty", "", errs.ErrInvalidPixCode},
+		{"without checksum", strings.TrimSuffix(staticPixPayload, "63041D3D"), errs.ErrInvalidPixCode},
+		{"wrong checksum", strings.TrimSuffix(staticPixPayload, "1D3D") + "0000", errs.ErrPixCodeChecksumMismatch},
+		{"tampered", strings.Replace(staticPixPayload, "Fulano", "Fulana", 1), errs.ErrPixCodeChecksumMismatch},
+		{"other currency", withPixChecksum(strings.Replace(staticPixPayload[:len(staticPixPayload)-4], "5303986", "5303840", 1)), errs.ErrPixCurrencyNotSupported},
+		{"truncated field", withPixChecksum("0002010126990014br.gov.bcb.pix6304"), errs.ErrInvalidPixCode},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			code, err := vo.ParsePixCode(test.payload)
+
+			assert.ErrorIs(t, err, test.err)
+			assert.Nil(t, code)
+		})
+	}
+}
+
+// withPixChecksum appends the CRC16 of payload, which must end with the
+// "6304" checksum field header.
+func withPixChecksum(payload string) string {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(payload); i++ {
+		crc ^= uint16(payload[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return payload + fmt.Sprintf("%04X", crc)
+}
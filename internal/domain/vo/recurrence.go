@@ -0,0 +1,221 @@
+package vo
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+)
+
+// Frequencies a recurrence can have.
+const (
+	WeeklyFrequency  = "weekly"
+	MonthlyFrequency = "monthly"
+	CronFrequency    = "cron"
+)
+
+// cronSearchLimit bounds the search for the next occurrence of a cron rule,
+// so rules that never match, e.g. February 30th, end.
+const cronSearchLimit = 5
+
+// Recurrence is how often something repeats: every week, every month or
+// following a cron rule.
+type Recurrence struct {
+	frequency  string
+	expression string
+	cron       *cronSchedule
+}
+
+// NewRecurrence creates a recurrence. The expression is the cron rule of the
+// cron frequency and must be empty for the other ones.
+func NewRecurrence(frequency, expression string) (*Recurrence, error) {
+	switch frequency {
+	case WeeklyFrequency, MonthlyFrequency:
+		if expression != "" {
+			return nil, errs.ErrInvalidCronExpression
+		}
+		return &Recurrence{frequency: frequency}, nil
+	case CronFrequency:
+		cron, err := parseCron(expression)
+		if err != nil {
+			return nil, err
+		}
+		return &Recurrence{frequency: frequency, expression: expression, cron: cron}, nil
+	}
+	return nil, errs.ErrInvalidRecurrenceFrequency
+}
+
+func (r Recurrence) Frequency() string {
+	return r.frequency
+}
+
+// Expression returns the cron rule, or an empty string.
+func (r Recurrence) Expression() string {
+	return r.expression
+}
+
+// Next returns the first occurrence not before anchor and strictly after
+// after. Weekly occurrences fall on the weekday and time of anchor, monthly
+// ones on its day of the month and time, on the last day of shorter months.
+// Cron rules are evaluated in the location of anchor, at minute precision. A
+// zero time means there is no such occurrence.
+func (r Recurrence) Next(anchor, after time.Time) time.Time {
+	switch r.frequency {
+	case WeeklyFrequency:
+		if after.Before(anchor) {
+			return anchor
+		}
+		week := 7 * 24 * time.Hour
+		weeks := after.Sub(anchor)/week + 1
+		return anchor.Add(weeks * week)
+	case MonthlyFrequency:
+		if after.Before(anchor) {
+			return anchor
+		}
+		months := (after.Year()-anchor.Year())*12 + int(after.Month()-anchor.Month())
+		next := addMonthsClamped(anchor, months)
+		if !next.After(after) {
+			next = addMonthsClamped(anchor, months+1)
+		}
+		return next
+	case CronFrequency:
+		if after.Before(anchor) {
+			after = anchor.Add(-time.Nanosecond)
+		}
+		return r.cron.next(after.In(anchor.Location()))
+	}
+	return time.Time{}
+}
+
+// addMonthsClamped adds months to t keeping its day of the month, or the last
+// day of the month when it is shorter.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// cronSchedule is a parsed five field cron rule: minute, hour, day of month,
+// month and day of week. Each field keeps the values it matches as a bit set.
+type cronSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	// When both days are restricted a day matching either of them matches,
+	// as in the standard cron.
+	anyDayOfMonth, anyDayOfWeek bool
+}
+
+func parseCron(expression string) (*cronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, errs.ErrInvalidCronExpression
+	}
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	var sets [5]uint64
+	for i, field := range fields {
+		set, err := parseCronField(field, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return nil, err
+		}
+		sets[i] = set
+	}
+	dayOfWeek := sets[4]
+	if dayOfWeek&(1<<7) != 0 {
+		// 7 is also Sunday
+		dayOfWeek = dayOfWeek&^(1<<7) | 1
+	}
+	return &cronSchedule{
+		minute:        sets[0],
+		hour:          sets[1],
+		dayOfMonth:    sets[2],
+		month:         sets[3],
+		dayOfWeek:     dayOfWeek,
+		anyDayOfMonth: fields[2] == "*",
+		anyDayOfWeek:  fields[4] == "*",
+	}, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges and steps
+// such as "*", "5", "1-5", "*/15" or "0-30/10".
+func parseCronField(field string, min, max int) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, errs.ErrInvalidCronExpression
+			}
+		}
+
+		start, end := min, max
+		if rangePart != "*" {
+			from, to, isRange := strings.Cut(rangePart, "-")
+			var err error
+			start, err = strconv.Atoi(from)
+			if err != nil {
+				return 0, errs.ErrInvalidCronExpression
+			}
+			end = start
+			if isRange {
+				end, err = strconv.Atoi(to)
+				if err != nil {
+					return 0, errs.ErrInvalidCronExpression
+				}
+			} else if hasStep {
+				end = max
+			}
+		}
+		if start < min || end > max || start > end {
+			return 0, errs.ErrInvalidCronExpression
+		}
+
+		for value := start; value <= end; value += step {
+			set |= 1 << value
+		}
+	}
+	return set, nil
+}
+
+func (c *cronSchedule) matchesDay(t time.Time) bool {
+	dayOfMonth := c.dayOfMonth&(1<<t.Day()) != 0
+	dayOfWeek := c.dayOfWeek&(1<<int(t.Weekday())) != 0
+	if c.anyDayOfMonth || c.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// next returns the first minute strictly after after matching the rule,
+// skipping whole months, days and hours that cannot match.
+func (c *cronSchedule) next(after time.Time) time.Time {
+	location := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(cronSearchLimit, 0, 0)
+	for t.Before(limit) {
+		if c.month&(1<<int(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, location)
+			continue
+		}
+		if !c.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, location)
+			continue
+		}
+		if c.hour&(1<<t.Hour()) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, location)
+			continue
+		}
+		if c.minute&(1<<t.Minute()) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
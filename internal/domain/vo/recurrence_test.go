@@ -0,0 +1,108 @@
+package vo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecurrence_ShouldRejectInvalidRules(t *testing.T) {
+	tests := []struct {
+		frequency  string
+		expression string
+		err        error
+	}{
+		{"daily", "", errs.ErrInvalidRecurrenceFrequency},
+		{vo.WeeklyFrequency, "0 9 * * 1", errs.ErrInvalidCronExpression},
+		{vo.CronFrequency, "", errs.ErrInvalidCronExpression},
+		{vo.CronFrequency, "0 9 * *", errs.ErrInvalidCronExpression},
+		{vo.CronFrequency, "60 9 * * *", errs.ErrInvalidCronExpression},
+		{vo.CronFrequency, "0 9 0 * *", errs.ErrInvalidCronExpression},
+		{vo.CronFrequency, "0 9 * * 1-", errs.ErrInvalidCronExpression},
+		{vo.CronFrequency, "*/0 9 * * *", errs.ErrInvalidCronExpression},
+		{vo.CronFrequency, "0 9 5-1 * *", errs.ErrInvalidCronExpression},
+	}
+	for _, tt := range tests {
+		// Act
+		recurrence, err := vo.NewRecurrence(tt.frequency, tt.expression)
+
+		// Assert
+		assert.Nil(t, recurrence, tt.expression)
+		assert.ErrorIs(t, err, tt.err, tt.expression)
+	}
+}
+
+func TestRecurrence_Next_ShouldRepeatWeeklyOnTheAnchorWeekday(t *testing.T) {
+	// Arrange
+	recurrence, err := vo.NewRecurrence(vo.WeeklyFrequency, "")
+	require.NoError(t, err)
+	anchor := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC) // Monday
+
+	// Act & Assert
+	assert.Equal(t, anchor, recurrence.Next(anchor, anchor.Add(-time.Hour)))
+	assert.Equal(t, anchor.AddDate(0, 0, 7), recurrence.Next(anchor, anchor))
+	assert.Equal(t, anchor.AddDate(0, 0, 21), recurrence.Next(anchor, time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestRecurrence_Next_ShouldRepeatMonthlyOnTheLastDayOfShorterMonths(t *testing.T) {
+	// Arrange
+	recurrence, err := vo.NewRecurrence(vo.MonthlyFrequency, "")
+	require.NoError(t, err)
+	anchor := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	// Act
+	february := recurrence.Next(anchor, anchor)
+	march := recurrence.Next(anchor, february)
+
+	// Assert
+	assert.Equal(t, time.Date(2026, 2, 28, 9, 0, 0, 0, time.UTC), february)
+	assert.Equal(t, time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC), march)
+}
+
+func TestRecurrence_Next_ShouldFollowTheCronRule(t *testing.T) {
+	tests := []struct {
+		expression string
+		after      time.Time
+		expected   time.Time
+	}{
+		// Weekdays at 09:30
+		{"30 9 * * 1-5", time.Date(2026, 3, 6, 10, 0, 0, 0, time.UTC), time.Date(2026, 3, 9, 9, 30, 0, 0, time.UTC)},
+		// Every 15 minutes
+		{"*/15 * * * *", time.Date(2026, 3, 6, 10, 7, 12, 0, time.UTC), time.Date(2026, 3, 6, 10, 15, 0, 0, time.UTC)},
+		// 1st and 15th of the month at midnight
+		{"0 0 1,15 * *", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		// Day of month or Sunday, as both are restricted
+		{"0 12 10 * 7", time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)},
+		// Never
+		{"0 0 30 2 *", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}},
+	}
+	for _, tt := range tests {
+		// Arrange
+		recurrence, err := vo.NewRecurrence(vo.CronFrequency, tt.expression)
+		require.NoError(t, err, tt.expression)
+		anchor := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		// Act
+		next := recurrence.Next(anchor, tt.after)
+
+		// Assert
+		assert.Equal(t, tt.expected, next, tt.expression)
+	}
+}
+
+func TestRecurrence_Next_CronShouldNotOccurBeforeTheAnchor(t *testing.T) {
+	// Arrange
+	recurrence, err := vo.NewRecurrence(vo.CronFrequency, "0 9 * * *")
+	require.NoError(t, err)
+	anchor := time.Date(2026, 3, 6, 9, 0, 0, 0, time.UTC)
+
+	// Act
+	next := recurrence.Next(anchor, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// Assert
+	assert.Equal(t, anchor, next)
+}
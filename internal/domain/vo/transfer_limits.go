@@ -0,0 +1,205 @@
+package vo
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+)
+
+// Periods transfer limits are applied over.
+const (
+	PerTransactionLimitPeriod = "transaction"
+	DailyLimitPeriod          = "daily"
+	WeeklyLimitPeriod         = "weekly"
+	MonthlyLimitPeriod        = "monthly"
+)
+
+// TransferLimits caps the amounts in cents a user can send in a single
+// transfer and over the current day, week and month. A zero limit means
+// there is no limit.
+type TransferLimits struct {
+	perTransaction int64
+	daily          int64
+	weekly         int64
+	monthly        int64
+}
+
+func NewTransferLimits(perTransaction, daily, weekly, monthly int64) (*TransferLimits, error) {
+	if perTransaction < 0 || daily < 0 || weekly < 0 || monthly < 0 {
+		return nil, errs.ErrInvalidTransferLimit
+	}
+	return &TransferLimits{
+		perTransaction: perTransaction,
+		daily:          daily,
+		weekly:         weekly,
+		monthly:        monthly,
+	}, nil
+}
+
+func (l TransferLimits) PerTransaction() int64 {
+	return l.perTransaction
+}
+
+func (l TransferLimits) Daily() int64 {
+	return l.daily
+}
+
+func (l TransferLimits) Weekly() int64 {
+	return l.weekly
+}
+
+func (l TransferLimits) Monthly() int64 {
+	return l.monthly
+}
+
+// Check returns a *errs.TransferLimitExceededError when sending amount on
+// top of what was already sent goes over any of the limits. The error carries
+// the tightest limit, so its remaining allowance is what can still be sent.
+func (l TransferLimits) Check(amount int64, sent TransferUsage) error {
+	var exceeded *errs.TransferLimitExceededError
+	tighten := func(period string, limit, used int64) {
+		if limit == 0 {
+			return
+		}
+		remaining := max(limit-used, 0)
+		if exceeded == nil || remaining < exceeded.Remaining {
+			exceeded = &errs.TransferLimitExceededError{Period: period, Limit: limit, Remaining: remaining}
+		}
+	}
+	tighten(PerTransactionLimitPeriod, l.perTransaction, 0)
+	tighten(DailyLimitPeriod, l.daily, sent.Daily)
+	tighten(WeeklyLimitPeriod, l.weekly, sent.Weekly)
+	tighten(MonthlyLimitPeriod, l.monthly, sent.Monthly)
+
+	if exceeded == nil || amount <= exceeded.Remaining {
+		return nil
+	}
+	return exceeded
+}
+
+// TransferUsage is how many cents a user already sent in the current day,
+// week and month.
+type TransferUsage struct {
+	Daily   int64
+	Weekly  int64
+	Monthly int64
+}
+
+// Add returns the usage after sending amount more in the current periods.
+func (u TransferUsage) Add(amount int64) TransferUsage {
+	return TransferUsage{
+		Daily:   u.Daily + amount,
+		Weekly:  u.Weekly + amount,
+		Monthly: u.Monthly + amount,
+	}
+}
+
+// LimitPeriodStart returns when the daily, weekly or monthly period containing
+// now started, in UTC. Weeks start on Mondays.
+func LimitPeriodStart(period string, now time.Time) time.Time {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch period {
+	case WeeklyLimitPeriod:
+		daysSinceMonday := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -daysSinceMonday)
+	case MonthlyLimitPeriod:
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// TransferLimitPolicy holds the transfer limits of each user type. User types
+// without limits can send any amount. Limits are in the default currency, and
+// what is sent in other currencies counts at its value in it.
+type TransferLimitPolicy struct {
+	limits map[UserType]TransferLimits
+	rates  map[string]ExchangeRate
+}
+
+// NewTransferLimitPolicy creates a policy from the limits of each user type
+// value, e.g. common or merchant, and the rates converting other currencies
+// into the default one.
+func NewTransferLimitPolicy(limits map[string]TransferLimits, rates ...*ExchangeRate) (*TransferLimitPolicy, error) {
+	policy := &TransferLimitPolicy{
+		limits: make(map[UserType]TransferLimits, len(limits)),
+		rates:  make(map[string]ExchangeRate, len(rates)),
+	}
+	for value, userLimits := range limits {
+		userType, err := NewUserType(value)
+		if err != nil {
+			return nil, err
+		}
+		policy.limits[*userType] = userLimits
+	}
+	for _, rate := range rates {
+		if rate.To() != DefaultCurrency {
+			return nil, errs.ErrCurrencyMismatch
+		}
+		policy.rates[rate.From()] = *rate
+	}
+	return policy, nil
+}
+
+// For returns the limits of the user type.
+func (p TransferLimitPolicy) For(userType UserType) TransferLimits {
+	return p.limits[userType]
+}
+
+// Check checks that a user of the type can send amount cents of the currency
+// on top of what it already sent in each currency. Everything is valued in the
+// default currency first, so spreading transfers across currencies does not
+// raise the limits.
+func (p TransferLimitPolicy) Check(userType UserType, currency string, amount int64, sent map[string]TransferUsage) error {
+	limits := p.For(userType)
+	if limits == (TransferLimits{}) {
+		return nil
+	}
+	amount, err := p.inDefaultCurrency(currency, amount)
+	if err != nil {
+		return err
+	}
+	var total TransferUsage
+	for sentCurrency, usage := range sent {
+		daily, err := p.inDefaultCurrency(sentCurrency, usage.Daily)
+		if err != nil {
+			return err
+		}
+		weekly, err := p.inDefaultCurrency(sentCurrency, usage.Weekly)
+		if err != nil {
+			return err
+		}
+		monthly, err := p.inDefaultCurrency(sentCurrency, usage.Monthly)
+		if err != nil {
+			return err
+		}
+		total = TransferUsage{
+			Daily:   total.Daily + daily,
+			Weekly:  total.Weekly + weekly,
+			Monthly: total.Monthly + monthly,
+		}
+	}
+	return limits.Check(amount, total)
+}
+
+// inDefaultCurrency converts cents of the currency into cents of the default
+// currency.
+func (p TransferLimitPolicy) inDefaultCurrency(currency string, cents int64) (int64, error) {
+	if currency == DefaultCurrency || cents == 0 {
+		return cents, nil
+	}
+	rate, ok := p.rates[currency]
+	if !ok {
+		return 0, errs.ErrExchangeRateNotFound
+	}
+	amount, err := NewMoney(cents, currency)
+	if err != nil {
+		return 0, err
+	}
+	converted, err := rate.Convert(amount)
+	if err != nil {
+		return 0, err
+	}
+	return converted.Value(), nil
+}
@@ -0,0 +1,169 @@
+package vo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransferLimits_ShouldReturnErrorWhenALimitIsNegative(t *testing.T) {
+	// Act
+	limits, err := vo.NewTransferLimits(1000, -1, 0, 0)
+
+	// Assert
+	assert.Nil(t, limits)
+	assert.ErrorIs(t, err, errs.ErrInvalidTransferLimit)
+}
+
+func TestTransferLimits_Check_ShouldAllowAmountsWithinEveryLimit(t *testing.T) {
+	// Arrange
+	limits, err := vo.NewTransferLimits(5000, 10000, 20000, 50000)
+	require.NoError(t, err)
+
+	// Act
+	err = limits.Check(5000, vo.TransferUsage{Daily: 5000, Weekly: 15000, Monthly: 45000})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestTransferLimits_Check_ShouldReturnPerTransactionLimitWhenAmountIsAboveIt(t *testing.T) {
+	// Arrange
+	limits, err := vo.NewTransferLimits(5000, 10000, 0, 0)
+	require.NoError(t, err)
+
+	// Act
+	err = limits.Check(5001, vo.TransferUsage{})
+
+	// Assert
+	var limitErr *errs.TransferLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.ErrorIs(t, err, errs.ErrTransferLimitExceeded)
+	assert.Equal(t, vo.PerTransactionLimitPeriod, limitErr.Period)
+	assert.Equal(t, int64(5000), limitErr.Remaining)
+}
+
+func TestTransferLimits_Check_ShouldReturnTheTightestRemainingAllowance(t *testing.T) {
+	// Arrange
+	limits, err := vo.NewTransferLimits(0, 10000, 20000, 50000)
+	require.NoError(t, err)
+
+	// Act
+	err = limits.Check(3000, vo.TransferUsage{Daily: 8000, Weekly: 19000, Monthly: 52000})
+
+	// Assert
+	var limitErr *errs.TransferLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, vo.MonthlyLimitPeriod, limitErr.Period)
+	assert.Equal(t, int64(50000), limitErr.Limit)
+	assert.Equal(t, int64(0), limitErr.Remaining)
+}
+
+func TestTransferLimits_Check_ShouldNotLimitWhenLimitsAreZero(t *testing.T) {
+	// Arrange
+	limits, err := vo.NewTransferLimits(0, 0, 0, 0)
+	require.NoError(t, err)
+
+	// Act
+	err = limits.Check(1_000_000_00, vo.TransferUsage{Daily: 1_000_000_00})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestLimitPeriodStart_ShouldStartPeriodsAtMidnightUTC(t *testing.T) {
+	// Arrange, a Wednesday
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC)
+
+	// Act
+	day := vo.LimitPeriodStart(vo.DailyLimitPeriod, now)
+	week := vo.LimitPeriodStart(vo.WeeklyLimitPeriod, now)
+	month := vo.LimitPeriodStart(vo.MonthlyLimitPeriod, now)
+
+	// Assert
+	assert.Equal(t, time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC), day)
+	assert.Equal(t, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), week)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), month)
+}
+
+func TestTransferLimitPolicy_For_ShouldReturnTheLimitsOfTheUserType(t *testing.T) {
+	// Arrange
+	commonLimits, err := vo.NewTransferLimits(5000, 0, 0, 0)
+	require.NoError(t, err)
+	policy, err := vo.NewTransferLimitPolicy(map[string]vo.TransferLimits{vo.CommonUserType: *commonLimits})
+	require.NoError(t, err)
+	common, err := vo.NewUserType(vo.CommonUserType)
+	require.NoError(t, err)
+	merchant, err := vo.NewUserType(vo.MerchantUserType)
+	require.NoError(t, err)
+
+	// Act
+	commonPerTransaction := policy.For(*common).PerTransaction()
+	merchantPerTransaction := policy.For(*merchant).PerTransaction()
+
+	// Assert
+	assert.Equal(t, int64(5000), commonPerTransaction)
+	assert.Equal(t, int64(0), merchantPerTransaction)
+}
+
+func TestNewTransferLimitPolicy_ShouldReturnErrorForUnknownUserTypes(t *testing.T) {
+	// Act
+	policy, err := vo.NewTransferLimitPolicy(map[string]vo.TransferLimits{"admin": {}})
+
+	// Assert
+	assert.Nil(t, policy)
+	assert.Error(t, err)
+}
+
+func TestTransferLimitPolicy_Check_ShouldValueOtherCurrenciesInTheDefaultCurrency(t *testing.T) {
+	// Arrange
+	commonLimits, err := vo.NewTransferLimits(10000, 0, 0, 0)
+	require.NoError(t, err)
+	usdRate, err := vo.NewExchangeRate(vo.USD, vo.BRL, "5")
+	require.NoError(t, err)
+	policy, err := vo.NewTransferLimitPolicy(map[string]vo.TransferLimits{vo.CommonUserType: *commonLimits}, usdRate)
+	require.NoError(t, err)
+	common, err := vo.NewUserType(vo.CommonUserType)
+	require.NoError(t, err)
+
+	// Act
+	withinErr := policy.Check(*common, vo.USD, 2000, nil)
+	overErr := policy.Check(*common, vo.USD, 2001, nil)
+	noRateErr := policy.Check(*common, vo.EUR, 100, nil)
+
+	// Assert
+	assert.NoError(t, withinErr)
+	assert.ErrorIs(t, overErr, errs.ErrTransferLimitExceeded)
+	assert.ErrorIs(t, noRateErr, errs.ErrExchangeRateNotFound)
+}
+
+func TestTransferLimitPolicy_Check_ShouldNotNeedRatesForUserTypesWithoutLimits(t *testing.T) {
+	// Arrange
+	policy, err := vo.NewTransferLimitPolicy(nil)
+	require.NoError(t, err)
+	merchant, err := vo.NewUserType(vo.MerchantUserType)
+	require.NoError(t, err)
+
+	// Act
+	err = policy.Check(*merchant, vo.EUR, 1_000_000_00, map[string]vo.TransferUsage{vo.USD: {Daily: 1_000_000_00}})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestNewTransferLimitPolicy_ShouldReturnErrorForRatesIntoOtherCurrencies(t *testing.T) {
+	// Arrange
+	rate, err := vo.NewExchangeRate(vo.EUR, vo.USD, "1.1")
+	require.NoError(t, err)
+
+	// Act
+	policy, err := vo.NewTransferLimitPolicy(nil, rate)
+
+	// Assert
+	assert.Nil(t, policy)
+	assert.ErrorIs(t, err, errs.ErrCurrencyMismatch)
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type AliasKeyModel struct {
+	ID        string    `db:"id"`
+	UserID    string    `db:"user_id"`
+	KeyType   string    `db:"key_type"`
+	Value     string    `db:"value"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func NewAliasKeyModelFrom(k *entity.AliasKey) *AliasKeyModel {
+	return &AliasKeyModel{
+		ID:        k.ID(),
+		UserID:    k.UserID(),
+		KeyType:   k.Type(),
+		Value:     k.Value(),
+		CreatedAt: k.CreatedAt(),
+	}
+}
+
+func (km *AliasKeyModel) ToEntity() *entity.AliasKey {
+	return entity.RestoreAliasKey(
+		uuid.MustParse(km.ID),
+		km.UserID,
+		km.KeyType,
+		km.Value,
+		km.CreatedAt,
+	)
+}
@@ -0,0 +1,14 @@
+package model
+
+import "github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+
+type BalanceCheckModel struct {
+	UserID          string `db:"user_id"`
+	Currency        string `db:"currency"`
+	Balance         int64  `db:"balance"`
+	ExpectedBalance int64  `db:"expected_balance"`
+}
+
+func (bm *BalanceCheckModel) ToEntity() *entity.BalanceCheck {
+	return entity.NewBalanceCheck(bm.UserID, bm.Currency, bm.Balance, bm.ExpectedBalance)
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type BalanceHoldModel struct {
+	ID             string         `db:"id"`
+	SenderID       string         `db:"sender_id"`
+	ReceiverID     string         `db:"receiver_id"`
+	Amount         int64          `db:"amount"`
+	Currency       string         `db:"currency"`
+	CapturedAmount int64          `db:"captured_amount"`
+	Status         string         `db:"status"`
+	TransactionID  sql.NullString `db:"transaction_id"`
+	ExpiresAt      time.Time      `db:"expires_at"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+func NewBalanceHoldModelFrom(h *entity.BalanceHold) *BalanceHoldModel {
+	return &BalanceHoldModel{
+		ID:             h.ID(),
+		SenderID:       h.SenderID(),
+		ReceiverID:     h.ReceiverID(),
+		Amount:         h.Amount(),
+		Currency:       h.Currency(),
+		CapturedAmount: h.CapturedAmount(),
+		Status:         h.Status(),
+		TransactionID:  nullString(h.TransactionID()),
+		ExpiresAt:      h.ExpiresAt(),
+		CreatedAt:      h.CreatedAt(),
+		UpdatedAt:      h.UpdatedAt(),
+	}
+}
+
+func (hm *BalanceHoldModel) ToEntity() (*entity.BalanceHold, error) {
+	return entity.RestoreBalanceHold(
+		uuid.MustParse(hm.ID),
+		hm.SenderID,
+		hm.ReceiverID,
+		hm.Amount,
+		hm.Currency,
+		hm.CapturedAmount,
+		hm.Status,
+		hm.TransactionID.String,
+		hm.ExpiresAt,
+		hm.CreatedAt,
+		hm.UpdatedAt,
+	)
+}
+
+// HeldAmountModel is the total a user has reserved in a currency by
+// authorized balance holds, pending disputes and escrows.
+type HeldAmountModel struct {
+	Currency string `db:"currency"`
+	Amount   int64  `db:"amount"`
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type ChargeModel struct {
+	ID            string         `db:"id"`
+	MerchantID    string         `db:"merchant_id"`
+	Reference     string         `db:"reference"`
+	Description   string         `db:"description"`
+	Amount        int64          `db:"amount"`
+	Currency      string         `db:"currency"`
+	Status        string         `db:"status"`
+	PayerID       sql.NullString `db:"payer_id"`
+	TransactionID sql.NullString `db:"transaction_id"`
+	ExpiresAt     time.Time      `db:"expires_at"`
+	CreatedAt     time.Time      `db:"created_at"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+}
+
+func NewChargeModelFrom(c *entity.Charge) *ChargeModel {
+	return &ChargeModel{
+		ID:            c.ID(),
+		MerchantID:    c.MerchantID(),
+		Reference:     c.Reference(),
+		Description:   c.Description(),
+		Amount:        c.Amount(),
+		Currency:      c.Currency(),
+		Status:        c.Status(),
+		PayerID:       nullString(c.PayerID()),
+		TransactionID: nullString(c.TransactionID()),
+		ExpiresAt:     c.ExpiresAt(),
+		CreatedAt:     c.CreatedAt(),
+		UpdatedAt:     c.UpdatedAt(),
+	}
+}
+
+func (cm *ChargeModel) ToEntity() (*entity.Charge, error) {
+	return entity.RestoreCharge(
+		uuid.MustParse(cm.ID),
+		cm.MerchantID,
+		cm.Reference,
+		cm.Description,
+		cm.Amount,
+		cm.Currency,
+		cm.Status,
+		cm.PayerID.String,
+		cm.TransactionID.String,
+		cm.ExpiresAt,
+		cm.CreatedAt,
+		cm.UpdatedAt,
+	)
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+)
+
+type DepositModel struct {
+	ID        string    `db:"id"`
+	UserID    string    `db:"user_id"`
+	Amount    int64     `db:"amount"`
+	Currency  string    `db:"currency"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func NewDepositModelFrom(d *entity.Deposit) *DepositModel {
+	return &DepositModel{
+		ID:        d.ID(),
+		UserID:    d.UserID(),
+		Amount:    d.Amount(),
+		Currency:  d.Currency(),
+		CreatedAt: d.CreatedAt(),
+	}
+}
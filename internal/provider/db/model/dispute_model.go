@@ -0,0 +1,77 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type DisputeModel struct {
+	ID            string         `db:"id"`
+	TransactionID string         `db:"transaction_id"`
+	SenderID      string         `db:"sender_id"`
+	ReceiverID    string         `db:"receiver_id"`
+	Amount        int64          `db:"amount"`
+	FrozenAmount  int64          `db:"frozen_amount"`
+	Currency      string         `db:"currency"`
+	Reason        string         `db:"reason"`
+	Evidence      string         `db:"evidence"`
+	Response      string         `db:"response"`
+	Status        string         `db:"status"`
+	RespondBy     time.Time      `db:"respond_by"`
+	ChargebackID  sql.NullString `db:"chargeback_id"`
+	ResolvedAt    sql.NullTime   `db:"resolved_at"`
+	CreatedAt     time.Time      `db:"created_at"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+}
+
+func NewDisputeModelFrom(d *entity.Dispute) *DisputeModel {
+	disputeModel := &DisputeModel{
+		ID:            d.ID(),
+		TransactionID: d.TransactionID(),
+		SenderID:      d.SenderID(),
+		ReceiverID:    d.ReceiverID(),
+		Amount:        d.Amount(),
+		FrozenAmount:  d.FrozenAmount(),
+		Currency:      d.Currency(),
+		Reason:        d.Reason(),
+		Evidence:      d.Evidence(),
+		Response:      d.Response(),
+		Status:        d.Status(),
+		RespondBy:     d.RespondBy(),
+		ChargebackID:  nullString(d.ChargebackID()),
+		CreatedAt:     d.CreatedAt(),
+		UpdatedAt:     d.UpdatedAt(),
+	}
+	if d.ResolvedAt() != nil {
+		disputeModel.ResolvedAt = sql.NullTime{Time: *d.ResolvedAt(), Valid: true}
+	}
+	return disputeModel
+}
+
+func (dm *DisputeModel) ToEntity() (*entity.Dispute, error) {
+	var resolvedAt *time.Time
+	if dm.ResolvedAt.Valid {
+		resolvedAt = &dm.ResolvedAt.Time
+	}
+	return entity.RestoreDispute(
+		uuid.MustParse(dm.ID),
+		dm.TransactionID,
+		dm.SenderID,
+		dm.ReceiverID,
+		dm.Amount,
+		dm.FrozenAmount,
+		dm.Currency,
+		dm.Reason,
+		dm.Evidence,
+		dm.Response,
+		dm.Status,
+		dm.RespondBy,
+		dm.ChargebackID.String,
+		resolvedAt,
+		dm.CreatedAt,
+		dm.UpdatedAt,
+	)
+}
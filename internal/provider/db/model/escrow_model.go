@@ -0,0 +1,70 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type EscrowModel struct {
+	ID            string         `db:"id"`
+	SenderID      string         `db:"sender_id"`
+	ReceiverID    string         `db:"receiver_id"`
+	Amount        int64          `db:"amount"`
+	Currency      string         `db:"currency"`
+	Status        string         `db:"status"`
+	ExpiresAt     time.Time      `db:"expires_at"`
+	ShippedAt     sql.NullTime   `db:"shipped_at"`
+	ReleaseAt     sql.NullTime   `db:"release_at"`
+	TransactionID sql.NullString `db:"transaction_id"`
+	CreatedAt     time.Time      `db:"created_at"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+}
+
+func NewEscrowModelFrom(e *entity.Escrow) *EscrowModel {
+	escrowModel := &EscrowModel{
+		ID:            e.ID(),
+		SenderID:      e.SenderID(),
+		ReceiverID:    e.ReceiverID(),
+		Amount:        e.Amount(),
+		Currency:      e.Currency(),
+		Status:        e.Status(),
+		ExpiresAt:     e.ExpiresAt(),
+		TransactionID: nullString(e.TransactionID()),
+		CreatedAt:     e.CreatedAt(),
+		UpdatedAt:     e.UpdatedAt(),
+	}
+	if e.ShippedAt() != nil {
+		escrowModel.ShippedAt = sql.NullTime{Time: *e.ShippedAt(), Valid: true}
+	}
+	if e.ReleaseAt() != nil {
+		escrowModel.ReleaseAt = sql.NullTime{Time: *e.ReleaseAt(), Valid: true}
+	}
+	return escrowModel
+}
+
+func (em *EscrowModel) ToEntity() (*entity.Escrow, error) {
+	var shippedAt, releaseAt *time.Time
+	if em.ShippedAt.Valid {
+		shippedAt = &em.ShippedAt.Time
+	}
+	if em.ReleaseAt.Valid {
+		releaseAt = &em.ReleaseAt.Time
+	}
+	return entity.RestoreEscrow(
+		uuid.MustParse(em.ID),
+		em.SenderID,
+		em.ReceiverID,
+		em.Amount,
+		em.Currency,
+		em.Status,
+		em.ExpiresAt,
+		shippedAt,
+		releaseAt,
+		em.TransactionID.String,
+		em.CreatedAt,
+		em.UpdatedAt,
+	)
+}
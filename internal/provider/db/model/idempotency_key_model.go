@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+)
+
+type IdempotencyKeyModel struct {
+	Key           string    `db:"idempotency_key"`
+	RequestHash   string    `db:"request_hash"`
+	TransactionID string    `db:"transaction_id"`
+	CreatedAt     time.Time `db:"created_at"`
+	ExpiresAt     time.Time `db:"expires_at"`
+}
+
+func (km *IdempotencyKeyModel) ToEntity() *entity.IdempotencyKey {
+	return entity.RestoreIdempotencyKey(km.Key, km.RequestHash, km.TransactionID, km.CreatedAt, km.ExpiresAt)
+}
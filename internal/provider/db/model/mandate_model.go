@@ -0,0 +1,115 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type MandateModel struct {
+	ID               string         `db:"id"`
+	SenderID         string         `db:"sender_id"`
+	ReceiverID       string         `db:"receiver_id"`
+	Amount           int64          `db:"amount"`
+	Currency         string         `db:"currency"`
+	ReceiverCurrency string         `db:"receiver_currency"`
+	Frequency        string         `db:"frequency"`
+	CronExpression   sql.NullString `db:"cron_expression"`
+	StartAt          time.Time      `db:"start_at"`
+	EndAt            sql.NullTime   `db:"end_at"`
+	MaxExecutions    int            `db:"max_executions"`
+	ExecutionCount   int            `db:"execution_count"`
+	NextExecutionAt  time.Time      `db:"next_execution_at"`
+	Status           string         `db:"status"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
+}
+
+func NewMandateModelFrom(m *entity.Mandate) *MandateModel {
+	var endAt sql.NullTime
+	if m.EndAt() != nil {
+		endAt = sql.NullTime{Time: *m.EndAt(), Valid: true}
+	}
+	return &MandateModel{
+		ID:               m.ID(),
+		SenderID:         m.SenderID(),
+		ReceiverID:       m.ReceiverID(),
+		Amount:           m.Amount(),
+		Currency:         m.Currency(),
+		ReceiverCurrency: m.ReceiverCurrency(),
+		Frequency:        m.Recurrence().Frequency(),
+		CronExpression:   nullString(m.Recurrence().Expression()),
+		StartAt:          m.StartAt(),
+		EndAt:            endAt,
+		MaxExecutions:    m.MaxExecutions(),
+		ExecutionCount:   m.ExecutionCount(),
+		NextExecutionAt:  m.NextExecutionAt(),
+		Status:           m.Status(),
+		CreatedAt:        m.CreatedAt(),
+		UpdatedAt:        m.UpdatedAt(),
+	}
+}
+
+func (mm *MandateModel) ToEntity() (*entity.Mandate, error) {
+	var endAt *time.Time
+	if mm.EndAt.Valid {
+		endAt = &mm.EndAt.Time
+	}
+	return entity.RestoreMandate(
+		uuid.MustParse(mm.ID),
+		mm.SenderID,
+		mm.ReceiverID,
+		mm.Amount,
+		mm.Currency,
+		mm.ReceiverCurrency,
+		mm.Frequency,
+		mm.CronExpression.String,
+		mm.StartAt,
+		endAt,
+		mm.MaxExecutions,
+		mm.ExecutionCount,
+		mm.NextExecutionAt,
+		mm.Status,
+		mm.CreatedAt,
+		mm.UpdatedAt,
+	)
+}
+
+type MandateExecutionModel struct {
+	ID            string         `db:"id"`
+	MandateID     string         `db:"mandate_id"`
+	Sequence      int            `db:"sequence"`
+	ScheduledFor  time.Time      `db:"scheduled_for"`
+	Status        string         `db:"status"`
+	TransactionID sql.NullString `db:"transaction_id"`
+	FailureReason sql.NullString `db:"failure_reason"`
+	CreatedAt     time.Time      `db:"created_at"`
+}
+
+func NewMandateExecutionModelFrom(e *entity.MandateExecution) *MandateExecutionModel {
+	return &MandateExecutionModel{
+		ID:            e.ID(),
+		MandateID:     e.MandateID(),
+		Sequence:      e.Sequence(),
+		ScheduledFor:  e.ScheduledFor(),
+		Status:        e.Status(),
+		TransactionID: nullString(e.TransactionID()),
+		FailureReason: nullString(e.FailureReason()),
+		CreatedAt:     e.CreatedAt(),
+	}
+}
+
+func (em *MandateExecutionModel) ToEntity() *entity.MandateExecution {
+	return entity.RestoreMandateExecution(
+		uuid.MustParse(em.ID),
+		em.MandateID,
+		em.Sequence,
+		em.ScheduledFor,
+		em.Status,
+		em.TransactionID.String,
+		em.FailureReason.String,
+		em.CreatedAt,
+	)
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type OutboxMessageModel struct {
+	ID            string         `db:"id"`
+	EventName     string         `db:"event_name"`
+	Payload       []byte         `db:"payload"`
+	Status        string         `db:"status"`
+	Attempts      int            `db:"attempts"`
+	LastError     sql.NullString `db:"last_error"`
+	NextAttemptAt time.Time      `db:"next_attempt_at"`
+	CreatedAt     time.Time      `db:"created_at"`
+	SentAt        sql.NullTime   `db:"sent_at"`
+}
+
+func NewOutboxMessageModelFrom(m *entity.OutboxMessage) *OutboxMessageModel {
+	outboxModel := &OutboxMessageModel{
+		ID:        m.ID(),
+		EventName: m.EventName(),
+		Payload:   m.Payload(),
+		Status:    m.Status(),
+		Attempts:  m.Attempts(),
+		LastError: sql.NullString{
+			String: m.LastError(),
+			Valid:  m.LastError() != "",
+		},
+		NextAttemptAt: m.NextAttemptAt(),
+		CreatedAt:     m.CreatedAt(),
+	}
+	if m.SentAt() != nil {
+		outboxModel.SentAt = sql.NullTime{Time: *m.SentAt(), Valid: true}
+	}
+	return outboxModel
+}
+
+func (om *OutboxMessageModel) ToEntity() *entity.OutboxMessage {
+	var sentAt *time.Time
+	if om.SentAt.Valid {
+		sentAt = &om.SentAt.Time
+	}
+	return entity.RestoreOutboxMessage(
+		uuid.MustParse(om.ID),
+		om.EventName,
+		om.Payload,
+		om.Status,
+		om.Attempts,
+		om.LastError.String,
+		om.NextAttemptAt,
+		om.CreatedAt,
+		sentAt,
+	)
+}
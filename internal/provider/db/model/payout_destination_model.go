@@ -0,0 +1,50 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type PayoutDestinationModel struct {
+	ID            string         `db:"id"`
+	UserID        string         `db:"user_id"`
+	Kind          string         `db:"kind"`
+	BankCode      sql.NullString `db:"bank_code"`
+	Branch        sql.NullString `db:"branch"`
+	AccountNumber sql.NullString `db:"account_number"`
+	PixKey        sql.NullString `db:"pix_key"`
+	CreatedAt     time.Time      `db:"created_at"`
+}
+
+func NewPayoutDestinationModelFrom(d *entity.PayoutDestination) *PayoutDestinationModel {
+	return &PayoutDestinationModel{
+		ID:            d.ID(),
+		UserID:        d.UserID(),
+		Kind:          d.Kind(),
+		BankCode:      nullString(d.BankCode()),
+		Branch:        nullString(d.Branch()),
+		AccountNumber: nullString(d.AccountNumber()),
+		PixKey:        nullString(d.PixKey()),
+		CreatedAt:     d.CreatedAt(),
+	}
+}
+
+func (dm *PayoutDestinationModel) ToEntity() *entity.PayoutDestination {
+	return entity.RestorePayoutDestination(
+		uuid.MustParse(dm.ID),
+		dm.UserID,
+		dm.Kind,
+		dm.BankCode.String,
+		dm.Branch.String,
+		dm.AccountNumber.String,
+		dm.PixKey.String,
+		dm.CreatedAt,
+	)
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type ScheduledTransferModel struct {
+	ID               string         `db:"id"`
+	SenderID         string         `db:"sender_id"`
+	ReceiverID       string         `db:"receiver_id"`
+	Amount           int64          `db:"amount"`
+	Currency         string         `db:"currency"`
+	ReceiverCurrency string         `db:"receiver_currency"`
+	ExecuteAt        time.Time      `db:"execute_at"`
+	Status           string         `db:"status"`
+	TransactionID    sql.NullString `db:"transaction_id"`
+	FailureReason    sql.NullString `db:"failure_reason"`
+	RequestKey       sql.NullString `db:"idempotency_key"`
+	RequestHash      sql.NullString `db:"request_hash"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
+}
+
+func NewScheduledTransferModelFrom(s *entity.ScheduledTransfer) *ScheduledTransferModel {
+	return &ScheduledTransferModel{
+		ID:               s.ID(),
+		SenderID:         s.SenderID(),
+		ReceiverID:       s.ReceiverID(),
+		Amount:           s.Amount(),
+		Currency:         s.Currency(),
+		ReceiverCurrency: s.ReceiverCurrency(),
+		ExecuteAt:        s.ExecuteAt(),
+		Status:           s.Status(),
+		TransactionID:    nullString(s.TransactionID()),
+		FailureReason:    nullString(s.FailureReason()),
+		RequestKey:       nullString(s.RequestKey()),
+		RequestHash:      nullString(s.RequestHash()),
+		CreatedAt:        s.CreatedAt(),
+		UpdatedAt:        s.UpdatedAt(),
+	}
+}
+
+func (sm *ScheduledTransferModel) ToEntity() (*entity.ScheduledTransfer, error) {
+	return entity.RestoreScheduledTransfer(
+		uuid.MustParse(sm.ID),
+		sm.SenderID,
+		sm.ReceiverID,
+		sm.Amount,
+		sm.Currency,
+		sm.ReceiverCurrency,
+		sm.ExecuteAt,
+		sm.Status,
+		sm.TransactionID.String,
+		sm.FailureReason.String,
+		sm.RequestKey.String,
+		sm.RequestHash.String,
+		sm.CreatedAt,
+		sm.UpdatedAt,
+	)
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type SettlementModel struct {
+	ID               string       `db:"id"`
+	MerchantID       string       `db:"merchant_id"`
+	SettlementDate   time.Time    `db:"settlement_date"`
+	Currency         string       `db:"currency"`
+	Gross            int64        `db:"gross"`
+	Fee              int64        `db:"fee"`
+	TransactionCount int          `db:"transaction_count"`
+	Status           string       `db:"status"`
+	ClosedAt         sql.NullTime `db:"closed_at"`
+	PaidAt           sql.NullTime `db:"paid_at"`
+	CreatedAt        time.Time    `db:"created_at"`
+	UpdatedAt        time.Time    `db:"updated_at"`
+}
+
+func NewSettlementModelFrom(s *entity.Settlement) *SettlementModel {
+	settlementModel := &SettlementModel{
+		ID:               s.ID(),
+		MerchantID:       s.MerchantID(),
+		SettlementDate:   s.Date(),
+		Currency:         s.Currency(),
+		Gross:            s.Gross(),
+		Fee:              s.Fee(),
+		TransactionCount: s.TransactionCount(),
+		Status:           s.Status(),
+		CreatedAt:        s.CreatedAt(),
+		UpdatedAt:        s.UpdatedAt(),
+	}
+	if s.ClosedAt() != nil {
+		settlementModel.ClosedAt = sql.NullTime{Time: *s.ClosedAt(), Valid: true}
+	}
+	if s.PaidAt() != nil {
+		settlementModel.PaidAt = sql.NullTime{Time: *s.PaidAt(), Valid: true}
+	}
+	return settlementModel
+}
+
+func (sm *SettlementModel) ToEntity() *entity.Settlement {
+	var closedAt, paidAt *time.Time
+	if sm.ClosedAt.Valid {
+		closedAt = &sm.ClosedAt.Time
+	}
+	if sm.PaidAt.Valid {
+		paidAt = &sm.PaidAt.Time
+	}
+	return entity.RestoreSettlement(
+		uuid.MustParse(sm.ID),
+		sm.MerchantID,
+		sm.SettlementDate,
+		sm.Currency,
+		sm.Gross,
+		sm.Fee,
+		sm.TransactionCount,
+		sm.Status,
+		closedAt,
+		paidAt,
+		sm.CreatedAt,
+		sm.UpdatedAt,
+	)
+}
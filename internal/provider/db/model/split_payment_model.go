@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type SplitPaymentModel struct {
+	ID        string    `db:"id"`
+	SenderID  string    `db:"sender_id"`
+	Amount    int64     `db:"amount"`
+	Currency  string    `db:"currency"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func NewSplitPaymentModelFrom(s *entity.SplitPayment) *SplitPaymentModel {
+	return &SplitPaymentModel{
+		ID:        s.ID(),
+		SenderID:  s.SenderID(),
+		Amount:    s.Amount(),
+		Currency:  s.Currency(),
+		CreatedAt: s.CreatedAt(),
+	}
+}
+
+func (sm *SplitPaymentModel) ToEntity(shares []*entity.SplitShare) (*entity.SplitPayment, error) {
+	return entity.RestoreSplitPayment(
+		uuid.MustParse(sm.ID),
+		sm.SenderID,
+		sm.Amount,
+		sm.Currency,
+		shares,
+		sm.CreatedAt,
+	)
+}
+
+type SplitShareModel struct {
+	SplitPaymentID string `db:"split_payment_id"`
+	Sequence       int    `db:"sequence"`
+	ReceiverID     string `db:"receiver_id"`
+	Amount         int64  `db:"amount"`
+	Percentage     int64  `db:"percentage"`
+	TransactionID  string `db:"transaction_id"`
+}
+
+func NewSplitShareModelFrom(splitPaymentID string, s *entity.SplitShare) *SplitShareModel {
+	return &SplitShareModel{
+		SplitPaymentID: splitPaymentID,
+		Sequence:       s.Sequence(),
+		ReceiverID:     s.ReceiverID(),
+		Amount:         s.Amount(),
+		Percentage:     s.Percentage(),
+		TransactionID:  s.TransactionID(),
+	}
+}
+
+func (sm *SplitShareModel) ToEntity() *entity.SplitShare {
+	return entity.RestoreSplitShare(
+		sm.Sequence,
+		sm.ReceiverID,
+		sm.Amount,
+		sm.Percentage,
+		sm.TransactionID,
+	)
+}
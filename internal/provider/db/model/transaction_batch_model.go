@@ -0,0 +1,84 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type TransactionBatchModel struct {
+	ID        string    `db:"id"`
+	Atomic    bool      `db:"atomic"`
+	Status    string    `db:"status"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func NewTransactionBatchModelFrom(b *entity.TransactionBatch) *TransactionBatchModel {
+	return &TransactionBatchModel{
+		ID:        b.ID(),
+		Atomic:    b.Atomic(),
+		Status:    b.Status(),
+		CreatedAt: b.CreatedAt(),
+		UpdatedAt: b.UpdatedAt(),
+	}
+}
+
+func (bm *TransactionBatchModel) ToEntity(items []*entity.TransactionBatchItem) *entity.TransactionBatch {
+	return entity.RestoreTransactionBatch(
+		uuid.MustParse(bm.ID),
+		bm.Atomic,
+		bm.Status,
+		items,
+		bm.CreatedAt,
+		bm.UpdatedAt,
+	)
+}
+
+type TransactionBatchItemModel struct {
+	ID            string         `db:"id"`
+	BatchID       string         `db:"batch_id"`
+	Sequence      int            `db:"sequence"`
+	SenderID      string         `db:"sender_id"`
+	ReceiverID    string         `db:"receiver_id"`
+	Amount        int64          `db:"amount"`
+	Currency      string         `db:"currency"`
+	Status        string         `db:"status"`
+	TransactionID sql.NullString `db:"transaction_id"`
+	FailureReason sql.NullString `db:"failure_reason"`
+	CreatedAt     time.Time      `db:"created_at"`
+}
+
+func NewTransactionBatchItemModelFrom(i *entity.TransactionBatchItem) *TransactionBatchItemModel {
+	return &TransactionBatchItemModel{
+		ID:            i.ID(),
+		BatchID:       i.BatchID(),
+		Sequence:      i.Sequence(),
+		SenderID:      i.SenderID(),
+		ReceiverID:    i.ReceiverID(),
+		Amount:        i.Amount(),
+		Currency:      i.Currency(),
+		Status:        i.Status(),
+		TransactionID: nullString(i.TransactionID()),
+		FailureReason: nullString(i.FailureReason()),
+		CreatedAt:     i.CreatedAt(),
+	}
+}
+
+func (im *TransactionBatchItemModel) ToEntity() (*entity.TransactionBatchItem, error) {
+	return entity.RestoreTransactionBatchItem(
+		uuid.MustParse(im.ID),
+		im.BatchID,
+		im.Sequence,
+		im.SenderID,
+		im.ReceiverID,
+		im.Amount,
+		im.Currency,
+		im.Status,
+		im.TransactionID.String,
+		im.FailureReason.String,
+		im.CreatedAt,
+	)
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type TransactionModel struct {
+	ID                    string         `db:"id"`
+	SenderID              string         `db:"sender_id"`
+	ReceiverID            string         `db:"receiver_id"`
+	Amount                int64          `db:"amount"`
+	Currency              string         `db:"currency"`
+	ReceivedAmount        int64          `db:"received_amount"`
+	ReceivedCurrency      string         `db:"received_currency"`
+	ExchangeRate          string         `db:"exchange_rate"`
+	Fee                   int64          `db:"fee"`
+	Kind                  string         `db:"kind"`
+	OriginalTransactionID sql.NullString `db:"original_transaction_id"`
+	CreatedAt             time.Time      `db:"created_at"`
+}
+
+func NewTransactionModelFrom(t *entity.Transaction) *TransactionModel {
+	return &TransactionModel{
+		ID:               t.ID(),
+		SenderID:         t.SenderID(),
+		ReceiverID:       t.ReceiverID(),
+		Amount:           t.Amount(),
+		Currency:         t.Currency(),
+		ReceivedAmount:   t.ReceivedAmount(),
+		ReceivedCurrency: t.ReceivedCurrency(),
+		ExchangeRate:     t.ExchangeRate().Rate(),
+		Fee:              t.Fee(),
+		Kind:             t.Kind(),
+		OriginalTransactionID: sql.NullString{
+			String: t.OriginalTransactionID(),
+			Valid:  t.OriginalTransactionID() != "",
+		},
+		CreatedAt: t.CreatedAt(),
+	}
+}
+
+func (tm *TransactionModel) ToEntity() (*entity.Transaction, error) {
+	return entity.RestoreTransaction(
+		uuid.MustParse(tm.ID),
+		tm.Amount,
+		tm.Currency,
+		tm.ReceivedAmount,
+		tm.ReceivedCurrency,
+		tm.ExchangeRate,
+		tm.Fee,
+		tm.SenderID,
+		tm.ReceiverID,
+		tm.Kind,
+		tm.OriginalTransactionID.String,
+		tm.CreatedAt,
+	)
+}
+
+// StatementEntryModel is a transaction along with the currency it moved and
+// the balance of the user whose statement lists it.
+type StatementEntryModel struct {
+	TransactionModel
+	EntryCurrency string `db:"entry_currency"`
+	Balance       int64  `db:"balance"`
+}
+
+func (sm *StatementEntryModel) ToEntity(userID string) (*entity.StatementEntry, error) {
+	transaction, err := sm.TransactionModel.ToEntity()
+	if err != nil {
+		return nil, err
+	}
+	return entity.NewStatementEntry(transaction, userID, sm.Balance), nil
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+)
+
+// TransferUsageModel is how much a user sent in a currency in each limit
+// period.
+type TransferUsageModel struct {
+	Currency string `db:"currency"`
+	Daily    int64  `db:"daily"`
+	Weekly   int64  `db:"weekly"`
+	Monthly  int64  `db:"monthly"`
+}
+
+func (tum *TransferUsageModel) ToTransferUsage() vo.TransferUsage {
+	return vo.TransferUsage{Daily: tum.Daily, Weekly: tum.Weekly, Monthly: tum.Monthly}
+}
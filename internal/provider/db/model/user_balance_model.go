@@ -0,0 +1,17 @@
+package model
+
+import (
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+)
+
+// UserBalanceModel is the balance of a user in a currency other than the
+// default one, which is kept in UserModel.
+type UserBalanceModel struct {
+	UserID   string `db:"user_id"`
+	Currency string `db:"currency"`
+	Balance  int64  `db:"balance"`
+}
+
+func (ubm *UserBalanceModel) ToMoney() (*vo.Money, error) {
+	return vo.NewMoney(ubm.Balance, ubm.Currency)
+}
@@ -47,7 +47,7 @@ func NewUserModelFrom(u *entity.User) *UserModel {
 func (um *UserModel) ToEntity() (*entity.User, error) {
 	user, err := entity.CreateUser(
 		uuid.MustParse(um.ID),
-		float64(um.Balance/100),
+		um.Balance,
 		um.Name,
 		um.Email,
 		um.Password,
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type WithdrawalModel struct {
+	ID            string         `db:"id"`
+	UserID        string         `db:"user_id"`
+	DestinationID string         `db:"destination_id"`
+	Amount        int64          `db:"amount"`
+	Currency      string         `db:"currency"`
+	Status        string         `db:"status"`
+	FailureReason sql.NullString `db:"failure_reason"`
+	CreatedAt     time.Time      `db:"created_at"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+}
+
+func NewWithdrawalModelFrom(w *entity.Withdrawal) *WithdrawalModel {
+	return &WithdrawalModel{
+		ID:            w.ID(),
+		UserID:        w.UserID(),
+		DestinationID: w.DestinationID(),
+		Amount:        w.Amount(),
+		Currency:      w.Currency(),
+		Status:        w.Status(),
+		FailureReason: nullString(w.FailureReason()),
+		CreatedAt:     w.CreatedAt(),
+		UpdatedAt:     w.UpdatedAt(),
+	}
+}
+
+func (wm *WithdrawalModel) ToEntity() (*entity.Withdrawal, error) {
+	return entity.RestoreWithdrawal(
+		uuid.MustParse(wm.ID),
+		wm.UserID,
+		wm.DestinationID,
+		wm.Amount,
+		wm.Currency,
+		wm.Status,
+		wm.FailureReason.String,
+		wm.CreatedAt,
+		wm.UpdatedAt,
+	)
+}
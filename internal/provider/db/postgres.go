@@ -9,14 +9,14 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
-var once sync.Once
+var (
+	once sync.Once
+	db   *sqlx.DB
+)
 
 func NewPostgresDB() *sqlx.DB {
-	pgConfig := config.GetPostgresConfig()
-
-	var db *sqlx.DB
-
 	once.Do(func() {
+		pgConfig := config.GetPostgresConfig()
 		var err error
 		db, err = sqlx.Connect("postgres", pgConfig.GetPostgresURL())
 		if err != nil {
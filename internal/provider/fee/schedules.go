@@ -0,0 +1,93 @@
+package fee
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+)
+
+// ScheduleConfig describes a fee schedule: a percentage in basis points, a
+// fixed amount in cents or tiers of those.
+type ScheduleConfig struct {
+	Type        string       `json:"type"`
+	BasisPoints int64        `json:"basis_points"`
+	Fixed       int64        `json:"fixed"`
+	Tiers       []TierConfig `json:"tiers"`
+}
+
+// TierConfig is a tier of a tiered schedule, applying to amounts up to UpTo
+// cents. The last tier may leave UpTo out to have no upper bound.
+type TierConfig struct {
+	UpTo int64 `json:"up_to"`
+	ScheduleConfig
+}
+
+// PolicyConfig holds the schedules of receiver user types and of merchants
+// keyed by their user id.
+type PolicyConfig struct {
+	UserTypes map[string]ScheduleConfig `json:"user_types"`
+	Merchants map[string]ScheduleConfig `json:"merchants"`
+}
+
+// NewPolicy builds the fee policy described by the config.
+func NewPolicy(config PolicyConfig) (*vo.FeePolicy, error) {
+	userTypes := make(map[string]vo.FeeSchedule, len(config.UserTypes))
+	for userType, scheduleConfig := range config.UserTypes {
+		schedule, err := newSchedule(scheduleConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee schedule for %s: %w", userType, err)
+		}
+		userTypes[userType] = *schedule
+	}
+	merchants := make(map[string]vo.FeeSchedule, len(config.Merchants))
+	for merchantID, scheduleConfig := range config.Merchants {
+		schedule, err := newSchedule(scheduleConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee schedule for merchant %s: %w", merchantID, err)
+		}
+		merchants[merchantID] = *schedule
+	}
+	return vo.NewFeePolicy(userTypes, merchants)
+}
+
+// NewFilePolicy loads the fee policy from a JSON file, e.g.
+// {"user_types": {"merchant": {"type": "percentage", "basis_points": 199}}}.
+func NewFilePolicy(path string) (*vo.FeePolicy, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config PolicyConfig
+	err = json.Unmarshal(content, &config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fee schedules file %s: %w", path, err)
+	}
+	return NewPolicy(config)
+}
+
+func newSchedule(config ScheduleConfig) (*vo.FeeSchedule, error) {
+	switch config.Type {
+	case vo.PercentageFeeKind:
+		return vo.NewPercentageFee(config.BasisPoints)
+	case vo.FixedFeeKind:
+		return vo.NewFixedFee(config.Fixed)
+	case vo.TieredFeeKind:
+		tiers := make([]vo.FeeTier, 0, len(config.Tiers))
+		for _, tierConfig := range config.Tiers {
+			if tierConfig.Type == vo.TieredFeeKind {
+				return nil, errs.ErrInvalidFeeSchedule
+			}
+			schedule, err := newSchedule(tierConfig.ScheduleConfig)
+			if err != nil {
+				return nil, err
+			}
+			tiers = append(tiers, vo.FeeTier{UpTo: tierConfig.UpTo, Fee: *schedule})
+		}
+		return vo.NewTieredFee(tiers)
+	default:
+		return nil, errs.ErrInvalidFeeSchedule
+	}
+}
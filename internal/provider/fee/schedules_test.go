@@ -0,0 +1,48 @@
+package fee_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fee"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFilePolicy_ShouldLoadSchedulesFromJSONFile(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "fees.json")
+	content := `{
+		"user_types": {"merchant": {"type": "percentage", "basis_points": 199}},
+		"merchants": {"merchant-1": {"type": "tiered", "tiers": [
+			{"up_to": 10000, "type": "fixed", "fixed": 100},
+			{"type": "percentage", "basis_points": 50}
+		]}}
+	}`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	merchant, err := vo.NewUserType(vo.MerchantUserType)
+	require.NoError(t, err)
+
+	// Act
+	policy, err := fee.NewFilePolicy(path)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(199), policy.For("merchant-2", *merchant).Fee(10000))
+	assert.Equal(t, int64(100), policy.For("merchant-1", *merchant).Fee(10000))
+	assert.Equal(t, int64(100), policy.For("merchant-1", *merchant).Fee(20000))
+}
+
+func TestNewPolicy_ShouldRejectUnknownScheduleTypes(t *testing.T) {
+	// Act
+	policy, err := fee.NewPolicy(fee.PolicyConfig{
+		UserTypes: map[string]fee.ScheduleConfig{vo.MerchantUserType: {Type: "flat"}},
+	})
+
+	// Assert
+	assert.Nil(t, policy)
+	assert.ErrorIs(t, err, errs.ErrInvalidFeeSchedule)
+}
@@ -0,0 +1,74 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+)
+
+// DefaultRates are indicative rates used for local development when no rates
+// file is configured.
+var DefaultRates = map[string]string{
+	"USD/BRL": "5.00",
+	"EUR/BRL": "5.50",
+	"EUR/USD": "1.10",
+}
+
+// InMemoryRateProvider serves fixed rates keyed by currency pair, e.g.
+// "USD/BRL". The inverse of a configured pair is derived from it.
+type InMemoryRateProvider struct {
+	rates map[string]*vo.ExchangeRate
+}
+
+func (p *InMemoryRateProvider) GetRate(ctx context.Context, from, to string) (*vo.ExchangeRate, error) {
+	if from == to {
+		return vo.NewIdentityExchangeRate(from)
+	}
+	if rate, ok := p.rates[pair(from, to)]; ok {
+		return rate, nil
+	}
+	if rate, ok := p.rates[pair(to, from)]; ok {
+		return rate.Inverse(), nil
+	}
+	return nil, errs.ErrExchangeRateNotFound
+}
+
+func pair(from, to string) string {
+	return from + "/" + to
+}
+
+func NewInMemoryRateProvider(rates map[string]string) (*InMemoryRateProvider, error) {
+	provider := &InMemoryRateProvider{rates: make(map[string]*vo.ExchangeRate, len(rates))}
+	for key, value := range rates {
+		var from, to string
+		_, err := fmt.Sscanf(key, "%3s/%3s", &from, &to)
+		if err != nil || pair(from, to) != key {
+			return nil, fmt.Errorf("invalid currency pair %q", key)
+		}
+		rate, err := vo.NewExchangeRate(from, to, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate for %s: %w", key, err)
+		}
+		provider.rates[key] = rate
+	}
+	return provider, nil
+}
+
+// NewFileRateProvider loads rates from a JSON file mapping currency pairs to
+// decimal rates, e.g. {"USD/BRL": "5.4321"}.
+func NewFileRateProvider(path string) (*InMemoryRateProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rates map[string]string
+	err = json.Unmarshal(content, &rates)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rates file %s: %w", path, err)
+	}
+	return NewInMemoryRateProvider(rates)
+}
@@ -0,0 +1,88 @@
+package fx_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryRateProvider_GetRate_ShouldReturnConfiguredAndInverseRates(t *testing.T) {
+	// Arrange
+	provider, err := fx.NewInMemoryRateProvider(map[string]string{"USD/BRL": "5"})
+	require.NoError(t, err)
+
+	// Act
+	direct, err := provider.GetRate(context.Background(), vo.USD, vo.BRL)
+	require.NoError(t, err)
+	inverse, err := provider.GetRate(context.Background(), vo.BRL, vo.USD)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "5", direct.Rate())
+	assert.Equal(t, vo.BRL, inverse.From())
+	assert.Equal(t, vo.USD, inverse.To())
+	assert.Equal(t, "0.2", inverse.Rate())
+}
+
+func TestInMemoryRateProvider_GetRate_ShouldReturnIdentityRateForTheSameCurrency(t *testing.T) {
+	// Arrange
+	provider, err := fx.NewInMemoryRateProvider(nil)
+	require.NoError(t, err)
+
+	// Act
+	rate, err := provider.GetRate(context.Background(), vo.EUR, vo.EUR)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, rate.IsIdentity())
+}
+
+func TestInMemoryRateProvider_GetRate_ShouldReturnErrorWhenPairIsUnknown(t *testing.T) {
+	// Arrange
+	provider, err := fx.NewInMemoryRateProvider(map[string]string{"USD/BRL": "5"})
+	require.NoError(t, err)
+
+	// Act
+	rate, err := provider.GetRate(context.Background(), vo.EUR, vo.BRL)
+
+	// Assert
+	assert.Nil(t, rate)
+	assert.ErrorIs(t, err, errs.ErrExchangeRateNotFound)
+}
+
+func TestNewInMemoryRateProvider_ShouldRejectInvalidPairsAndRates(t *testing.T) {
+	for _, rates := range []map[string]string{
+		{"USDBRL": "5"},
+		{"USD/XYZ": "5"},
+		{"USD/BRL": "-5"},
+	} {
+		// Act
+		provider, err := fx.NewInMemoryRateProvider(rates)
+
+		// Assert
+		assert.Nil(t, provider)
+		assert.Error(t, err)
+	}
+}
+
+func TestNewFileRateProvider_ShouldLoadRatesFromJSONFile(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "rates.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"EUR/BRL": "5.4321"}`), 0o600))
+
+	// Act
+	provider, err := fx.NewFileRateProvider(path)
+	require.NoError(t, err)
+	rate, err := provider.GetRate(context.Background(), vo.EUR, vo.BRL)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "5.4321", rate.Rate())
+}
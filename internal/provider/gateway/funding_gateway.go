@@ -0,0 +1,80 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+)
+
+type fundingRequest struct {
+	DepositID     string `json:"deposit_id"`
+	UserID        string `json:"user_id"`
+	AmountInCents int64  `json:"amount_in_cents"`
+	Currency      string `json:"currency"`
+}
+
+// FundingGateway confirms deposits against an external funding provider,
+// which answers 200 OK once the funds were received.
+type FundingGateway struct {
+	httpClient Client
+	url        string
+	logger     *log.Logger
+	otel       telemetry.Telemetry
+}
+
+func (fg *FundingGateway) ConfirmFunds(ctx context.Context, deposit *entity.Deposit) bool {
+	body, err := json.Marshal(fundingRequest{
+		DepositID:     deposit.ID(),
+		UserID:        deposit.UserID(),
+		AmountInCents: deposit.Amount(),
+		Currency:      deposit.Currency(),
+	})
+	if err != nil {
+		fg.logger.Printf("Error marshalling funding request: %v\n", err)
+		return false
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fg.url, bytes.NewReader(body))
+	if err != nil {
+		fg.logger.Printf("Error creating HTTP request: %v\n", err)
+		return false
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := fg.httpClient.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode == http.StatusOK
+}
+
+func NewFundingGateway(httpClient Client, url string, otel telemetry.Telemetry) *FundingGateway {
+	return &FundingGateway{
+		httpClient: httpClient,
+		url:        url,
+		logger:     log.New(os.Stdout, "funding_gateway: ", log.LstdFlags),
+		otel:       otel,
+	}
+}
+
+// FakeFundingGateway confirms every deposit. It is meant for local
+// development, where there is no funding provider to talk to.
+type FakeFundingGateway struct {
+	logger *log.Logger
+}
+
+func (fg *FakeFundingGateway) ConfirmFunds(ctx context.Context, deposit *entity.Deposit) bool {
+	fg.logger.Printf("Confirming deposit %s of %d cents of %s\n", deposit.ID(), deposit.Amount(), deposit.Currency())
+	return true
+}
+
+func NewFakeFundingGateway() *FakeFundingGateway {
+	return &FakeFundingGateway{
+		logger: log.New(os.Stdout, "fake_funding_gateway: ", log.LstdFlags),
+	}
+}
@@ -0,0 +1,86 @@
+package gateway_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/gateway"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeposit(t *testing.T) *entity.Deposit {
+	t.Helper()
+	amount, err := vo.NewMoney(5000, vo.BRL)
+	require.NoError(t, err)
+	deposit, err := entity.NewDeposit("d6ae1675-5978-49d3-a6e3-619955ec6b2e", amount)
+	require.NoError(t, err)
+	return deposit
+}
+
+func TestFundingGateway_ConfirmFunds_ShouldReturnTrueWhenResponseStatusCodeIsOK(t *testing.T) {
+	// Arrange
+	deposit := newDeposit(t)
+	mockHttpClient := &mockHTTPClient{}
+	mockHttpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		var body map[string]interface{}
+		err := json.NewDecoder(req.Body).Decode(&body)
+		return err == nil &&
+			req.Method == http.MethodPost &&
+			body["deposit_id"] == deposit.ID() &&
+			body["amount_in_cents"] == float64(5000) &&
+			body["currency"] == vo.BRL
+	})).Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil)
+
+	fundingGateway := gateway.NewFundingGateway(mockHttpClient, "http://funding.local/confirm", telemetry.NewMockTelemetry())
+
+	// Act
+	result := fundingGateway.ConfirmFunds(context.Background(), deposit)
+
+	// Assert
+	assert.True(t, result)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestFundingGateway_ConfirmFunds_ShouldReturnFalseWhenResponseStatusCodeIsNotOK(t *testing.T) {
+	// Arrange
+	mockHttpClient := &mockHTTPClient{}
+	mockHttpClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: http.StatusPaymentRequired, Body: http.NoBody}, nil)
+
+	fundingGateway := gateway.NewFundingGateway(mockHttpClient, "http://funding.local/confirm", telemetry.NewMockTelemetry())
+
+	// Act
+	result := fundingGateway.ConfirmFunds(context.Background(), newDeposit(t))
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestFundingGateway_ConfirmFunds_ShouldReturnFalseWhenHTTPClientDoFails(t *testing.T) {
+	// Arrange
+	mockHttpClient := &mockHTTPClient{}
+	mockHttpClient.On("Do", mock.Anything).Return(nil, errors.New("connection error"))
+
+	fundingGateway := gateway.NewFundingGateway(mockHttpClient, "http://funding.local/confirm", telemetry.NewMockTelemetry())
+
+	// Act
+	result := fundingGateway.ConfirmFunds(context.Background(), newDeposit(t))
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestFakeFundingGateway_ConfirmFunds_ShouldConfirmEveryDeposit(t *testing.T) {
+	// Act
+	result := gateway.NewFakeFundingGateway().ConfirmFunds(context.Background(), newDeposit(t))
+
+	// Assert
+	assert.True(t, result)
+}
@@ -0,0 +1,112 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+)
+
+type payoutRequest struct {
+	WithdrawalID  string `json:"withdrawal_id"`
+	AmountInCents int64  `json:"amount_in_cents"`
+	Currency      string `json:"currency"`
+	Kind          string `json:"kind"`
+	BankCode      string `json:"bank_code,omitempty"`
+	Branch        string `json:"branch,omitempty"`
+	AccountNumber string `json:"account_number,omitempty"`
+	PixKey        string `json:"pix_key,omitempty"`
+}
+
+// PayoutGateway submits payouts to an external payout provider, which answers
+// 202 Accepted and reports the result later through the withdrawal result
+// webhook.
+type PayoutGateway struct {
+	httpClient Client
+	url        string
+	logger     *log.Logger
+	otel       telemetry.Telemetry
+}
+
+func (pg *PayoutGateway) RequestPayout(ctx context.Context, withdrawal *entity.Withdrawal, destination *entity.PayoutDestination) error {
+	body, err := json.Marshal(payoutRequest{
+		WithdrawalID:  withdrawal.ID(),
+		AmountInCents: withdrawal.Amount(),
+		Currency:      withdrawal.Currency(),
+		Kind:          destination.Kind(),
+		BankCode:      destination.BankCode(),
+		Branch:        destination.Branch(),
+		AccountNumber: destination.AccountNumber(),
+		PixKey:        destination.PixKey(),
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, pg.url, bytes.NewReader(body))
+	if err != nil {
+		pg.logger.Printf("Error creating HTTP request: %v\n", err)
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := pg.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+		return fmt.Errorf("%w: payout provider answered with status %d", errs.ErrPayoutNotAccepted, resp.StatusCode)
+	}
+	// The provider may have taken the payout before failing
+	return fmt.Errorf("payout provider answered with status %d", resp.StatusCode)
+}
+
+func NewPayoutGateway(httpClient Client, url string, otel telemetry.Telemetry) *PayoutGateway {
+	return &PayoutGateway{
+		httpClient: httpClient,
+		url:        url,
+		logger:     log.New(os.Stdout, "payout_gateway: ", log.LstdFlags),
+		otel:       otel,
+	}
+}
+
+// PayoutResultFunc receives the result of a payout, an empty failureReason
+// meaning it succeeded.
+type PayoutResultFunc func(ctx context.Context, withdrawalID string, succeeded bool, failureReason string)
+
+// StubPayoutGateway accepts every payout and reports it as paid after a delay,
+// mimicking the asynchronous result of a real provider. It is meant for local
+// development.
+type StubPayoutGateway struct {
+	delay    time.Duration
+	onResult PayoutResultFunc
+	logger   *log.Logger
+}
+
+func (pg *StubPayoutGateway) RequestPayout(ctx context.Context, withdrawal *entity.Withdrawal, destination *entity.PayoutDestination) error {
+	pg.logger.Printf("Paying out withdrawal %s of %d cents of %s to %s\n", withdrawal.ID(), withdrawal.Amount(), withdrawal.Currency(), destination.Kind())
+	withdrawalID := withdrawal.ID()
+	ctx = context.WithoutCancel(ctx)
+	time.AfterFunc(pg.delay, func() {
+		pg.onResult(ctx, withdrawalID, true, "")
+	})
+	return nil
+}
+
+func NewStubPayoutGateway(delay time.Duration, onResult PayoutResultFunc) *StubPayoutGateway {
+	return &StubPayoutGateway{
+		delay:    delay,
+		onResult: onResult,
+		logger:   log.New(os.Stdout, "stub_payout_gateway: ", log.LstdFlags),
+	}
+}
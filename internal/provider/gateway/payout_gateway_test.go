@@ -0,0 +1,122 @@
+package gateway_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/gateway"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newWithdrawal(t *testing.T) (*entity.Withdrawal, *entity.PayoutDestination) {
+	t.Helper()
+	userID := "d6ae1675-5978-49d3-a6e3-619955ec6b2e"
+	destination, err := entity.NewPixKeyDestination(userID, "john@mail.com")
+	require.NoError(t, err)
+	amount, err := vo.NewMoney(5000, vo.BRL)
+	require.NoError(t, err)
+	withdrawal, err := entity.NewWithdrawal(userID, destination.ID(), amount)
+	require.NoError(t, err)
+	return withdrawal, destination
+}
+
+func TestPayoutGateway_RequestPayout_ShouldSucceedWhenPayoutIsAccepted(t *testing.T) {
+	// Arrange
+	withdrawal, destination := newWithdrawal(t)
+	mockHttpClient := &mockHTTPClient{}
+	mockHttpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		var body map[string]interface{}
+		err := json.NewDecoder(req.Body).Decode(&body)
+		return err == nil &&
+			body["withdrawal_id"] == withdrawal.ID() &&
+			body["kind"] == entity.PixKeyDestinationKind &&
+			body["pix_key"] == "john@mail.com"
+	})).Return(&http.Response{StatusCode: http.StatusAccepted, Body: http.NoBody}, nil)
+
+	payoutGateway := gateway.NewPayoutGateway(mockHttpClient, "http://payouts.local/payouts", telemetry.NewMockTelemetry())
+
+	// Act
+	err := payoutGateway.RequestPayout(context.Background(), withdrawal, destination)
+
+	// Assert
+	assert.NoError(t, err)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestPayoutGateway_RequestPayout_ShouldReturnErrorWhenPayoutIsRejected(t *testing.T) {
+	// Arrange
+	withdrawal, destination := newWithdrawal(t)
+	mockHttpClient := &mockHTTPClient{}
+	mockHttpClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody}, nil)
+
+	payoutGateway := gateway.NewPayoutGateway(mockHttpClient, "http://payouts.local/payouts", telemetry.NewMockTelemetry())
+
+	// Act
+	err := payoutGateway.RequestPayout(context.Background(), withdrawal, destination)
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrPayoutNotAccepted)
+}
+
+func TestPayoutGateway_RequestPayout_ShouldNotReportARejectionWhenTheProviderFails(t *testing.T) {
+	// Arrange
+	withdrawal, destination := newWithdrawal(t)
+	mockHttpClient := &mockHTTPClient{}
+	mockHttpClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: http.StatusBadGateway, Body: http.NoBody}, nil)
+
+	payoutGateway := gateway.NewPayoutGateway(mockHttpClient, "http://payouts.local/payouts", telemetry.NewMockTelemetry())
+
+	// Act
+	err := payoutGateway.RequestPayout(context.Background(), withdrawal, destination)
+
+	// Assert
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, errs.ErrPayoutNotAccepted)
+}
+
+func TestPayoutGateway_RequestPayout_ShouldReturnErrorWhenHTTPClientDoFails(t *testing.T) {
+	// Arrange
+	withdrawal, destination := newWithdrawal(t)
+	mockHttpClient := &mockHTTPClient{}
+	mockHttpClient.On("Do", mock.Anything).Return(nil, errors.New("connection error"))
+
+	payoutGateway := gateway.NewPayoutGateway(mockHttpClient, "http://payouts.local/payouts", telemetry.NewMockTelemetry())
+
+	// Act
+	err := payoutGateway.RequestPayout(context.Background(), withdrawal, destination)
+
+	// Assert
+	assert.EqualError(t, err, "connection error")
+}
+
+func TestStubPayoutGateway_RequestPayout_ShouldReportSuccessAfterTheDelay(t *testing.T) {
+	// Arrange
+	withdrawal, destination := newWithdrawal(t)
+	results := make(chan string, 1)
+	payoutGateway := gateway.NewStubPayoutGateway(time.Millisecond, func(ctx context.Context, withdrawalID string, succeeded bool, failureReason string) {
+		assert.True(t, succeeded)
+		results <- withdrawalID
+	})
+
+	// Act
+	err := payoutGateway.RequestPayout(context.Background(), withdrawal, destination)
+
+	// Assert
+	assert.NoError(t, err)
+	select {
+	case withdrawalID := <-results:
+		assert.Equal(t, withdrawal.ID(), withdrawalID)
+	case <-time.After(time.Second):
+		t.Fatal("payout result was not reported")
+	}
+}
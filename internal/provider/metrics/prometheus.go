@@ -33,11 +33,32 @@ var (
 		},
 	)
 
-	// TransactionAmount tracks the total amount of transactions
-	TransactionAmount = promauto.NewCounter(
+	// TransactionAmount tracks the total amount of transactions per currency
+	TransactionAmount = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "transactions_amount_total",
 			Help: "Total amount of transactions",
 		},
+		[]string{"currency"},
+	)
+
+	// BalanceDriftAccounts tracks how many balances disagreed with their
+	// history on the last reconciliation, per currency
+	BalanceDriftAccounts = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "balance_drift_accounts",
+			Help: "Number of balances that disagree with their history",
+		},
+		[]string{"currency"},
+	)
+
+	// BalanceDriftAmount tracks the sum of the absolute drifts found on the
+	// last reconciliation, per currency
+	BalanceDriftAmount = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "balance_drift_amount",
+			Help: "Total amount by which balances disagree with their history",
+		},
+		[]string{"currency"},
 	)
 )
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type AliasKeyRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allAliasKeyColumns = []string{
+	"id",
+	"user_id",
+	"key_type",
+	"value",
+	"created_at",
+}
+
+// Create loads the owner and persists the key returned by createFn. A key
+// already registered, by the owner or anyone else, results in
+// errs.ErrAliasKeyTaken.
+func (ar AliasKeyRepository) Create(ctx context.Context, userID string, createFn func(owner *entity.User) (*entity.AliasKey, error)) error {
+	return runInTx(ctx, ar.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allUserColumns, ", ") + " FROM users WHERE id = $1"
+		var ownerModel model.UserModel
+		err := tx.GetContext(ctx, &ownerModel, query, userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrUserNotFound
+		}
+		if err != nil {
+			return err
+		}
+		owner, err := restoreUser(ctx, tx, &ownerModel, "")
+		if err != nil {
+			return err
+		}
+
+		key, err := createFn(owner)
+		if err != nil {
+			return err
+		}
+
+		keyModel := model.NewAliasKeyModelFrom(key)
+		insertQuery := "INSERT INTO alias_keys (" + strings.Join(allAliasKeyColumns, ", ") + ") VALUES ($1, $2, $3, $4, $5)"
+		_, err = tx.ExecContext(
+			ctx,
+			insertQuery,
+			keyModel.ID,
+			keyModel.UserID,
+			keyModel.KeyType,
+			keyModel.Value,
+			keyModel.CreatedAt,
+		)
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode {
+			return errs.ErrAliasKeyTaken
+		}
+		return err
+	})
+}
+
+// ListByUser returns the keys of the user, the oldest first.
+func (ar AliasKeyRepository) ListByUser(ctx context.Context, userID string) ([]*entity.AliasKey, error) {
+	query := "SELECT " + strings.Join(allAliasKeyColumns, ", ") + " FROM alias_keys WHERE user_id = $1 ORDER BY created_at"
+	var keyModels []model.AliasKeyModel
+	err := ar.db.SelectContext(ctx, &keyModels, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*entity.AliasKey, 0, len(keyModels))
+	for _, keyModel := range keyModels {
+		keys = append(keys, keyModel.ToEntity())
+	}
+	return keys, nil
+}
+
+// GetByValue returns the key of the given type and normalized value.
+func (ar AliasKeyRepository) GetByValue(ctx context.Context, keyType, value string) (*entity.AliasKey, error) {
+	query := "SELECT " + strings.Join(allAliasKeyColumns, ", ") + " FROM alias_keys WHERE key_type = $1 AND value = $2"
+	var keyModel model.AliasKeyModel
+	err := ar.db.GetContext(ctx, &keyModel, query, keyType, value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrAliasKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return keyModel.ToEntity(), nil
+}
+
+// Delete removes a key of the user. Keys of other users are reported as not
+// found.
+func (ar AliasKeyRepository) Delete(ctx context.Context, userID, id string) error {
+	result, err := ar.db.ExecContext(ctx, "DELETE FROM alias_keys WHERE id = $1 AND user_id = $2", id, userID)
+	if err != nil {
+		return err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errs.ErrAliasKeyNotFound
+	}
+	return nil
+}
+
+func NewAliasKeyRepository(db *sqlx.DB, otel telemetry.Telemetry) AliasKeyRepository {
+	return AliasKeyRepository{db: db, otel: otel}
+}
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type BalanceHoldRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allBalanceHoldColumns = []string{
+	"id",
+	"sender_id",
+	"receiver_id",
+	"amount",
+	"currency",
+	"captured_amount",
+	"status",
+	"transaction_id",
+	"expires_at",
+	"created_at",
+	"updated_at",
+}
+
+// Authorize locks the sender and the receiver, in id order, and persists the
+// hold returned by authorizeFn along with its outbox events. The sender is
+// loaded with what it already sent in the current limit periods.
+func (hr BalanceHoldRepository) Authorize(ctx context.Context, senderID, receiverID string, authorizeFn func(sender, receiver *entity.User) (*entity.BalanceHold, error)) error {
+	return runInTx(ctx, hr.db, func(tx *sqlx.Tx) error {
+		users, err := lockUsers(ctx, tx, []string{senderID, receiverID})
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+		sender, ok := users[senderID]
+		if !ok {
+			return errs.ErrSenderNotFound
+		}
+		err = restoreTransferUsage(ctx, tx, sender, time.Now())
+		if err != nil {
+			return err
+		}
+
+		receiver, ok := users[receiverID]
+		if !ok {
+			return errs.ErrReceiverNotFound
+		}
+
+		hold, err := authorizeFn(sender, receiver)
+		if err != nil {
+			return err
+		}
+
+		holdModel := model.NewBalanceHoldModelFrom(hold)
+		query := "INSERT INTO balance_holds (" + strings.Join(allBalanceHoldColumns, ", ") + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			holdModel.ID,
+			holdModel.SenderID,
+			holdModel.ReceiverID,
+			holdModel.Amount,
+			holdModel.Currency,
+			holdModel.CapturedAmount,
+			holdModel.Status,
+			holdModel.TransactionID,
+			holdModel.ExpiresAt,
+			holdModel.CreatedAt,
+			holdModel.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxMessages(ctx, tx, hold.Events())
+	})
+}
+
+func (hr BalanceHoldRepository) GetBalanceHold(ctx context.Context, id string) (*entity.BalanceHold, error) {
+	query := "SELECT " + strings.Join(allBalanceHoldColumns, ", ") + " FROM balance_holds WHERE id = $1"
+	var holdModel model.BalanceHoldModel
+	err := hr.db.GetContext(ctx, &holdModel, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrBalanceHoldNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return holdModel.ToEntity()
+}
+
+// Settle locks the hold along with its sender and receiver, in id order, and
+// persists the outcome applied by settleFn: the new status of the hold, its
+// outbox events and, when settleFn returns one, the transfer moving the
+// captured money.
+func (hr BalanceHoldRepository) Settle(ctx context.Context, id string, settleFn func(hold *entity.BalanceHold, sender, receiver *entity.User) (*entity.Transaction, error)) error {
+	return runInTx(ctx, hr.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allBalanceHoldColumns, ", ") + " FROM balance_holds WHERE id = $1 FOR UPDATE"
+		var holdModel model.BalanceHoldModel
+		err := tx.GetContext(ctx, &holdModel, query, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrBalanceHoldNotFound
+		}
+		if err != nil {
+			return err
+		}
+		hold, err := holdModel.ToEntity()
+		if err != nil {
+			return err
+		}
+
+		users, err := lockUsers(ctx, tx, []string{hold.SenderID(), hold.ReceiverID()})
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+		sender, ok := users[hold.SenderID()]
+		if !ok {
+			return errs.ErrSenderNotFound
+		}
+		receiver, ok := users[hold.ReceiverID()]
+		if !ok {
+			return errs.ErrReceiverNotFound
+		}
+
+		transaction, err := settleFn(hold, sender, receiver)
+		if err != nil {
+			return err
+		}
+
+		if transaction != nil {
+			err = saveTransaction(ctx, tx, transaction, sender, receiver)
+			if err != nil {
+				return err
+			}
+		}
+
+		return updateBalanceHold(ctx, tx, hold)
+	})
+}
+
+// ExpireDue locks up to limit authorized holds that expired at now, skipping
+// the ones locked by other workers, and persists the outcome expireFn records
+// on each of them. It returns how many holds were processed.
+func (hr BalanceHoldRepository) ExpireDue(ctx context.Context, now time.Time, limit int, expireFn func(hold *entity.BalanceHold) error) (int, error) {
+	var processed int
+	err := runInTx(ctx, hr.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allBalanceHoldColumns, ", ") + ` FROM balance_holds
+		WHERE status = $1 AND expires_at <= $2
+		ORDER BY expires_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED`
+		var holdModels []model.BalanceHoldModel
+		err := tx.SelectContext(ctx, &holdModels, query, entity.BalanceHoldAuthorizedStatus, now, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, holdModel := range holdModels {
+			hold, err := holdModel.ToEntity()
+			if err != nil {
+				return err
+			}
+			err = expireFn(hold)
+			if err != nil {
+				return err
+			}
+
+			err = updateBalanceHold(ctx, tx, hold)
+			if err != nil {
+				return err
+			}
+		}
+		processed = len(holdModels)
+		return nil
+	})
+	return processed, err
+}
+
+func updateBalanceHold(ctx context.Context, tx *sqlx.Tx, hold *entity.BalanceHold) error {
+	updated := model.NewBalanceHoldModelFrom(hold)
+	query := `UPDATE balance_holds
+	SET captured_amount = $1, status = $2, transaction_id = $3, updated_at = $4
+	WHERE id = $5`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		updated.CapturedAmount,
+		updated.Status,
+		updated.TransactionID,
+		updated.UpdatedAt,
+		updated.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return insertOutboxMessages(ctx, tx, hold.Events())
+}
+
+func NewBalanceHoldRepository(db *sqlx.DB, otel telemetry.Telemetry) BalanceHoldRepository {
+	return BalanceHoldRepository{db: db, otel: otel}
+}
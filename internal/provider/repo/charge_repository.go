@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type ChargeRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allChargeColumns = []string{
+	"id",
+	"merchant_id",
+	"reference",
+	"description",
+	"amount",
+	"currency",
+	"status",
+	"payer_id",
+	"transaction_id",
+	"expires_at",
+	"created_at",
+	"updated_at",
+}
+
+// Create loads the merchant and persists the charge returned by createFn
+// along with its outbox events. A reference the merchant already used results
+// in errs.ErrChargeReferenceTaken.
+func (cr ChargeRepository) Create(ctx context.Context, merchantID string, createFn func(merchant *entity.User) (*entity.Charge, error)) error {
+	return runInTx(ctx, cr.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allUserColumns, ", ") + " FROM users WHERE id = $1"
+		var merchantModel model.UserModel
+		err := tx.GetContext(ctx, &merchantModel, query, merchantID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrUserNotFound
+		}
+		if err != nil {
+			return err
+		}
+		merchant, err := restoreUser(ctx, tx, &merchantModel, "")
+		if err != nil {
+			return err
+		}
+
+		charge, err := createFn(merchant)
+		if err != nil {
+			return err
+		}
+
+		chargeModel := model.NewChargeModelFrom(charge)
+		insertQuery := "INSERT INTO charges (" + strings.Join(allChargeColumns, ", ") + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+		_, err = tx.ExecContext(
+			ctx,
+			insertQuery,
+			chargeModel.ID,
+			chargeModel.MerchantID,
+			chargeModel.Reference,
+			chargeModel.Description,
+			chargeModel.Amount,
+			chargeModel.Currency,
+			chargeModel.Status,
+			chargeModel.PayerID,
+			chargeModel.TransactionID,
+			chargeModel.ExpiresAt,
+			chargeModel.CreatedAt,
+			chargeModel.UpdatedAt,
+		)
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode {
+			return errs.ErrChargeReferenceTaken
+		}
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxMessages(ctx, tx, charge.Events())
+	})
+}
+
+func (cr ChargeRepository) GetCharge(ctx context.Context, id string) (*entity.Charge, error) {
+	query := "SELECT " + strings.Join(allChargeColumns, ", ") + " FROM charges WHERE id = $1"
+	var chargeModel model.ChargeModel
+	err := cr.db.GetContext(ctx, &chargeModel, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrChargeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return chargeModel.ToEntity()
+}
+
+// Pay locks the charge along with the payer and the merchant, and persists
+// the transfer returned by payFn and the new state of the charge. The payer
+// is loaded with what it already sent in the current limit periods.
+func (cr ChargeRepository) Pay(ctx context.Context, id, payerID string, payFn func(charge *entity.Charge, payer, merchant *entity.User) (*entity.Transaction, error)) error {
+	return runInTx(ctx, cr.db, func(tx *sqlx.Tx) error {
+		charge, err := getChargeForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		users, err := lockUsers(ctx, tx, []string{payerID, charge.MerchantID()})
+		if err != nil {
+			return err
+		}
+		payer, ok := users[payerID]
+		if !ok {
+			return errs.ErrSenderNotFound
+		}
+		merchant, ok := users[charge.MerchantID()]
+		if !ok {
+			return errs.ErrReceiverNotFound
+		}
+		err = restoreTransferUsage(ctx, tx, payer, time.Now())
+		if err != nil {
+			return err
+		}
+
+		transaction, err := payFn(charge, payer, merchant)
+		if err != nil {
+			return err
+		}
+		err = saveTransaction(ctx, tx, transaction, payer, merchant)
+		if err != nil {
+			return err
+		}
+
+		return updateCharge(ctx, tx, charge)
+	})
+}
+
+// Cancel locks the charge and persists the outcome cancelFn records on it.
+func (cr ChargeRepository) Cancel(ctx context.Context, id string, cancelFn func(charge *entity.Charge) error) error {
+	return runInTx(ctx, cr.db, func(tx *sqlx.Tx) error {
+		charge, err := getChargeForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		err = cancelFn(charge)
+		if err != nil {
+			return err
+		}
+
+		return updateCharge(ctx, tx, charge)
+	})
+}
+
+// ExpireDue locks up to limit pending charges that expired at now, skipping
+// the ones locked by other workers, and persists the outcome expireFn records
+// on each of them. It returns how many charges were processed.
+func (cr ChargeRepository) ExpireDue(ctx context.Context, now time.Time, limit int, expireFn func(charge *entity.Charge) error) (int, error) {
+	var processed int
+	err := runInTx(ctx, cr.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allChargeColumns, ", ") + ` FROM charges
+		WHERE status = $1 AND expires_at <= $2
+		ORDER BY expires_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED`
+		var chargeModels []model.ChargeModel
+		err := tx.SelectContext(ctx, &chargeModels, query, entity.ChargePendingStatus, now, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, chargeModel := range chargeModels {
+			charge, err := chargeModel.ToEntity()
+			if err != nil {
+				return err
+			}
+			err = expireFn(charge)
+			if err != nil {
+				return err
+			}
+
+			err = updateCharge(ctx, tx, charge)
+			if err != nil {
+				return err
+			}
+		}
+		processed = len(chargeModels)
+		return nil
+	})
+	return processed, err
+}
+
+func getChargeForUpdate(ctx context.Context, tx *sqlx.Tx, id string) (*entity.Charge, error) {
+	query := "SELECT " + strings.Join(allChargeColumns, ", ") + " FROM charges WHERE id = $1 FOR UPDATE"
+	var chargeModel model.ChargeModel
+	err := tx.GetContext(ctx, &chargeModel, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrChargeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return chargeModel.ToEntity()
+}
+
+func updateCharge(ctx context.Context, tx *sqlx.Tx, charge *entity.Charge) error {
+	updated := model.NewChargeModelFrom(charge)
+	query := `UPDATE charges
+	SET status = $1, payer_id = $2, transaction_id = $3, updated_at = $4
+	WHERE id = $5`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		updated.Status,
+		updated.PayerID,
+		updated.TransactionID,
+		updated.UpdatedAt,
+		updated.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return insertOutboxMessages(ctx, tx, charge.Events())
+}
+
+func NewChargeRepository(db *sqlx.DB, otel telemetry.Telemetry) ChargeRepository {
+	return ChargeRepository{db: db, otel: otel}
+}
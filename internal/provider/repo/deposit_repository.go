@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type DepositRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+// Deposit locks the user and persists the deposit returned by depositFn along
+// with the new balance of the user, its idempotency key, ledger postings and
+// outbox events.
+func (dr DepositRepository) Deposit(ctx context.Context, userID string, depositFn func(user *entity.User) (*entity.Deposit, error)) error {
+	return runInTx(ctx, dr.db, func(tx *sqlx.Tx) error {
+		user, err := getUserForUpdate(ctx, tx, userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrUserNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		deposit, err := depositFn(user)
+		if err != nil {
+			return err
+		}
+
+		err = updateUserBalance(ctx, tx, user)
+		if err != nil {
+			return err
+		}
+
+		depositModel := model.NewDepositModelFrom(deposit)
+		query := `INSERT INTO deposits (id, user_id, amount, currency, created_at) VALUES ($1, $2, $3, $4, $5)`
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			depositModel.ID,
+			depositModel.UserID,
+			depositModel.Amount,
+			depositModel.Currency,
+			depositModel.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		if deposit.IdempotencyKey() != nil {
+			err = insertIdempotencyKey(ctx, tx, deposit.IdempotencyKey())
+			if err != nil {
+				return err
+			}
+		}
+
+		entries, err := deposit.LedgerEntries()
+		if err != nil {
+			return err
+		}
+		err = insertLedgerEntries(ctx, tx, entries)
+		if err != nil {
+			return err
+		}
+
+		err = insertOutboxMessages(ctx, tx, deposit.Events())
+		if err != nil {
+			return err
+		}
+
+		return checkLedgerBalance(ctx, tx, user)
+	})
+}
+
+func NewDepositRepository(db *sqlx.DB, otel telemetry.Telemetry) DepositRepository {
+	return DepositRepository{db: db, otel: otel}
+}
@@ -0,0 +1,295 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type DisputeRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allDisputeColumns = []string{
+	"id",
+	"transaction_id",
+	"sender_id",
+	"receiver_id",
+	"amount",
+	"frozen_amount",
+	"currency",
+	"reason",
+	"evidence",
+	"response",
+	"status",
+	"respond_by",
+	"chargeback_id",
+	"resolved_at",
+	"created_at",
+	"updated_at",
+}
+
+// Open locks the transaction and its receiver, and persists the dispute
+// returned by openFn along with its outbox events. refundedAmount is the
+// amount in cents of the received currency already refunded from the
+// transaction. A transaction disputed before results in
+// errs.ErrTransactionAlreadyDisputed.
+func (dr DisputeRepository) Open(ctx context.Context, transactionID string, openFn func(transaction *entity.Transaction, refundedAmount int64, receiver *entity.User) (*entity.Dispute, error)) error {
+	return runInTx(ctx, dr.db, func(tx *sqlx.Tx) error {
+		transaction, err := getTransactionForUpdate(ctx, tx, transactionID)
+		if err != nil {
+			return err
+		}
+		refundedAmount, err := getRefundedAmount(ctx, tx, transaction.ID())
+		if err != nil {
+			return err
+		}
+
+		users, err := lockUsers(ctx, tx, []string{transaction.ReceiverID()})
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+		receiver, ok := users[transaction.ReceiverID()]
+		if !ok {
+			return errs.ErrReceiverNotFound
+		}
+
+		dispute, err := openFn(transaction, refundedAmount, receiver)
+		if err != nil {
+			return err
+		}
+
+		disputeModel := model.NewDisputeModelFrom(dispute)
+		query := "INSERT INTO disputes (" + strings.Join(allDisputeColumns, ", ") + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			disputeModel.ID,
+			disputeModel.TransactionID,
+			disputeModel.SenderID,
+			disputeModel.ReceiverID,
+			disputeModel.Amount,
+			disputeModel.FrozenAmount,
+			disputeModel.Currency,
+			disputeModel.Reason,
+			disputeModel.Evidence,
+			disputeModel.Response,
+			disputeModel.Status,
+			disputeModel.RespondBy,
+			disputeModel.ChargebackID,
+			disputeModel.ResolvedAt,
+			disputeModel.CreatedAt,
+			disputeModel.UpdatedAt,
+		)
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode {
+			return errs.ErrTransactionAlreadyDisputed
+		}
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxMessages(ctx, tx, dispute.Events())
+	})
+}
+
+func (dr DisputeRepository) GetDispute(ctx context.Context, id string) (*entity.Dispute, error) {
+	query := "SELECT " + strings.Join(allDisputeColumns, ", ") + " FROM disputes WHERE id = $1"
+	var disputeModel model.DisputeModel
+	err := dr.db.GetContext(ctx, &disputeModel, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrDisputeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return disputeModel.ToEntity()
+}
+
+// ListUserDisputes returns the disputes the user opened or received, from the
+// most recent.
+func (dr DisputeRepository) ListUserDisputes(ctx context.Context, userID string) ([]*entity.Dispute, error) {
+	var exists bool
+	err := dr.db.GetContext(ctx, &exists, "SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)", userID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errs.ErrUserNotFound
+	}
+
+	query := "SELECT " + strings.Join(allDisputeColumns, ", ") + ` FROM disputes
+	WHERE sender_id = $1 OR receiver_id = $1
+	ORDER BY created_at DESC, id DESC`
+	var disputeModels []model.DisputeModel
+	err = dr.db.SelectContext(ctx, &disputeModels, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	disputes := make([]*entity.Dispute, 0, len(disputeModels))
+	for _, disputeModel := range disputeModels {
+		dispute, err := disputeModel.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		disputes = append(disputes, dispute)
+	}
+	return disputes, nil
+}
+
+// Respond locks the dispute and persists the outcome respondFn records on it.
+func (dr DisputeRepository) Respond(ctx context.Context, id string, respondFn func(dispute *entity.Dispute) error) error {
+	return runInTx(ctx, dr.db, func(tx *sqlx.Tx) error {
+		dispute, err := getDisputeForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		err = respondFn(dispute)
+		if err != nil {
+			return err
+		}
+
+		return updateDispute(ctx, tx, dispute)
+	})
+}
+
+// Resolve locks the dispute, the disputed transaction and both of its users,
+// and persists the outcome resolveFn records on the dispute along with the
+// chargeback it returns, if any. refundedAmount is the amount in cents of the
+// received currency already moved back from the transaction.
+func (dr DisputeRepository) Resolve(ctx context.Context, id string, resolveFn func(dispute *entity.Dispute, transaction *entity.Transaction, refundedAmount int64, receiver, sender *entity.User) (*entity.Transaction, error)) error {
+	return runInTx(ctx, dr.db, func(tx *sqlx.Tx) error {
+		dispute, err := getDisputeForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		return resolveDispute(ctx, tx, dispute, resolveFn)
+	})
+}
+
+// ResolveOverdue locks up to limit disputes whose receiver did not respond by
+// now, skipping the ones locked by other workers, and resolves each of them
+// like Resolve. It returns how many disputes were processed.
+func (dr DisputeRepository) ResolveOverdue(ctx context.Context, now time.Time, limit int, resolveFn func(dispute *entity.Dispute, transaction *entity.Transaction, refundedAmount int64, receiver, sender *entity.User) (*entity.Transaction, error)) (int, error) {
+	var processed int
+	err := runInTx(ctx, dr.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allDisputeColumns, ", ") + ` FROM disputes
+		WHERE status = $1 AND respond_by <= $2
+		ORDER BY respond_by
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED`
+		var disputeModels []model.DisputeModel
+		err := tx.SelectContext(ctx, &disputeModels, query, entity.DisputeOpenStatus, now, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, disputeModel := range disputeModels {
+			dispute, err := disputeModel.ToEntity()
+			if err != nil {
+				return err
+			}
+			err = resolveDispute(ctx, tx, dispute, resolveFn)
+			if err != nil {
+				return err
+			}
+		}
+		processed = len(disputeModels)
+		return nil
+	})
+	return processed, err
+}
+
+// resolveDispute loads the disputed transaction and its users for a locked
+// dispute, and persists what resolveFn decides.
+func resolveDispute(ctx context.Context, tx *sqlx.Tx, dispute *entity.Dispute, resolveFn func(dispute *entity.Dispute, transaction *entity.Transaction, refundedAmount int64, receiver, sender *entity.User) (*entity.Transaction, error)) error {
+	transaction, err := getTransactionForUpdate(ctx, tx, dispute.TransactionID())
+	if err != nil {
+		return err
+	}
+	refundedAmount, err := getRefundedAmount(ctx, tx, transaction.ID())
+	if err != nil {
+		return err
+	}
+
+	users, err := lockUsers(ctx, tx, []string{dispute.ReceiverID(), dispute.SenderID()})
+	if err != nil {
+		return err
+	}
+	receiver, ok := users[dispute.ReceiverID()]
+	if !ok {
+		return errs.ErrReceiverNotFound
+	}
+	sender, ok := users[dispute.SenderID()]
+	if !ok {
+		return errs.ErrSenderNotFound
+	}
+
+	chargeback, err := resolveFn(dispute, transaction, refundedAmount, receiver, sender)
+	if err != nil {
+		return err
+	}
+	if chargeback != nil {
+		err = saveTransaction(ctx, tx, chargeback, receiver, sender)
+		if err != nil {
+			return err
+		}
+	}
+
+	return updateDispute(ctx, tx, dispute)
+}
+
+func getDisputeForUpdate(ctx context.Context, tx *sqlx.Tx, id string) (*entity.Dispute, error) {
+	query := "SELECT " + strings.Join(allDisputeColumns, ", ") + " FROM disputes WHERE id = $1 FOR UPDATE"
+	var disputeModel model.DisputeModel
+	err := tx.GetContext(ctx, &disputeModel, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrDisputeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return disputeModel.ToEntity()
+}
+
+func updateDispute(ctx context.Context, tx *sqlx.Tx, dispute *entity.Dispute) error {
+	updated := model.NewDisputeModelFrom(dispute)
+	query := `UPDATE disputes
+	SET response = $1, status = $2, chargeback_id = $3, resolved_at = $4, updated_at = $5
+	WHERE id = $6`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		updated.Response,
+		updated.Status,
+		updated.ChargebackID,
+		updated.ResolvedAt,
+		updated.UpdatedAt,
+		updated.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return insertOutboxMessages(ctx, tx, dispute.Events())
+}
+
+func NewDisputeRepository(db *sqlx.DB, otel telemetry.Telemetry) DisputeRepository {
+	return DisputeRepository{db: db, otel: otel}
+}
@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type EscrowRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allEscrowColumns = []string{
+	"id",
+	"sender_id",
+	"receiver_id",
+	"amount",
+	"currency",
+	"status",
+	"expires_at",
+	"shipped_at",
+	"release_at",
+	"transaction_id",
+	"created_at",
+	"updated_at",
+}
+
+// Create locks the sender and the receiver like UserRepository.UpdateBalance
+// and persists the escrow returned by createFn along with its outbox events.
+// The sender is loaded with what it already sent in the current limit
+// periods.
+func (er EscrowRepository) Create(ctx context.Context, senderID, receiverID string, createFn func(sender, receiver *entity.User) (*entity.Escrow, error)) error {
+	return runInTx(ctx, er.db, func(tx *sqlx.Tx) error {
+		users, err := lockUsers(ctx, tx, []string{senderID, receiverID})
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+		sender, ok := users[senderID]
+		if !ok {
+			return errs.ErrSenderNotFound
+		}
+		err = restoreTransferUsage(ctx, tx, sender, time.Now())
+		if err != nil {
+			return err
+		}
+
+		receiver, ok := users[receiverID]
+		if !ok {
+			return errs.ErrReceiverNotFound
+		}
+
+		escrow, err := createFn(sender, receiver)
+		if err != nil {
+			return err
+		}
+
+		escrowModel := model.NewEscrowModelFrom(escrow)
+		query := "INSERT INTO escrows (" + strings.Join(allEscrowColumns, ", ") + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			escrowModel.ID,
+			escrowModel.SenderID,
+			escrowModel.ReceiverID,
+			escrowModel.Amount,
+			escrowModel.Currency,
+			escrowModel.Status,
+			escrowModel.ExpiresAt,
+			escrowModel.ShippedAt,
+			escrowModel.ReleaseAt,
+			escrowModel.TransactionID,
+			escrowModel.CreatedAt,
+			escrowModel.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxMessages(ctx, tx, escrow.Events())
+	})
+}
+
+func (er EscrowRepository) GetEscrow(ctx context.Context, id string) (*entity.Escrow, error) {
+	query := "SELECT " + strings.Join(allEscrowColumns, ", ") + " FROM escrows WHERE id = $1"
+	var escrowModel model.EscrowModel
+	err := er.db.GetContext(ctx, &escrowModel, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrEscrowNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return escrowModel.ToEntity()
+}
+
+// Ship locks the escrow and persists the shipment shipFn records on it.
+func (er EscrowRepository) Ship(ctx context.Context, id string, shipFn func(escrow *entity.Escrow) error) error {
+	return runInTx(ctx, er.db, func(tx *sqlx.Tx) error {
+		escrow, err := getEscrowForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		err = shipFn(escrow)
+		if err != nil {
+			return err
+		}
+
+		return updateEscrow(ctx, tx, escrow)
+	})
+}
+
+// Settle locks the escrow along with its sender and receiver, and persists
+// the outcome applied by settleFn: the new status of the escrow, its outbox
+// events and, when settleFn returns one, the transfer releasing the money.
+func (er EscrowRepository) Settle(ctx context.Context, id string, settleFn func(escrow *entity.Escrow, sender, receiver *entity.User) (*entity.Transaction, error)) error {
+	return runInTx(ctx, er.db, func(tx *sqlx.Tx) error {
+		escrow, err := getEscrowForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		return settleEscrow(ctx, tx, escrow, settleFn)
+	})
+}
+
+// SettleDue locks up to limit escrows that were not shipped by their expiry
+// or reached their release deadline at now, skipping the ones locked by
+// other workers, and settles each of them like Settle. It returns how many
+// escrows were processed.
+func (er EscrowRepository) SettleDue(ctx context.Context, now time.Time, limit int, settleFn func(escrow *entity.Escrow, sender, receiver *entity.User) (*entity.Transaction, error)) (int, error) {
+	var processed int
+	err := runInTx(ctx, er.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allEscrowColumns, ", ") + ` FROM escrows
+		WHERE (status = $1 AND expires_at <= $3) OR (status = $2 AND release_at <= $3)
+		ORDER BY created_at
+		LIMIT $4
+		FOR UPDATE SKIP LOCKED`
+		var escrowModels []model.EscrowModel
+		err := tx.SelectContext(ctx, &escrowModels, query, entity.EscrowHeldStatus, entity.EscrowShippedStatus, now, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, escrowModel := range escrowModels {
+			escrow, err := escrowModel.ToEntity()
+			if err != nil {
+				return err
+			}
+			err = settleEscrow(ctx, tx, escrow, settleFn)
+			if err != nil {
+				return err
+			}
+		}
+		processed = len(escrowModels)
+		return nil
+	})
+	return processed, err
+}
+
+// settleEscrow locks the users of a locked escrow and persists what settleFn
+// decides.
+func settleEscrow(ctx context.Context, tx *sqlx.Tx, escrow *entity.Escrow, settleFn func(escrow *entity.Escrow, sender, receiver *entity.User) (*entity.Transaction, error)) error {
+	users, err := lockUsers(ctx, tx, []string{escrow.SenderID(), escrow.ReceiverID()})
+	if err != nil {
+		return err
+	}
+	sender, ok := users[escrow.SenderID()]
+	if !ok {
+		return errs.ErrSenderNotFound
+	}
+	receiver, ok := users[escrow.ReceiverID()]
+	if !ok {
+		return errs.ErrReceiverNotFound
+	}
+
+	transaction, err := settleFn(escrow, sender, receiver)
+	if err != nil {
+		return err
+	}
+	if transaction != nil {
+		err = saveTransaction(ctx, tx, transaction, sender, receiver)
+		if err != nil {
+			return err
+		}
+	}
+
+	return updateEscrow(ctx, tx, escrow)
+}
+
+func getEscrowForUpdate(ctx context.Context, tx *sqlx.Tx, id string) (*entity.Escrow, error) {
+	query := "SELECT " + strings.Join(allEscrowColumns, ", ") + " FROM escrows WHERE id = $1 FOR UPDATE"
+	var escrowModel model.EscrowModel
+	err := tx.GetContext(ctx, &escrowModel, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrEscrowNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return escrowModel.ToEntity()
+}
+
+func updateEscrow(ctx context.Context, tx *sqlx.Tx, escrow *entity.Escrow) error {
+	updated := model.NewEscrowModelFrom(escrow)
+	query := `UPDATE escrows
+	SET status = $1, shipped_at = $2, release_at = $3, transaction_id = $4, updated_at = $5
+	WHERE id = $6`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		updated.Status,
+		updated.ShippedAt,
+		updated.ReleaseAt,
+		updated.TransactionID,
+		updated.UpdatedAt,
+		updated.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return insertOutboxMessages(ctx, tx, escrow.Events())
+}
+
+func NewEscrowRepository(db *sqlx.DB, otel telemetry.Telemetry) EscrowRepository {
+	return EscrowRepository{db: db, otel: otel}
+}
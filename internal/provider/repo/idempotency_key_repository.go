@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const (
+	uniqueViolationCode      = "23505"
+	serializationFailureCode = "40001"
+)
+
+type IdempotencyKeyRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+// GetIdempotencyKey returns the key if it exists and has not expired yet, or
+// nil otherwise.
+func (kr IdempotencyKeyRepository) GetIdempotencyKey(ctx context.Context, key string) (*entity.IdempotencyKey, error) {
+	var keyModel model.IdempotencyKeyModel
+	query := `SELECT idempotency_key, request_hash, transaction_id, created_at, expires_at
+	FROM idempotency_keys WHERE idempotency_key = $1 AND expires_at > NOW()`
+	err := kr.db.GetContext(ctx, &keyModel, query, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return keyModel.ToEntity(), nil
+}
+
+// insertIdempotencyKey stores the key of a transaction. An expired key is
+// taken over, while a live one, or one being inserted by a concurrent
+// transaction, results in errs.ErrIdempotencyKeyConflict.
+func insertIdempotencyKey(ctx context.Context, tx *sqlx.Tx, key *entity.IdempotencyKey) error {
+	query := `INSERT INTO idempotency_keys (idempotency_key, request_hash, transaction_id, created_at, expires_at)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (idempotency_key) DO UPDATE SET
+		request_hash = EXCLUDED.request_hash,
+		transaction_id = EXCLUDED.transaction_id,
+		created_at = EXCLUDED.created_at,
+		expires_at = EXCLUDED.expires_at
+	WHERE idempotency_keys.expires_at <= NOW()`
+	result, err := tx.ExecContext(
+		ctx,
+		query,
+		key.Key(),
+		key.RequestHash(),
+		key.TransactionID(),
+		key.CreatedAt(),
+		key.ExpiresAt(),
+	)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && (pqErr.Code == uniqueViolationCode || pqErr.Code == serializationFailureCode) {
+		return errs.ErrIdempotencyKeyConflict
+	}
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.ErrIdempotencyKeyConflict
+	}
+	return nil
+}
+
+func NewIdempotencyKeyRepository(db *sqlx.DB, otel telemetry.Telemetry) IdempotencyKeyRepository {
+	return IdempotencyKeyRepository{db: db, otel: otel}
+}
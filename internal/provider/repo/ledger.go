@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com/jmoiron/sqlx"
+)
+
+// insertLedgerEntries persists the postings of a movement, refusing to write
+// entries whose debits and credits do not match.
+func insertLedgerEntries(ctx context.Context, tx *sqlx.Tx, entries []*entity.LedgerEntry) error {
+	if !entity.IsBalanced(entries) {
+		return errs.ErrUnbalancedLedgerEntries
+	}
+	query := `INSERT INTO ledger_entries (id, transaction_id, account_id, direction, amount, currency, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	for _, entry := range entries {
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			entry.ID(),
+			entry.TransactionID(),
+			entry.AccountID(),
+			entry.Direction(),
+			entry.Amount(),
+			entry.Currency(),
+			entry.CreatedAt(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ledgerBalance derives the balance of an account in a currency from its
+// ledger entries.
+func ledgerBalance(ctx context.Context, q sqlx.QueryerContext, accountID, currency string) (int64, error) {
+	var balance int64
+	query := `SELECT COALESCE(SUM(CASE WHEN direction = 'credit' THEN amount ELSE -amount END), 0)
+	FROM ledger_entries WHERE account_id = $1 AND currency = $2`
+	err := sqlx.GetContext(ctx, q, &balance, query, accountID, currency)
+	return balance, err
+}
+
+// ledgerBalancesAt derives the balances an account had in each currency at a
+// moment from its ledger entries. Entries posted exactly at that moment are
+// not part of it, so the balance at the start of a period is the one before
+// its first entry and periods can be chained without counting entries twice.
+func ledgerBalancesAt(ctx context.Context, q sqlx.QueryerContext, accountID string, at time.Time) ([]*vo.Money, error) {
+	var rows []struct {
+		Currency string `db:"currency"`
+		Balance  int64  `db:"balance"`
+	}
+	query := `SELECT currency, SUM(CASE WHEN direction = 'credit' THEN amount ELSE -amount END) AS balance
+	FROM ledger_entries WHERE account_id = $1 AND created_at < $2 GROUP BY currency ORDER BY currency`
+	err := sqlx.SelectContext(ctx, q, &rows, query, accountID, at)
+	if err != nil {
+		return nil, err
+	}
+	balances := make([]*vo.Money, 0, len(rows))
+	for _, row := range rows {
+		balance, err := vo.NewMoney(row.Balance, row.Currency)
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, balance)
+	}
+	return balances, nil
+}
+
+// checkLedgerBalance makes sure the cached balances of the user match the
+// balances derived from the ledger.
+func checkLedgerBalance(ctx context.Context, tx *sqlx.Tx, user *entity.User) error {
+	for _, cached := range user.Balances() {
+		balance, err := ledgerBalance(ctx, tx, user.ID(), cached.Currency())
+		if err != nil {
+			return err
+		}
+		if balance != cached.Value() {
+			return errs.ErrLedgerBalanceMismatch
+		}
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type MandateRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allMandateColumns = []string{
+	"id",
+	"sender_id",
+	"receiver_id",
+	"amount",
+	"currency",
+	"receiver_currency",
+	"frequency",
+	"cron_expression",
+	"start_at",
+	"end_at",
+	"max_executions",
+	"execution_count",
+	"next_execution_at",
+	"status",
+	"created_at",
+	"updated_at",
+}
+
+var allMandateExecutionColumns = []string{
+	"id",
+	"mandate_id",
+	"sequence",
+	"scheduled_for",
+	"status",
+	"transaction_id",
+	"failure_reason",
+	"created_at",
+}
+
+func (mr MandateRepository) Save(ctx context.Context, mandate *entity.Mandate) error {
+	return runInTx(ctx, mr.db, func(tx *sqlx.Tx) error {
+		existsQuery := "SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)"
+		var exists bool
+		err := tx.GetContext(ctx, &exists, existsQuery, mandate.SenderID())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errs.ErrSenderNotFound
+		}
+		err = tx.GetContext(ctx, &exists, existsQuery, mandate.ReceiverID())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errs.ErrReceiverNotFound
+		}
+
+		mandateModel := model.NewMandateModelFrom(mandate)
+		query := "INSERT INTO mandates (" + strings.Join(allMandateColumns, ", ") + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			mandateModel.ID,
+			mandateModel.SenderID,
+			mandateModel.ReceiverID,
+			mandateModel.Amount,
+			mandateModel.Currency,
+			mandateModel.ReceiverCurrency,
+			mandateModel.Frequency,
+			mandateModel.CronExpression,
+			mandateModel.StartAt,
+			mandateModel.EndAt,
+			mandateModel.MaxExecutions,
+			mandateModel.ExecutionCount,
+			mandateModel.NextExecutionAt,
+			mandateModel.Status,
+			mandateModel.CreatedAt,
+			mandateModel.UpdatedAt,
+		)
+		return err
+	})
+}
+
+// ListBySender returns the mandates of the user, the most recent first.
+func (mr MandateRepository) ListBySender(ctx context.Context, senderID string) ([]*entity.Mandate, error) {
+	query := "SELECT " + strings.Join(allMandateColumns, ", ") + " FROM mandates WHERE sender_id = $1 ORDER BY created_at DESC"
+	var mandateModels []model.MandateModel
+	err := mr.db.SelectContext(ctx, &mandateModels, query, senderID)
+	if err != nil {
+		return nil, err
+	}
+
+	mandates := make([]*entity.Mandate, 0, len(mandateModels))
+	for _, mandateModel := range mandateModels {
+		mandate, err := mandateModel.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		mandates = append(mandates, mandate)
+	}
+	return mandates, nil
+}
+
+// ListExecutions returns the executions of the mandate in the order they ran.
+func (mr MandateRepository) ListExecutions(ctx context.Context, mandateID string) ([]*entity.MandateExecution, error) {
+	var exists bool
+	err := mr.db.GetContext(ctx, &exists, "SELECT EXISTS(SELECT 1 FROM mandates WHERE id = $1)", mandateID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errs.ErrMandateNotFound
+	}
+
+	query := "SELECT " + strings.Join(allMandateExecutionColumns, ", ") + " FROM mandate_executions WHERE mandate_id = $1 ORDER BY sequence"
+	var executionModels []model.MandateExecutionModel
+	err = mr.db.SelectContext(ctx, &executionModels, query, mandateID)
+	if err != nil {
+		return nil, err
+	}
+
+	executions := make([]*entity.MandateExecution, 0, len(executionModels))
+	for _, executionModel := range executionModels {
+		executions = append(executions, executionModel.ToEntity())
+	}
+	return executions, nil
+}
+
+// Update locks the mandate and persists the changes updateFn makes to it.
+func (mr MandateRepository) Update(ctx context.Context, id string, updateFn func(mandate *entity.Mandate) error) error {
+	return runInTx(ctx, mr.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allMandateColumns, ", ") + " FROM mandates WHERE id = $1 FOR UPDATE"
+		var mandateModel model.MandateModel
+		err := tx.GetContext(ctx, &mandateModel, query, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrMandateNotFound
+		}
+		if err != nil {
+			return err
+		}
+		mandate, err := mandateModel.ToEntity()
+		if err != nil {
+			return err
+		}
+
+		err = updateFn(mandate)
+		if err != nil {
+			return err
+		}
+
+		return updateMandate(ctx, tx, mandate)
+	})
+}
+
+// ProcessDue locks up to limit active mandates due at now, skipping the ones
+// locked by other schedulers, and persists the execution processFn returns
+// for each of them along with the mandate and its outbox events. A nil
+// execution leaves the mandate untouched. It returns how many mandates were
+// processed.
+func (mr MandateRepository) ProcessDue(ctx context.Context, now time.Time, limit int, processFn func(mandate *entity.Mandate) *entity.MandateExecution) (int, error) {
+	var processed int
+	err := runInTx(ctx, mr.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allMandateColumns, ", ") + ` FROM mandates
+		WHERE status = $1 AND next_execution_at <= $2
+		ORDER BY next_execution_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED`
+		var mandateModels []model.MandateModel
+		err := tx.SelectContext(ctx, &mandateModels, query, entity.MandateActiveStatus, now, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, mandateModel := range mandateModels {
+			mandate, err := mandateModel.ToEntity()
+			if err != nil {
+				return err
+			}
+			execution := processFn(mandate)
+			if execution == nil {
+				continue
+			}
+
+			err = insertMandateExecution(ctx, tx, execution)
+			if err != nil {
+				return err
+			}
+			err = updateMandate(ctx, tx, mandate)
+			if err != nil {
+				return err
+			}
+		}
+		processed = len(mandateModels)
+		return nil
+	})
+	return processed, err
+}
+
+func insertMandateExecution(ctx context.Context, tx *sqlx.Tx, execution *entity.MandateExecution) error {
+	executionModel := model.NewMandateExecutionModelFrom(execution)
+	query := "INSERT INTO mandate_executions (" + strings.Join(allMandateExecutionColumns, ", ") + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		executionModel.ID,
+		executionModel.MandateID,
+		executionModel.Sequence,
+		executionModel.ScheduledFor,
+		executionModel.Status,
+		executionModel.TransactionID,
+		executionModel.FailureReason,
+		executionModel.CreatedAt,
+	)
+	return err
+}
+
+func updateMandate(ctx context.Context, tx *sqlx.Tx, mandate *entity.Mandate) error {
+	updated := model.NewMandateModelFrom(mandate)
+	query := `UPDATE mandates
+	SET execution_count = $1, next_execution_at = $2, status = $3, updated_at = $4
+	WHERE id = $5`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		updated.ExecutionCount,
+		updated.NextExecutionAt,
+		updated.Status,
+		updated.UpdatedAt,
+		updated.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return insertOutboxMessages(ctx, tx, mandate.Events())
+}
+
+func NewMandateRepository(db *sqlx.DB, otel telemetry.Telemetry) MandateRepository {
+	return MandateRepository{db: db, otel: otel}
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/event"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type OutboxRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allOutboxColumns = []string{
+	"id",
+	"event_name",
+	"payload",
+	"status",
+	"attempts",
+	"last_error",
+	"next_attempt_at",
+	"created_at",
+	"sent_at",
+}
+
+// ProcessPending locks up to limit messages due for publication, skipping the
+// ones locked by other relays, and persists the outcome processFn records on
+// each of them. It returns how many messages were processed.
+func (or OutboxRepository) ProcessPending(ctx context.Context, limit int, processFn func(message *entity.OutboxMessage)) (int, error) {
+	var processed int
+	err := runInTx(ctx, or.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allOutboxColumns, ", ") + ` FROM outbox
+		WHERE status = $1 AND next_attempt_at <= NOW()
+		ORDER BY created_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`
+		var messages []model.OutboxMessageModel
+		err := tx.SelectContext(ctx, &messages, query, entity.OutboxPendingStatus, limit)
+		if err != nil {
+			return err
+		}
+
+		updateQuery := `UPDATE outbox
+		SET status = $1, attempts = $2, last_error = $3, next_attempt_at = $4, sent_at = $5
+		WHERE id = $6`
+		for _, messageModel := range messages {
+			message := messageModel.ToEntity()
+			processFn(message)
+
+			updated := model.NewOutboxMessageModelFrom(message)
+			_, err = tx.ExecContext(
+				ctx,
+				updateQuery,
+				updated.Status,
+				updated.Attempts,
+				updated.LastError,
+				updated.NextAttemptAt,
+				updated.SentAt,
+				updated.ID,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		processed = len(messages)
+		return nil
+	})
+	return processed, err
+}
+
+// insertOutboxMessages stores events in the outbox as part of the caller's
+// transaction.
+func insertOutboxMessages(ctx context.Context, tx *sqlx.Tx, events []event.Event) error {
+	query := `INSERT INTO outbox (id, event_name, payload, status, attempts, next_attempt_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	for _, e := range events {
+		message := model.NewOutboxMessageModelFrom(entity.NewOutboxMessage(e))
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			message.ID,
+			message.EventName,
+			message.Payload,
+			message.Status,
+			message.Attempts,
+			message.NextAttemptAt,
+			message.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewOutboxRepository(db *sqlx.DB, otel telemetry.Telemetry) OutboxRepository {
+	return OutboxRepository{db: db, otel: otel}
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type PayoutDestinationRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+func (pr PayoutDestinationRepository) Save(ctx context.Context, destination *entity.PayoutDestination) error {
+	return runInTx(ctx, pr.db, func(tx *sqlx.Tx) error {
+		var exists bool
+		err := tx.GetContext(ctx, &exists, "SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)", destination.UserID())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errs.ErrUserNotFound
+		}
+
+		destinationModel := model.NewPayoutDestinationModelFrom(destination)
+		query := `INSERT INTO payout_destinations (id, user_id, kind, bank_code, branch, account_number, pix_key, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			destinationModel.ID,
+			destinationModel.UserID,
+			destinationModel.Kind,
+			destinationModel.BankCode,
+			destinationModel.Branch,
+			destinationModel.AccountNumber,
+			destinationModel.PixKey,
+			destinationModel.CreatedAt,
+		)
+		return err
+	})
+}
+
+func (pr PayoutDestinationRepository) GetPayoutDestination(ctx context.Context, id string) (*entity.PayoutDestination, error) {
+	var destinationModel model.PayoutDestinationModel
+	query := `SELECT id, user_id, kind, bank_code, branch, account_number, pix_key, created_at
+	FROM payout_destinations WHERE id = $1`
+	err := pr.db.GetContext(ctx, &destinationModel, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrPayoutDestinationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return destinationModel.ToEntity(), nil
+}
+
+func NewPayoutDestinationRepository(db *sqlx.DB, otel telemetry.Telemetry) PayoutDestinationRepository {
+	return PayoutDestinationRepository{db: db, otel: otel}
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type ReconciliationRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+// balanceChecksQuery recomputes every balance from the movements that
+// produced it: opening balances posted when the ledger was introduced,
+// transfers sent and received net of their fees, deposits and withdrawals
+// that did not fail. It is compared with users.balance for the default
+// currency and user_balances for the other ones.
+const balanceChecksQuery = `WITH movements AS (
+	SELECT account_id AS user_id, currency, CASE WHEN direction = 'credit' THEN amount ELSE -amount END AS amount
+	FROM ledger_entries WHERE transaction_id IS NULL AND account_id NOT LIKE 'system:%'
+	UNION ALL
+	SELECT receiver_id, received_currency, received_amount - fee FROM transactions
+	UNION ALL
+	SELECT sender_id, currency, -amount FROM transactions
+	UNION ALL
+	SELECT user_id, currency, amount FROM deposits
+	UNION ALL
+	SELECT user_id, currency, -amount FROM withdrawals WHERE status <> 'failed'
+), expected AS (
+	SELECT user_id, currency, SUM(amount) AS balance FROM movements GROUP BY user_id, currency
+), cached AS (
+	SELECT id AS user_id, $1::CHAR(3) AS currency, balance FROM users
+	UNION ALL
+	SELECT user_id, currency, balance FROM user_balances
+)
+SELECT
+	COALESCE(c.user_id, e.user_id) AS user_id,
+	COALESCE(c.currency, e.currency) AS currency,
+	COALESCE(c.balance, 0) AS balance,
+	COALESCE(e.balance, 0) AS expected_balance
+FROM cached c
+FULL OUTER JOIN expected e ON e.user_id = c.user_id AND e.currency = c.currency
+ORDER BY 1, 2`
+
+// ListBalanceChecks returns every balance of every user along with the
+// balance expected from its history.
+func (rr ReconciliationRepository) ListBalanceChecks(ctx context.Context) ([]*entity.BalanceCheck, error) {
+	var checkModels []model.BalanceCheckModel
+	err := rr.db.SelectContext(ctx, &checkModels, balanceChecksQuery, vo.DefaultCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]*entity.BalanceCheck, 0, len(checkModels))
+	for _, checkModel := range checkModels {
+		checks = append(checks, checkModel.ToEntity())
+	}
+	return checks, nil
+}
+
+func NewReconciliationRepository(db *sqlx.DB, otel telemetry.Telemetry) ReconciliationRepository {
+	return ReconciliationRepository{db: db, otel: otel}
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type ScheduledTransferRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allScheduledTransferColumns = []string{
+	"id",
+	"sender_id",
+	"receiver_id",
+	"amount",
+	"currency",
+	"receiver_currency",
+	"execute_at",
+	"status",
+	"transaction_id",
+	"failure_reason",
+	"idempotency_key",
+	"request_hash",
+	"created_at",
+	"updated_at",
+}
+
+func (sr ScheduledTransferRepository) Save(ctx context.Context, scheduledTransfer *entity.ScheduledTransfer) error {
+	return runInTx(ctx, sr.db, func(tx *sqlx.Tx) error {
+		existsQuery := "SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)"
+		var exists bool
+		err := tx.GetContext(ctx, &exists, existsQuery, scheduledTransfer.SenderID())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errs.ErrSenderNotFound
+		}
+		err = tx.GetContext(ctx, &exists, existsQuery, scheduledTransfer.ReceiverID())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errs.ErrReceiverNotFound
+		}
+
+		scheduledModel := model.NewScheduledTransferModelFrom(scheduledTransfer)
+		query := "INSERT INTO scheduled_transfers (" + strings.Join(allScheduledTransferColumns, ", ") + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			scheduledModel.ID,
+			scheduledModel.SenderID,
+			scheduledModel.ReceiverID,
+			scheduledModel.Amount,
+			scheduledModel.Currency,
+			scheduledModel.ReceiverCurrency,
+			scheduledModel.ExecuteAt,
+			scheduledModel.Status,
+			scheduledModel.TransactionID,
+			scheduledModel.FailureReason,
+			scheduledModel.RequestKey,
+			scheduledModel.RequestHash,
+			scheduledModel.CreatedAt,
+			scheduledModel.UpdatedAt,
+		)
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode {
+			return errs.ErrIdempotencyKeyConflict
+		}
+		return err
+	})
+}
+
+// GetByRequestKey returns the transfer scheduled with the idempotency key.
+func (sr ScheduledTransferRepository) GetByRequestKey(ctx context.Context, key string) (*entity.ScheduledTransfer, error) {
+	query := "SELECT " + strings.Join(allScheduledTransferColumns, ", ") + " FROM scheduled_transfers WHERE idempotency_key = $1"
+	var scheduledModel model.ScheduledTransferModel
+	err := sr.db.GetContext(ctx, &scheduledModel, query, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrScheduledTransferNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return scheduledModel.ToEntity()
+}
+
+// ListBySender returns the transfers scheduled by the user, the next ones to
+// run first.
+func (sr ScheduledTransferRepository) ListBySender(ctx context.Context, senderID string) ([]*entity.ScheduledTransfer, error) {
+	query := "SELECT " + strings.Join(allScheduledTransferColumns, ", ") + " FROM scheduled_transfers WHERE sender_id = $1 ORDER BY execute_at, created_at"
+	var scheduledModels []model.ScheduledTransferModel
+	err := sr.db.SelectContext(ctx, &scheduledModels, query, senderID)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledTransfers := make([]*entity.ScheduledTransfer, 0, len(scheduledModels))
+	for _, scheduledModel := range scheduledModels {
+		scheduledTransfer, err := scheduledModel.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		scheduledTransfers = append(scheduledTransfers, scheduledTransfer)
+	}
+	return scheduledTransfers, nil
+}
+
+// Update locks the scheduled transfer and persists the changes updateFn makes
+// to it.
+func (sr ScheduledTransferRepository) Update(ctx context.Context, id string, updateFn func(scheduledTransfer *entity.ScheduledTransfer) error) error {
+	return runInTx(ctx, sr.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allScheduledTransferColumns, ", ") + " FROM scheduled_transfers WHERE id = $1 FOR UPDATE"
+		var scheduledModel model.ScheduledTransferModel
+		err := tx.GetContext(ctx, &scheduledModel, query, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrScheduledTransferNotFound
+		}
+		if err != nil {
+			return err
+		}
+		scheduledTransfer, err := scheduledModel.ToEntity()
+		if err != nil {
+			return err
+		}
+
+		err = updateFn(scheduledTransfer)
+		if err != nil {
+			return err
+		}
+
+		return updateScheduledTransfer(ctx, tx, scheduledTransfer)
+	})
+}
+
+// ProcessDue locks up to limit scheduled transfers due at now, skipping the
+// ones locked by other schedulers, and persists the outcome processFn records
+// on each of them along with their outbox events. It returns how many
+// transfers were processed.
+func (sr ScheduledTransferRepository) ProcessDue(ctx context.Context, now time.Time, limit int, processFn func(scheduledTransfer *entity.ScheduledTransfer)) (int, error) {
+	var processed int
+	err := runInTx(ctx, sr.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allScheduledTransferColumns, ", ") + ` FROM scheduled_transfers
+		WHERE status = $1 AND execute_at <= $2
+		ORDER BY execute_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED`
+		var scheduledModels []model.ScheduledTransferModel
+		err := tx.SelectContext(ctx, &scheduledModels, query, entity.ScheduledTransferScheduledStatus, now, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, scheduledModel := range scheduledModels {
+			scheduledTransfer, err := scheduledModel.ToEntity()
+			if err != nil {
+				return err
+			}
+			processFn(scheduledTransfer)
+
+			err = updateScheduledTransfer(ctx, tx, scheduledTransfer)
+			if err != nil {
+				return err
+			}
+		}
+		processed = len(scheduledModels)
+		return nil
+	})
+	return processed, err
+}
+
+func updateScheduledTransfer(ctx context.Context, tx *sqlx.Tx, scheduledTransfer *entity.ScheduledTransfer) error {
+	updated := model.NewScheduledTransferModelFrom(scheduledTransfer)
+	query := `UPDATE scheduled_transfers
+	SET status = $1, transaction_id = $2, failure_reason = $3, updated_at = $4
+	WHERE id = $5`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		updated.Status,
+		updated.TransactionID,
+		updated.FailureReason,
+		updated.UpdatedAt,
+		updated.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return insertOutboxMessages(ctx, tx, scheduledTransfer.Events())
+}
+
+func NewScheduledTransferRepository(db *sqlx.DB, otel telemetry.Telemetry) ScheduledTransferRepository {
+	return ScheduledTransferRepository{db: db, otel: otel}
+}
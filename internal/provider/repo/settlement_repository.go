@@ -0,0 +1,334 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type SettlementRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allSettlementColumns = []string{
+	"id",
+	"merchant_id",
+	"settlement_date",
+	"currency",
+	"gross",
+	"fee",
+	"transaction_count",
+	"status",
+	"closed_at",
+	"paid_at",
+	"created_at",
+	"updated_at",
+}
+
+// settlementKey identifies the settlement a transfer belongs to.
+type settlementKey struct {
+	merchantID string
+	date       time.Time
+	currency   string
+}
+
+// SettleTransactions locks up to limit transactions of merchants that are in
+// no settlement yet, oldest first, skipping the ones locked by other workers.
+// Those are the transfers merchants received and the refunds and chargebacks
+// they paid back. addFn is called with each of them and the settlement of its
+// merchant, day and currency, nil when there is none yet, and the settlement
+// it returns is persisted along with the transaction. It returns how many
+// transactions were processed.
+func (sr SettlementRepository) SettleTransactions(ctx context.Context, limit int, addFn func(settlement *entity.Settlement, transaction *entity.Transaction) (*entity.Settlement, error)) (int, error) {
+	var processed int
+	err := runInTx(ctx, sr.db, func(tx *sqlx.Tx) error {
+		columns := make([]string, 0, len(allTransactionColumns))
+		for _, column := range allTransactionColumns {
+			columns = append(columns, "t."+column)
+		}
+		query := "SELECT " + strings.Join(columns, ", ") + ` FROM transactions t
+		JOIN users u ON u.id = CASE WHEN t.kind = $2 THEN t.receiver_id ELSE t.sender_id END
+		WHERE u.user_type = $1 AND t.kind IN ($2, $3, $4)
+		AND NOT EXISTS (SELECT 1 FROM settlement_transactions st WHERE st.transaction_id = t.id)
+		ORDER BY t.created_at, t.id
+		LIMIT $5
+		FOR UPDATE OF t SKIP LOCKED`
+		var transactionModels []model.TransactionModel
+		err := tx.SelectContext(
+			ctx,
+			&transactionModels,
+			query,
+			vo.MerchantUserType,
+			entity.TransferTransactionKind,
+			entity.RefundTransactionKind,
+			entity.ChargebackTransactionKind,
+			limit,
+		)
+		if err != nil {
+			return err
+		}
+
+		settlements := make(map[settlementKey]*entity.Settlement)
+		stored := make(map[string]bool)
+		var order []settlementKey
+		links := make(map[string]string, len(transactionModels))
+		for _, transactionModel := range transactionModels {
+			transaction, err := transactionModel.ToEntity()
+			if err != nil {
+				return err
+			}
+			merchantID, currency := entity.SettlementAccount(transaction)
+			key := settlementKey{
+				merchantID: merchantID,
+				date:       entity.SettlementDate(transaction.CreatedAt()),
+				currency:   currency,
+			}
+			settlement, ok := settlements[key]
+			if !ok {
+				settlement, err = getSettlementByKeyForUpdate(ctx, tx, key)
+				if err != nil {
+					return err
+				}
+				if settlement != nil {
+					stored[settlement.ID()] = true
+				}
+				order = append(order, key)
+			}
+
+			settlement, err = addFn(settlement, transaction)
+			if err != nil {
+				return err
+			}
+			settlements[key] = settlement
+			links[transaction.ID()] = settlement.ID()
+		}
+
+		for _, key := range order {
+			settlement := settlements[key]
+			if stored[settlement.ID()] {
+				err = updateSettlement(ctx, tx, settlement)
+			} else {
+				err = insertSettlement(ctx, tx, settlement)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		for transactionID, settlementID := range links {
+			_, err = tx.ExecContext(
+				ctx,
+				"INSERT INTO settlement_transactions (transaction_id, settlement_id) VALUES ($1, $2)",
+				transactionID,
+				settlementID,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		processed = len(transactionModels)
+		return nil
+	})
+	return processed, err
+}
+
+// CloseDue locks up to limit open settlements of days before the one of
+// before, skipping the ones locked by other workers, and persists the outcome
+// closeFn records on each of them. It returns how many settlements were
+// processed.
+func (sr SettlementRepository) CloseDue(ctx context.Context, before time.Time, limit int, closeFn func(settlement *entity.Settlement) error) (int, error) {
+	var processed int
+	err := runInTx(ctx, sr.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allSettlementColumns, ", ") + ` FROM settlements
+		WHERE status = $1 AND settlement_date < $2
+		ORDER BY settlement_date
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED`
+		var settlementModels []model.SettlementModel
+		err := tx.SelectContext(ctx, &settlementModels, query, entity.SettlementOpenStatus, entity.SettlementDate(before), limit)
+		if err != nil {
+			return err
+		}
+
+		for _, settlementModel := range settlementModels {
+			settlement := settlementModel.ToEntity()
+			err = closeFn(settlement)
+			if err != nil {
+				return err
+			}
+
+			err = updateSettlement(ctx, tx, settlement)
+			if err != nil {
+				return err
+			}
+		}
+		processed = len(settlementModels)
+		return nil
+	})
+	return processed, err
+}
+
+// MarkPaid locks the settlement and persists the outcome payFn records on
+// it.
+func (sr SettlementRepository) MarkPaid(ctx context.Context, id string, payFn func(settlement *entity.Settlement) error) error {
+	return runInTx(ctx, sr.db, func(tx *sqlx.Tx) error {
+		query := "SELECT " + strings.Join(allSettlementColumns, ", ") + " FROM settlements WHERE id = $1 FOR UPDATE"
+		var settlementModel model.SettlementModel
+		err := tx.GetContext(ctx, &settlementModel, query, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrSettlementNotFound
+		}
+		if err != nil {
+			return err
+		}
+		settlement := settlementModel.ToEntity()
+
+		err = payFn(settlement)
+		if err != nil {
+			return err
+		}
+
+		return updateSettlement(ctx, tx, settlement)
+	})
+}
+
+// ListMerchantSettlements returns the settlements of the merchant from the
+// most recent day, only the ones in status when it is not empty.
+func (sr SettlementRepository) ListMerchantSettlements(ctx context.Context, merchantID, status string) ([]*entity.Settlement, error) {
+	query := "SELECT " + strings.Join(allSettlementColumns, ", ") + " FROM settlements WHERE merchant_id = $1"
+	args := []any{merchantID}
+	if status != "" {
+		query += " AND status = $2"
+		args = append(args, status)
+	}
+	query += " ORDER BY settlement_date DESC, currency"
+	var settlementModels []model.SettlementModel
+	err := sr.db.SelectContext(ctx, &settlementModels, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	settlements := make([]*entity.Settlement, 0, len(settlementModels))
+	for _, settlementModel := range settlementModels {
+		settlements = append(settlements, settlementModel.ToEntity())
+	}
+	return settlements, nil
+}
+
+// ListSettlementTransactions returns the transfers grouped in the
+// settlement, from the oldest.
+func (sr SettlementRepository) ListSettlementTransactions(ctx context.Context, id string) ([]*entity.Transaction, error) {
+	var exists bool
+	err := sr.db.GetContext(ctx, &exists, "SELECT EXISTS(SELECT 1 FROM settlements WHERE id = $1)", id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errs.ErrSettlementNotFound
+	}
+
+	columns := make([]string, 0, len(allTransactionColumns))
+	for _, column := range allTransactionColumns {
+		columns = append(columns, "t."+column)
+	}
+	query := "SELECT " + strings.Join(columns, ", ") + ` FROM transactions t
+	JOIN settlement_transactions st ON st.transaction_id = t.id
+	WHERE st.settlement_id = $1
+	ORDER BY t.created_at, t.id`
+	var transactionModels []model.TransactionModel
+	err = sr.db.SelectContext(ctx, &transactionModels, query, id)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entity.Transaction, 0, len(transactionModels))
+	for _, transactionModel := range transactionModels {
+		transaction, err := transactionModel.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, transaction)
+	}
+	return transactions, nil
+}
+
+// getSettlementByKeyForUpdate locks the settlement of the merchant, day and
+// currency, returning nil when there is none.
+func getSettlementByKeyForUpdate(ctx context.Context, tx *sqlx.Tx, key settlementKey) (*entity.Settlement, error) {
+	query := "SELECT " + strings.Join(allSettlementColumns, ", ") + ` FROM settlements
+	WHERE merchant_id = $1 AND settlement_date = $2 AND currency = $3
+	FOR UPDATE`
+	var settlementModel model.SettlementModel
+	err := tx.GetContext(ctx, &settlementModel, query, key.merchantID, key.date, key.currency)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return settlementModel.ToEntity(), nil
+}
+
+func insertSettlement(ctx context.Context, tx *sqlx.Tx, settlement *entity.Settlement) error {
+	settlementModel := model.NewSettlementModelFrom(settlement)
+	query := "INSERT INTO settlements (" + strings.Join(allSettlementColumns, ", ") + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		settlementModel.ID,
+		settlementModel.MerchantID,
+		settlementModel.SettlementDate,
+		settlementModel.Currency,
+		settlementModel.Gross,
+		settlementModel.Fee,
+		settlementModel.TransactionCount,
+		settlementModel.Status,
+		settlementModel.ClosedAt,
+		settlementModel.PaidAt,
+		settlementModel.CreatedAt,
+		settlementModel.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return insertOutboxMessages(ctx, tx, settlement.Events())
+}
+
+func updateSettlement(ctx context.Context, tx *sqlx.Tx, settlement *entity.Settlement) error {
+	updated := model.NewSettlementModelFrom(settlement)
+	query := `UPDATE settlements
+	SET gross = $1, fee = $2, transaction_count = $3, status = $4, closed_at = $5, paid_at = $6, updated_at = $7
+	WHERE id = $8`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		updated.Gross,
+		updated.Fee,
+		updated.TransactionCount,
+		updated.Status,
+		updated.ClosedAt,
+		updated.PaidAt,
+		updated.UpdatedAt,
+		updated.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return insertOutboxMessages(ctx, tx, settlement.Events())
+}
+
+func NewSettlementRepository(db *sqlx.DB, otel telemetry.Telemetry) SettlementRepository {
+	return SettlementRepository{db: db, otel: otel}
+}
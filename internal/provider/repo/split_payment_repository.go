@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type SplitPaymentRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allSplitPaymentColumns = []string{
+	"id",
+	"sender_id",
+	"amount",
+	"currency",
+	"created_at",
+}
+
+var allSplitShareColumns = []string{
+	"split_payment_id",
+	"sequence",
+	"receiver_id",
+	"amount",
+	"percentage",
+	"transaction_id",
+}
+
+// Pay locks the sender and every receiver of the split payment, in id order,
+// and persists the transfers returned by payFn along with the payment. The
+// receivers are passed in the order of the shares, and the sender is loaded
+// with what it already sent in the current limit periods.
+func (sr SplitPaymentRepository) Pay(ctx context.Context, splitPayment *entity.SplitPayment, payFn func(sender *entity.User, receivers []*entity.User) ([]*entity.Transaction, error)) error {
+	return runInTx(ctx, sr.db, func(tx *sqlx.Tx) error {
+		users, err := lockUsers(ctx, tx, append(splitPayment.ReceiverIDs(), splitPayment.SenderID()))
+		if err != nil {
+			return err
+		}
+		sender, ok := users[splitPayment.SenderID()]
+		if !ok {
+			return errs.ErrSenderNotFound
+		}
+		err = restoreTransferUsage(ctx, tx, sender, time.Now())
+		if err != nil {
+			return err
+		}
+		receivers := make([]*entity.User, 0, len(splitPayment.Shares()))
+		for _, receiverID := range splitPayment.ReceiverIDs() {
+			receiver, ok := users[receiverID]
+			if !ok {
+				return errs.ErrReceiverNotFound
+			}
+			receivers = append(receivers, receiver)
+		}
+
+		transactions, err := payFn(sender, receivers)
+		if err != nil {
+			return err
+		}
+		err = saveTransactions(ctx, tx, transactions, users)
+		if err != nil {
+			return err
+		}
+
+		splitModel := model.NewSplitPaymentModelFrom(splitPayment)
+		query := "INSERT INTO split_payments (" + strings.Join(allSplitPaymentColumns, ", ") + `)
+		VALUES ($1, $2, $3, $4, $5)`
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			splitModel.ID,
+			splitModel.SenderID,
+			splitModel.Amount,
+			splitModel.Currency,
+			splitModel.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		shareQuery := "INSERT INTO split_payment_shares (" + strings.Join(allSplitShareColumns, ", ") + `)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+		for _, share := range splitPayment.Shares() {
+			shareModel := model.NewSplitShareModelFrom(splitPayment.ID(), share)
+			_, err = tx.ExecContext(
+				ctx,
+				shareQuery,
+				shareModel.SplitPaymentID,
+				shareModel.Sequence,
+				shareModel.ReceiverID,
+				shareModel.Amount,
+				shareModel.Percentage,
+				shareModel.TransactionID,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (sr SplitPaymentRepository) GetSplitPayment(ctx context.Context, id string) (*entity.SplitPayment, error) {
+	query := "SELECT " + strings.Join(allSplitPaymentColumns, ", ") + " FROM split_payments WHERE id = $1"
+	var splitModel model.SplitPaymentModel
+	err := sr.db.GetContext(ctx, &splitModel, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrSplitPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sharesQuery := "SELECT " + strings.Join(allSplitShareColumns, ", ") + " FROM split_payment_shares WHERE split_payment_id = $1 ORDER BY sequence"
+	var shareModels []model.SplitShareModel
+	err = sr.db.SelectContext(ctx, &shareModels, sharesQuery, id)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]*entity.SplitShare, 0, len(shareModels))
+	for _, shareModel := range shareModels {
+		shares = append(shares, shareModel.ToEntity())
+	}
+	return splitModel.ToEntity(shares)
+}
+
+func NewSplitPaymentRepository(db *sqlx.DB, otel telemetry.Telemetry) SplitPaymentRepository {
+	return SplitPaymentRepository{db: db, otel: otel}
+}
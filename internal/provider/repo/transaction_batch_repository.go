@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type TransactionBatchRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allTransactionBatchColumns = []string{
+	"id",
+	"atomic",
+	"status",
+	"created_at",
+	"updated_at",
+}
+
+var allTransactionBatchItemColumns = []string{
+	"id",
+	"batch_id",
+	"sequence",
+	"sender_id",
+	"receiver_id",
+	"amount",
+	"currency",
+	"status",
+	"transaction_id",
+	"failure_reason",
+	"created_at",
+}
+
+// Execute runs transferFn for each item of the batch, in order, and persists
+// the batch along with the transactions of the items that succeeded. Every
+// user of the batch is locked once, in id order so concurrent batches cannot
+// deadlock, and shared by all its items. Items whose users do not exist or
+// whose transferFn fails are recorded as failed; when an atomic batch has any
+// failed item no transaction is saved at all.
+func (br TransactionBatchRepository) Execute(ctx context.Context, batch *entity.TransactionBatch, transferFn func(item *entity.TransactionBatchItem, sender, receiver *entity.User) (*entity.Transaction, error)) error {
+	return runInTx(ctx, br.db, func(tx *sqlx.Tx) error {
+		users, err := lockBatchUsers(ctx, tx, batch)
+		if err != nil {
+			return err
+		}
+
+		transactions := make(map[string]*entity.Transaction, len(batch.Items()))
+		for _, item := range batch.Items() {
+			sender, ok := users[item.SenderID()]
+			if !ok {
+				item.Fail(errs.ErrSenderNotFound.Error())
+				continue
+			}
+			receiver, ok := users[item.ReceiverID()]
+			if !ok {
+				item.Fail(errs.ErrReceiverNotFound.Error())
+				continue
+			}
+
+			transaction, err := transferFn(item, sender, receiver)
+			if err != nil {
+				item.Fail(err.Error())
+				continue
+			}
+			item.Succeed(transaction.ID())
+			transactions[item.ID()] = transaction
+		}
+		batch.Complete(time.Now())
+
+		err = insertTransactionBatch(ctx, tx, batch)
+		if err != nil {
+			return err
+		}
+
+		// No item of a failed atomic batch succeeded, so the balances its
+		// users reached in memory are discarded along with its transactions
+		succeeded := make([]*entity.Transaction, 0, len(transactions))
+		for _, item := range batch.Items() {
+			if item.Status() == entity.TransactionBatchItemSucceededStatus {
+				succeeded = append(succeeded, transactions[item.ID()])
+			}
+		}
+		err = saveTransactions(ctx, tx, succeeded, users)
+		if err != nil {
+			return err
+		}
+
+		return insertTransactionBatchItems(ctx, tx, batch)
+	})
+}
+
+func (br TransactionBatchRepository) GetTransactionBatch(ctx context.Context, id string) (*entity.TransactionBatch, error) {
+	query := "SELECT " + strings.Join(allTransactionBatchColumns, ", ") + " FROM transaction_batches WHERE id = $1"
+	var batchModel model.TransactionBatchModel
+	err := br.db.GetContext(ctx, &batchModel, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrTransactionBatchNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	itemsQuery := "SELECT " + strings.Join(allTransactionBatchItemColumns, ", ") + " FROM transaction_batch_items WHERE batch_id = $1 ORDER BY sequence"
+	var itemModels []model.TransactionBatchItemModel
+	err = br.db.SelectContext(ctx, &itemModels, itemsQuery, id)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*entity.TransactionBatchItem, 0, len(itemModels))
+	for _, itemModel := range itemModels {
+		item, err := itemModel.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return batchModel.ToEntity(items), nil
+}
+
+// lockBatchUsers locks every sender and receiver of the batch that exists,
+// loading senders with what they already sent in the current limit periods.
+func lockBatchUsers(ctx context.Context, tx *sqlx.Tx, batch *entity.TransactionBatch) (map[string]*entity.User, error) {
+	userIDs := make([]string, 0, 2*len(batch.Items()))
+	for _, item := range batch.Items() {
+		userIDs = append(userIDs, item.SenderID(), item.ReceiverID())
+	}
+	users, err := lockUsers(ctx, tx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	restored := map[string]bool{}
+	for _, item := range batch.Items() {
+		sender, ok := users[item.SenderID()]
+		if !ok || restored[item.SenderID()] {
+			continue
+		}
+		err = restoreTransferUsage(ctx, tx, sender, now)
+		if err != nil {
+			return nil, err
+		}
+		restored[item.SenderID()] = true
+	}
+	return users, nil
+}
+
+func insertTransactionBatch(ctx context.Context, tx *sqlx.Tx, batch *entity.TransactionBatch) error {
+	batchModel := model.NewTransactionBatchModelFrom(batch)
+	query := "INSERT INTO transaction_batches (" + strings.Join(allTransactionBatchColumns, ", ") + `)
+	VALUES ($1, $2, $3, $4, $5)`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		batchModel.ID,
+		batchModel.Atomic,
+		batchModel.Status,
+		batchModel.CreatedAt,
+		batchModel.UpdatedAt,
+	)
+	return err
+}
+
+func insertTransactionBatchItems(ctx context.Context, tx *sqlx.Tx, batch *entity.TransactionBatch) error {
+	query := "INSERT INTO transaction_batch_items (" + strings.Join(allTransactionBatchItemColumns, ", ") + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+	for _, item := range batch.Items() {
+		itemModel := model.NewTransactionBatchItemModelFrom(item)
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			itemModel.ID,
+			itemModel.BatchID,
+			itemModel.Sequence,
+			itemModel.SenderID,
+			itemModel.ReceiverID,
+			itemModel.Amount,
+			itemModel.Currency,
+			itemModel.Status,
+			itemModel.TransactionID,
+			itemModel.FailureReason,
+			itemModel.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewTransactionBatchRepository(db *sqlx.DB, otel telemetry.Telemetry) TransactionBatchRepository {
+	return TransactionBatchRepository{db: db, otel: otel}
+}
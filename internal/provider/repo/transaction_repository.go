@@ -0,0 +1,384 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type TransactionRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+var allTransactionColumns = []string{
+	"id",
+	"sender_id",
+	"receiver_id",
+	"amount",
+	"currency",
+	"received_amount",
+	"received_currency",
+	"exchange_rate",
+	"fee",
+	"kind",
+	"original_transaction_id",
+	"created_at",
+}
+
+// Refund locks the original transaction and both of its users, in id order,
+// and persists the compensating transaction returned by refundFn.
+// refundedAmount is the amount in cents of the received currency already
+// refunded or charged back from the original transaction. The payer of a
+// refund is the receiver of the original transaction. Transactions with a
+// pending dispute result in errs.ErrTransactionDisputed, as the dispute
+// decides whether the money goes back.
+func (tr TransactionRepository) Refund(ctx context.Context, transactionID string, refundFn func(original *entity.Transaction, refundedAmount int64, payer, payee *entity.User) (*entity.Transaction, error)) error {
+	return runInTx(ctx, tr.db, func(tx *sqlx.Tx) error {
+		original, err := getTransactionForUpdate(ctx, tx, transactionID)
+		if err != nil {
+			return err
+		}
+
+		refundedAmount, err := getRefundedAmount(ctx, tx, original.ID())
+		if err != nil {
+			return err
+		}
+
+		var disputed bool
+		err = tx.GetContext(ctx, &disputed, "SELECT EXISTS(SELECT 1 FROM disputes WHERE transaction_id = $1 AND status IN ($2, $3))", original.ID(), entity.DisputeOpenStatus, entity.DisputeRespondedStatus)
+		if err != nil {
+			return err
+		}
+		if disputed {
+			return errs.ErrTransactionDisputed
+		}
+
+		users, err := lockUsers(ctx, tx, []string{original.ReceiverID(), original.SenderID()})
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+		payer, ok := users[original.ReceiverID()]
+		if !ok {
+			return errs.ErrSenderNotFound
+		}
+		payee, ok := users[original.SenderID()]
+		if !ok {
+			return errs.ErrReceiverNotFound
+		}
+
+		refund, err := refundFn(original, refundedAmount, payer, payee)
+		if err != nil {
+			return err
+		}
+
+		return saveTransaction(ctx, tx, refund, payer, payee)
+	})
+}
+
+func getTransactionForUpdate(ctx context.Context, tx *sqlx.Tx, id string) (*entity.Transaction, error) {
+	query := "SELECT " + strings.Join(allTransactionColumns, ", ") + " FROM transactions WHERE id = $1 FOR UPDATE"
+	var transactionModel model.TransactionModel
+	err := tx.GetContext(ctx, &transactionModel, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrTransactionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return transactionModel.ToEntity()
+}
+
+// getRefundedAmount returns the cents of the received currency moved back
+// from the transaction by refunds and chargebacks.
+func getRefundedAmount(ctx context.Context, tx *sqlx.Tx, transactionID string) (int64, error) {
+	var refundedAmount int64
+	query := "SELECT COALESCE(SUM(amount), 0) FROM transactions WHERE original_transaction_id = $1 AND kind IN ($2, $3)"
+	err := tx.GetContext(ctx, &refundedAmount, query, transactionID, entity.RefundTransactionKind, entity.ChargebackTransactionKind)
+	return refundedAmount, err
+}
+
+// saveTransaction persists the balances of the users involved in a
+// transaction, the transaction itself with its idempotency key, ledger
+// postings and outbox events, and checks the resulting balances against the
+// ledger.
+func saveTransaction(ctx context.Context, tx *sqlx.Tx, transaction *entity.Transaction, users ...*entity.User) error {
+	for _, user := range users {
+		err := updateUserBalance(ctx, tx, user)
+		if err != nil {
+			return err
+		}
+	}
+
+	transactionModel := model.NewTransactionModelFrom(transaction)
+	query := `INSERT INTO transactions
+	(id, sender_id, receiver_id, amount, currency, received_amount, received_currency, exchange_rate, fee, kind, original_transaction_id, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		transactionModel.ID,
+		transactionModel.SenderID,
+		transactionModel.ReceiverID,
+		transactionModel.Amount,
+		transactionModel.Currency,
+		transactionModel.ReceivedAmount,
+		transactionModel.ReceivedCurrency,
+		transactionModel.ExchangeRate,
+		transactionModel.Fee,
+		transactionModel.Kind,
+		transactionModel.OriginalTransactionID,
+		transactionModel.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if transaction.IdempotencyKey() != nil {
+		err = insertIdempotencyKey(ctx, tx, transaction.IdempotencyKey())
+		if err != nil {
+			return err
+		}
+	}
+
+	entries, err := transaction.LedgerEntries()
+	if err != nil {
+		return err
+	}
+	err = insertLedgerEntries(ctx, tx, entries)
+	if err != nil {
+		return err
+	}
+
+	err = insertOutboxMessages(ctx, tx, transaction.Events())
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		err = checkLedgerBalance(ctx, tx, user)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveTransactions saves transactions between the users, updating and
+// checking the balance of each user once however many transactions it takes
+// part in.
+func saveTransactions(ctx context.Context, tx *sqlx.Tx, transactions []*entity.Transaction, users map[string]*entity.User) error {
+	moved := map[string]*entity.User{}
+	for _, transaction := range transactions {
+		err := saveTransaction(ctx, tx, transaction)
+		if err != nil {
+			return err
+		}
+		moved[transaction.SenderID()] = users[transaction.SenderID()]
+		moved[transaction.ReceiverID()] = users[transaction.ReceiverID()]
+	}
+	for _, user := range moved {
+		err := updateUserBalance(ctx, tx, user)
+		if err != nil {
+			return err
+		}
+		err = checkLedgerBalance(ctx, tx, user)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// statementQuery lists the transactions of a user, as $1, with the signed
+// amount they moved in the user's balance and the balance right after each of
+// them. The balance is a running sum, computed in a single pass, of the ledger
+// entries of the user in the currency of the entry posted since $2, so the
+// balance the user had before $2 must be added to it. Filters are appended to
+// its WHERE clause.
+const statementQuery = `WITH statement AS (
+	SELECT t.*,
+		CASE WHEN t.sender_id = $1 THEN -t.amount ELSE t.received_amount - t.fee END AS entry_amount,
+		CASE WHEN t.sender_id = $1 THEN t.currency ELSE t.received_currency END AS entry_currency,
+		CASE WHEN t.sender_id = $1 THEN t.receiver_id ELSE t.sender_id END AS counterparty_id
+	FROM transactions t
+	WHERE t.sender_id = $1 OR t.receiver_id = $1
+),
+balances AS (
+	SELECT currency, COALESCE(transaction_id, '') AS transaction_id,
+		SUM(SUM(CASE WHEN direction = 'credit' THEN amount ELSE -amount END))
+			OVER (PARTITION BY currency ORDER BY created_at, COALESCE(transaction_id, '')) AS balance
+	FROM ledger_entries
+	WHERE account_id = $1 AND created_at >= $2
+	GROUP BY currency, created_at, COALESCE(transaction_id, '')
+)
+SELECT %s, s.entry_currency, COALESCE(b.balance, 0) AS balance
+FROM statement s
+LEFT JOIN balances b ON b.transaction_id = s.id AND b.currency = s.entry_currency
+WHERE TRUE`
+
+// ListStatement returns up to limit entries of the statement of the user
+// matching the filter, from the most recent, starting after the cursor when
+// one is given.
+func (tr TransactionRepository) ListStatement(ctx context.Context, userID string, filter entity.StatementFilter, after *entity.StatementCursor, limit int) ([]*entity.StatementEntry, error) {
+	var exists bool
+	err := tr.db.GetContext(ctx, &exists, "SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)", userID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errs.ErrUserNotFound
+	}
+
+	opening, err := statementOpening(ctx, tr.db, userID, filter.From)
+	if err != nil {
+		return nil, err
+	}
+	statement := newStatementSelect(userID, filter, filter.From)
+	if after != nil {
+		statement.where("(s.created_at, s.id) < (?, ?)", after.CreatedAt, after.TransactionID)
+	}
+	statement.args = append(statement.args, limit)
+	statement.query += fmt.Sprintf(" ORDER BY s.created_at DESC, s.id DESC LIMIT $%d", len(statement.args))
+
+	var entryModels []model.StatementEntryModel
+	err = tr.db.SelectContext(ctx, &entryModels, statement.query, statement.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*entity.StatementEntry, 0, len(entryModels))
+	for _, entryModel := range entryModels {
+		entryModel.Balance += opening[entryModel.EntryCurrency]
+		entry, err := entryModel.ToEntity(userID)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// EachStatementEntry calls fn with every entry of the statement of the user
+// matching the filter, from the oldest, as rows are read from the database so
+// long statements are not held in memory.
+func (tr TransactionRepository) EachStatementEntry(ctx context.Context, userID string, filter entity.StatementFilter, fn func(entry *entity.StatementEntry) error) error {
+	opening, err := statementOpening(ctx, tr.db, userID, filter.From)
+	if err != nil {
+		return err
+	}
+	statement := newStatementSelect(userID, filter, filter.From)
+	statement.query += " ORDER BY s.created_at, s.id"
+
+	rows, err := tr.db.QueryxContext(ctx, statement.query, statement.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entryModel model.StatementEntryModel
+		err = rows.StructScan(&entryModel)
+		if err != nil {
+			return err
+		}
+		entryModel.Balance += opening[entryModel.EntryCurrency]
+		entry, err := entryModel.ToEntity(userID)
+		if err != nil {
+			return err
+		}
+		err = fn(entry)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// statementSelect builds a statement query along with its arguments.
+type statementSelect struct {
+	query string
+	args  []any
+}
+
+// statementOpening returns the balances of the user in each currency before
+// since, which the running balances of a statement computed since then start
+// from.
+func statementOpening(ctx context.Context, q sqlx.QueryerContext, userID string, since time.Time) (map[string]int64, error) {
+	opening := make(map[string]int64)
+	if since.IsZero() {
+		return opening, nil
+	}
+	balances, err := ledgerBalancesAt(ctx, q, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	for _, balance := range balances {
+		opening[balance.Currency()] = balance.Value()
+	}
+	return opening, nil
+}
+
+// newStatementSelect selects the statement of the user narrowed by the
+// filter, leaving the order to the caller. Balances are summed from the ledger
+// entries posted since the given moment.
+func newStatementSelect(userID string, filter entity.StatementFilter, since time.Time) *statementSelect {
+	columns := make([]string, 0, len(allTransactionColumns))
+	for _, column := range allTransactionColumns {
+		columns = append(columns, "s."+column)
+	}
+	statement := &statementSelect{
+		query: fmt.Sprintf(statementQuery, strings.Join(columns, ", ")),
+		args:  []any{userID, since},
+	}
+	if !filter.From.IsZero() {
+		statement.where("s.created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		statement.where("s.created_at < ?", filter.To)
+	}
+	switch filter.Direction {
+	case entity.SentStatementDirection:
+		statement.where("s.sender_id = $1")
+	case entity.ReceivedStatementDirection:
+		statement.where("s.receiver_id = $1")
+	}
+	if filter.CounterpartyID != "" {
+		statement.where("s.counterparty_id = ?", filter.CounterpartyID)
+	}
+	if filter.Currency != "" {
+		statement.where("s.entry_currency = ?", filter.Currency)
+	}
+	if filter.MinAmount > 0 {
+		statement.where("ABS(s.entry_amount) >= ?", filter.MinAmount)
+	}
+	if filter.MaxAmount > 0 {
+		statement.where("ABS(s.entry_amount) <= ?", filter.MaxAmount)
+	}
+	return statement
+}
+
+// where narrows the statement with a condition whose ? placeholders are
+// bound to values.
+func (s *statementSelect) where(condition string, values ...any) {
+	for _, value := range values {
+		s.args = append(s.args, value)
+		condition = strings.Replace(condition, "?", fmt.Sprintf("$%d", len(s.args)), 1)
+	}
+	s.query += " AND " + condition
+}
+
+func NewTransactionRepository(db *sqlx.DB, otel telemetry.Telemetry) TransactionRepository {
+	return TransactionRepository{db: db, otel: otel}
+}
@@ -2,12 +2,17 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
 	"log"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
 	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
 	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -73,10 +78,13 @@ func (ur UserRepository) GetUserByID(ctx context.Context, userID uuid.UUID) (*en
 		&user,
 		query, userID,
 	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.ErrUserNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
-	return user.ToEntity()
+	return restoreUser(ctx, ur.db, &user, "")
 }
 
 func (ur UserRepository) Save(ctx context.Context, user *entity.User) error {
@@ -106,54 +114,223 @@ func (ur UserRepository) Save(ctx context.Context, user *entity.User) error {
 	return nil
 }
 
+// GetBalanceAt derives the balance of the user in the currency at a moment
+// from the ledger, see ledgerBalancesAt.
+func (ur UserRepository) GetBalanceAt(ctx context.Context, userID uuid.UUID, currency string, at time.Time) (int64, error) {
+	balances, err := ledgerBalancesAt(ctx, ur.db, userID.String(), at)
+	if err != nil {
+		return 0, err
+	}
+	for _, balance := range balances {
+		if balance.Currency() == currency {
+			return balance.Value(), nil
+		}
+	}
+	return 0, nil
+}
+
+// GetBalancesAt derives the balances of the user in every currency at a
+// moment from the ledger, see ledgerBalancesAt.
+func (ur UserRepository) GetBalancesAt(ctx context.Context, userID uuid.UUID, at time.Time) ([]*vo.Money, error) {
+	return ledgerBalancesAt(ctx, ur.db, userID.String(), at)
+}
+
+// UpdateBalance locks the sender and the receiver, in id order, and persists
+// the transfer returned by updateFn.
 func (ur UserRepository) UpdateBalance(ctx context.Context, senderID, receiverID string, updateFn func(sender, receiver *entity.User) (*entity.Transaction, error)) error {
 	return runInTx(ctx, ur.db, func(tx *sqlx.Tx) error {
-		query1 := "SELECT " + strings.Join(allUserColumns, ", ") + " FROM users WHERE id = $1"
-		var sender model.UserModel
-		err := tx.GetContext(ctx, &sender, query1, senderID)
+		users, err := lockUsers(ctx, tx, []string{senderID, receiverID})
 		if err != nil {
 			log.Println(err)
-			return errs.ErrSenderNotFound
+			return err
 		}
-
-		var receiver model.UserModel
-		err = tx.GetContext(ctx, &receiver, query1, receiverID)
-		if err != nil {
-			log.Println(err)
-			return errs.ErrReceiverNotFound
+		senderEntity, ok := users[senderID]
+		if !ok {
+			return errs.ErrSenderNotFound
 		}
-
-		senderEntity, err := sender.ToEntity()
+		err = restoreTransferUsage(ctx, tx, senderEntity, time.Now())
 		if err != nil {
 			return err
 		}
-		receiverEntity, err := receiver.ToEntity()
-		if err != nil {
-			return err
+
+		receiverEntity, ok := users[receiverID]
+		if !ok {
+			return errs.ErrReceiverNotFound
 		}
+
 		transaction, err := updateFn(senderEntity, receiverEntity)
 
 		if err != nil {
 			return err
 		}
 
-		query2 := "UPDATE users SET balance = $1, updated_at = NOW() WHERE id = $2"
-		_, err = tx.ExecContext(ctx, query2, senderEntity.Balance(), senderID)
+		return saveTransaction(ctx, tx, transaction, senderEntity, receiverEntity)
+	})
+}
+
+// getUserForUpdate loads a user locking its rows until the transaction ends.
+func getUserForUpdate(ctx context.Context, tx *sqlx.Tx, userID string) (*entity.User, error) {
+	query := "SELECT " + strings.Join(allUserColumns, ", ") + " FROM users WHERE id = $1 FOR UPDATE"
+	var user model.UserModel
+	err := tx.GetContext(ctx, &user, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	return restoreUser(ctx, tx, &user, " FOR UPDATE")
+}
+
+// lockUsers locks the users in id order, so units of work locking several
+// users cannot deadlock each other. Users that do not exist are left out.
+func lockUsers(ctx context.Context, tx *sqlx.Tx, userIDs []string) (map[string]*entity.User, error) {
+	ordered := slices.Clone(userIDs)
+	slices.Sort(ordered)
+	ordered = slices.Compact(ordered)
+
+	users := make(map[string]*entity.User, len(ordered))
+	for _, userID := range ordered {
+		user, err := getUserForUpdate(ctx, tx, userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
 		if err != nil {
-			return err
+			return nil, err
 		}
+		users[userID] = user
+	}
+	return users, nil
+}
 
-		_, err = tx.ExecContext(ctx, query2, receiverEntity.Balance(), receiverID)
+// restoreTransferUsage loads how much the user sent in each currency in the
+// limit periods containing now. Refunds are not counted, while the money
+// reserved by authorized balance holds and pending escrows is, as it is
+// transferred later without checking the limits again. Holds and escrows stop
+// counting once they become a transfer, or once the money goes back.
+func restoreTransferUsage(ctx context.Context, tx *sqlx.Tx, user *entity.User, now time.Time) error {
+	dayStart := vo.LimitPeriodStart(vo.DailyLimitPeriod, now)
+	weekStart := vo.LimitPeriodStart(vo.WeeklyLimitPeriod, now)
+	monthStart := vo.LimitPeriodStart(vo.MonthlyLimitPeriod, now)
+	query := `SELECT currency,
+		COALESCE(SUM(amount) FILTER (WHERE created_at >= $3), 0) AS daily,
+		COALESCE(SUM(amount) FILTER (WHERE created_at >= $4), 0) AS weekly,
+		COALESCE(SUM(amount) FILTER (WHERE created_at >= $5), 0) AS monthly
+	FROM (
+		SELECT currency, amount, created_at FROM transactions
+		WHERE sender_id = $1 AND kind = $2
+		UNION ALL
+		SELECT currency, amount, created_at FROM balance_holds
+		WHERE sender_id = $1 AND status = $6 AND expires_at > $9
+		UNION ALL
+		SELECT currency, amount, created_at FROM escrows
+		WHERE sender_id = $1 AND ((status = $7 AND expires_at > $9) OR status = $8)
+	) sent
+	WHERE created_at >= LEAST($4::TIMESTAMPTZ, $5::TIMESTAMPTZ)
+	GROUP BY currency`
+	var usages []model.TransferUsageModel
+	err := tx.SelectContext(
+		ctx,
+		&usages,
+		query,
+		user.ID(),
+		entity.TransferTransactionKind,
+		dayStart,
+		weekStart,
+		monthStart,
+		entity.BalanceHoldAuthorizedStatus,
+		entity.EscrowHeldStatus,
+		entity.EscrowShippedStatus,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	for _, usage := range usages {
+		user.RestoreTransferUsage(usage.Currency, usage.ToTransferUsage())
+	}
+	return nil
+}
+
+// restoreUser rebuilds a user along with its balances in other currencies than
+// the default one and the amounts reserved by its balance holds and frozen by
+// disputes. lockClause is appended to the balances query.
+func restoreUser(ctx context.Context, q sqlx.QueryerContext, userModel *model.UserModel, lockClause string) (*entity.User, error) {
+	user, err := userModel.ToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	var balances []model.UserBalanceModel
+	query := "SELECT user_id, currency, balance FROM user_balances WHERE user_id = $1 ORDER BY currency" + lockClause
+	err = sqlx.SelectContext(ctx, q, &balances, query, user.ID())
+	if err != nil {
+		return nil, err
+	}
+	for _, balanceModel := range balances {
+		balance, err := balanceModel.ToMoney()
 		if err != nil {
-			return err
+			return nil, err
 		}
+		user.RestoreBalance(balance)
+	}
 
-		insertQuery := "INSERT INTO transactions (id, sender_id, receiver_id, amount, created_at) VALUES ($1, $2, $3, $4, $5)"
-		_, err = tx.ExecContext(ctx, insertQuery, transaction.ID(), transaction.SenderID(), transaction.ReceiverID(), transaction.Amount(), transaction.CreatedAt())
+	// Holds are authorized, disputes opened and escrows created while the
+	// user is locked, so the sum cannot grow behind a locked user. Pending
+	// disputes freeze what they could of the disputed amount on the receiver,
+	// and escrows keep the money of the sender until released, unless not
+	// shipped in time.
+	var heldAmounts []model.HeldAmountModel
+	heldQuery := `SELECT currency, SUM(amount) AS amount FROM (
+		SELECT currency, amount FROM balance_holds
+		WHERE sender_id = $1 AND status = $2 AND expires_at > $3
+		UNION ALL
+		SELECT currency, frozen_amount FROM disputes
+		WHERE receiver_id = $1 AND status IN ($4, $5)
+		UNION ALL
+		SELECT currency, amount FROM escrows
+		WHERE sender_id = $1 AND ((status = $6 AND expires_at > $3) OR status = $7)
+	) held
+	GROUP BY currency`
+	err = sqlx.SelectContext(
+		ctx,
+		q,
+		&heldAmounts,
+		heldQuery,
+		user.ID(),
+		entity.BalanceHoldAuthorizedStatus,
+		time.Now(),
+		entity.DisputeOpenStatus,
+		entity.DisputeRespondedStatus,
+		entity.EscrowHeldStatus,
+		entity.EscrowShippedStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, held := range heldAmounts {
+		user.RestoreHeld(held.Currency, held.Amount)
+	}
+	return user, nil
+}
 
+func updateUserBalance(ctx context.Context, tx *sqlx.Tx, user *entity.User) error {
+	query := "UPDATE users SET balance = $1, updated_at = NOW() WHERE id = $2"
+	_, err := tx.ExecContext(ctx, query, user.Balance(), user.ID())
+	if err != nil {
 		return err
+	}
 
-	})
+	balanceQuery := `INSERT INTO user_balances (user_id, currency, balance, updated_at)
+	VALUES ($1, $2, $3, NOW())
+	ON CONFLICT (user_id, currency) DO UPDATE SET balance = EXCLUDED.balance, updated_at = NOW()`
+	for _, balance := range user.Balances() {
+		if balance.Currency() == vo.DefaultCurrency {
+			continue
+		}
+		_, err = tx.ExecContext(ctx, balanceQuery, user.ID(), balance.Currency(), balance.Value())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func NewUserRepository(db *sqlx.DB, otel telemetry.Telemetry) UserRepository {
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/db/model"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/jmoiron/sqlx"
+)
+
+type WithdrawalRepository struct {
+	db   *sqlx.DB
+	otel telemetry.Telemetry
+}
+
+// Hold locks the user and persists the withdrawal returned by holdFn along
+// with the new balance of the user, the postings moving the money on hold and
+// the outbox events.
+func (wr WithdrawalRepository) Hold(ctx context.Context, userID string, holdFn func(user *entity.User) (*entity.Withdrawal, error)) error {
+	return runInTx(ctx, wr.db, func(tx *sqlx.Tx) error {
+		user, err := getUserForUpdate(ctx, tx, userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrUserNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		withdrawal, err := holdFn(user)
+		if err != nil {
+			return err
+		}
+
+		withdrawalModel := model.NewWithdrawalModelFrom(withdrawal)
+		query := `INSERT INTO withdrawals (id, user_id, destination_id, amount, currency, status, failure_reason, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			withdrawalModel.ID,
+			withdrawalModel.UserID,
+			withdrawalModel.DestinationID,
+			withdrawalModel.Amount,
+			withdrawalModel.Currency,
+			withdrawalModel.Status,
+			withdrawalModel.FailureReason,
+			withdrawalModel.CreatedAt,
+			withdrawalModel.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		return saveWithdrawalMovement(ctx, tx, withdrawal, user)
+	})
+}
+
+// Settle locks the withdrawal and its user, and persists the outcome applied
+// by settleFn: the new status of the withdrawal, the balance of the user, the
+// postings releasing the hold and the outbox events.
+func (wr WithdrawalRepository) Settle(ctx context.Context, withdrawalID string, settleFn func(withdrawal *entity.Withdrawal, user *entity.User) error) error {
+	return runInTx(ctx, wr.db, func(tx *sqlx.Tx) error {
+		var withdrawalModel model.WithdrawalModel
+		query := `SELECT id, user_id, destination_id, amount, currency, status, failure_reason, created_at, updated_at
+		FROM withdrawals WHERE id = $1 FOR UPDATE`
+		err := tx.GetContext(ctx, &withdrawalModel, query, withdrawalID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrWithdrawalNotFound
+		}
+		if err != nil {
+			return err
+		}
+		withdrawal, err := withdrawalModel.ToEntity()
+		if err != nil {
+			return err
+		}
+
+		user, err := getUserForUpdate(ctx, tx, withdrawal.UserID())
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.ErrUserNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		err = settleFn(withdrawal, user)
+		if err != nil {
+			return err
+		}
+
+		withdrawalModel = *model.NewWithdrawalModelFrom(withdrawal)
+		updateQuery := "UPDATE withdrawals SET status = $1, failure_reason = $2, updated_at = $3 WHERE id = $4"
+		_, err = tx.ExecContext(
+			ctx,
+			updateQuery,
+			withdrawalModel.Status,
+			withdrawalModel.FailureReason,
+			withdrawalModel.UpdatedAt,
+			withdrawalModel.ID,
+		)
+		if err != nil {
+			return err
+		}
+
+		return saveWithdrawalMovement(ctx, tx, withdrawal, user)
+	})
+}
+
+// saveWithdrawalMovement persists the balance of the user, the postings of the
+// current status of the withdrawal and its outbox events, and checks the
+// balance against the ledger.
+func saveWithdrawalMovement(ctx context.Context, tx *sqlx.Tx, withdrawal *entity.Withdrawal, user *entity.User) error {
+	err := updateUserBalance(ctx, tx, user)
+	if err != nil {
+		return err
+	}
+
+	entries, err := withdrawal.LedgerEntries()
+	if err != nil {
+		return err
+	}
+	err = insertLedgerEntries(ctx, tx, entries)
+	if err != nil {
+		return err
+	}
+
+	err = insertOutboxMessages(ctx, tx, withdrawal.Events())
+	if err != nil {
+		return err
+	}
+
+	return checkLedgerBalance(ctx, tx, user)
+}
+
+func NewWithdrawalRepository(db *sqlx.DB, otel telemetry.Telemetry) WithdrawalRepository {
+	return WithdrawalRepository{db: db, otel: otel}
+}
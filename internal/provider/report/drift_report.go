@@ -0,0 +1,92 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+)
+
+type driftReport struct {
+	CheckedAt       time.Time          `json:"checked_at"`
+	AccountsChecked int                `json:"accounts_checked"`
+	Totals          []driftReportTotal `json:"totals"`
+	Drifts          []driftReportEntry `json:"drifts"`
+}
+
+type driftReportTotal struct {
+	Currency        string `json:"currency"`
+	DriftedAccounts int    `json:"drifted_accounts"`
+	// TotalDrift is the sum in cents of the absolute drifts
+	TotalDrift int64 `json:"total_drift"`
+}
+
+// driftReportEntry has its amounts in cents.
+type driftReportEntry struct {
+	UserID          string `json:"user_id"`
+	Currency        string `json:"currency"`
+	Balance         int64  `json:"balance"`
+	ExpectedBalance int64  `json:"expected_balance"`
+	Drift           int64  `json:"drift"`
+}
+
+// FileDriftReportWriter writes each reconciliation report as a JSON file to
+// a directory, named after the time of the reconciliation.
+type FileDriftReportWriter struct {
+	dir  string
+	otel telemetry.Telemetry
+}
+
+// Write stores the report and returns the path of the file.
+func (fw *FileDriftReportWriter) Write(ctx context.Context, reconciliation *entity.ReconciliationReport) (string, error) {
+	_, span := fw.otel.Start(ctx, "FileDriftReportWriter.Write")
+	defer span.End()
+
+	content := driftReport{
+		CheckedAt:       reconciliation.CheckedAt().UTC(),
+		AccountsChecked: reconciliation.AccountsChecked(),
+		Totals:          make([]driftReportTotal, 0, len(reconciliation.Currencies())),
+		Drifts:          make([]driftReportEntry, 0, len(reconciliation.Drifts())),
+	}
+	for _, currency := range reconciliation.Currencies() {
+		content.Totals = append(content.Totals, driftReportTotal{
+			Currency:        currency,
+			DriftedAccounts: reconciliation.DriftedAccounts(currency),
+			TotalDrift:      reconciliation.TotalDrift(currency),
+		})
+	}
+	for _, drift := range reconciliation.Drifts() {
+		content.Drifts = append(content.Drifts, driftReportEntry{
+			UserID:          drift.UserID(),
+			Currency:        drift.Currency(),
+			Balance:         drift.Balance(),
+			ExpectedBalance: drift.ExpectedBalance(),
+			Drift:           drift.Drift(),
+		})
+	}
+
+	data, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	err = os.MkdirAll(fw.dir, 0o755)
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("balance-drift-%s.json", content.CheckedAt.Format("20060102T150405Z"))
+	path := filepath.Join(fw.dir, name)
+	err = os.WriteFile(path, data, 0o644)
+	if err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func NewFileDriftReportWriter(dir string, otel telemetry.Telemetry) *FileDriftReportWriter {
+	return &FileDriftReportWriter{dir: dir, otel: otel}
+}
@@ -0,0 +1,58 @@
+package report_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/report"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileDriftReportWriter_Write_ShouldWriteTheDriftsAsJSON(t *testing.T) {
+	// Arrange
+	dir := filepath.Join(t.TempDir(), "reports")
+	reconciliation := entity.NewReconciliationReport([]*entity.BalanceCheck{
+		entity.NewBalanceCheck("user-1", vo.BRL, 10000, 10000),
+		entity.NewBalanceCheck("user-2", vo.BRL, 9999, 10050),
+	}, time.Date(2026, 10, 17, 3, 0, 0, 0, time.UTC))
+	writer := report.NewFileDriftReportWriter(dir, telemetry.NewMockTelemetry())
+
+	// Act
+	path, err := writer.Write(context.Background(), reconciliation)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "balance-drift-20261017T030000Z.json"), path)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var content struct {
+		AccountsChecked int `json:"accounts_checked"`
+		Totals          []struct {
+			Currency        string `json:"currency"`
+			DriftedAccounts int    `json:"drifted_accounts"`
+			TotalDrift      int64  `json:"total_drift"`
+		} `json:"totals"`
+		Drifts []struct {
+			UserID string `json:"user_id"`
+			Drift  int64  `json:"drift"`
+		} `json:"drifts"`
+	}
+	require.NoError(t, json.Unmarshal(data, &content))
+	assert.Equal(t, 2, content.AccountsChecked)
+	require.Len(t, content.Totals, 1)
+	assert.Equal(t, vo.BRL, content.Totals[0].Currency)
+	assert.Equal(t, 1, content.Totals[0].DriftedAccounts)
+	assert.Equal(t, int64(51), content.Totals[0].TotalDrift)
+	require.Len(t, content.Drifts, 1)
+	assert.Equal(t, "user-2", content.Drifts[0].UserID)
+	assert.Equal(t, int64(-51), content.Drifts[0].Drift)
+}
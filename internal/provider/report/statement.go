@@ -0,0 +1,58 @@
+package report
+
+import (
+	"fmt"
+	"io"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+)
+
+// Formats statements can be exported in.
+const (
+	CSVStatementFormat = "csv"
+	OFXStatementFormat = "ofx"
+	PDFStatementFormat = "pdf"
+)
+
+// StatementWriter renders a statement entry by entry.
+type StatementWriter interface {
+	Begin(header entity.StatementHeader) error
+	WriteEntry(entry *entity.StatementEntry) error
+	End(summary entity.StatementSummary) error
+}
+
+// NewStatementWriter creates the writer rendering statements in the format
+// to w.
+func NewStatementWriter(format string, w io.Writer) (StatementWriter, error) {
+	switch format {
+	case CSVStatementFormat:
+		return NewCSVStatementWriter(w), nil
+	case OFXStatementFormat:
+		return NewOFXStatementWriter(w), nil
+	case PDFStatementFormat:
+		return NewPDFStatementWriter(w), nil
+	default:
+		return nil, errs.ErrUnsupportedStatementFormat
+	}
+}
+
+// entryFee returns the fee the owner of the statement paid on the entry,
+// which is only charged on what was received.
+func entryFee(entry *entity.StatementEntry) int64 {
+	if entry.Direction() == entity.ReceivedStatementDirection {
+		return entry.Transaction().Fee()
+	}
+	return 0
+}
+
+// formatCents converts cents into a decimal amount with two places, e.g.
+// "-10.50" for -1050.
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
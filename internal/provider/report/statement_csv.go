@@ -0,0 +1,48 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+)
+
+var csvStatementColumns = []string{
+	"date", "transaction_id", "kind", "direction", "counterparty_id", "amount", "currency", "fee", "balance",
+}
+
+// CSVStatementWriter renders one row per entry under a header row, amounts
+// being decimals in the currency of the statement. Rows are buffered a few
+// kilobytes at a time, so long statements reach w as they are read.
+type CSVStatementWriter struct {
+	w *csv.Writer
+}
+
+func NewCSVStatementWriter(w io.Writer) *CSVStatementWriter {
+	return &CSVStatementWriter{w: csv.NewWriter(w)}
+}
+
+func (cw *CSVStatementWriter) Begin(_ entity.StatementHeader) error {
+	return cw.w.Write(csvStatementColumns)
+}
+
+func (cw *CSVStatementWriter) WriteEntry(entry *entity.StatementEntry) error {
+	transaction := entry.Transaction()
+	return cw.w.Write([]string{
+		transaction.CreatedAt().UTC().Format(time.RFC3339),
+		transaction.ID(),
+		transaction.Kind(),
+		entry.Direction(),
+		entry.CounterpartyID(),
+		formatCents(entry.Amount()),
+		entry.Currency(),
+		formatCents(entryFee(entry)),
+		formatCents(entry.Balance()),
+	})
+}
+
+func (cw *CSVStatementWriter) End(_ entity.StatementSummary) error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
@@ -0,0 +1,85 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+)
+
+const ofxHeader = "OFXHEADER:100\r\nDATA:OFXSGML\r\nVERSION:102\r\nSECURITY:NONE\r\nENCODING:USASCII\r\nCHARSET:1252\r\nCOMPRESSION:NONE\r\nOLDFILEUID:NONE\r\nNEWFILEUID:NONE\r\n\r\n"
+
+// ofxDateLayout is the OFX datetime, which is always written in UTC.
+const ofxDateLayout = "20060102150405.000"
+
+// ofxBankID identifies the wallet as the institution holding the accounts.
+const ofxBankID = "0000"
+
+// OFXStatementWriter renders statements as OFX 1.02 bank statements, the
+// format accounting software imports, with the wallet of the owner as a
+// checking account.
+type OFXStatementWriter struct {
+	w  *bufio.Writer
+	to time.Time
+}
+
+func NewOFXStatementWriter(w io.Writer) *OFXStatementWriter {
+	return &OFXStatementWriter{w: bufio.NewWriter(w)}
+}
+
+func (ow *OFXStatementWriter) Begin(header entity.StatementHeader) error {
+	ow.to = header.To
+	_, err := fmt.Fprintf(
+		ow.w,
+		"%s<OFX>\r\n<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO</STATUS><DTSERVER>%s<LANGUAGE>POR</SONRS></SIGNONMSGSRSV1>\r\n"+
+			"<BANKMSGSRSV1><STMTTRNRS><TRNUID>0<STATUS><CODE>0<SEVERITY>INFO</STATUS>\r\n"+
+			"<STMTRS><CURDEF>%s<BANKACCTFROM><BANKID>%s<ACCTID>%s<ACCTTYPE>CHECKING</BANKACCTFROM>\r\n"+
+			"<BANKTRANLIST><DTSTART>%s<DTEND>%s\r\n",
+		ofxHeader,
+		formatOFXDate(time.Now()),
+		header.Currency,
+		ofxBankID,
+		header.Owner.ID(),
+		formatOFXDate(header.From),
+		formatOFXDate(header.To),
+	)
+	return err
+}
+
+func (ow *OFXStatementWriter) WriteEntry(entry *entity.StatementEntry) error {
+	transactionType := "CREDIT"
+	if entry.Direction() == entity.SentStatementDirection {
+		transactionType = "DEBIT"
+	}
+	transaction := entry.Transaction()
+	_, err := fmt.Fprintf(
+		ow.w,
+		"<STMTTRN><TRNTYPE>%s<DTPOSTED>%s<TRNAMT>%s<FITID>%s<NAME>%s<MEMO>%s</STMTTRN>\r\n",
+		transactionType,
+		formatOFXDate(transaction.CreatedAt()),
+		formatCents(entry.Amount()),
+		transaction.ID(),
+		entry.CounterpartyID(),
+		transaction.Kind(),
+	)
+	return err
+}
+
+func (ow *OFXStatementWriter) End(summary entity.StatementSummary) error {
+	_, err := fmt.Fprintf(
+		ow.w,
+		"</BANKTRANLIST>\r\n<LEDGERBAL><BALAMT>%s<DTASOF>%s</LEDGERBAL>\r\n</STMTRS></STMTTRNRS></BANKMSGSRSV1>\r\n</OFX>\r\n",
+		formatCents(summary.ClosingBalance),
+		formatOFXDate(ow.to),
+	)
+	if err != nil {
+		return err
+	}
+	return ow.w.Flush()
+}
+
+func formatOFXDate(t time.Time) string {
+	return t.UTC().Format(ofxDateLayout) + "[0:GMT]"
+}
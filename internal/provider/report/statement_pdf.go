@@ -0,0 +1,242 @@
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+)
+
+// A4 page in points, with the area text is laid out in.
+const (
+	pdfPageWidth  = 595
+	pdfPageHeight = 842
+	pdfMargin     = 40
+	pdfFontSize   = 8
+	pdfLineHeight = 12
+)
+
+// Objects every statement has, the pages being numbered after them.
+const (
+	pdfCatalogObject = iota + 1
+	pdfPagesObject
+	pdfFontObject
+	pdfBoldFontObject
+	pdfFirstPageObject
+)
+
+// pdfColumn is a column of the entries table. Right aligned columns end at x.
+type pdfColumn struct {
+	title string
+	x     float64
+	right bool
+}
+
+var pdfStatementColumns = []pdfColumn{
+	{title: "Date", x: pdfMargin},
+	{title: "Kind", x: 125},
+	{title: "Counterparty", x: 170},
+	{title: "Fee", x: 395, right: true},
+	{title: "Amount", x: 470, right: true},
+	{title: "Balance", x: pdfPageWidth - pdfMargin, right: true},
+}
+
+// PDFStatementWriter renders statements as A4 PDF documents with a line per
+// entry. Pages are written as they fill up, so only the page being laid out
+// is held in memory.
+type PDFStatementWriter struct {
+	w       *bufio.Writer
+	offset  int
+	objects map[int]int
+	pages   []int
+	page    *bytes.Buffer
+	y       float64
+	header  entity.StatementHeader
+	err     error
+}
+
+func NewPDFStatementWriter(w io.Writer) *PDFStatementWriter {
+	return &PDFStatementWriter{w: bufio.NewWriter(w), objects: make(map[int]int)}
+}
+
+func (pw *PDFStatementWriter) Begin(header entity.StatementHeader) error {
+	pw.header = header
+	// The binary comment tells tools the file is not plain text
+	pw.write("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	pw.newPage()
+
+	pw.text(pdfMargin, pw.y, true, 14, "Statement")
+	pw.y -= 2 * pdfLineHeight
+	pw.text(pdfMargin, pw.y, false, pdfFontSize+1, header.Owner.Name())
+	pw.y -= pdfLineHeight
+	pw.text(pdfMargin, pw.y, false, pdfFontSize, "Account "+header.Owner.ID())
+	pw.y -= pdfLineHeight
+	pw.text(pdfMargin, pw.y, false, pdfFontSize, fmt.Sprintf(
+		"Period %s to %s (UTC)",
+		header.From.UTC().Format(time.DateTime),
+		header.To.UTC().Format(time.DateTime),
+	))
+	pw.y -= pdfLineHeight
+	pw.text(pdfMargin, pw.y, false, pdfFontSize, fmt.Sprintf(
+		"Opening balance %s %s", formatCents(header.OpeningBalance), header.Currency,
+	))
+	pw.y -= 2 * pdfLineHeight
+	pw.columnTitles()
+	return pw.err
+}
+
+func (pw *PDFStatementWriter) WriteEntry(entry *entity.StatementEntry) error {
+	if pw.y < pdfMargin+pdfLineHeight {
+		pw.endPage()
+		pw.newPage()
+		pw.columnTitles()
+	}
+	transaction := entry.Transaction()
+	values := []string{
+		transaction.CreatedAt().UTC().Format(time.DateTime),
+		transaction.Kind(),
+		entry.CounterpartyID(),
+		formatCents(entryFee(entry)),
+		formatCents(entry.Amount()),
+		formatCents(entry.Balance()),
+	}
+	for i, column := range pdfStatementColumns {
+		pw.cell(column, false, values[i])
+	}
+	pw.y -= pdfLineHeight
+	return pw.err
+}
+
+func (pw *PDFStatementWriter) End(summary entity.StatementSummary) error {
+	if pw.y < pdfMargin+5*pdfLineHeight {
+		pw.endPage()
+		pw.newPage()
+	}
+	pw.y -= pdfLineHeight
+	lines := []string{
+		fmt.Sprintf("Transactions %d", summary.Entries),
+		fmt.Sprintf("Credits %s %s", formatCents(summary.Credits), pw.header.Currency),
+		fmt.Sprintf("Debits %s %s", formatCents(summary.Debits), pw.header.Currency),
+		fmt.Sprintf("Closing balance %s %s", formatCents(summary.ClosingBalance), pw.header.Currency),
+	}
+	for i, line := range lines {
+		pw.text(pdfMargin, pw.y, i == len(lines)-1, pdfFontSize, line)
+		pw.y -= pdfLineHeight
+	}
+	pw.endPage()
+
+	kids := make([]string, 0, len(pw.pages))
+	for _, page := range pw.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", page))
+	}
+	pw.object(pdfPagesObject, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pw.pages)))
+	pw.object(pdfCatalogObject, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pdfPagesObject))
+	pw.object(pdfFontObject, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	pw.object(pdfBoldFontObject, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	// The cross-reference table lists where every object starts
+	xref := pw.offset
+	size := len(pw.objects) + 1
+	pw.write(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", size))
+	for number := 1; number < size; number++ {
+		pw.write(fmt.Sprintf("%010d 00000 n \n", pw.objects[number]))
+	}
+	pw.write(fmt.Sprintf("trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", size, pdfCatalogObject, xref))
+	if pw.err != nil {
+		return pw.err
+	}
+	return pw.w.Flush()
+}
+
+func (pw *PDFStatementWriter) newPage() {
+	pw.page = &bytes.Buffer{}
+	pw.y = pdfPageHeight - pdfMargin
+}
+
+// endPage writes the content of the page being laid out and the page itself.
+func (pw *PDFStatementWriter) endPage() {
+	content := pdfFirstPageObject + 2*len(pw.pages)
+	page := content + 1
+	pw.object(content, fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", pw.page.Len(), pw.page.String()))
+	pw.object(page, fmt.Sprintf(
+		"<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 %d 0 R /F2 %d 0 R >> >> /Contents %d 0 R >>",
+		pdfPagesObject, pdfPageWidth, pdfPageHeight, pdfFontObject, pdfBoldFontObject, content,
+	))
+	pw.pages = append(pw.pages, page)
+}
+
+func (pw *PDFStatementWriter) columnTitles() {
+	for _, column := range pdfStatementColumns {
+		pw.cell(column, true, column.title)
+	}
+	pw.y -= pdfLineHeight
+}
+
+func (pw *PDFStatementWriter) cell(column pdfColumn, bold bool, value string) {
+	x := column.x
+	if column.right {
+		x -= pdfTextWidth(value, pdfFontSize)
+	}
+	pw.text(x, pw.y, bold, pdfFontSize, value)
+}
+
+func (pw *PDFStatementWriter) text(x, y float64, bold bool, size int, value string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(pw.page, "BT /%s %d Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, pdfString(value))
+}
+
+func (pw *PDFStatementWriter) object(number int, body string) {
+	pw.objects[number] = pw.offset
+	pw.write(fmt.Sprintf("%d 0 obj\n%s\nendobj\n", number, body))
+}
+
+func (pw *PDFStatementWriter) write(s string) {
+	if pw.err != nil {
+		return
+	}
+	n, err := pw.w.WriteString(s)
+	pw.offset += n
+	pw.err = err
+}
+
+// pdfString encodes the value in WinAnsi, which covers Latin-1, escaping
+// the characters that delimit PDF strings.
+func pdfString(value string) string {
+	var encoded strings.Builder
+	for _, r := range value {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			encoded.WriteByte('\\')
+			encoded.WriteRune(r)
+		case r < ' ' || r > 0xff:
+			encoded.WriteByte('?')
+		default:
+			encoded.WriteByte(byte(r))
+		}
+	}
+	return encoded.String()
+}
+
+// pdfTextWidth measures amounts written in Helvetica, whose digits all have
+// the same width.
+func pdfTextWidth(value string, size int) float64 {
+	var units int
+	for _, r := range value {
+		switch r {
+		case '.', ',', ' ':
+			units += 278
+		case '-':
+			units += 333
+		default:
+			units += 556
+		}
+	}
+	return float64(units*size) / 1000
+}
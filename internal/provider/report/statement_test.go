@@ -0,0 +1,133 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/report"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeStatement renders a statement opening at 100.00 with the entries
+// alternating between 10.50 sent and 25.00 received, of which 0.75 is
+// charged as fee.
+func writeStatement(t *testing.T, format string, entries int) *bytes.Buffer {
+	t.Helper()
+	owner, err := entity.NewUser("José (Zé)", "jose@example.com", "password123", "52998224725", "", vo.CommonUserType)
+	require.NoError(t, err)
+	counterpartyID := uuid.NewString()
+	fee, err := vo.NewFixedFee(75)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	writer, err := report.NewStatementWriter(format, &out)
+	require.NoError(t, err)
+	require.NoError(t, writer.Begin(entity.StatementHeader{
+		Owner:          owner,
+		Currency:       vo.BRL,
+		From:           time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC),
+		To:             time.Date(2026, 11, 1, 3, 0, 0, 0, time.UTC),
+		OpeningBalance: 10000,
+	}))
+
+	balance := int64(10000)
+	var summary entity.StatementSummary
+	for i := range entries {
+		var transaction *entity.Transaction
+		if i%2 == 0 {
+			transaction, err = entity.NewTransaction(1050, owner.ID(), counterpartyID)
+			require.NoError(t, err)
+			balance -= 1050
+		} else {
+			transaction, err = entity.NewTransaction(2500, counterpartyID, owner.ID())
+			require.NoError(t, err)
+			require.NoError(t, transaction.ChargeFee(*fee))
+			balance += 2425
+		}
+		entry := entity.NewStatementEntry(transaction, owner.ID(), balance)
+		summary.Add(entry)
+		require.NoError(t, writer.WriteEntry(entry))
+	}
+	summary.ClosingBalance = balance
+	require.NoError(t, writer.End(summary))
+	return &out
+}
+
+func TestNewStatementWriter_UnknownFormat_ShouldFail(t *testing.T) {
+	// Act
+	_, err := report.NewStatementWriter("xlsx", &bytes.Buffer{})
+
+	// Assert
+	assert.ErrorIs(t, err, errs.ErrUnsupportedStatementFormat)
+}
+
+func TestCSVStatementWriter_ShouldWriteARowPerEntry(t *testing.T) {
+	// Act
+	out := writeStatement(t, report.CSVStatementFormat, 2)
+
+	// Assert
+	rows, err := csv.NewReader(out).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, []string{"date", "transaction_id", "kind", "direction", "counterparty_id", "amount", "currency", "fee", "balance"}, rows[0])
+	assert.Equal(t, []string{"sent", "-10.50", "BRL", "0.00", "89.50"}, []string{rows[1][3], rows[1][5], rows[1][6], rows[1][7], rows[1][8]})
+	assert.Equal(t, []string{"received", "24.25", "BRL", "0.75", "113.75"}, []string{rows[2][3], rows[2][5], rows[2][6], rows[2][7], rows[2][8]})
+}
+
+func TestOFXStatementWriter_ShouldWriteDebitsCreditsAndTheLedgerBalance(t *testing.T) {
+	// Act
+	out := writeStatement(t, report.OFXStatementFormat, 2).String()
+
+	// Assert
+	assert.True(t, strings.HasPrefix(out, "OFXHEADER:100\r\nDATA:OFXSGML\r\nVERSION:102"))
+	assert.Contains(t, out, "<CURDEF>BRL")
+	assert.Contains(t, out, "<DTSTART>20261001030000.000[0:GMT]<DTEND>20261101030000.000[0:GMT]")
+	assert.Contains(t, out, "<TRNTYPE>DEBIT")
+	assert.Contains(t, out, "<TRNAMT>-10.50")
+	assert.Contains(t, out, "<TRNTYPE>CREDIT")
+	assert.Contains(t, out, "<TRNAMT>24.25")
+	assert.Contains(t, out, "<LEDGERBAL><BALAMT>113.75<DTASOF>20261101030000.000[0:GMT]</LEDGERBAL>")
+	assert.True(t, strings.HasSuffix(out, "</OFX>\r\n"))
+}
+
+func TestPDFStatementWriter_ShouldWriteAPageEveryFewDozenEntries(t *testing.T) {
+	// Act
+	out := writeStatement(t, report.PDFStatementFormat, 150).String()
+
+	// Assert
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+	// Latin-1 names are kept in WinAnsi, and parentheses escaped
+	assert.Contains(t, out, "(Jos\xe9 \\(Z\xe9\\)) Tj")
+	assert.Contains(t, out, "(Closing balance 1131.25 BRL) Tj")
+
+	count := regexp.MustCompile(`/Count (\d+)`).FindStringSubmatch(out)
+	require.Len(t, count, 2)
+	pages, err := strconv.Atoi(count[1])
+	require.NoError(t, err)
+	assert.Equal(t, 3, pages)
+
+	// Every object listed in the cross-reference table starts where it says
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(out)
+	require.Len(t, startxref, 2)
+	xref, err := strconv.Atoi(startxref[1])
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(out[xref:], "xref\n"))
+	offsets := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(out[xref:], -1)
+	require.Len(t, offsets, 4+2*pages)
+	for i, offset := range offsets {
+		position, err := strconv.Atoi(offset[1])
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(out[position:], strconv.Itoa(i+1)+" 0 obj\n"), "object %d", i+1)
+	}
+}
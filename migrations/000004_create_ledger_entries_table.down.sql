@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_ledger_entries_transaction_id;
+DROP INDEX IF EXISTS idx_ledger_entries_account_id;
+DROP TABLE IF EXISTS ledger_entries;
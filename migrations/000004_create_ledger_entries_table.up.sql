@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS ledger_entries(
+   id VARCHAR(36) PRIMARY KEY,
+   transaction_id VARCHAR(36),
+   account_id VARCHAR(64) NOT NULL,
+   direction VARCHAR(6) NOT NULL CHECK (direction IN ('debit', 'credit')),
+   amount BIGINT NOT NULL CHECK (amount >= 0),
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_account_id ON ledger_entries(account_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_transaction_id ON ledger_entries(transaction_id);
+
+-- Existing balances have no history to derive them from, so they are posted
+-- as opening entries against the system opening-balance account.
+INSERT INTO ledger_entries (id, transaction_id, account_id, direction, amount)
+SELECT gen_random_uuid()::text, NULL, id, 'credit', balance FROM users WHERE balance > 0;
+
+INSERT INTO ledger_entries (id, transaction_id, account_id, direction, amount)
+SELECT gen_random_uuid()::text, NULL, 'system:opening-balance', 'debit', balance FROM users WHERE balance > 0;
@@ -0,0 +1,4 @@
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS kind VARCHAR(20) DEFAULT 'transfer' NOT NULL;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS original_transaction_id VARCHAR(36) REFERENCES transactions(id);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_original_transaction_id ON transactions(original_transaction_id);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys(
+   idempotency_key VARCHAR(255) PRIMARY KEY,
+   request_hash VARCHAR(64) NOT NULL,
+   transaction_id VARCHAR(36) NOT NULL,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   expires_at TIMESTAMPTZ NOT NULL,
+   FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
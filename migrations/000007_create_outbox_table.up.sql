@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS outbox(
+   id VARCHAR(36) PRIMARY KEY,
+   event_name VARCHAR(100) NOT NULL,
+   payload JSONB NOT NULL,
+   status VARCHAR(10) DEFAULT 'pending' NOT NULL,
+   attempts INT DEFAULT 0 NOT NULL,
+   last_error TEXT,
+   next_attempt_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   sent_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(next_attempt_at) WHERE status = 'pending';
@@ -0,0 +1,11 @@
+ALTER TABLE transactions DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE transactions DROP COLUMN IF EXISTS received_currency;
+ALTER TABLE transactions DROP COLUMN IF EXISTS received_amount;
+ALTER TABLE transactions DROP COLUMN IF EXISTS currency;
+
+DROP INDEX IF EXISTS idx_ledger_entries_account_id;
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_account_id ON ledger_entries(account_id, created_at);
+
+ALTER TABLE ledger_entries DROP COLUMN IF EXISTS currency;
+
+DROP TABLE IF EXISTS user_balances;
@@ -0,0 +1,23 @@
+-- users.balance keeps the balance in the default currency (BRL), balances in
+-- other currencies are stored here.
+CREATE TABLE IF NOT EXISTS user_balances(
+   user_id VARCHAR(36) NOT NULL,
+   currency CHAR(3) NOT NULL,
+   balance BIGINT DEFAULT 0 NOT NULL CHECK (balance >= 0),
+   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   PRIMARY KEY (user_id, currency),
+   FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+ALTER TABLE ledger_entries ADD COLUMN IF NOT EXISTS currency CHAR(3) DEFAULT 'BRL' NOT NULL;
+
+DROP INDEX IF EXISTS idx_ledger_entries_account_id;
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_account_id ON ledger_entries(account_id, currency, created_at);
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS currency CHAR(3) DEFAULT 'BRL' NOT NULL;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS received_amount BIGINT;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS received_currency CHAR(3) DEFAULT 'BRL' NOT NULL;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS exchange_rate NUMERIC(20, 10) DEFAULT 1 NOT NULL;
+
+UPDATE transactions SET received_amount = amount WHERE received_amount IS NULL;
+ALTER TABLE transactions ALTER COLUMN received_amount SET NOT NULL;
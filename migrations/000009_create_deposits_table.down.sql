@@ -0,0 +1,8 @@
+DELETE FROM idempotency_keys WHERE transaction_id IN (SELECT id FROM deposits);
+ALTER TABLE idempotency_keys ADD CONSTRAINT idempotency_keys_transaction_id_fkey FOREIGN KEY (transaction_id) REFERENCES transactions(id);
+
+DELETE FROM ledger_entries WHERE transaction_id IN (SELECT id FROM deposits);
+ALTER TABLE ledger_entries ADD CONSTRAINT ledger_entries_transaction_id_fkey FOREIGN KEY (transaction_id) REFERENCES transactions(id);
+
+DROP INDEX IF EXISTS idx_deposits_user_id;
+DROP TABLE IF EXISTS deposits;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS deposits(
+   id VARCHAR(36) PRIMARY KEY,
+   user_id VARCHAR(36) NOT NULL,
+   amount BIGINT NOT NULL CHECK (amount > 0),
+   currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_deposits_user_id ON deposits(user_id, created_at);
+
+-- Ledger entries are no longer posted by transfers only, transaction_id now
+-- identifies the movement that produced them, either a transaction or a deposit.
+ALTER TABLE ledger_entries DROP CONSTRAINT IF EXISTS ledger_entries_transaction_id_fkey;
+
+-- Idempotency keys are no longer used by transfers only, transaction_id now
+-- identifies what the request created, either a transaction or a deposit.
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_transaction_id_fkey;
@@ -0,0 +1,8 @@
+DELETE FROM ledger_entries WHERE transaction_id IN (SELECT id FROM withdrawals);
+
+DROP INDEX IF EXISTS idx_withdrawals_pending;
+DROP INDEX IF EXISTS idx_withdrawals_user_id;
+DROP TABLE IF EXISTS withdrawals;
+
+DROP INDEX IF EXISTS idx_payout_destinations_user_id;
+DROP TABLE IF EXISTS payout_destinations;
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS payout_destinations(
+   id VARCHAR(36) PRIMARY KEY,
+   user_id VARCHAR(36) NOT NULL,
+   kind VARCHAR(20) NOT NULL CHECK (kind IN ('bank_account', 'pix_key')),
+   bank_code VARCHAR(3),
+   branch VARCHAR(5),
+   account_number VARCHAR(14),
+   pix_key VARCHAR(77),
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payout_destinations_user_id ON payout_destinations(user_id);
+
+CREATE TABLE IF NOT EXISTS withdrawals(
+   id VARCHAR(36) PRIMARY KEY,
+   user_id VARCHAR(36) NOT NULL,
+   destination_id VARCHAR(36) NOT NULL,
+   amount BIGINT NOT NULL CHECK (amount > 0),
+   currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   status VARCHAR(20) DEFAULT 'pending' NOT NULL CHECK (status IN ('pending', 'completed', 'failed')),
+   failure_reason TEXT,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (user_id) REFERENCES users(id),
+   FOREIGN KEY (destination_id) REFERENCES payout_destinations(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_withdrawals_user_id ON withdrawals(user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_withdrawals_pending ON withdrawals(created_at) WHERE status = 'pending';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_scheduled_transfers_due;
+DROP INDEX IF EXISTS idx_scheduled_transfers_sender_id;
+DROP TABLE IF EXISTS scheduled_transfers;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS scheduled_transfers(
+   id VARCHAR(36) PRIMARY KEY,
+   sender_id VARCHAR(36) NOT NULL,
+   receiver_id VARCHAR(36) NOT NULL,
+   amount BIGINT NOT NULL CHECK (amount > 0),
+   currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   receiver_currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   execute_at TIMESTAMPTZ NOT NULL,
+   status VARCHAR(20) DEFAULT 'scheduled' NOT NULL CHECK (status IN ('scheduled', 'executed', 'failed', 'cancelled')),
+   transaction_id VARCHAR(36),
+   failure_reason TEXT,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (sender_id) REFERENCES users(id),
+   FOREIGN KEY (receiver_id) REFERENCES users(id),
+   FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_sender_id ON scheduled_transfers(sender_id, execute_at);
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_due ON scheduled_transfers(execute_at) WHERE status = 'scheduled';
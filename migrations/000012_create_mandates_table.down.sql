@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS mandate_executions;
+DROP INDEX IF EXISTS idx_mandates_due;
+DROP INDEX IF EXISTS idx_mandates_sender_id;
+DROP TABLE IF EXISTS mandates;
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS mandates(
+   id VARCHAR(36) PRIMARY KEY,
+   sender_id VARCHAR(36) NOT NULL,
+   receiver_id VARCHAR(36) NOT NULL,
+   amount BIGINT NOT NULL CHECK (amount > 0),
+   currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   receiver_currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   frequency VARCHAR(10) NOT NULL CHECK (frequency IN ('weekly', 'monthly', 'cron')),
+   cron_expression VARCHAR(100),
+   start_at TIMESTAMPTZ NOT NULL,
+   end_at TIMESTAMPTZ,
+   max_executions INT DEFAULT 0 NOT NULL CHECK (max_executions >= 0),
+   execution_count INT DEFAULT 0 NOT NULL,
+   next_execution_at TIMESTAMPTZ NOT NULL,
+   status VARCHAR(20) DEFAULT 'active' NOT NULL CHECK (status IN ('active', 'paused', 'cancelled', 'completed')),
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (sender_id) REFERENCES users(id),
+   FOREIGN KEY (receiver_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_mandates_sender_id ON mandates(sender_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_mandates_due ON mandates(next_execution_at) WHERE status = 'active';
+
+CREATE TABLE IF NOT EXISTS mandate_executions(
+   id VARCHAR(36) PRIMARY KEY,
+   mandate_id VARCHAR(36) NOT NULL,
+   sequence INT NOT NULL,
+   scheduled_for TIMESTAMPTZ NOT NULL,
+   status VARCHAR(20) NOT NULL CHECK (status IN ('executed', 'failed')),
+   transaction_id VARCHAR(36),
+   failure_reason TEXT,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (mandate_id) REFERENCES mandates(id),
+   FOREIGN KEY (transaction_id) REFERENCES transactions(id),
+   UNIQUE (mandate_id, sequence)
+);
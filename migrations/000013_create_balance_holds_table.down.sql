@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_balance_holds_due;
+DROP INDEX IF EXISTS idx_balance_holds_authorized;
+DROP TABLE IF EXISTS balance_holds;
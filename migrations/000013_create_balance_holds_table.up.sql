@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS balance_holds(
+   id VARCHAR(36) PRIMARY KEY,
+   sender_id VARCHAR(36) NOT NULL,
+   receiver_id VARCHAR(36) NOT NULL,
+   amount BIGINT NOT NULL CHECK (amount > 0),
+   currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   captured_amount BIGINT DEFAULT 0 NOT NULL CHECK (captured_amount >= 0 AND captured_amount <= amount),
+   status VARCHAR(20) DEFAULT 'authorized' NOT NULL CHECK (status IN ('authorized', 'captured', 'voided', 'expired')),
+   transaction_id VARCHAR(36),
+   expires_at TIMESTAMPTZ NOT NULL,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (sender_id) REFERENCES users(id),
+   FOREIGN KEY (receiver_id) REFERENCES users(id),
+   FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_balance_holds_authorized ON balance_holds(sender_id, currency) WHERE status = 'authorized';
+CREATE INDEX IF NOT EXISTS idx_balance_holds_due ON balance_holds(expires_at) WHERE status = 'authorized';
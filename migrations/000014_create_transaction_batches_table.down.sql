@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS transaction_batch_items;
+DROP TABLE IF EXISTS transaction_batches;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS transaction_batches(
+   id VARCHAR(36) PRIMARY KEY,
+   atomic BOOLEAN DEFAULT FALSE NOT NULL,
+   status VARCHAR(20) NOT NULL CHECK (status IN ('completed', 'partially_completed', 'failed')),
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+-- Items keep the ids they were requested with, even when no such user exists
+CREATE TABLE IF NOT EXISTS transaction_batch_items(
+   id VARCHAR(36) PRIMARY KEY,
+   batch_id VARCHAR(36) NOT NULL,
+   sequence INT NOT NULL,
+   sender_id VARCHAR(36) NOT NULL,
+   receiver_id VARCHAR(36) NOT NULL,
+   amount BIGINT NOT NULL CHECK (amount > 0),
+   currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   status VARCHAR(20) NOT NULL CHECK (status IN ('succeeded', 'failed')),
+   transaction_id VARCHAR(36),
+   failure_reason TEXT,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (batch_id) REFERENCES transaction_batches(id),
+   FOREIGN KEY (transaction_id) REFERENCES transactions(id),
+   UNIQUE (batch_id, sequence)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS split_payment_shares;
+DROP TABLE IF EXISTS split_payments;
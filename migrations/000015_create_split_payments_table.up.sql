@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS split_payments(
+   id VARCHAR(36) PRIMARY KEY,
+   sender_id VARCHAR(36) NOT NULL,
+   amount BIGINT NOT NULL CHECK (amount > 0),
+   currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (sender_id) REFERENCES users(id)
+);
+
+-- percentage is in basis points, 0 when the share was requested as an amount
+CREATE TABLE IF NOT EXISTS split_payment_shares(
+   split_payment_id VARCHAR(36) NOT NULL,
+   sequence INT NOT NULL,
+   receiver_id VARCHAR(36) NOT NULL,
+   amount BIGINT NOT NULL CHECK (amount > 0),
+   percentage INT DEFAULT 0 NOT NULL CHECK (percentage >= 0 AND percentage <= 10000),
+   transaction_id VARCHAR(36) NOT NULL,
+   PRIMARY KEY (split_payment_id, sequence),
+   FOREIGN KEY (split_payment_id) REFERENCES split_payments(id),
+   FOREIGN KEY (receiver_id) REFERENCES users(id),
+   FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS charges(
+   id VARCHAR(36) PRIMARY KEY,
+   merchant_id VARCHAR(36) NOT NULL,
+   reference VARCHAR(64) NOT NULL,
+   description VARCHAR(140) DEFAULT '' NOT NULL,
+   amount BIGINT NOT NULL CHECK (amount > 0),
+   currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   status VARCHAR(20) DEFAULT 'pending' NOT NULL CHECK (status IN ('pending', 'paid', 'expired', 'cancelled')),
+   payer_id VARCHAR(36),
+   transaction_id VARCHAR(36),
+   expires_at TIMESTAMPTZ NOT NULL,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (merchant_id) REFERENCES users(id),
+   FOREIGN KEY (payer_id) REFERENCES users(id),
+   FOREIGN KEY (transaction_id) REFERENCES transactions(id),
+   UNIQUE (merchant_id, reference)
+);
+
+CREATE INDEX IF NOT EXISTS idx_charges_due ON charges(expires_at) WHERE status = 'pending';
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS alias_keys(
+   id VARCHAR(36) PRIMARY KEY,
+   user_id VARCHAR(36) NOT NULL,
+   key_type VARCHAR(10) NOT NULL CHECK (key_type IN ('email', 'cpf', 'cnpj', 'phone', 'random')),
+   value VARCHAR(77) NOT NULL,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (user_id) REFERENCES users(id),
+   UNIQUE (key_type, value)
+);
+
+CREATE INDEX IF NOT EXISTS idx_alias_keys_user_id ON alias_keys(user_id);
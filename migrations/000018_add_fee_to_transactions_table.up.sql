@@ -0,0 +1,3 @@
+-- fee is taken out of received_amount, in received_currency, and credited to
+-- the platform account.
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS fee BIGINT DEFAULT 0 NOT NULL CHECK (fee >= 0);
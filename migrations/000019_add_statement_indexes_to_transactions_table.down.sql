@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_transactions_receiver_id_created_at;
+DROP INDEX IF EXISTS idx_transactions_sender_id_created_at;
@@ -0,0 +1,4 @@
+-- Statements list the transactions a user sent and received from the most
+-- recent, paging over (created_at, id).
+CREATE INDEX IF NOT EXISTS idx_transactions_sender_id_created_at ON transactions(sender_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_transactions_receiver_id_created_at ON transactions(receiver_id, created_at DESC, id DESC);
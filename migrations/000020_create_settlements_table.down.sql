@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_transactions_created_at;
+DROP TABLE IF EXISTS settlement_transactions;
+DROP TABLE IF EXISTS settlements;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS settlements(
+   id VARCHAR(36) PRIMARY KEY,
+   merchant_id VARCHAR(36) NOT NULL,
+   settlement_date DATE NOT NULL,
+   currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   gross BIGINT DEFAULT 0 NOT NULL,
+   fee BIGINT DEFAULT 0 NOT NULL,
+   transaction_count INT DEFAULT 0 NOT NULL,
+   status VARCHAR(20) DEFAULT 'open' NOT NULL CHECK (status IN ('open', 'closed', 'paid')),
+   closed_at TIMESTAMPTZ,
+   paid_at TIMESTAMPTZ,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (merchant_id) REFERENCES users(id),
+   UNIQUE (merchant_id, settlement_date, currency)
+);
+
+CREATE INDEX IF NOT EXISTS idx_settlements_open ON settlements(settlement_date) WHERE status = 'open';
+
+-- A transaction belongs to a single settlement, which is how the worker tells
+-- the ones still to be settled
+CREATE TABLE IF NOT EXISTS settlement_transactions(
+   transaction_id VARCHAR(36) PRIMARY KEY,
+   settlement_id VARCHAR(36) NOT NULL,
+   FOREIGN KEY (transaction_id) REFERENCES transactions(id),
+   FOREIGN KEY (settlement_id) REFERENCES settlements(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_settlement_transactions_settlement ON settlement_transactions(settlement_id);
+
+-- The transactions still to be settled are picked oldest first
+CREATE INDEX IF NOT EXISTS idx_transactions_created_at ON transactions(created_at);
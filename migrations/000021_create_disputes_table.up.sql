@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS disputes(
+   id VARCHAR(36) PRIMARY KEY,
+   transaction_id VARCHAR(36) NOT NULL UNIQUE,
+   sender_id VARCHAR(36) NOT NULL,
+   receiver_id VARCHAR(36) NOT NULL,
+   amount BIGINT NOT NULL CHECK (amount > 0),
+   frozen_amount BIGINT DEFAULT 0 NOT NULL CHECK (frozen_amount >= 0 AND frozen_amount <= amount),
+   currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   reason VARCHAR(140) NOT NULL,
+   evidence TEXT DEFAULT '' NOT NULL,
+   response TEXT DEFAULT '' NOT NULL,
+   status VARCHAR(20) DEFAULT 'open' NOT NULL CHECK (status IN ('open', 'responded', 'won', 'lost')),
+   respond_by TIMESTAMPTZ NOT NULL,
+   chargeback_id VARCHAR(36),
+   resolved_at TIMESTAMPTZ,
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (transaction_id) REFERENCES transactions(id),
+   FOREIGN KEY (sender_id) REFERENCES users(id),
+   FOREIGN KEY (receiver_id) REFERENCES users(id),
+   FOREIGN KEY (chargeback_id) REFERENCES transactions(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_disputes_sender ON disputes(sender_id);
+CREATE INDEX IF NOT EXISTS idx_disputes_receiver ON disputes(receiver_id);
+CREATE INDEX IF NOT EXISTS idx_disputes_due ON disputes(respond_by) WHERE status = 'open';
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS escrows(
+   id VARCHAR(36) PRIMARY KEY,
+   sender_id VARCHAR(36) NOT NULL,
+   receiver_id VARCHAR(36) NOT NULL,
+   amount BIGINT NOT NULL CHECK (amount > 0),
+   currency CHAR(3) DEFAULT 'BRL' NOT NULL,
+   status VARCHAR(20) DEFAULT 'held' NOT NULL CHECK (status IN ('held', 'shipped', 'released', 'returned', 'expired')),
+   expires_at TIMESTAMPTZ NOT NULL,
+   shipped_at TIMESTAMPTZ,
+   release_at TIMESTAMPTZ,
+   transaction_id VARCHAR(36),
+   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+   FOREIGN KEY (sender_id) REFERENCES users(id),
+   FOREIGN KEY (receiver_id) REFERENCES users(id),
+   FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_escrows_pending ON escrows(sender_id, currency) WHERE status IN ('held', 'shipped');
+CREATE INDEX IF NOT EXISTS idx_escrows_expiry_due ON escrows(expires_at) WHERE status = 'held';
+CREATE INDEX IF NOT EXISTS idx_escrows_release_due ON escrows(release_at) WHERE status = 'shipped';
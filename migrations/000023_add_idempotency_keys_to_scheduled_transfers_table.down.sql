@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_scheduled_transfers_idempotency_key;
+ALTER TABLE scheduled_transfers DROP COLUMN IF EXISTS request_hash;
+ALTER TABLE scheduled_transfers DROP COLUMN IF EXISTS idempotency_key;
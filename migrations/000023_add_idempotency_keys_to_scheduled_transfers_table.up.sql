@@ -0,0 +1,4 @@
+ALTER TABLE scheduled_transfers ADD COLUMN IF NOT EXISTS idempotency_key VARCHAR(255);
+ALTER TABLE scheduled_transfers ADD COLUMN IF NOT EXISTS request_hash VARCHAR(64);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_scheduled_transfers_idempotency_key ON scheduled_transfers(idempotency_key) WHERE idempotency_key IS NOT NULL;
@@ -0,0 +1,10 @@
+-- users and transactions predate the TIMESTAMPTZ columns of the other tables,
+-- so values written from Go, the column defaults and NOW() all refer to the
+-- same instant whatever the time zone of the application or the database
+-- session. Existing values are read as UTC.
+ALTER TABLE users
+   ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC',
+   ALTER COLUMN updated_at TYPE TIMESTAMPTZ USING updated_at AT TIME ZONE 'UTC';
+
+ALTER TABLE transactions
+   ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC';
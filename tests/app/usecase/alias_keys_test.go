@@ -0,0 +1,109 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAliasKeys_Integration(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	ownerID, err := createTestUser(ctx, db, "owner", "common", "86395839004", 0)
+	require.NoError(t, err)
+	otherID, err := createTestUser(ctx, db, "other", "common", "52998224725", 0)
+	require.NoError(t, err)
+
+	aliasKeyRepo := repository.NewAliasKeyRepository(db, otel)
+	registerAliasKey := usecase.NewRegisterAliasKey(aliasKeyRepo, otel)
+	listAliasKeys := usecase.NewListAliasKeys(aliasKeyRepo, otel)
+	deleteAliasKey := usecase.NewDeleteAliasKey(aliasKeyRepo, otel)
+	resolveAliasKey := usecase.NewResolveAliasKey(aliasKeyRepo, otel)
+
+	phoneKey, err := registerAliasKey.Execute(ctx, usecase.RegisterAliasKeyInput{
+		UserID: ownerID,
+		Type:   entity.PhoneAliasKeyType,
+		Value:  "(11) 98765-4321",
+	})
+	require.NoError(t, err)
+	_, err = registerAliasKey.Execute(ctx, usecase.RegisterAliasKeyInput{
+		UserID: ownerID,
+		Type:   entity.CPFAliasKeyType,
+		Value:  "863.958.390-04",
+	})
+	require.NoError(t, err)
+
+	t.Run("keys resolve to their owner", func(t *testing.T) {
+		// Act
+		byPhone, err := resolveAliasKey.Execute(ctx, "+5511987654321")
+		require.NoError(t, err)
+		byCPF, err := resolveAliasKey.Execute(ctx, "86395839004")
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, ownerID, byPhone)
+		assert.Equal(t, ownerID, byCPF)
+	})
+
+	t.Run("a key is owned by a single user", func(t *testing.T) {
+		// Act
+		_, err := registerAliasKey.Execute(ctx, usecase.RegisterAliasKeyInput{
+			UserID: otherID,
+			Type:   entity.PhoneAliasKeyType,
+			Value:  "+5511987654321",
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, errs.ErrAliasKeyTaken)
+	})
+
+	t.Run("only the owner deletes its keys", func(t *testing.T) {
+		// Act
+		otherErr := deleteAliasKey.Execute(ctx, otherID, uuid.MustParse(phoneKey.ID()))
+		ownerErr := deleteAliasKey.Execute(ctx, ownerID, uuid.MustParse(phoneKey.ID()))
+
+		// Assert
+		assert.ErrorIs(t, otherErr, errs.ErrAliasKeyNotFound)
+		require.NoError(t, ownerErr)
+		keys, err := listAliasKeys.Execute(ctx, ownerID)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, entity.CPFAliasKeyType, keys[0].Type())
+		_, err = resolveAliasKey.Execute(ctx, "+5511987654321")
+		assert.ErrorIs(t, err, errs.ErrAliasKeyNotFound)
+	})
+}
@@ -0,0 +1,154 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceHolds_Integration(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	senderID, err := createTestUser(ctx, db, "holder", "common", "86395839004", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, senderID))
+	receiverID, err := createTestUser(ctx, db, "merchant", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	balanceHoldRepo := repository.NewBalanceHoldRepository(db, otel)
+	authorizeHold := usecase.NewAuthorizeHold(balanceHoldRepo, NewMockTransactionAuthorizerGateway(true), vo.TransferLimitPolicy{}, time.Hour, otel)
+	captureHold := usecase.NewCaptureHold(balanceHoldRepo, vo.FeePolicy{}, otel)
+	voidHold := usecase.NewVoidHold(balanceHoldRepo, otel)
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransaction := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		vo.FeePolicy{},
+		otel,
+	)
+
+	t.Run("held money is not available for transfers", func(t *testing.T) {
+		// Act
+		holdID, err := authorizeHold.Execute(ctx, usecase.AuthorizeHoldInput{
+			Amount:     70000,
+			SenderID:   senderID,
+			ReceiverID: receiverID,
+		})
+		require.NoError(t, err)
+		_, transferErr := createTransaction.Execute(ctx, usecase.CreateTransactionInput{
+			Amount:     40000,
+			SenderID:   senderID,
+			ReceiverID: receiverID,
+		})
+
+		// Assert
+		assert.ErrorIs(t, transferErr, errs.ErrInsufficientBalance)
+		require.NoError(t, voidHold.Execute(ctx, uuid.MustParse(holdID)))
+	})
+
+	t.Run("partial capture moves only the captured amount", func(t *testing.T) {
+		// Arrange
+		holdID, err := authorizeHold.Execute(ctx, usecase.AuthorizeHoldInput{
+			Amount:     50000,
+			SenderID:   senderID,
+			ReceiverID: receiverID,
+		})
+		require.NoError(t, err)
+
+		// Act
+		transactionID, err := captureHold.Execute(ctx, usecase.CaptureHoldInput{
+			HoldID: uuid.MustParse(holdID),
+			Amount: 30000,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotEmpty(t, transactionID)
+		hold, err := balanceHoldRepo.GetBalanceHold(ctx, holdID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.BalanceHoldCapturedStatus, hold.Status())
+		assert.Equal(t, transactionID, hold.TransactionID())
+
+		senderBalance, err := getBalance(ctx, db, senderID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(70000), senderBalance)
+		receiverBalance, err := getBalance(ctx, db, receiverID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(30000), receiverBalance)
+
+		_, err = createTransaction.Execute(ctx, usecase.CreateTransactionInput{
+			Amount:     70000,
+			SenderID:   senderID,
+			ReceiverID: receiverID,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("expired holds are released", func(t *testing.T) {
+		// Arrange
+		depositorID, err := createTestUser(ctx, db, "expiring", "common", "52998224725", 20000)
+		require.NoError(t, err)
+		require.NoError(t, postOpeningBalance(ctx, db, depositorID))
+		holdID, err := authorizeHold.Execute(ctx, usecase.AuthorizeHoldInput{
+			Amount:     20000,
+			SenderID:   depositorID,
+			ReceiverID: receiverID,
+			ExpiresAt:  time.Now().Add(time.Second),
+		})
+		require.NoError(t, err)
+		time.Sleep(1100 * time.Millisecond)
+
+		// Act
+		processed, err := usecase.NewExpireHolds(balanceHoldRepo, 100, otel).Execute(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+		hold, err := balanceHoldRepo.GetBalanceHold(ctx, holdID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.BalanceHoldExpiredStatus, hold.Status())
+		_, err = captureHold.Execute(ctx, usecase.CaptureHoldInput{HoldID: uuid.MustParse(holdID)})
+		assert.ErrorIs(t, err, errs.ErrBalanceHoldNotAuthorized)
+	})
+}
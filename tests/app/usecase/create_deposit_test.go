@@ -0,0 +1,151 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/gateway"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDeposit_Integration_Success(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	userID, err := createTestUser(ctx, db, "depositor", "common", "86395839004", 0)
+	require.NoError(t, err)
+
+	createDepositUseCase := usecase.NewCreateDeposit(
+		repository.NewDepositRepository(db, otel),
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		gateway.NewFakeFundingGateway(),
+		otel,
+	)
+
+	// Act
+	depositID, err := createDepositUseCase.Execute(ctx, usecase.CreateDepositInput{
+		UserID: userID,
+		Amount: 25075,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotEmpty(t, depositID)
+
+	balance, err := getBalance(ctx, db, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(25075), balance)
+
+	ledgerBalance, err := getLedgerBalance(ctx, db, userID)
+	require.NoError(t, err)
+	assert.Equal(t, balance, ledgerBalance)
+
+	var fundingBalance int64
+	err = db.QueryRowContext(
+		ctx,
+		"SELECT COALESCE(SUM(amount), 0) FROM ledger_entries WHERE transaction_id = $1 AND account_id = $2 AND direction = 'debit'",
+		depositID,
+		entity.FundingAccountID,
+	).Scan(&fundingBalance)
+	require.NoError(t, err)
+	assert.Equal(t, int64(25075), fundingBalance)
+
+	var eventName string
+	err = db.QueryRowContext(ctx, "SELECT event_name FROM outbox WHERE payload->>'DepositID' = $1", depositID).Scan(&eventName)
+	require.NoError(t, err)
+	assert.Equal(t, "DepositEventV1", eventName)
+}
+
+func TestCreateDeposit_Integration_RetriedRequestCreditsOnce(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	userID, err := createTestUser(ctx, db, "depositor", "common", "86395839004", 0)
+	require.NoError(t, err)
+
+	createDepositUseCase := usecase.NewCreateDeposit(
+		repository.NewDepositRepository(db, otel),
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		gateway.NewFakeFundingGateway(),
+		otel,
+	)
+	input := usecase.CreateDepositInput{
+		UserID:         userID,
+		Amount:         10000,
+		IdempotencyKey: "deposit-retry-key",
+	}
+
+	// Act
+	firstID, err := createDepositUseCase.Execute(ctx, input)
+	require.NoError(t, err)
+	secondID, err := createDepositUseCase.Execute(ctx, input)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, firstID, secondID)
+
+	balance, err := getBalance(ctx, db, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10000), balance)
+
+	var deposits int
+	err = db.QueryRowContext(ctx, "SELECT COUNT(*) FROM deposits WHERE user_id = $1", userID).Scan(&deposits)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deposits)
+}
@@ -0,0 +1,106 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSplitPayment_Integration(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	buyerID, err := createTestUser(ctx, db, "buyer", "common", "86395839004", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, buyerID))
+	sellerID, err := createTestUser(ctx, db, "seller", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+	platformID, err := createTestUser(ctx, db, "platform", "common", "52998224725", 0)
+	require.NoError(t, err)
+
+	splitPaymentRepo := repository.NewSplitPaymentRepository(db, otel)
+	createSplitPayment := usecase.NewCreateSplitPayment(splitPaymentRepo, NewMockTransactionAuthorizerGateway(true), vo.TransferLimitPolicy{}, vo.FeePolicy{}, otel)
+
+	t.Run("unknown receiver moves nothing", func(t *testing.T) {
+		// Act
+		_, err := createSplitPayment.Execute(ctx, usecase.CreateSplitPaymentInput{
+			Amount:   6000,
+			SenderID: buyerID,
+			Shares: []usecase.CreateSplitPaymentShareInput{
+				{ReceiverID: sellerID, Amount: 5000},
+				{ReceiverID: uuid.New(), Amount: 1000},
+			},
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, errs.ErrReceiverNotFound)
+		buyerBalance, err := getBalance(ctx, db, buyerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10000), buyerBalance)
+	})
+
+	t.Run("percentages split the amount between receivers", func(t *testing.T) {
+		// Act
+		splitPayment, err := createSplitPayment.Execute(ctx, usecase.CreateSplitPaymentInput{
+			Amount:   6001,
+			SenderID: buyerID,
+			Shares: []usecase.CreateSplitPaymentShareInput{
+				{ReceiverID: sellerID, Percentage: 9000},
+				{ReceiverID: platformID, Percentage: 1000},
+			},
+		})
+
+		// Assert
+		require.NoError(t, err)
+
+		stored, err := splitPaymentRepo.GetSplitPayment(ctx, splitPayment.ID())
+		require.NoError(t, err)
+		require.Len(t, stored.Shares(), 2)
+		assert.Equal(t, int64(5401), stored.Shares()[0].Amount())
+		assert.Equal(t, int64(9000), stored.Shares()[0].Percentage())
+		assert.Equal(t, splitPayment.Shares()[1].TransactionID(), stored.Shares()[1].TransactionID())
+
+		buyerBalance, err := getBalance(ctx, db, buyerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3999), buyerBalance)
+		sellerBalance, err := getBalance(ctx, db, sellerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5401), sellerBalance)
+		platformBalance, err := getBalance(ctx, db, platformID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(600), platformBalance)
+	})
+}
@@ -0,0 +1,106 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTransactionBatch_Integration(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	payerID, err := createTestUser(ctx, db, "payer", "common", "86395839004", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, payerID))
+	firstEmployeeID, err := createTestUser(ctx, db, "first employee", "common", "52998224725", 0)
+	require.NoError(t, err)
+	secondEmployeeID, err := createTestUser(ctx, db, "second employee", "common", "54075359042", 0)
+	require.NoError(t, err)
+
+	batchRepo := repository.NewTransactionBatchRepository(db, otel)
+	createBatch := usecase.NewCreateTransactionBatch(batchRepo, NewMockTransactionAuthorizerGateway(true), vo.TransferLimitPolicy{}, vo.FeePolicy{}, 100, otel)
+
+	t.Run("atomic batch moves nothing when an item fails", func(t *testing.T) {
+		// Act
+		batch, err := createBatch.Execute(ctx, usecase.CreateTransactionBatchInput{
+			Atomic: true,
+			Items: []usecase.CreateTransactionBatchItemInput{
+				{Amount: 30000, SenderID: payerID, ReceiverID: firstEmployeeID},
+				{Amount: 30000, SenderID: payerID, ReceiverID: uuid.New()},
+			},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, entity.TransactionBatchFailedStatus, batch.Status())
+		assert.Equal(t, errs.ErrTransactionBatchAborted.Error(), batch.Items()[0].FailureReason())
+		assert.Equal(t, errs.ErrReceiverNotFound.Error(), batch.Items()[1].FailureReason())
+		payerBalance, err := getBalance(ctx, db, payerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(100000), payerBalance)
+	})
+
+	t.Run("items succeed or fail on their own", func(t *testing.T) {
+		// Act
+		batch, err := createBatch.Execute(ctx, usecase.CreateTransactionBatchInput{
+			Items: []usecase.CreateTransactionBatchItemInput{
+				{Amount: 60000, SenderID: payerID, ReceiverID: firstEmployeeID},
+				{Amount: 60000, SenderID: payerID, ReceiverID: secondEmployeeID},
+				{Amount: 40000, SenderID: payerID, ReceiverID: secondEmployeeID},
+			},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, entity.TransactionBatchPartiallyCompletedStatus, batch.Status())
+
+		stored, err := batchRepo.GetTransactionBatch(ctx, batch.ID())
+		require.NoError(t, err)
+		require.Len(t, stored.Items(), 3)
+		assert.Equal(t, entity.TransactionBatchItemSucceededStatus, stored.Items()[0].Status())
+		assert.Equal(t, errs.ErrInsufficientBalance.Error(), stored.Items()[1].FailureReason())
+		assert.Equal(t, batch.Items()[2].TransactionID(), stored.Items()[2].TransactionID())
+
+		payerBalance, err := getBalance(ctx, db, payerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), payerBalance)
+		secondEmployeeBalance, err := getBalance(ctx, db, secondEmployeeID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(40000), secondEmployeeBalance)
+	})
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
 	"log"
+	"sync"
 	"testing"
 
 	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
 	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
 	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
 	test "github.com.br/gibranct/simplified-wallet/tests"
 	"github.com/google/uuid"
@@ -20,9 +22,9 @@ import (
 	_ "github.com/lib/pq"
 )
 
-func createTestUser(ctx context.Context, db *sqlx.DB, name, userType, document string, initialBalance float64) (uuid.UUID, error) {
+func createTestUser(ctx context.Context, db *sqlx.DB, name, userType, document string, initialBalance int64) (uuid.UUID, error) {
 	userID := uuid.New()
-	money, err := vo.NewMoney(initialBalance)
+	money, err := vo.NewMoney(initialBalance, vo.DefaultCurrency)
 	if err != nil {
 		log.Panicln(err)
 	}
@@ -52,15 +54,45 @@ func createTestUser(ctx context.Context, db *sqlx.DB, name, userType, document s
 	return userID, err
 }
 
+// postOpeningBalance backs a seeded balance with opening ledger entries so the
+// ledger check performed on every transfer holds.
+func postOpeningBalance(ctx context.Context, db *sqlx.DB, userID uuid.UUID) error {
+	balance, err := getBalance(ctx, db, userID)
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO ledger_entries (id, account_id, direction, amount) VALUES ($1, $2, $3, $4), ($5, $6, $7, $8)`
+	_, err = db.ExecContext(
+		ctx,
+		query,
+		uuid.New(), userID, "credit", balance,
+		uuid.New(), "system:opening-balance", "debit", balance,
+	)
+	return err
+}
+
 func getBalance(ctx context.Context, db *sqlx.DB, userID uuid.UUID) (int64, error) {
 	var balance int64
 	err := db.QueryRowContext(ctx, "SELECT balance FROM users WHERE id = $1", userID.String()).Scan(&balance)
 	return balance, err
 }
 
+// getLedgerBalance derives the balance of the user in the default currency
+// from its ledger entries.
+func getLedgerBalance(ctx context.Context, db *sqlx.DB, userID uuid.UUID) (int64, error) {
+	var balance int64
+	err := db.QueryRowContext(
+		ctx,
+		"SELECT COALESCE(SUM(CASE WHEN direction = 'credit' THEN amount ELSE -amount END), 0) FROM ledger_entries WHERE account_id = $1 AND currency = $2",
+		userID.String(),
+		vo.DefaultCurrency,
+	).Scan(&balance)
+	return balance, err
+}
+
 func TestCreateTransaction_Integration_Success(t *testing.T) {
 	ctx := context.Background()
-	migrateVersion := uint(2) // Use the latest migration version
+	migrateVersion := uint(23) // Use the latest migration version
 
 	// Setup
 	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
@@ -89,21 +121,24 @@ func TestCreateTransaction_Integration_Success(t *testing.T) {
 	cnpj := "71627571000107"
 
 	// Create test users
-	senderID, err := createTestUser(ctx, db, "sender", "common", cpf, 1000.0)
+	senderID, err := createTestUser(ctx, db, "sender", "common", cpf, 100055)
 	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, senderID))
 
-	receiverID, err := createTestUser(ctx, db, "receiver", "merchant", cnpj, 0.0)
+	receiverID, err := createTestUser(ctx, db, "receiver", "merchant", cnpj, 0)
 	require.NoError(t, err)
 
 	userRepo := repository.NewUserRepository(db, otel)
 	authorizerGateway := NewMockTransactionAuthorizerGateway(true) // Always authorize
-	snsService := NewMockQueue()
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
 
 	// Create use case
-	createTransactionUseCase := usecase.NewCreateTransaction(userRepo, authorizerGateway, snsService, otel)
+	createTransactionUseCase := usecase.NewCreateTransaction(userRepo, repository.NewIdempotencyKeyRepository(db, otel), authorizerGateway, fxRateProvider, vo.TransferLimitPolicy{}, vo.FeePolicy{}, otel)
 
 	// Execute transaction
-	amount := 400.0
+	// Cents must survive the round trip through the database untouched
+	amount := int64(40025)
 	input := usecase.CreateTransactionInput{
 		Amount:     amount,
 		SenderID:   senderID,
@@ -120,17 +155,50 @@ func TestCreateTransaction_Integration_Success(t *testing.T) {
 	// Verify balances were updated correctly
 	senderBalance, err := getBalance(ctx, db, senderID)
 	require.NoError(t, err)
-	assert.Equal(t, int64(60000), senderBalance)
+	assert.Equal(t, int64(60030), senderBalance)
 
 	receiverBalance, err := getBalance(ctx, db, receiverID)
 	require.NoError(t, err)
-	assert.Equal(t, int64(40000), receiverBalance)
+	assert.Equal(t, int64(40025), receiverBalance)
 
 	// Verify transaction was recorded
 	var count int
 	err = db.QueryRowContext(ctx, "SELECT COUNT(*) FROM transactions WHERE id = $1", transactionID).Scan(&count)
 	require.NoError(t, err)
 	assert.Equal(t, 1, count)
+
+	// Verify the ledger postings are balanced and back the cached balances
+	var debits, credits int64
+	err = db.QueryRowContext(
+		ctx,
+		"SELECT COALESCE(SUM(amount) FILTER (WHERE direction = 'debit'), 0), COALESCE(SUM(amount) FILTER (WHERE direction = 'credit'), 0) FROM ledger_entries WHERE transaction_id = $1",
+		transactionID,
+	).Scan(&debits, &credits)
+	require.NoError(t, err)
+	assert.Equal(t, int64(40025), debits)
+	assert.Equal(t, debits, credits)
+
+	senderLedgerBalance, err := getLedgerBalance(ctx, db, senderID)
+	require.NoError(t, err)
+	assert.Equal(t, senderBalance, senderLedgerBalance)
+
+	receiverLedgerBalance, err := getLedgerBalance(ctx, db, receiverID)
+	require.NoError(t, err)
+	assert.Equal(t, receiverBalance, receiverLedgerBalance)
+
+	// Verify the event was stored in the outbox and is published by the relay
+	queueMock := NewMockQueue()
+	relayOutbox := usecase.NewRelayOutbox(repository.NewOutboxRepository(db, otel), queueMock, 10, otel)
+	processed, err := relayOutbox.Execute(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	require.Len(t, queueMock.messages, 1)
+	assert.Contains(t, string(queueMock.messages[0]), transactionID)
+
+	var status string
+	err = db.QueryRowContext(ctx, "SELECT status FROM outbox WHERE payload->>'TransactionID' = $1", transactionID).Scan(&status)
+	require.NoError(t, err)
+	assert.Equal(t, "sent", status)
 }
 
 func TestCreateTransaction_Integration_Rollback(t *testing.T) {
@@ -164,19 +232,20 @@ func TestCreateTransaction_Integration_Rollback(t *testing.T) {
 	cnpj := "71627571000107"
 
 	// Create test users
-	senderID, err := createTestUser(ctx, db, "sender_rollback", "common", cpf, 100.0)
+	senderID, err := createTestUser(ctx, db, "sender_rollback", "common", cpf, 10000)
 	require.NoError(t, err)
 
-	receiverID, err := createTestUser(ctx, db, "receiver_rollback", "merchant", cnpj, 0.0)
+	receiverID, err := createTestUser(ctx, db, "receiver_rollback", "merchant", cnpj, 0)
 	require.NoError(t, err)
 
 	userRepo := repository.NewUserRepository(db, otel)
 
 	authorizerGateway := NewMockTransactionAuthorizerGateway(true) // Always authorize
-	snsService := NewMockQueue()
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
 
 	// Create use case with the failing repository
-	createTransactionUseCase := usecase.NewCreateTransaction(userRepo, authorizerGateway, snsService, otel)
+	createTransactionUseCase := usecase.NewCreateTransaction(userRepo, repository.NewIdempotencyKeyRepository(db, otel), authorizerGateway, fxRateProvider, vo.TransferLimitPolicy{}, vo.FeePolicy{}, otel)
 
 	// Get initial balances
 	initialSenderBalance, err := getBalance(ctx, db, senderID)
@@ -186,7 +255,7 @@ func TestCreateTransaction_Integration_Rollback(t *testing.T) {
 	require.NoError(t, err)
 
 	// Execute transaction that should fail
-	amount := 50.0
+	amount := int64(5000)
 	input := usecase.CreateTransactionInput{
 		Amount:     amount,
 		SenderID:   senderID,
@@ -210,6 +279,87 @@ func TestCreateTransaction_Integration_Rollback(t *testing.T) {
 	assert.Equal(t, initialReceiverBalance, currentReceiverBalance)
 }
 
+func TestCreateTransaction_Integration_OppositeDirectionsConcurrently(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	aliceID, err := createTestUser(ctx, db, "alice", "common", "86395839004", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, aliceID))
+	bobID, err := createTestUser(ctx, db, "bob", "common", "52998224725", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, bobID))
+
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransactionUseCase := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		vo.FeePolicy{},
+		otel,
+	)
+
+	// Act
+	// Transfers in both directions lock the same pair of users at once
+	const transfersPerDirection = 20
+	var wg sync.WaitGroup
+	errCh := make(chan error, 2*transfersPerDirection)
+	for i := 0; i < transfersPerDirection; i++ {
+		for _, pair := range [][2]uuid.UUID{{aliceID, bobID}, {bobID, aliceID}} {
+			wg.Add(1)
+			go func(senderID, receiverID uuid.UUID) {
+				defer wg.Done()
+				_, err := createTransactionUseCase.Execute(ctx, usecase.CreateTransactionInput{
+					Amount:     1000,
+					SenderID:   senderID,
+					ReceiverID: receiverID,
+				})
+				errCh <- err
+			}(pair[0], pair[1])
+		}
+	}
+	wg.Wait()
+	close(errCh)
+
+	// Assert
+	for err := range errCh {
+		assert.NoError(t, err)
+	}
+	aliceBalance, err := getBalance(ctx, db, aliceID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100000), aliceBalance)
+	bobBalance, err := getBalance(ctx, db, bobID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100000), bobBalance)
+}
+
 type TransactionAuthorizerGatewayMock struct {
 	authorize bool
 }
@@ -224,9 +374,12 @@ func (m *TransactionAuthorizerGatewayMock) IsTransactionAllowed(ctx context.Cont
 	return m.authorize
 }
 
-type QueueMock struct{}
+type QueueMock struct {
+	messages [][]byte
+}
 
 func (m *QueueMock) Send(ctx context.Context, message []byte) error {
+	m.messages = append(m.messages, message)
 	return nil
 }
 
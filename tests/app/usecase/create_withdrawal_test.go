@@ -0,0 +1,124 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type payoutGatewayFunc func(ctx context.Context, withdrawal *entity.Withdrawal, destination *entity.PayoutDestination) error
+
+func (f payoutGatewayFunc) RequestPayout(ctx context.Context, withdrawal *entity.Withdrawal, destination *entity.PayoutDestination) error {
+	return f(ctx, withdrawal, destination)
+}
+
+func TestCreateWithdrawal_Integration_HoldAndSettle(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	merchantID, err := createTestUser(ctx, db, "merchant", "merchant", "71627571000107", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, merchantID))
+
+	withdrawalRepo := repository.NewWithdrawalRepository(db, otel)
+	destinationRepo := repository.NewPayoutDestinationRepository(db, otel)
+
+	destinationID, err := usecase.NewRegisterPayoutDestination(destinationRepo, otel).Execute(ctx, usecase.RegisterPayoutDestinationInput{
+		UserID: merchantID,
+		Kind:   entity.PixKeyDestinationKind,
+		PixKey: "merchant@example.com",
+	})
+	require.NoError(t, err)
+
+	accepting := payoutGatewayFunc(func(ctx context.Context, withdrawal *entity.Withdrawal, destination *entity.PayoutDestination) error {
+		return nil
+	})
+	rejecting := payoutGatewayFunc(func(ctx context.Context, withdrawal *entity.Withdrawal, destination *entity.PayoutDestination) error {
+		return fmt.Errorf("%w: pix key not found", errs.ErrPayoutNotAccepted)
+	})
+	settleWithdrawal := usecase.NewSettleWithdrawal(withdrawalRepo, otel)
+
+	// Act: a withdrawal paid out and one rejected by the gateway
+	completedID, err := usecase.NewCreateWithdrawal(withdrawalRepo, destinationRepo, accepting, otel).Execute(ctx, usecase.CreateWithdrawalInput{
+		UserID:        merchantID,
+		DestinationID: uuid.MustParse(destinationID),
+		Amount:        30000,
+	})
+	require.NoError(t, err)
+
+	heldBalance, err := getBalance(ctx, db, merchantID)
+	require.NoError(t, err)
+
+	status, err := settleWithdrawal.Execute(ctx, usecase.SettleWithdrawalInput{
+		WithdrawalID: uuid.MustParse(completedID),
+		Succeeded:    true,
+	})
+	require.NoError(t, err)
+
+	_, rejectErr := usecase.NewCreateWithdrawal(withdrawalRepo, destinationRepo, rejecting, otel).Execute(ctx, usecase.CreateWithdrawalInput{
+		UserID:        merchantID,
+		DestinationID: uuid.MustParse(destinationID),
+		Amount:        20000,
+	})
+
+	// Assert
+	assert.Equal(t, int64(70000), heldBalance)
+	assert.Equal(t, entity.WithdrawalCompletedStatus, status)
+	assert.ErrorIs(t, rejectErr, errs.ErrPayoutNotAccepted)
+
+	balance, err := getBalance(ctx, db, merchantID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(70000), balance)
+
+	ledgerBalance, err := getLedgerBalance(ctx, db, merchantID)
+	require.NoError(t, err)
+	assert.Equal(t, balance, ledgerBalance)
+
+	var holdBalance int64
+	err = db.QueryRowContext(
+		ctx,
+		"SELECT COALESCE(SUM(CASE WHEN direction = 'credit' THEN amount ELSE -amount END), 0) FROM ledger_entries WHERE account_id = $1",
+		entity.PayoutHoldAccountID,
+	).Scan(&holdBalance)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), holdBalance)
+
+	var failed int
+	err = db.QueryRowContext(ctx, "SELECT COUNT(*) FROM withdrawals WHERE user_id = $1 AND status = 'failed'", merchantID.String()).Scan(&failed)
+	require.NoError(t, err)
+	assert.Equal(t, 1, failed)
+}
@@ -0,0 +1,180 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisputes_Integration(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	senderID, err := createTestUser(ctx, db, "buyer", "common", "86395839004", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, senderID))
+	merchantID, err := createTestUser(ctx, db, "merchant", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransaction := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		vo.FeePolicy{},
+		otel,
+	)
+	refundTransaction := usecase.NewRefundTransaction(repository.NewTransactionRepository(db, otel), otel)
+	disputeRepo := repository.NewDisputeRepository(db, otel)
+	openDispute := usecase.NewOpenDispute(disputeRepo, time.Hour, otel)
+	respondDispute := usecase.NewRespondDispute(disputeRepo, otel)
+	resolveDispute := usecase.NewResolveDispute(disputeRepo, otel)
+
+	transfer := func(t *testing.T, amount int64) uuid.UUID {
+		transactionID, err := createTransaction.Execute(ctx, usecase.CreateTransactionInput{
+			Amount:     amount,
+			SenderID:   senderID,
+			ReceiverID: merchantID,
+		})
+		require.NoError(t, err)
+		return uuid.MustParse(transactionID)
+	}
+
+	t.Run("won disputes charge the amount back to the sender", func(t *testing.T) {
+		// Arrange
+		transactionID := transfer(t, 30000)
+
+		// Act
+		dispute, err := openDispute.Execute(ctx, usecase.OpenDisputeInput{
+			TransactionID: transactionID,
+			SenderID:      senderID,
+			Reason:        "not delivered",
+		})
+		require.NoError(t, err)
+		_, refundErr := refundTransaction.Execute(ctx, usecase.RefundTransactionInput{TransactionID: transactionID})
+		_, duplicateErr := openDispute.Execute(ctx, usecase.OpenDisputeInput{
+			TransactionID: transactionID,
+			SenderID:      senderID,
+			Reason:        "not delivered",
+		})
+		won, err := resolveDispute.Execute(ctx, usecase.ResolveDisputeInput{
+			DisputeID: uuid.MustParse(dispute.ID()),
+			Outcome:   entity.DisputeWonStatus,
+		})
+
+		// Assert
+		assert.ErrorIs(t, refundErr, errs.ErrTransactionDisputed)
+		assert.ErrorIs(t, duplicateErr, errs.ErrTransactionAlreadyDisputed)
+		require.NoError(t, err)
+		assert.Equal(t, entity.DisputeWonStatus, won.Status())
+		assert.NotEmpty(t, won.ChargebackID())
+
+		senderBalance, err := getBalance(ctx, db, senderID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(100000), senderBalance)
+		merchantBalance, err := getBalance(ctx, db, merchantID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), merchantBalance)
+
+		var events []string
+		err = db.SelectContext(ctx, &events, "SELECT event_name FROM outbox WHERE payload->>'DisputeID' = $1 ORDER BY event_name", dispute.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"DisputeOpenedEventV1", "DisputeWonEventV1"}, events)
+	})
+
+	t.Run("lost disputes release the amount to the receiver", func(t *testing.T) {
+		// Arrange
+		transactionID := transfer(t, 20000)
+		dispute, err := openDispute.Execute(ctx, usecase.OpenDisputeInput{
+			TransactionID: transactionID,
+			SenderID:      senderID,
+			Reason:        "wrong item",
+		})
+		require.NoError(t, err)
+
+		// Act
+		_, err = respondDispute.Execute(ctx, usecase.RespondDisputeInput{
+			DisputeID:  uuid.MustParse(dispute.ID()),
+			ReceiverID: merchantID,
+			Response:   "item matches the listing",
+		})
+		require.NoError(t, err)
+		lost, err := resolveDispute.Execute(ctx, usecase.ResolveDisputeInput{
+			DisputeID: uuid.MustParse(dispute.ID()),
+			Outcome:   entity.DisputeLostStatus,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, entity.DisputeLostStatus, lost.Status())
+		assert.Empty(t, lost.ChargebackID())
+		merchantBalance, err := getBalance(ctx, db, merchantID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(20000), merchantBalance)
+
+		_, err = refundTransaction.Execute(ctx, usecase.RefundTransactionInput{TransactionID: transactionID, Amount: 5000})
+		assert.NoError(t, err)
+	})
+
+	t.Run("unanswered disputes are resolved in favor of the sender", func(t *testing.T) {
+		// Arrange
+		transactionID := transfer(t, 10000)
+		_, err := usecase.NewOpenDispute(disputeRepo, time.Second, otel).Execute(ctx, usecase.OpenDisputeInput{
+			TransactionID: transactionID,
+			SenderID:      senderID,
+			Reason:        "not delivered",
+		})
+		require.NoError(t, err)
+		time.Sleep(1100 * time.Millisecond)
+
+		// Act
+		processed, err := usecase.NewResolveOverdueDisputes(disputeRepo, 100, otel).Execute(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+		disputes, err := usecase.NewListDisputes(disputeRepo, otel).Execute(ctx, senderID)
+		require.NoError(t, err)
+		require.Len(t, disputes, 3)
+		assert.Equal(t, entity.DisputeWonStatus, disputes[0].Status())
+	})
+}
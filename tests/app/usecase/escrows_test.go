@@ -0,0 +1,181 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscrows_Integration(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	buyerID, err := createTestUser(ctx, db, "buyer", "common", "86395839004", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, buyerID))
+	sellerID, err := createTestUser(ctx, db, "seller", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	escrowRepo := repository.NewEscrowRepository(db, otel)
+	createEscrow := usecase.NewCreateEscrow(escrowRepo, NewMockTransactionAuthorizerGateway(true), vo.TransferLimitPolicy{}, time.Hour, otel)
+	shipEscrow := usecase.NewShipEscrow(escrowRepo, time.Hour, otel)
+	confirmEscrow := usecase.NewConfirmEscrow(escrowRepo, vo.FeePolicy{}, otel)
+	resolveEscrow := usecase.NewResolveEscrow(escrowRepo, vo.FeePolicy{}, otel)
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransaction := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		vo.FeePolicy{},
+		otel,
+	)
+
+	t.Run("escrowed money is released once the buyer confirms", func(t *testing.T) {
+		// Arrange
+		escrow, err := createEscrow.Execute(ctx, usecase.CreateEscrowInput{
+			Amount:     70000,
+			SenderID:   buyerID,
+			ReceiverID: sellerID,
+		})
+		require.NoError(t, err)
+		_, transferErr := createTransaction.Execute(ctx, usecase.CreateTransactionInput{
+			Amount:     40000,
+			SenderID:   buyerID,
+			ReceiverID: sellerID,
+		})
+		assert.ErrorIs(t, transferErr, errs.ErrInsufficientBalance)
+
+		// Act
+		_, err = shipEscrow.Execute(ctx, usecase.ShipEscrowInput{EscrowID: uuid.MustParse(escrow.ID()), ReceiverID: sellerID})
+		require.NoError(t, err)
+		confirmed, err := confirmEscrow.Execute(ctx, usecase.ConfirmEscrowInput{EscrowID: uuid.MustParse(escrow.ID()), SenderID: buyerID})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, entity.EscrowReleasedStatus, confirmed.Status())
+		assert.NotEmpty(t, confirmed.TransactionID())
+
+		buyerBalance, err := getBalance(ctx, db, buyerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(30000), buyerBalance)
+		sellerBalance, err := getBalance(ctx, db, sellerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(70000), sellerBalance)
+
+		var events []string
+		err = db.SelectContext(ctx, &events, "SELECT event_name FROM outbox WHERE payload->>'EscrowID' = $1 ORDER BY event_name", escrow.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"EscrowCreatedEventV1", "EscrowReleasedEventV1", "EscrowShippedEventV1"}, events)
+	})
+
+	t.Run("returned escrows give the money back to the buyer", func(t *testing.T) {
+		// Arrange
+		escrow, err := createEscrow.Execute(ctx, usecase.CreateEscrowInput{
+			Amount:     20000,
+			SenderID:   buyerID,
+			ReceiverID: sellerID,
+		})
+		require.NoError(t, err)
+
+		// Act
+		returned, err := resolveEscrow.Execute(ctx, usecase.ResolveEscrowInput{
+			EscrowID: uuid.MustParse(escrow.ID()),
+			Outcome:  entity.EscrowReturnedStatus,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, entity.EscrowReturnedStatus, returned.Status())
+		_, err = createTransaction.Execute(ctx, usecase.CreateTransactionInput{
+			Amount:     30000,
+			SenderID:   buyerID,
+			ReceiverID: sellerID,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("deadlines release shipped escrows and expire unshipped ones", func(t *testing.T) {
+		// Arrange
+		depositorID, err := createTestUser(ctx, db, "depositor", "common", "52998224725", 20000)
+		require.NoError(t, err)
+		require.NoError(t, postOpeningBalance(ctx, db, depositorID))
+		shipped, err := createEscrow.Execute(ctx, usecase.CreateEscrowInput{
+			Amount:     12000,
+			SenderID:   depositorID,
+			ReceiverID: sellerID,
+		})
+		require.NoError(t, err)
+		_, err = usecase.NewShipEscrow(escrowRepo, time.Second, otel).Execute(ctx, usecase.ShipEscrowInput{EscrowID: uuid.MustParse(shipped.ID()), ReceiverID: sellerID})
+		require.NoError(t, err)
+		unshipped, err := createEscrow.Execute(ctx, usecase.CreateEscrowInput{
+			Amount:     8000,
+			SenderID:   depositorID,
+			ReceiverID: sellerID,
+			ExpiresAt:  time.Now().Add(time.Second),
+		})
+		require.NoError(t, err)
+		time.Sleep(1100 * time.Millisecond)
+
+		// Act
+		processed, err := usecase.NewSettleDueEscrows(escrowRepo, 100, vo.FeePolicy{}, otel).Execute(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, processed)
+		released, err := escrowRepo.GetEscrow(ctx, shipped.ID())
+		require.NoError(t, err)
+		assert.Equal(t, entity.EscrowReleasedStatus, released.Status())
+		expired, err := escrowRepo.GetEscrow(ctx, unshipped.ID())
+		require.NoError(t, err)
+		assert.Equal(t, entity.EscrowExpiredStatus, expired.Status())
+
+		depositorBalance, err := getBalance(ctx, db, depositorID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(8000), depositorBalance)
+		_, err = createTransaction.Execute(ctx, usecase.CreateTransactionInput{
+			Amount:     8000,
+			SenderID:   depositorID,
+			ReceiverID: sellerID,
+		})
+		assert.NoError(t, err)
+	})
+}
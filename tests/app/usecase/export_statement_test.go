@@ -0,0 +1,182 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/report"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportStatement_Integration_WritesThePeriodFromTheOldestTransaction(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	userID, err := createTestUser(ctx, db, "user", "common", "86395839004", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, userID))
+	friendID, err := createTestUser(ctx, db, "friend", "common", "52998224725", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, friendID))
+
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransaction := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		vo.FeePolicy{},
+		otel,
+	)
+	transfers := []usecase.CreateTransactionInput{
+		{Amount: 1000, SenderID: userID, ReceiverID: friendID},
+		{Amount: 2500, SenderID: friendID, ReceiverID: userID},
+		{Amount: 300, SenderID: userID, ReceiverID: friendID},
+	}
+	for _, input := range transfers {
+		_, err := createTransaction.Execute(ctx, input)
+		require.NoError(t, err)
+	}
+	exportStatement := usecase.NewExportStatement(
+		repository.NewTransactionRepository(db, otel),
+		repository.NewUserRepository(db, otel),
+		func(format string, w io.Writer) (usecase.StatementWriter, error) {
+			return report.NewStatementWriter(format, w)
+		},
+		otel,
+	)
+	now := time.Now()
+
+	// Act
+	var out bytes.Buffer
+	err = exportStatement.Execute(ctx, usecase.ExportStatementInput{
+		UserID: userID,
+		Format: report.CSVStatementFormat,
+		From:   now.Add(-time.Hour),
+		To:     now.Add(time.Hour),
+	}, &out)
+
+	// Assert
+	require.NoError(t, err)
+	rows, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	assert.Equal(t, []string{"sent", "-10.00", "90.00"}, []string{rows[1][3], rows[1][5], rows[1][8]})
+	assert.Equal(t, []string{"received", "25.00", "115.00"}, []string{rows[2][3], rows[2][5], rows[2][8]})
+	assert.Equal(t, []string{"sent", "-3.00", "112.00"}, []string{rows[3][3], rows[3][5], rows[3][8]})
+	assert.Equal(t, friendID.String(), rows[1][4])
+}
+
+func TestExportStatement_Integration_StartsTheBalanceFromTheOneBeforeThePeriod(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	userID, err := createTestUser(ctx, db, "user", "common", "86395839004", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, userID))
+	friendID, err := createTestUser(ctx, db, "friend", "common", "52998224725", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, friendID))
+
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransaction := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		vo.FeePolicy{},
+		otel,
+	)
+	_, err = createTransaction.Execute(ctx, usecase.CreateTransactionInput{Amount: 1000, SenderID: userID, ReceiverID: friendID})
+	require.NoError(t, err)
+	from := time.Now()
+	_, err = createTransaction.Execute(ctx, usecase.CreateTransactionInput{Amount: 2500, SenderID: friendID, ReceiverID: userID})
+	require.NoError(t, err)
+	exportStatement := usecase.NewExportStatement(
+		repository.NewTransactionRepository(db, otel),
+		repository.NewUserRepository(db, otel),
+		func(format string, w io.Writer) (usecase.StatementWriter, error) {
+			return report.NewStatementWriter(format, w)
+		},
+		otel,
+	)
+
+	// Act
+	var out bytes.Buffer
+	err = exportStatement.Execute(ctx, usecase.ExportStatementInput{
+		UserID: userID,
+		Format: report.CSVStatementFormat,
+		From:   from,
+		To:     from.Add(time.Hour),
+	}, &out)
+
+	// Assert
+	require.NoError(t, err)
+	rows, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []string{"received", "25.00", "115.00"}, []string{rows[1][3], rows[1][5], rows[1][8]})
+}
@@ -0,0 +1,221 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTransaction_Integration_ChargesTheFeeToThePlatformAccount(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	senderID, err := createTestUser(ctx, db, "sender", "common", "86395839004", 50000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, senderID))
+	receiverID, err := createTestUser(ctx, db, "receiver", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	schedule, err := vo.NewPercentageFee(199)
+	require.NoError(t, err)
+	fees, err := vo.NewFeePolicy(map[string]vo.FeeSchedule{vo.MerchantUserType: *schedule}, nil)
+	require.NoError(t, err)
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransactionUseCase := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		*fees,
+		otel,
+	)
+
+	// Act
+	transactionID, err := createTransactionUseCase.Execute(ctx, usecase.CreateTransactionInput{
+		Amount:     20000,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+	})
+
+	// Assert
+	require.NoError(t, err)
+
+	senderBalance, err := getBalance(ctx, db, senderID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(30000), senderBalance)
+	receiverBalance, err := getBalance(ctx, db, receiverID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(19602), receiverBalance)
+
+	var fee int64
+	err = db.QueryRowContext(ctx, "SELECT fee FROM transactions WHERE id = $1", transactionID).Scan(&fee)
+	require.NoError(t, err)
+	assert.Equal(t, int64(398), fee)
+
+	var platformBalance int64
+	err = db.QueryRowContext(
+		ctx,
+		"SELECT COALESCE(SUM(amount), 0) FROM ledger_entries WHERE account_id = $1 AND direction = 'credit'",
+		entity.PlatformAccountID,
+	).Scan(&platformBalance)
+	require.NoError(t, err)
+	assert.Equal(t, int64(398), platformBalance)
+}
+
+// getPlatformBalance sums what the ledger credited to the platform account.
+func getPlatformBalance(ctx context.Context, db *sqlx.DB) (int64, error) {
+	var platformBalance int64
+	err := db.QueryRowContext(
+		ctx,
+		"SELECT COALESCE(SUM(amount), 0) FROM ledger_entries WHERE account_id = $1 AND direction = 'credit'",
+		entity.PlatformAccountID,
+	).Scan(&platformBalance)
+	return platformBalance, err
+}
+
+func TestFees_Integration_EveryFlowCreditingMerchantsChargesTheFee(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	senderID, err := createTestUser(ctx, db, "sender", "common", "86395839004", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, senderID))
+	merchantID, err := createTestUser(ctx, db, "merchant", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	schedule, err := vo.NewPercentageFee(199)
+	require.NoError(t, err)
+	fees, err := vo.NewFeePolicy(map[string]vo.FeeSchedule{vo.MerchantUserType: *schedule}, nil)
+	require.NoError(t, err)
+
+	// assertFeeCharged checks that moving 100.00 to the merchant credited the
+	// platform with 1.99.
+	assertFeeCharged := func(t *testing.T, move func() error) {
+		platformBefore, err := getPlatformBalance(ctx, db)
+		require.NoError(t, err)
+		merchantBefore, err := getBalance(ctx, db, merchantID)
+		require.NoError(t, err)
+
+		require.NoError(t, move())
+
+		platformAfter, err := getPlatformBalance(ctx, db)
+		require.NoError(t, err)
+		assert.Equal(t, int64(199), platformAfter-platformBefore)
+		merchantAfter, err := getBalance(ctx, db, merchantID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(9801), merchantAfter-merchantBefore)
+	}
+
+	t.Run("transaction batches", func(t *testing.T) {
+		createBatch := usecase.NewCreateTransactionBatch(repository.NewTransactionBatchRepository(db, otel), NewMockTransactionAuthorizerGateway(true), vo.TransferLimitPolicy{}, *fees, 100, otel)
+		assertFeeCharged(t, func() error {
+			_, err := createBatch.Execute(ctx, usecase.CreateTransactionBatchInput{
+				Atomic: true,
+				Items:  []usecase.CreateTransactionBatchItemInput{{Amount: 10000, SenderID: senderID, ReceiverID: merchantID}},
+			})
+			return err
+		})
+	})
+
+	t.Run("split payments", func(t *testing.T) {
+		createSplitPayment := usecase.NewCreateSplitPayment(repository.NewSplitPaymentRepository(db, otel), NewMockTransactionAuthorizerGateway(true), vo.TransferLimitPolicy{}, *fees, otel)
+		assertFeeCharged(t, func() error {
+			_, err := createSplitPayment.Execute(ctx, usecase.CreateSplitPaymentInput{
+				Amount:   10000,
+				SenderID: senderID,
+				Shares:   []usecase.CreateSplitPaymentShareInput{{ReceiverID: merchantID, Percentage: 10000}},
+			})
+			return err
+		})
+	})
+
+	t.Run("captured holds", func(t *testing.T) {
+		balanceHoldRepo := repository.NewBalanceHoldRepository(db, otel)
+		authorizeHold := usecase.NewAuthorizeHold(balanceHoldRepo, NewMockTransactionAuthorizerGateway(true), vo.TransferLimitPolicy{}, time.Hour, otel)
+		captureHold := usecase.NewCaptureHold(balanceHoldRepo, *fees, otel)
+		assertFeeCharged(t, func() error {
+			holdID, err := authorizeHold.Execute(ctx, usecase.AuthorizeHoldInput{Amount: 10000, SenderID: senderID, ReceiverID: merchantID})
+			if err != nil {
+				return err
+			}
+			_, err = captureHold.Execute(ctx, usecase.CaptureHoldInput{HoldID: uuid.MustParse(holdID)})
+			return err
+		})
+	})
+
+	t.Run("released escrows", func(t *testing.T) {
+		escrowRepo := repository.NewEscrowRepository(db, otel)
+		createEscrow := usecase.NewCreateEscrow(escrowRepo, NewMockTransactionAuthorizerGateway(true), vo.TransferLimitPolicy{}, time.Hour, otel)
+		confirmEscrow := usecase.NewConfirmEscrow(escrowRepo, *fees, otel)
+		assertFeeCharged(t, func() error {
+			escrow, err := createEscrow.Execute(ctx, usecase.CreateEscrowInput{Amount: 10000, SenderID: senderID, ReceiverID: merchantID})
+			if err != nil {
+				return err
+			}
+			_, err = confirmEscrow.Execute(ctx, usecase.ConfirmEscrowInput{EscrowID: uuid.MustParse(escrow.ID()), SenderID: senderID})
+			return err
+		})
+	})
+}
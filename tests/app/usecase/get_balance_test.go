@@ -0,0 +1,86 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBalance_Integration_DerivesPastBalancesFromTheLedger(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	userID, err := createTestUser(ctx, db, "user", "common", "86395839004", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, userID))
+	friendID, err := createTestUser(ctx, db, "friend", "common", "52998224725", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, friendID))
+
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransaction := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		vo.FeePolicy{},
+		otel,
+	)
+	_, err = createTransaction.Execute(ctx, usecase.CreateTransactionInput{Amount: 1000, SenderID: userID, ReceiverID: friendID})
+	require.NoError(t, err)
+	afterFirstTransfer := time.Now()
+	_, err = createTransaction.Execute(ctx, usecase.CreateTransactionInput{Amount: 2500, SenderID: friendID, ReceiverID: userID})
+	require.NoError(t, err)
+	getBalance := usecase.NewGetBalance(repository.NewUserRepository(db, otel), otel)
+
+	// Act
+	current, err := getBalance.Execute(ctx, usecase.GetBalanceInput{UserID: userID})
+	require.NoError(t, err)
+	past, err := getBalance.Execute(ctx, usecase.GetBalanceInput{UserID: userID, At: afterFirstTransfer})
+	require.NoError(t, err)
+	pastInAnotherZone, err := getBalance.Execute(ctx, usecase.GetBalanceInput{
+		UserID: userID,
+		At:     afterFirstTransfer.In(time.FixedZone("BRT", -3*60*60)),
+	})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []usecase.UserBalance{{Currency: vo.BRL, Ledger: 11500, Available: 11500}}, current.Balances)
+	assert.Equal(t, []usecase.UserBalance{{Currency: vo.BRL, Ledger: 9000}}, past.Balances)
+	assert.Equal(t, past.Balances, pastInAnotherZone.Balances)
+}
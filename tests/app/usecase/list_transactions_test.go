@@ -0,0 +1,116 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTransactions_Integration_PagesThroughTheStatementWithRunningBalances(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	userID, err := createTestUser(ctx, db, "user", "common", "86395839004", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, userID))
+	friendID, err := createTestUser(ctx, db, "friend", "common", "52998224725", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, friendID))
+
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransaction := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		vo.FeePolicy{},
+		otel,
+	)
+	transfers := []usecase.CreateTransactionInput{
+		{Amount: 1000, SenderID: userID, ReceiverID: friendID},
+		{Amount: 2500, SenderID: friendID, ReceiverID: userID},
+		{Amount: 300, SenderID: userID, ReceiverID: friendID},
+	}
+	var afterFirstTransfer time.Time
+	for i, input := range transfers {
+		_, err := createTransaction.Execute(ctx, input)
+		require.NoError(t, err)
+		if i == 0 {
+			afterFirstTransfer = time.Now()
+		}
+	}
+	listTransactions := usecase.NewListTransactions(repository.NewTransactionRepository(db, otel), 2, 100, otel)
+
+	// Act
+	firstPage, err := listTransactions.Execute(ctx, usecase.ListTransactionsInput{UserID: userID})
+	require.NoError(t, err)
+	secondPage, err := listTransactions.Execute(ctx, usecase.ListTransactionsInput{UserID: userID, Cursor: firstPage.NextCursor})
+	require.NoError(t, err)
+	received, err := listTransactions.Execute(ctx, usecase.ListTransactionsInput{
+		UserID: userID,
+		Filter: entity.StatementFilter{Direction: entity.ReceivedStatementDirection},
+	})
+	require.NoError(t, err)
+	recent, err := listTransactions.Execute(ctx, usecase.ListTransactionsInput{
+		UserID: userID,
+		Filter: entity.StatementFilter{From: afterFirstTransfer},
+	})
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, firstPage.Entries, 2)
+	assert.NotEmpty(t, firstPage.NextCursor)
+	assert.Equal(t, int64(-300), firstPage.Entries[0].Amount())
+	assert.Equal(t, int64(11200), firstPage.Entries[0].Balance())
+	assert.Equal(t, int64(2500), firstPage.Entries[1].Amount())
+	assert.Equal(t, int64(11500), firstPage.Entries[1].Balance())
+
+	require.Len(t, secondPage.Entries, 1)
+	assert.Empty(t, secondPage.NextCursor)
+	assert.Equal(t, int64(-1000), secondPage.Entries[0].Amount())
+	assert.Equal(t, int64(9000), secondPage.Entries[0].Balance())
+	assert.Equal(t, friendID.String(), secondPage.Entries[0].CounterpartyID())
+
+	require.Len(t, received.Entries, 1)
+	assert.Equal(t, int64(2500), received.Entries[0].Amount())
+
+	require.Len(t, recent.Entries, 2)
+	assert.Equal(t, int64(11200), recent.Entries[0].Balance())
+	assert.Equal(t, int64(11500), recent.Entries[1].Balance())
+}
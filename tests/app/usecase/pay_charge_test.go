@@ -0,0 +1,136 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayCharge_Integration(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	payerID, err := createTestUser(ctx, db, "payer", "common", "86395839004", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, payerID))
+	merchantID, err := createTestUser(ctx, db, "merchant", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	chargeRepo := repository.NewChargeRepository(db, otel)
+	createCharge := usecase.NewCreateCharge(chargeRepo, time.Hour, otel)
+	payCharge := usecase.NewPayCharge(chargeRepo, NewMockTransactionAuthorizerGateway(true), vo.TransferLimitPolicy{}, vo.FeePolicy{}, otel)
+
+	charge, err := createCharge.Execute(ctx, usecase.CreateChargeInput{
+		MerchantID: merchantID,
+		Amount:     4990,
+		Reference:  "order-42",
+	})
+	require.NoError(t, err)
+
+	t.Run("reference is unique per merchant", func(t *testing.T) {
+		// Act
+		_, err := createCharge.Execute(ctx, usecase.CreateChargeInput{
+			MerchantID: merchantID,
+			Amount:     1000,
+			Reference:  "order-42",
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, errs.ErrChargeReferenceTaken)
+	})
+
+	t.Run("scanned PIX codes resolve the charge and the merchant", func(t *testing.T) {
+		// Arrange
+		userRepo := repository.NewUserRepository(db, otel)
+		generate := usecase.NewGenerateChargePixCode(chargeRepo, userRepo, "pix.example.com/v1/charges", "SAO PAULO", otel)
+		generateStatic := usecase.NewGenerateStaticPixCode(userRepo, "SAO PAULO", otel)
+		decode := usecase.NewDecodePixCode(chargeRepo, userRepo, repository.NewAliasKeyRepository(db, otel), "pix.example.com/v1/charges", otel)
+		dynamicCode, err := generate.Execute(ctx, uuid.MustParse(charge.ID()))
+		require.NoError(t, err)
+		staticCode, err := generateStatic.Execute(ctx, usecase.GenerateStaticPixCodeInput{MerchantID: merchantID})
+		require.NoError(t, err)
+
+		// Act
+		chargePayment, err := decode.Execute(ctx, dynamicCode.Payload())
+		require.NoError(t, err)
+		transferPayment, err := decode.Execute(ctx, staticCode.Payload())
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, charge.ID(), chargePayment.Charge.ID())
+		assert.Equal(t, merchantID.String(), transferPayment.Receiver.ID())
+		assert.Equal(t, int64(0), transferPayment.Amount)
+	})
+
+	t.Run("payer pays the charge with a transfer", func(t *testing.T) {
+		// Act
+		paid, err := payCharge.Execute(ctx, usecase.PayChargeInput{
+			ChargeID: uuid.MustParse(charge.ID()),
+			PayerID:  payerID,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		stored, err := chargeRepo.GetCharge(ctx, charge.ID())
+		require.NoError(t, err)
+		assert.Equal(t, entity.ChargePaidStatus, stored.Status())
+		assert.Equal(t, paid.TransactionID(), stored.TransactionID())
+		assert.Equal(t, payerID.String(), stored.PayerID())
+
+		payerBalance, err := getBalance(ctx, db, payerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5010), payerBalance)
+		merchantBalance, err := getBalance(ctx, db, merchantID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4990), merchantBalance)
+	})
+
+	t.Run("charge cannot be paid twice", func(t *testing.T) {
+		// Act
+		_, err := payCharge.Execute(ctx, usecase.PayChargeInput{
+			ChargeID: uuid.MustParse(charge.ID()),
+			PayerID:  payerID,
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, errs.ErrChargeNotPending)
+		payerBalance, err := getBalance(ctx, db, payerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5010), payerBalance)
+	})
+}
@@ -0,0 +1,98 @@
+package usecase_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/report"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileBalances_Integration_Success(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	senderID, err := createTestUser(ctx, db, "reconciled", "common", "86395839004", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, senderID))
+	receiverID, err := createTestUser(ctx, db, "drifted", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransactionUseCase := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		vo.FeePolicy{},
+		otel,
+	)
+	_, err = createTransactionUseCase.Execute(ctx, usecase.CreateTransactionInput{
+		Amount:     2500,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+	})
+	require.NoError(t, err)
+
+	// Change the receiver balance behind the ledger's back
+	_, err = db.ExecContext(ctx, "UPDATE users SET balance = balance + 100 WHERE id = $1", receiverID.String())
+	require.NoError(t, err)
+
+	reportDir := t.TempDir()
+	reconcileBalancesUseCase := usecase.NewReconcileBalances(
+		repository.NewReconciliationRepository(db, otel),
+		report.NewFileDriftReportWriter(reportDir, otel),
+		otel,
+	)
+
+	// Act
+	driftReport, path, err := reconcileBalancesUseCase.Execute(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, driftReport.AccountsChecked())
+	require.Len(t, driftReport.Drifts(), 1)
+	drift := driftReport.Drifts()[0]
+	assert.Equal(t, receiverID.String(), drift.UserID())
+	assert.Equal(t, vo.DefaultCurrency, drift.Currency())
+	assert.Equal(t, int64(2600), drift.Balance())
+	assert.Equal(t, int64(2500), drift.ExpectedBalance())
+	assert.Equal(t, int64(100), drift.Drift())
+
+	_, err = os.Stat(path)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,112 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunMandates_Integration_Success(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	senderID, err := createTestUser(ctx, db, "subscriber", "common", "86395839004", 5000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, senderID))
+	receiverID, err := createTestUser(ctx, db, "streaming", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	mandateRepo := repository.NewMandateRepository(db, otel)
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransactionUseCase := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		vo.FeePolicy{},
+		otel,
+	)
+
+	mandateID, err := usecase.NewCreateMandate(mandateRepo, otel).Execute(ctx, usecase.CreateMandateInput{
+		Amount:        3000,
+		SenderID:      senderID,
+		ReceiverID:    receiverID,
+		Frequency:     vo.WeeklyFrequency,
+		MaxExecutions: 2,
+	})
+	require.NoError(t, err)
+
+	runMandatesUseCase := usecase.NewRunMandates(mandateRepo, createTransactionUseCase, 10, otel)
+
+	// Act
+	firstRun, err := runMandatesUseCase.Execute(ctx)
+	require.NoError(t, err)
+	// Make the second execution due, the sender can no longer afford it
+	_, err = db.ExecContext(ctx, "UPDATE mandates SET next_execution_at = NOW() - INTERVAL '1 minute' WHERE id = $1", mandateID)
+	require.NoError(t, err)
+	secondRun, err := runMandatesUseCase.Execute(ctx)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 1, firstRun)
+	assert.Equal(t, 1, secondRun)
+
+	executions, err := usecase.NewListMandateExecutions(mandateRepo, otel).Execute(ctx, uuid.MustParse(mandateID))
+	require.NoError(t, err)
+	require.Len(t, executions, 2)
+	assert.Equal(t, entity.MandateExecutionExecutedStatus, executions[0].Status())
+	assert.NotEmpty(t, executions[0].TransactionID())
+	assert.Equal(t, entity.MandateExecutionFailedStatus, executions[1].Status())
+	assert.NotEmpty(t, executions[1].FailureReason())
+
+	mandates, err := mandateRepo.ListBySender(ctx, senderID.String())
+	require.NoError(t, err)
+	require.Len(t, mandates, 1)
+	assert.Equal(t, entity.MandateCompletedStatus, mandates[0].Status())
+	assert.Equal(t, 2, mandates[0].ExecutionCount())
+
+	senderBalance, err := getBalance(ctx, db, senderID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), senderBalance)
+
+	var eventName string
+	err = db.QueryRowContext(ctx, "SELECT event_name FROM outbox WHERE payload->>'MandateID' = $1", mandateID).Scan(&eventName)
+	require.NoError(t, err)
+	assert.Equal(t, "MandateExecutionFailedEventV1", eventName)
+}
@@ -0,0 +1,179 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunScheduledTransfers_Integration_Success(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	senderID, err := createTestUser(ctx, db, "scheduler", "common", "86395839004", 10000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, senderID))
+	receiverID, err := createTestUser(ctx, db, "scheduled", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	scheduledTransferRepo := repository.NewScheduledTransferRepository(db, otel)
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransactionUseCase := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		vo.TransferLimitPolicy{},
+		vo.FeePolicy{},
+		otel,
+	)
+	scheduleTransferUseCase := usecase.NewScheduleTransfer(scheduledTransferRepo, otel)
+
+	affordableID, err := scheduleTransferUseCase.Execute(ctx, usecase.ScheduleTransferInput{
+		Amount:     4000,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+		ExecuteAt:  time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	unaffordableID, err := scheduleTransferUseCase.Execute(ctx, usecase.ScheduleTransferInput{
+		Amount:     50000,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+		ExecuteAt:  time.Now().Add(2 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Make both transfers due
+	_, err = db.ExecContext(ctx, "UPDATE scheduled_transfers SET execute_at = NOW() - INTERVAL '1 minute'")
+	require.NoError(t, err)
+
+	runScheduledTransfersUseCase := usecase.NewRunScheduledTransfers(scheduledTransferRepo, createTransactionUseCase, 10, otel)
+
+	// Act
+	processed, err := runScheduledTransfersUseCase.Execute(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, processed)
+
+	scheduledTransfers, err := scheduledTransferRepo.ListBySender(ctx, senderID.String())
+	require.NoError(t, err)
+	statuses := map[string]*entity.ScheduledTransfer{}
+	for _, scheduledTransfer := range scheduledTransfers {
+		statuses[scheduledTransfer.ID()] = scheduledTransfer
+	}
+	require.Contains(t, statuses, affordableID)
+	require.Contains(t, statuses, unaffordableID)
+	assert.Equal(t, entity.ScheduledTransferExecutedStatus, statuses[affordableID].Status())
+	assert.NotEmpty(t, statuses[affordableID].TransactionID())
+	assert.Equal(t, entity.ScheduledTransferFailedStatus, statuses[unaffordableID].Status())
+	assert.NotEmpty(t, statuses[unaffordableID].FailureReason())
+
+	senderBalance, err := getBalance(ctx, db, senderID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6000), senderBalance)
+
+	var eventName string
+	err = db.QueryRowContext(ctx, "SELECT event_name FROM outbox WHERE payload->>'ScheduledTransferID' = $1", unaffordableID).Scan(&eventName)
+	require.NoError(t, err)
+	assert.Equal(t, "ScheduledTransferFailedEventV1", eventName)
+
+	// Nothing is left to run
+	processed, err = runScheduledTransfersUseCase.Execute(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, processed)
+}
+
+func TestScheduleTransfer_Integration_RetriedRequestSchedulesOnce(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	senderID, err := createTestUser(ctx, db, "scheduler", "common", "86395839004", 10000)
+	require.NoError(t, err)
+	receiverID, err := createTestUser(ctx, db, "scheduled", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	scheduledTransferRepo := repository.NewScheduledTransferRepository(db, otel)
+	scheduleTransferUseCase := usecase.NewScheduleTransfer(scheduledTransferRepo, otel)
+	input := usecase.ScheduleTransferInput{
+		Amount:         4000,
+		SenderID:       senderID,
+		ReceiverID:     receiverID,
+		ExecuteAt:      time.Now().Add(time.Hour),
+		IdempotencyKey: "schedule-1",
+	}
+
+	// Act
+	firstID, firstErr := scheduleTransferUseCase.Execute(ctx, input)
+	retriedID, retryErr := scheduleTransferUseCase.Execute(ctx, input)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, retryErr)
+	assert.Equal(t, firstID, retriedID)
+
+	scheduledTransfers, err := scheduledTransferRepo.ListBySender(ctx, senderID.String())
+	require.NoError(t, err)
+	require.Len(t, scheduledTransfers, 1)
+	assert.Equal(t, "schedule-1", scheduledTransfers[0].RequestKey())
+}
@@ -0,0 +1,227 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/entity"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettlements_Integration(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	payerID, err := createTestUser(ctx, db, "payer", "common", "86395839004", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, payerID))
+	merchantID, err := createTestUser(ctx, db, "merchant", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	userRepo := repository.NewUserRepository(db, otel)
+	settlementRepo := repository.NewSettlementRepository(db, otel)
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransaction := usecase.NewCreateTransaction(userRepo, repository.NewIdempotencyKeyRepository(db, otel), NewMockTransactionAuthorizerGateway(true), fxRateProvider, vo.TransferLimitPolicy{}, vo.FeePolicy{}, otel)
+	listSettlements := usecase.NewListSettlements(userRepo, settlementRepo, otel)
+
+	// Two transfers made two days ago and one made today
+	var pastIDs []string
+	for _, amount := range []int64{10000, 2550} {
+		transactionID, err := createTransaction.Execute(ctx, usecase.CreateTransactionInput{Amount: amount, SenderID: payerID, ReceiverID: merchantID})
+		require.NoError(t, err)
+		pastIDs = append(pastIDs, transactionID)
+	}
+	twoDaysAgo := time.Now().AddDate(0, 0, -2)
+	for _, id := range pastIDs {
+		_, err = db.ExecContext(ctx, "UPDATE transactions SET created_at = $1 WHERE id = $2", twoDaysAgo, id)
+		require.NoError(t, err)
+	}
+	_, err = createTransaction.Execute(ctx, usecase.CreateTransactionInput{Amount: 700, SenderID: payerID, ReceiverID: merchantID})
+	require.NoError(t, err)
+
+	t.Run("transfers are grouped by day while the close delay runs", func(t *testing.T) {
+		// Arrange
+		runSettlements := usecase.NewRunSettlements(settlementRepo, 72*time.Hour, 100, otel)
+
+		// Act
+		processed, err := runSettlements.Execute(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 3, processed)
+		settlements, err := listSettlements.Execute(ctx, usecase.ListSettlementsInput{MerchantID: merchantID, Status: entity.SettlementOpenStatus})
+		require.NoError(t, err)
+		require.Len(t, settlements, 2)
+		assert.Equal(t, entity.SettlementDate(time.Now()), settlements[0].Date())
+		assert.Equal(t, int64(700), settlements[0].Gross())
+		assert.Equal(t, entity.SettlementDate(twoDaysAgo), settlements[1].Date())
+		assert.Equal(t, int64(12550), settlements[1].Net())
+		assert.Equal(t, 2, settlements[1].TransactionCount())
+	})
+
+	t.Run("settlements of past days are closed once the delay is over", func(t *testing.T) {
+		// Arrange
+		runSettlements := usecase.NewRunSettlements(settlementRepo, time.Hour, 100, otel)
+
+		// Act
+		_, err := runSettlements.Execute(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		settlements, err := listSettlements.Execute(ctx, usecase.ListSettlementsInput{MerchantID: merchantID, Status: entity.SettlementClosedStatus})
+		require.NoError(t, err)
+		require.Len(t, settlements, 1)
+		closed := settlements[0]
+		assert.Equal(t, int64(12550), closed.Gross())
+		assert.NotNil(t, closed.ClosedAt())
+
+		transactions, err := usecase.NewListSettlementTransactions(settlementRepo, otel).Execute(ctx, uuid.MustParse(closed.ID()))
+		require.NoError(t, err)
+		require.Len(t, transactions, 2)
+		assert.ElementsMatch(t, pastIDs, []string{transactions[0].ID(), transactions[1].ID()})
+
+		var eventName string
+		err = db.QueryRowContext(ctx, "SELECT event_name FROM outbox WHERE payload->>'SettlementID' = $1", closed.ID()).Scan(&eventName)
+		require.NoError(t, err)
+		assert.Equal(t, "SettlementClosedEventV1", eventName)
+	})
+
+	t.Run("only closed settlements are paid", func(t *testing.T) {
+		// Arrange
+		paySettlement := usecase.NewPaySettlement(settlementRepo, otel)
+		open, err := listSettlements.Execute(ctx, usecase.ListSettlementsInput{MerchantID: merchantID, Status: entity.SettlementOpenStatus})
+		require.NoError(t, err)
+		require.Len(t, open, 1)
+		closed, err := listSettlements.Execute(ctx, usecase.ListSettlementsInput{MerchantID: merchantID, Status: entity.SettlementClosedStatus})
+		require.NoError(t, err)
+		require.Len(t, closed, 1)
+
+		// Act
+		errOpen := paySettlement.Execute(ctx, uuid.MustParse(open[0].ID()))
+		err = paySettlement.Execute(ctx, uuid.MustParse(closed[0].ID()))
+
+		// Assert
+		assert.ErrorIs(t, errOpen, errs.ErrSettlementNotClosed)
+		require.NoError(t, err)
+		paid, err := listSettlements.Execute(ctx, usecase.ListSettlementsInput{MerchantID: merchantID, Status: entity.SettlementPaidStatus})
+		require.NoError(t, err)
+		require.Len(t, paid, 1)
+		assert.NotNil(t, paid[0].PaidAt())
+	})
+
+	t.Run("common users have no settlements", func(t *testing.T) {
+		// Act
+		_, err := listSettlements.Execute(ctx, usecase.ListSettlementsInput{MerchantID: payerID})
+
+		// Assert
+		assert.ErrorIs(t, err, errs.ErrOnlyMerchantsHaveSettlements)
+	})
+}
+
+func TestSettlements_Integration_SettlesTheBacklogAndRefunds(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	payerID, err := createTestUser(ctx, db, "payer", "common", "86395839004", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, payerID))
+	merchantID, err := createTestUser(ctx, db, "merchant", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	userRepo := repository.NewUserRepository(db, otel)
+	settlementRepo := repository.NewSettlementRepository(db, otel)
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransaction := usecase.NewCreateTransaction(userRepo, repository.NewIdempotencyKeyRepository(db, otel), NewMockTransactionAuthorizerGateway(true), fxRateProvider, vo.TransferLimitPolicy{}, vo.FeePolicy{}, otel)
+	refundTransaction := usecase.NewRefundTransaction(repository.NewTransactionRepository(db, otel), otel)
+	listSettlements := usecase.NewListSettlements(userRepo, settlementRepo, otel)
+
+	// A transfer left behind by a worker that was down for longer than the
+	// close delay, partially refunded today
+	transactionID, err := createTransaction.Execute(ctx, usecase.CreateTransactionInput{Amount: 10000, SenderID: payerID, ReceiverID: merchantID})
+	require.NoError(t, err)
+	tenDaysAgo := time.Now().AddDate(0, 0, -10)
+	_, err = db.ExecContext(ctx, "UPDATE transactions SET created_at = $1 WHERE id = $2", tenDaysAgo, transactionID)
+	require.NoError(t, err)
+	_, err = refundTransaction.Execute(ctx, usecase.RefundTransactionInput{TransactionID: uuid.MustParse(transactionID), Amount: 3000})
+	require.NoError(t, err)
+
+	runSettlements := usecase.NewRunSettlements(settlementRepo, time.Hour, 100, otel)
+
+	// Act
+	processed, err := runSettlements.Execute(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 3, processed)
+	closed, err := listSettlements.Execute(ctx, usecase.ListSettlementsInput{MerchantID: merchantID, Status: entity.SettlementClosedStatus})
+	require.NoError(t, err)
+	require.Len(t, closed, 1)
+	assert.Equal(t, entity.SettlementDate(tenDaysAgo), closed[0].Date())
+	assert.Equal(t, int64(10000), closed[0].Gross())
+	open, err := listSettlements.Execute(ctx, usecase.ListSettlementsInput{MerchantID: merchantID, Status: entity.SettlementOpenStatus})
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	assert.Equal(t, entity.SettlementDate(time.Now()), open[0].Date())
+	assert.Equal(t, int64(-3000), open[0].Net())
+	assert.Equal(t, 1, open[0].TransactionCount())
+}
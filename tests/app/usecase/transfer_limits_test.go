@@ -0,0 +1,177 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com.br/gibranct/simplified-wallet/internal/app/usecase"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/errs"
+	"github.com.br/gibranct/simplified-wallet/internal/domain/vo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/fx"
+	repository "github.com.br/gibranct/simplified-wallet/internal/provider/repo"
+	"github.com.br/gibranct/simplified-wallet/internal/provider/telemetry"
+	test "github.com.br/gibranct/simplified-wallet/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTransaction_Integration_TransferLimits(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	senderID, err := createTestUser(ctx, db, "limited", "common", "86395839004", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, senderID))
+	receiverID, err := createTestUser(ctx, db, "merchant", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	commonLimits, err := vo.NewTransferLimits(0, 50000, 0, 0)
+	require.NoError(t, err)
+	transferLimits, err := vo.NewTransferLimitPolicy(map[string]vo.TransferLimits{vo.CommonUserType: *commonLimits})
+	require.NoError(t, err)
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	createTransactionUseCase := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		*transferLimits,
+		vo.FeePolicy{},
+		otel,
+	)
+	input := usecase.CreateTransactionInput{
+		Amount:     30000,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+	}
+
+	// Act
+	_, firstErr := createTransactionUseCase.Execute(ctx, input)
+	_, secondErr := createTransactionUseCase.Execute(ctx, input)
+
+	// Assert
+	assert.NoError(t, firstErr)
+	var limitErr *errs.TransferLimitExceededError
+	require.ErrorAs(t, secondErr, &limitErr)
+	assert.Equal(t, vo.DailyLimitPeriod, limitErr.Period)
+	assert.Equal(t, int64(20000), limitErr.Remaining)
+
+	senderBalance, err := getBalance(ctx, db, senderID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(70000), senderBalance)
+}
+
+func TestCreateTransaction_Integration_TransferLimitsCountReservedMoney(t *testing.T) {
+	ctx := context.Background()
+	migrateVersion := uint(23) // Use the latest migration version
+
+	// Setup
+	container, db, err := test.SetupTestDatabase(ctx, migrateVersion)
+	require.NoError(t, err)
+	otel, err := telemetry.NewJaeger(context.Background(), "")
+	require.NoError(t, err)
+	defer func() {
+		err := container.Terminate(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := db.Close()
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer func() {
+		err := otel.Shutdown(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	senderID, err := createTestUser(ctx, db, "limited", "common", "86395839004", 100000)
+	require.NoError(t, err)
+	require.NoError(t, postOpeningBalance(ctx, db, senderID))
+	receiverID, err := createTestUser(ctx, db, "merchant", "merchant", "71627571000107", 0)
+	require.NoError(t, err)
+
+	commonLimits, err := vo.NewTransferLimits(0, 50000, 0, 0)
+	require.NoError(t, err)
+	transferLimits, err := vo.NewTransferLimitPolicy(map[string]vo.TransferLimits{vo.CommonUserType: *commonLimits})
+	require.NoError(t, err)
+	fxRateProvider, err := fx.NewInMemoryRateProvider(fx.DefaultRates)
+	require.NoError(t, err)
+	balanceHoldRepo := repository.NewBalanceHoldRepository(db, otel)
+	authorizeHold := usecase.NewAuthorizeHold(balanceHoldRepo, NewMockTransactionAuthorizerGateway(true), *transferLimits, time.Hour, otel)
+	captureHold := usecase.NewCaptureHold(balanceHoldRepo, vo.FeePolicy{}, otel)
+	createEscrow := usecase.NewCreateEscrow(repository.NewEscrowRepository(db, otel), NewMockTransactionAuthorizerGateway(true), *transferLimits, time.Hour, otel)
+	createTransaction := usecase.NewCreateTransaction(
+		repository.NewUserRepository(db, otel),
+		repository.NewIdempotencyKeyRepository(db, otel),
+		NewMockTransactionAuthorizerGateway(true),
+		fxRateProvider,
+		*transferLimits,
+		vo.FeePolicy{},
+		otel,
+	)
+
+	// Act
+	holdID, holdErr := authorizeHold.Execute(ctx, usecase.AuthorizeHoldInput{
+		Amount:     30000,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+	})
+	_, escrowErr := createEscrow.Execute(ctx, usecase.CreateEscrowInput{
+		Amount:     30000,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+	})
+	require.NoError(t, holdErr)
+	_, captureErr := captureHold.Execute(ctx, usecase.CaptureHoldInput{HoldID: uuid.MustParse(holdID)})
+	_, transferErr := createTransaction.Execute(ctx, usecase.CreateTransactionInput{
+		Amount:     30000,
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+	})
+
+	// Assert
+	var limitErr *errs.TransferLimitExceededError
+	require.ErrorAs(t, escrowErr, &limitErr)
+	assert.Equal(t, vo.DailyLimitPeriod, limitErr.Period)
+	assert.Equal(t, int64(20000), limitErr.Remaining)
+	require.NoError(t, captureErr)
+	require.ErrorAs(t, transferErr, &limitErr)
+	assert.Equal(t, vo.DailyLimitPeriod, limitErr.Period)
+	assert.Equal(t, int64(20000), limitErr.Remaining)
+
+	senderBalance, err := getBalance(ctx, db, senderID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(70000), senderBalance)
+}
@@ -50,6 +50,10 @@ func TestMain(m *testing.M) {
 		}
 	}()
 
+	err = os.Setenv("FUNDING_USE_FAKE_GATEWAY", "true")
+	if err != nil {
+		panic(err)
+	}
 	r := router.InitRoutes(otel)
 
 	server = httptest.NewServer(r)